@@ -205,6 +205,39 @@ app: {{$name}}
 {{- end -}}
 {{- end -}}
 
+{{- define "karmada.multiclusterDNS.labels" -}}
+{{ $name :=  include "karmada.name" . }}
+{{- if .Values.multiclusterDNS.labels -}}
+{{- range $key, $value := .Values.multiclusterDNS.labels }}
+{{ $key }}: {{ $value }}
+{{- end -}}
+{{- else -}}
+app: {{$name}}
+{{- end -}}
+{{- include "karmada.commonLabels" . -}}
+{{- end -}}
+
+{{- define "karmada.multiclusterDNS.podLabels" -}}
+{{- if .Values.multiclusterDNS.podLabels }}
+{{- range $key, $value := .Values.multiclusterDNS.podLabels }}
+{{ $key }}: {{ $value }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
+{{- define "karmada.multiclusterDNS.kubeconfig.volume" -}}
+{{ $name :=  include "karmada.name" . }}
+{{- if eq .Values.installMode "host" -}}
+- name: kubeconfig-secret
+  secret:
+    secretName: {{ $name }}-kubeconfig
+{{- else -}}
+- name: kubeconfig-secret
+  secret:
+    secretName: {{ .Values.multiclusterDNS.kubeconfig }}
+{{- end -}}
+{{- end -}}
+
 {{- define "karmada.webhook.labels" -}}
 {{ $name :=  include "karmada.name" .}}
 {{- if .Values.webhook.labels }}
@@ -448,6 +481,20 @@ Return the proper Docker Image Registry Secret Names
 {{ include "common.images.pullSecrets" (dict "images" (list .Values.descheduler.image) "global" .Values.global) }}
 {{- end -}}
 
+{{/*
+Return the proper karmada multiclusterDNS image name
+*/}}
+{{- define "karmada.multiclusterDNS.image" -}}
+{{ include "common.images.image" (dict "imageRoot" .Values.multiclusterDNS.image "global" .Values.global) }}
+{{- end -}}
+
+{{/*
+Return the proper Docker Image Registry Secret Names
+*/}}
+{{- define "karmada.multiclusterDNS.imagePullSecrets" -}}
+{{ include "common.images.pullSecrets" (dict "images" (list .Values.multiclusterDNS.image) "global" .Values.global) }}
+{{- end -}}
+
 {{/*
 Return the proper karmada schedulerEstimator image name
 */}}
@@ -564,7 +611,7 @@ Return the proper karmada kubectl image name
 Return the proper Docker Image Registry Secret Names
 */}}
 {{- define "karmada.imagePullSecrets" -}}
-{{ include "common.images.pullSecrets" (dict "images" (list .Values.cfssl.image .Values.kubectl.image .Values.etcd.internal.image .Values.agent.image .Values.apiServer.image .Values.controllerManager.image .Values.descheduler.image .Values.schedulerEstimator.image .Values.scheduler.image .Values.webhook.image .Values.aggregatedApiServer.image .Values.metricsAdapter.image .Values.search.image .Values.kubeControllerManager.image) "global" .Values.global) }}
+{{ include "common.images.pullSecrets" (dict "images" (list .Values.cfssl.image .Values.kubectl.image .Values.etcd.internal.image .Values.agent.image .Values.apiServer.image .Values.controllerManager.image .Values.descheduler.image .Values.multiclusterDNS.image .Values.schedulerEstimator.image .Values.scheduler.image .Values.webhook.image .Values.aggregatedApiServer.image .Values.metricsAdapter.image .Values.search.image .Values.kubeControllerManager.image) "global" .Values.global) }}
 {{- end -}}
 
 {{- /* 
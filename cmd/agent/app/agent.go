@@ -245,6 +245,7 @@ func run(ctx context.Context, opts *options.Options) error {
 	ctrlmetrics.Registry.MustRegister(metrics.ClusterCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.ResourceCollectorsForAgent()...)
 	ctrlmetrics.Registry.MustRegister(metrics.PoolCollectors()...)
+	ctrlmetrics.Registry.MustRegister(metrics.ResourceInterpreterCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.NewBuildInfoCollector())
 
 	if err = setupControllers(ctx, controllerManager, opts); err != nil {
@@ -54,6 +54,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/clusterdiscovery/clusterapi"
 	"github.com/karmada-io/karmada/pkg/controllers/applicationfailover"
 	"github.com/karmada-io/karmada/pkg/controllers/binding"
+	"github.com/karmada-io/karmada/pkg/controllers/bindinghistory"
 	"github.com/karmada-io/karmada/pkg/controllers/certificate/approver"
 	"github.com/karmada-io/karmada/pkg/controllers/cluster"
 	controllerscontext "github.com/karmada-io/karmada/pkg/controllers/context"
@@ -66,6 +67,7 @@ import (
 	"github.com/karmada-io/karmada/pkg/controllers/gracefuleviction"
 	"github.com/karmada-io/karmada/pkg/controllers/hpascaletargetmarker"
 	"github.com/karmada-io/karmada/pkg/controllers/mcs"
+	"github.com/karmada-io/karmada/pkg/controllers/multiclusteringress"
 	"github.com/karmada-io/karmada/pkg/controllers/multiclusterservice"
 	"github.com/karmada-io/karmada/pkg/controllers/namespace"
 	"github.com/karmada-io/karmada/pkg/controllers/remediation"
@@ -217,6 +219,7 @@ func Run(ctx context.Context, opts *options.Options) error {
 	ctrlmetrics.Registry.MustRegister(metrics.ClusterCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.ResourceCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.PoolCollectors()...)
+	ctrlmetrics.Registry.MustRegister(metrics.ResourceInterpreterCollectors()...)
 	ctrlmetrics.Registry.MustRegister(metrics.NewBuildInfoCollector())
 
 	setupControllers(ctx, controllerManager, opts)
@@ -234,7 +237,7 @@ func Run(ctx context.Context, opts *options.Options) error {
 var controllers = make(controllerscontext.Initializers)
 
 // controllersDisabledByDefault is the set of controllers which is disabled by default
-var controllersDisabledByDefault = sets.New("hpaScaleTargetMarker", "deploymentReplicasSyncer")
+var controllersDisabledByDefault = sets.New("hpaScaleTargetMarker", "deploymentReplicasSyncer", "multiclusteringress", "bindingHistory")
 
 func init() {
 	controllers["cluster"] = startClusterController
@@ -258,12 +261,14 @@ func init() {
 	controllers["hpaScaleTargetMarker"] = startHPAScaleTargetMarkerController
 	controllers["deploymentReplicasSyncer"] = startDeploymentReplicasSyncerController
 	controllers["multiclusterservice"] = startMCSController
+	controllers["multiclusteringress"] = startMCIController
 	controllers["endpointsliceCollect"] = startEndpointSliceCollectController
 	controllers["endpointsliceDispatch"] = startEndpointSliceDispatchController
 	controllers["remedy"] = startRemedyController
 	controllers["workloadRebalancer"] = startWorkloadRebalancerController
 	controllers["agentcsrapproving"] = startAgentCSRApprovingController
 	controllers["clustertaintpolicy"] = startClusterTaintPolicyController
+	controllers["bindingHistory"] = startBindingHistoryController
 }
 
 func startClusterController(ctx controllerscontext.Context) (enabled bool, err error) {
@@ -787,6 +792,45 @@ func startMCSController(ctx controllerscontext.Context) (enabled bool, err error
 	return true, nil
 }
 
+func startMCIController(ctx controllerscontext.Context) (enabled bool, err error) {
+	mciController := &multiclusteringress.MCIController{
+		Client:             ctx.Mgr.GetClient(),
+		EventRecorder:      ctx.Mgr.GetEventRecorderFor(multiclusteringress.ControllerName), //nolint:staticcheck // Note: GetEventRecorderFor is deprecated in controller-runtime v0.23.0 in favor of GetEventRecorder. This changes event API from v1 events to events.k8s.io. We need to migrate carefully, especially considering the impact on users and RBAC permission changes in installation/deployment tools.
+		RateLimiterOptions: ctx.Opts.RateLimiterOptions,
+	}
+	if err = mciController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func startBindingHistoryController(ctx controllerscontext.Context) (enabled bool, err error) {
+	bindingHistoryController := &bindinghistory.Controller{
+		Client:               ctx.Mgr.GetClient(),
+		DynamicClient:        ctx.DynamicClientSet,
+		InformerManager:      ctx.ControlPlaneInformerManager,
+		RESTMapper:           ctx.Mgr.GetRESTMapper(),
+		RevisionHistoryLimit: ctx.Opts.BindingRevisionHistoryLimit,
+		RateLimiterOptions:   ctx.Opts.RateLimiterOptions,
+	}
+	if err = bindingHistoryController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+
+	clusterBindingHistoryController := &bindinghistory.ClusterResourceBindingController{
+		Client:               ctx.Mgr.GetClient(),
+		DynamicClient:        ctx.DynamicClientSet,
+		InformerManager:      ctx.ControlPlaneInformerManager,
+		RESTMapper:           ctx.Mgr.GetRESTMapper(),
+		RevisionHistoryLimit: ctx.Opts.BindingRevisionHistoryLimit,
+		RateLimiterOptions:   ctx.Opts.RateLimiterOptions,
+	}
+	if err = clusterBindingHistoryController.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func startRemedyController(ctx controllerscontext.Context) (enabled bool, err error) {
 	c := &remediation.RemedyController{
 		Client:           ctx.Mgr.GetClient(),
@@ -926,6 +970,7 @@ func setupControllers(ctx context.Context, mgr controllerruntime.Manager, opts *
 			HPAControllerConfiguration:    opts.HPAControllerConfiguration,
 			FederatedResourceQuotaOptions: opts.FederatedResourceQuotaOptions,
 			ClusterFailoverConfiguration:  opts.ClusterFailoverOptions,
+			BindingRevisionHistoryLimit:   opts.BindingRevisionHistoryLimit,
 		},
 		Context:                     ctx,
 		DynamicClientSet:            dynamicClientSet,
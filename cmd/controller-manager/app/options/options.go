@@ -142,6 +142,8 @@ type Options struct {
 	FederatedResourceQuotaOptions FederatedResourceQuotaOptions
 	// ClusterFailoverOptions holds the cluster failover configurations.
 	ClusterFailoverOptions ClusterFailoverOptions
+	// BindingRevisionHistoryLimit is the number of old revisions to retain for each ResourceBinding and ClusterResourceBinding.
+	BindingRevisionHistoryLimit int
 }
 
 // NewOptions builds an empty options.
@@ -218,6 +220,7 @@ func (o *Options) AddFlags(flags *pflag.FlagSet, allControllers, disabledByDefau
 	flags.BoolVar(&o.EnableClusterResourceModeling, "enable-cluster-resource-modeling", true, "Enable means controller would build resource modeling for each cluster by syncing Nodes and Pods resources.\n"+
 		"The resource modeling might be used by the scheduler to make scheduling decisions in scenario of dynamic replica assignment based on cluster free resources.\n"+
 		"Disable if it does not fit your cases for better performance.")
+	flags.IntVar(&o.BindingRevisionHistoryLimit, "binding-revision-history-limit", 10, "The number of old revisions to retain for each ResourceBinding and ClusterResourceBinding, which could be rolled back to. Only takes effect when the bindingHistory controller is enabled.")
 
 	o.RateLimiterOpts.AddFlags(flags)
 	o.ProfileOpts.AddFlags(flags)
@@ -94,6 +94,12 @@ func TestValidateControllerManagerConfiguration(t *testing.T) {
 			}),
 			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("ClusterStartupGracePeriod"), metav1.Duration{Duration: 0 * time.Second}, "must be greater than 0")},
 		},
+		"invalid BindingRevisionHistoryLimit": {
+			opt: New(func(options *Options) {
+				options.BindingRevisionHistoryLimit = -1
+			}),
+			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("BindingRevisionHistoryLimit"), -1, "must be greater than or equal to 0")},
+		},
 		"invalid ClusterFailoverOptions": {
 			opt: New(func(options *Options) {
 				options.ClusterFailoverOptions.EnableNoExecuteTaintEviction = true
@@ -52,6 +52,12 @@ type Options struct {
 
 	// SchedulerEstimatorTimeout specifies the timeout period of calling the accurate scheduler estimator service.
 	SchedulerEstimatorTimeout metav1.Duration
+	// SchedulerEstimatorBatchWindow specifies the window within which the estimation requests towards the same cluster
+	// are coalesced into one batch request. Zero means batching is disabled.
+	// When batching is enabled, up to SchedulerEstimatorMaxBatchSize bindings are descheduled concurrently.
+	SchedulerEstimatorBatchWindow metav1.Duration
+	// SchedulerEstimatorMaxBatchSize specifies the maximum number of estimation requests in one batch request.
+	SchedulerEstimatorMaxBatchSize int
 	// SchedulerEstimatorServiceNamespace specifies the namespace to be used for discovering scheduler estimator services.
 	SchedulerEstimatorServiceNamespace string
 	// SchedulerEstimatorServicePrefix presents the prefix of the accurate scheduler estimator service name.
@@ -123,6 +129,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.Float32Var(&o.KubeAPIQPS, "kube-api-qps", 40.0, "QPS to use while talking with karmada-apiserver.")
 	fs.IntVar(&o.KubeAPIBurst, "kube-api-burst", 60, "Burst to use while talking with karmada-apiserver.")
 	fs.DurationVar(&o.SchedulerEstimatorTimeout.Duration, "scheduler-estimator-timeout", 3*time.Second, "Specifies the timeout period of calling the scheduler estimator service.")
+	fs.DurationVar(&o.SchedulerEstimatorBatchWindow.Duration, "scheduler-estimator-batch-window", 0, "The window within which the estimation requests towards the same cluster are coalesced into one batch request to the scheduler estimator. Zero means batching is disabled. When it is greater than 0, up to scheduler-estimator-max-batch-size bindings are descheduled concurrently so that their requests are able to be coalesced.")
+	fs.IntVar(&o.SchedulerEstimatorMaxBatchSize, "scheduler-estimator-max-batch-size", 100, "The maximum number of estimation requests in one batch request to the scheduler estimator, which takes effect only when scheduler-estimator-batch-window is greater than 0.")
 	fs.IntVar(&o.SchedulerEstimatorPort, "scheduler-estimator-port", defaultEstimatorPort, "The secure port on which to connect the accurate scheduler estimator.")
 	fs.StringVar(&o.SchedulerEstimatorCertFile, "scheduler-estimator-cert-file", "", "SSL certification file used to secure scheduler estimator communication.")
 	fs.StringVar(&o.SchedulerEstimatorKeyFile, "scheduler-estimator-key-file", "", "SSL key file used to secure scheduler estimator communication.")
@@ -32,6 +32,12 @@ func (o *Options) Validate() field.ErrorList {
 	if o.SchedulerEstimatorTimeout.Duration < 0 {
 		errs = append(errs, field.Invalid(newPath.Child("SchedulerEstimatorTimeout"), o.SchedulerEstimatorTimeout, "must be greater than or equal to 0"))
 	}
+	if o.SchedulerEstimatorBatchWindow.Duration < 0 {
+		errs = append(errs, field.Invalid(newPath.Child("SchedulerEstimatorBatchWindow"), o.SchedulerEstimatorBatchWindow, "must be greater than or equal to 0"))
+	}
+	if o.SchedulerEstimatorBatchWindow.Duration > 0 && o.SchedulerEstimatorMaxBatchSize <= 0 {
+		errs = append(errs, field.Invalid(newPath.Child("SchedulerEstimatorMaxBatchSize"), o.SchedulerEstimatorMaxBatchSize, "must be greater than 0"))
+	}
 
 	if o.DeschedulingInterval.Duration < 0 {
 		errs = append(errs, field.Invalid(newPath.Child("DeschedulingInterval"), o.DeschedulingInterval, "must be greater than or equal to 0"))
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/component-base/logs"
+	logsv1 "k8s.io/component-base/logs/api/v1"
+	"k8s.io/component-base/term"
+	"k8s.io/klog/v2"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/karmada-io/karmada/cmd/multicluster-dns/app/options"
+	"github.com/karmada-io/karmada/pkg/features"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadainformers "github.com/karmada-io/karmada/pkg/generated/informers/externalversions"
+	versionmetrics "github.com/karmada-io/karmada/pkg/metrics"
+	"github.com/karmada-io/karmada/pkg/multiclusterdns"
+	"github.com/karmada-io/karmada/pkg/sharedcli"
+	"github.com/karmada-io/karmada/pkg/sharedcli/klogflag"
+	"github.com/karmada-io/karmada/pkg/sharedcli/profileflag"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/version"
+	"github.com/karmada-io/karmada/pkg/version/sharedcommand"
+)
+
+const (
+	// ReadHeaderTimeout is the amount of time allowed to read
+	// request headers.
+	// HTTP timeouts are necessary to expire inactive connections
+	// and failing to do so might make the application vulnerable
+	// to attacks like slowloris which work by sending data very slow,
+	// which in case of no timeout will keep the connection active
+	// eventually leading to a denial-of-service (DoS) attack.
+	// References:
+	// - https://en.wikipedia.org/wiki/Slowloris_(computer_security)
+	ReadHeaderTimeout = 32 * time.Second
+	// WriteTimeout is the amount of time allowed to write the
+	// request data.
+	WriteTimeout = 5 * time.Minute
+	// ReadTimeout is the amount of time allowed to read
+	// response data.
+	ReadTimeout = 5 * time.Minute
+)
+
+// NewMultiClusterDNSCommand creates a *cobra.Command object with default parameters
+func NewMultiClusterDNSCommand(ctx context.Context) *cobra.Command {
+	logConfig := logsv1.NewLoggingConfiguration()
+	fss := cliflag.NamedFlagSets{}
+
+	logsFlagSet := fss.FlagSet("logs")
+	logs.AddFlags(logsFlagSet, logs.SkipLoggingConfigurationFlags())
+	logsv1.AddFlags(logConfig, logsFlagSet)
+	klogflag.Add(logsFlagSet)
+
+	genericFlagSet := fss.FlagSet("generic")
+	opts := options.NewOptions()
+	opts.AddFlags(genericFlagSet)
+
+	cmd := &cobra.Command{
+		Use: names.KarmadaMultiClusterDNSComponentName,
+		Long: `The karmada-multicluster-dns answers the DNS queries of '<service>.<namespace>.svc.clusterset.local'
+with the healthy endpoints collected from member clusters for ServiceImports and MultiClusterServices.`,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			if err := logsv1.ValidateAndApply(logConfig, features.FeatureGate); err != nil {
+				return err
+			}
+			logs.InitLogs()
+			return nil
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			// validate options
+			if errs := opts.Validate(); len(errs) != 0 {
+				return errs.ToAggregate()
+			}
+			if err := run(ctx, opts); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(sharedcommand.NewCmdVersion(names.KarmadaMultiClusterDNSComponentName))
+	cmd.Flags().AddFlagSet(genericFlagSet)
+	cmd.Flags().AddFlagSet(logsFlagSet)
+
+	cols, _, _ := term.TerminalSize(cmd.OutOrStdout())
+	sharedcli.SetUsageAndHelpFunc(cmd, fss, cols)
+	return cmd
+}
+
+func run(ctx context.Context, opts *options.Options) error {
+	klog.Infof("karmada-multicluster-dns version: %s", version.Get())
+
+	ctrlmetrics.Registry.MustRegister(versionmetrics.NewBuildInfoCollector())
+
+	profileflag.ListenAndServe(opts.ProfileOpts)
+
+	restConfig, err := clientcmd.BuildConfigFromFlags(opts.Master, opts.KubeConfig)
+	if err != nil {
+		return fmt.Errorf("error building kubeconfig: %s", err.Error())
+	}
+	restConfig.QPS, restConfig.Burst = opts.KubeAPIQPS, opts.KubeAPIBurst
+
+	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubernetes.NewForConfigOrDie(restConfig), 0,
+		informers.WithTweakListOptions(multiclusterdns.TweakEndpointSliceListOptions))
+	karmadaClient := karmadaclientset.NewForConfigOrDie(restConfig)
+	karmadaInformerFactory := karmadainformers.NewSharedInformerFactory(karmadaClient, 0)
+	workInformerFactory := karmadainformers.NewSharedInformerFactoryWithOptions(karmadaClient, 0,
+		karmadainformers.WithTweakListOptions(multiclusterdns.TweakWorkListOptions))
+
+	resolver, err := multiclusterdns.NewResolver(kubeInformerFactory.Discovery().V1().EndpointSlices(),
+		workInformerFactory.Work().V1alpha1().Works(), karmadaInformerFactory.Cluster().V1alpha1().Clusters())
+	if err != nil {
+		return err
+	}
+
+	serveHealthzAndMetrics(opts.HealthProbeBindAddress, opts.MetricsBindAddress, resolver)
+
+	kubeInformerFactory.Start(ctx.Done())
+	karmadaInformerFactory.Start(ctx.Done())
+	workInformerFactory.Start(ctx.Done())
+	if !resolver.WaitForCacheSync(ctx) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	server := multiclusterdns.NewServer(resolver, opts.Zone, opts.TTL)
+	if err = server.ListenAndServe(ctx, opts.BindAddress); err != nil {
+		klog.Errorf("Multi-cluster DNS server exits unexpectedly: %v", err)
+		return err
+	}
+	return nil
+}
+
+func serveHealthzAndMetrics(healthProbeBindAddress, metricsBindAddress string, resolver *multiclusterdns.Resolver) {
+	healthz := healthzHandler(resolver)
+	if healthProbeBindAddress == metricsBindAddress {
+		if healthProbeBindAddress != "0" {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/healthz", healthz)
+			mux.Handle("/metrics", metricsHandler())
+			go serveHTTP(healthProbeBindAddress, mux, "healthz and metrics")
+		}
+	} else {
+		if healthProbeBindAddress != "0" {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/healthz", healthz)
+			go serveHTTP(healthProbeBindAddress, mux, "healthz")
+		}
+		if metricsBindAddress != "0" {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metricsHandler())
+			go serveHTTP(metricsBindAddress, mux, "metrics")
+		}
+	}
+}
+
+// healthzHandler reports healthy only after the caches used to answer the queries have been synced.
+func healthzHandler(resolver *multiclusterdns.Resolver) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if !resolver.HasSynced() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("caches not synced"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	}
+}
+
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.HTTPErrorOnError,
+	})
+}
+
+func serveHTTP(address string, handler http.Handler, name string) {
+	httpServer := &http.Server{
+		Addr:              address,
+		Handler:           handler,
+		ReadHeaderTimeout: ReadHeaderTimeout,
+		WriteTimeout:      WriteTimeout,
+		ReadTimeout:       ReadTimeout,
+	}
+
+	klog.Infof("Starting %s server on %s", name, address)
+	if err := httpServer.ListenAndServe(); err != nil {
+		klog.Errorf("Failed to serve %s on %s: %v", name, address, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/karmada-io/karmada/pkg/multiclusterdns"
+	"github.com/karmada-io/karmada/pkg/sharedcli/profileflag"
+)
+
+const (
+	defaultBindAddress = ":53"
+	defaultTTL         = 5 * time.Second
+)
+
+// Options contains everything necessary to create and run multicluster-dns.
+type Options struct {
+	KubeConfig string
+	Master     string
+	// KubeAPIQPS is the QPS to use while talking with karmada-apiserver.
+	KubeAPIQPS float32
+	// KubeAPIBurst is the burst to allow while talking with karmada-apiserver.
+	KubeAPIBurst int
+	// BindAddress is the address on which to serve DNS over both UDP and TCP.
+	BindAddress string
+	// Zone is the DNS zone served, names are resolved in form of "<service>.<namespace>.svc.<zone>".
+	Zone string
+	// TTL is the time to live of the DNS records in the answers.
+	TTL         time.Duration
+	ProfileOpts profileflag.Options
+	// MetricsBindAddress is the TCP address that the server should bind to
+	// for serving prometheus metrics.
+	// It can be set to "0" to disable the metrics serving.
+	// Defaults to ":8080".
+	MetricsBindAddress string
+	// HealthProbeBindAddress is the TCP address that the server should bind to
+	// for serving health probes
+	// It can be set to "0" to disable serving the health probe.
+	// Defaults to ":10359".
+	HealthProbeBindAddress string
+}
+
+// NewOptions builds an empty options.
+func NewOptions() *Options {
+	return &Options{}
+}
+
+// AddFlags adds flags of multicluster-dns to the specified FlagSet
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+	fs.StringVar(&o.KubeConfig, "kubeconfig", o.KubeConfig, "Path to karmada control plane kubeconfig file.")
+	fs.StringVar(&o.Master, "master", o.Master, "The address of the karmada-apiserver. Overrides any value in KubeConfig. Only required if out-of-cluster.")
+	fs.Float32Var(&o.KubeAPIQPS, "kube-api-qps", 40.0, "QPS to use while talking with karmada-apiserver.")
+	fs.IntVar(&o.KubeAPIBurst, "kube-api-burst", 60, "Burst to use while talking with karmada-apiserver.")
+	fs.StringVar(&o.BindAddress, "bind-address", defaultBindAddress, "The address on which to serve DNS over both UDP and TCP(e.g. 127.0.0.1:53, :53).")
+	fs.StringVar(&o.Zone, "zone", multiclusterdns.DefaultZone, "The DNS zone to serve, names are resolved in form of '<service>.<namespace>.svc.<zone>'.")
+	fs.DurationVar(&o.TTL, "ttl", defaultTTL, "The time to live of the DNS records in the answers.")
+	fs.StringVar(&o.MetricsBindAddress, "metrics-bind-address", ":8080", "The TCP address that the server should bind to for serving prometheus metrics(e.g. 127.0.0.1:8080, :8080). It can be set to \"0\" to disable the metrics serving. Defaults to 0.0.0.0:8080.")
+	fs.StringVar(&o.HealthProbeBindAddress, "health-probe-bind-address", ":10359", "The TCP address that the server should bind to for serving health probes(e.g. 127.0.0.1:10359, :10359). It can be set to \"0\" to disable serving the health probe. Defaults to 0.0.0.0:10359.")
+
+	o.ProfileOpts.AddFlags(fs)
+}
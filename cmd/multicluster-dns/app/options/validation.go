@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks Options and return a slice of found errs.
+func (o *Options) Validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	newPath := field.NewPath("Options")
+	if _, _, err := net.SplitHostPort(o.BindAddress); err != nil {
+		errs = append(errs, field.Invalid(newPath.Child("BindAddress"), o.BindAddress, err.Error()))
+	}
+
+	zone := strings.TrimSuffix(o.Zone, ".")
+	for _, msg := range validation.IsDNS1123Subdomain(strings.ToLower(zone)) {
+		errs = append(errs, field.Invalid(newPath.Child("Zone"), o.Zone, msg))
+	}
+
+	if o.TTL < 0 {
+		errs = append(errs, field.Invalid(newPath.Child("TTL"), o.TTL, "must be greater than or equal to 0"))
+	}
+
+	return errs
+}
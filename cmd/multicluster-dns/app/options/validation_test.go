@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// a callback function to modify options
+type ModifyOptions func(option *Options)
+
+// New an Options with default parameters
+func New(modifyOptions ModifyOptions) Options {
+	option := Options{
+		BindAddress: ":53",
+		Zone:        "clusterset.local.",
+		TTL:         5 * time.Second,
+	}
+
+	if modifyOptions != nil {
+		modifyOptions(&option)
+	}
+	return option
+}
+
+func TestValidateMultiClusterDNS(t *testing.T) {
+	successCases := []Options{
+		New(nil),
+		New(func(option *Options) {
+			option.Zone = "ClusterSet.Local"
+			option.TTL = 0
+		}),
+	}
+	for _, successCase := range successCases {
+		if errs := successCase.Validate(); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	newPath := field.NewPath("Options")
+	testCases := map[string]struct {
+		opt          Options
+		expectedErrs field.ErrorList
+	}{
+		"invalid BindAddress": {
+			opt: New(func(option *Options) {
+				option.BindAddress = "53"
+			}),
+			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("BindAddress"), "53", "address 53: missing port in address")},
+		},
+		"invalid Zone": {
+			opt: New(func(option *Options) {
+				option.Zone = "cluster_set.local"
+			}),
+			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("Zone"), "cluster_set.local", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')")},
+		},
+		"invalid TTL": {
+			opt: New(func(option *Options) {
+				option.TTL = -time.Second
+			}),
+			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("TTL"), -time.Second, "must be greater than or equal to 0")},
+		},
+	}
+	for _, testCase := range testCases {
+		errs := testCase.opt.Validate()
+		if len(testCase.expectedErrs) != len(errs) {
+			t.Fatalf("Expected %d errors, got %d errors: %v", len(testCase.expectedErrs), len(errs), errs)
+		}
+		for i, err := range errs {
+			if err.Error() != testCase.expectedErrs[i].Error() {
+				t.Fatalf("Expected error: %s, got %s", testCase.expectedErrs[i], err.Error())
+			}
+		}
+	}
+}
@@ -73,6 +73,12 @@ type Options struct {
 	DisableSchedulerEstimatorInPullMode bool
 	// SchedulerEstimatorTimeout specifies the timeout period of calling the accurate scheduler estimator service.
 	SchedulerEstimatorTimeout metav1.Duration
+	// SchedulerEstimatorBatchWindow specifies the window within which the estimation requests towards the same cluster
+	// are coalesced into one batch request. Zero means batching is disabled.
+	// When batching is enabled, up to SchedulerEstimatorMaxBatchSize bindings are scheduled concurrently.
+	SchedulerEstimatorBatchWindow metav1.Duration
+	// SchedulerEstimatorMaxBatchSize specifies the maximum number of estimation requests in one batch request.
+	SchedulerEstimatorMaxBatchSize int
 	// SchedulerEstimatorServiceNamespace specifies the namespace to be used for discovering scheduler estimator services.
 	SchedulerEstimatorServiceNamespace string
 	// SchedulerEstimatorServicePrefix presents the prefix of the accurate scheduler estimator service name.
@@ -152,6 +158,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&o.EnableSchedulerEstimator, "enable-scheduler-estimator", false, "Enable calling cluster scheduler estimator for adjusting replicas.")
 	fs.BoolVar(&o.DisableSchedulerEstimatorInPullMode, "disable-scheduler-estimator-in-pull-mode", false, "Disable the scheduler estimator for clusters in pull mode, which takes effect only when enable-scheduler-estimator is true.")
 	fs.DurationVar(&o.SchedulerEstimatorTimeout.Duration, "scheduler-estimator-timeout", 3*time.Second, "Specifies the timeout period of calling the scheduler estimator service.")
+	fs.DurationVar(&o.SchedulerEstimatorBatchWindow.Duration, "scheduler-estimator-batch-window", 0, "The window within which the estimation requests towards the same cluster are coalesced into one batch request to the scheduler estimator. Zero means batching is disabled. When it is greater than 0, up to scheduler-estimator-max-batch-size bindings are scheduled concurrently so that their requests are able to be coalesced.")
+	fs.IntVar(&o.SchedulerEstimatorMaxBatchSize, "scheduler-estimator-max-batch-size", 100, "The maximum number of estimation requests in one batch request to the scheduler estimator, which takes effect only when scheduler-estimator-batch-window is greater than 0.")
 	fs.StringVar(&o.SchedulerEstimatorServiceNamespace, "scheduler-estimator-service-namespace", names.NamespaceKarmadaSystem, "The namespace to be used for discovering scheduler estimator services.")
 	fs.StringVar(&o.SchedulerEstimatorServicePrefix, "scheduler-estimator-service-prefix", names.KarmadaSchedulerEstimatorComponentName, "The prefix of scheduler estimator service name")
 	fs.IntVar(&o.SchedulerEstimatorPort, "scheduler-estimator-port", defaultEstimatorPort, "The secure port on which to connect the accurate scheduler estimator.")
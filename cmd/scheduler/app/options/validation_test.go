@@ -89,6 +89,19 @@ func TestValidateKarmadaSchedulerConfiguration(t *testing.T) {
 			}),
 			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("SchedulerEstimatorTimeout"), metav1.Duration{Duration: -1 * time.Second}, "must be greater than or equal to 0")},
 		},
+		"invalid SchedulerEstimatorBatchWindow": {
+			opt: New(func(option *Options) {
+				option.SchedulerEstimatorBatchWindow = metav1.Duration{Duration: -1 * time.Second}
+			}),
+			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("SchedulerEstimatorBatchWindow"), metav1.Duration{Duration: -1 * time.Second}, "must be greater than or equal to 0")},
+		},
+		"invalid SchedulerEstimatorMaxBatchSize": {
+			opt: New(func(option *Options) {
+				option.SchedulerEstimatorBatchWindow = metav1.Duration{Duration: 10 * time.Millisecond}
+				option.SchedulerEstimatorMaxBatchSize = 0
+			}),
+			expectedErrs: field.ErrorList{field.Invalid(newPath.Child("SchedulerEstimatorMaxBatchSize"), 0, "must be greater than 0")},
+		},
 		"invalid SchedulerName": {
 			opt: New(func(option *Options) {
 				option.SchedulerName = ""
@@ -63,6 +63,8 @@ func (e *workloadInterpreter) Handle(_ context.Context, req interpreter.Request)
 		return e.responseWithExploreAggregateStatus(workload, req)
 	case configv1alpha1.InterpreterOperationInterpretHealth:
 		return e.responseWithExploreInterpretHealth(workload)
+	case configv1alpha1.InterpreterOperationInterpretRolloutStatus:
+		return e.responseWithExploreInterpretRolloutStatus(workload)
 	case configv1alpha1.InterpreterOperationInterpretStatus:
 		return e.responseWithExploreInterpretStatus(workload)
 	case configv1alpha1.InterpreterOperationInterpretDependency:
@@ -173,6 +175,21 @@ func (e *workloadInterpreter) responseWithExploreInterpretHealth(workload *workl
 	return res
 }
 
+func (e *workloadInterpreter) responseWithExploreInterpretRolloutStatus(workload *workloadv1alpha1.Workload) interpreter.Response {
+	rolloutStatus := &workv1alpha2.RolloutStatus{
+		State:         workv1alpha2.RolloutProgressing,
+		Generation:    workload.Generation,
+		ReadyReplicas: &workload.Status.ReadyReplicas,
+	}
+	if workload.Status.ReadyReplicas == *workload.Spec.Replicas {
+		rolloutStatus.State = workv1alpha2.RolloutDone
+	}
+
+	res := interpreter.Succeeded("")
+	res.RolloutStatus = rolloutStatus
+	return res
+}
+
 func (e *workloadInterpreter) responseWithExploreInterpretStatus(workload *workloadv1alpha1.Workload) interpreter.Response {
 	status := workloadv1alpha1.WorkloadStatus{
 		ReadyReplicas: workload.Status.ReadyReplicas,
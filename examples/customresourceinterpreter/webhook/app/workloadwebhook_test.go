@@ -194,6 +194,11 @@ func TestWorkloadInterpreter_Handle(t *testing.T) {
 			operation:      configv1alpha1.InterpreterOperationInterpretHealth,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "InterpretRolloutStatus operation",
+			operation:      configv1alpha1.InterpreterOperationInterpretRolloutStatus,
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "InterpretStatus operation",
 			operation:      configv1alpha1.InterpreterOperationInterpretStatus,
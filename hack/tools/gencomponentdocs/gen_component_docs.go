@@ -30,6 +30,7 @@ import (
 	deschapp "github.com/karmada-io/karmada/cmd/descheduler/app"
 	searchapp "github.com/karmada-io/karmada/cmd/karmada-search/app"
 	adapterapp "github.com/karmada-io/karmada/cmd/metrics-adapter/app"
+	dnsapp "github.com/karmada-io/karmada/cmd/multicluster-dns/app"
 	estiapp "github.com/karmada-io/karmada/cmd/scheduler-estimator/app"
 	schapp "github.com/karmada-io/karmada/cmd/scheduler/app"
 	webhookapp "github.com/karmada-io/karmada/cmd/webhook/app"
@@ -125,6 +126,9 @@ func generateCMDs(module string) ([]*cobra.Command, error) {
 	case names.KarmadaMetricsAdapterComponentName:
 		// generate docs for karmada-metrics-adapter
 		cmds = append(cmds, adapterapp.NewMetricsAdapterCommand(context.TODO()))
+	case names.KarmadaMultiClusterDNSComponentName:
+		// generate docs for karmada-multicluster-dns
+		cmds = append(cmds, dnsapp.NewMultiClusterDNSCommand(context.TODO()))
 	case "all":
 		cmds = append(cmds, cmapp.NewControllerManagerCommand(context.TODO()))
 		cmds = append(cmds, schapp.NewSchedulerCommand(context.TODO()))
@@ -135,6 +139,7 @@ func generateCMDs(module string) ([]*cobra.Command, error) {
 		cmds = append(cmds, estiapp.NewSchedulerEstimatorCommand(context.TODO()))
 		cmds = append(cmds, webhookapp.NewWebhookCommand(context.TODO()))
 		cmds = append(cmds, adapterapp.NewMetricsAdapterCommand(context.TODO()))
+		cmds = append(cmds, dnsapp.NewMultiClusterDNSCommand(context.TODO()))
 	default:
 		return nil, fmt.Errorf("module %s is not supported", module)
 	}
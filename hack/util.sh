@@ -63,6 +63,7 @@ KARMADA_TARGET_SOURCE=(
   karmada-search=cmd/karmada-search
   karmada-operator=operator/cmd/operator
   karmada-metrics-adapter=cmd/metrics-adapter
+  karmada-multicluster-dns=cmd/multicluster-dns
 )
 
 #https://textkool.com/en/ascii-art-generator?hl=default&vl=default&font=DOS%20Rebel&text=KARMADA
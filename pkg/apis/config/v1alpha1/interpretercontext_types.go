@@ -37,6 +37,17 @@ type ResourceInterpreterContext struct {
 	// Response describes the attributes for the interpreter response.
 	// +optional
 	Response *ResourceInterpreterResponse `json:"response,omitempty"`
+
+	// Requests describes multiple interpreter requests sent in one call.
+	// It is only used for webhooks which set MaxBatchSize, in which case Request is not set.
+	// All requests in a batch have the same operation.
+	// +optional
+	Requests []ResourceInterpreterRequest `json:"requests,omitempty"`
+
+	// Responses describes the responses for Requests. Each response is correlated to
+	// the request by UID, and must be present for every request.
+	// +optional
+	Responses []ResourceInterpreterResponse `json:"responses,omitempty"`
 }
 
 // ResourceInterpreterRequest describes the interpreter.Attributes for the interpreter request.
@@ -140,6 +151,11 @@ type ResourceInterpreterResponse struct {
 	// Healthy represents the referencing object's healthy status.
 	// +optional
 	Healthy *bool `json:"healthy,omitempty"`
+
+	// RolloutStatus represents the referencing object's rollout progress.
+	// Required if InterpreterOperation is InterpreterOperationInterpretRolloutStatus.
+	// +optional
+	RolloutStatus *workv1alpha2.RolloutStatus `json:"rolloutStatus,omitempty"`
 }
 
 // RequestStatus holds the status of a request.
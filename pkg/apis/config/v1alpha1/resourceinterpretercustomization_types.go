@@ -137,6 +137,13 @@ type CustomizationRules struct {
 	// +optional
 	HealthInterpretation *HealthInterpretation `json:"healthInterpretation,omitempty"`
 
+	// RolloutStatusInterpretation describes the rules by which Karmada can assess
+	// whether the resource has finished rolling out its latest generation.
+	// Karmada provides built-in rules for Deployment, StatefulSet, DaemonSet and Job.
+	// If RolloutStatusInterpretation is set, the built-in rules will be ignored.
+	// +optional
+	RolloutStatusInterpretation *RolloutStatusInterpretation `json:"rolloutStatusInterpretation,omitempty"`
+
 	// DependencyInterpretation describes the rules for Karmada to analyze the
 	// dependent resources.
 	// Karmada provides built-in rules for several standard Kubernetes types, see:
@@ -144,6 +151,35 @@ type CustomizationRules struct {
 	// If DependencyInterpretation is set, the built-in rules will be ignored.
 	// +optional
 	DependencyInterpretation *DependencyInterpretation `json:"dependencyInterpretation,omitempty"`
+
+	// LuaScriptLimits describes the resource limits of running the Lua scripts
+	// of the rules. It doesn't apply to CEL expressions.
+	// If not set, the scripts are only bound by a timeout of one second.
+	// +optional
+	LuaScriptLimits *LuaScriptLimits `json:"luaScriptLimits,omitempty"`
+}
+
+// LuaScriptLimits describes the resource limits of running Lua scripts.
+// A script exceeding any of the limits is aborted and the interpretation fails.
+// The heap memory allocated by a script, e.g. for strings and tables, is not
+// limited, since the Lua VM doesn't account for it. Only the data stack and the
+// call stack of the Lua VM are bounded, which limits the memory used by local
+// variables, arguments and nested calls of a script.
+type LuaScriptLimits struct {
+	// TimeoutMilliseconds is the maximum wall-clock time of running a script.
+	// Defaults to 1000.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutMilliseconds *int32 `json:"timeoutMilliseconds,omitempty"`
+
+	// MaxInstructions is the maximum number of Lua VM instructions executed by
+	// a script. It bounds the CPU time of a script regardless of the load of the
+	// component. It doesn't bound the memory of a script, since a single instruction,
+	// e.g. a string concatenation, allocates in proportion to the size of its operands.
+	// If not set, the number of instructions is not limited.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInstructions *int64 `json:"maxInstructions,omitempty"`
 }
 
 // LocalValueRetention holds the scripts for retention.
@@ -173,8 +209,24 @@ type LocalValueRetention struct {
 	//
 	// The returned object should be a retained configuration which will be
 	// applied to member cluster eventually.
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to retain runtime values
+	// to the desired specification. It is an alternative to LuaScript, exactly
+	// one of them should be specified.
+	//
+	// The expression should return the fields to be retained, which will be
+	// merged into the desired object as a JSON merge patch, for example:
+	//
+	// ```
+	//   celExpression: '{"spec": {"fieldFoo": observedObj.spec.fieldFoo}}'
+	// ```
+	//
+	// The variables desiredObj and observedObj are supplied by the system,
+	// which have the same meaning as the parameters of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // ReplicaResourceRequirement holds the scripts for getting the desired replicas
@@ -210,8 +262,24 @@ type ReplicaResourceRequirement struct {
 	//   - requirement: the resource required by each replica expressed with a
 	//       ResourceBindingSpec.ReplicaRequirements.
 	// The returned values will be set into a ResourceBinding or ClusterResourceBinding.
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to discover the resource's
+	// replica as well as resource requirements. It is an alternative to LuaScript,
+	// exactly one of them should be specified.
+	//
+	// The expression should return either the replica as an integer, or a map
+	// with the 'replica' and 'requires' keys, for example:
+	//
+	// ```
+	//   celExpression: 'desiredObj.spec.replicas'
+	// ```
+	//
+	// The variable desiredObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // ComponentResourceRequirement holds the scripts for extracting the desired replica count
@@ -255,8 +323,23 @@ type ComponentResourceRequirement struct {
 	// The function expects one return value:
 	//   - components: the resource requirements for each component.
 	// The returned value will be set into a ResourceBinding or ClusterResourceBinding.
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to extract the components
+	// of the resource. It is an alternative to LuaScript, exactly one of them
+	// should be specified.
+	//
+	// The expression should return a list of components, for example:
+	//
+	// ```
+	//   celExpression: '[{"name": "jobmanager", "replicas": desiredObj.spec.jobManager.replicas}]'
+	// ```
+	//
+	// The variable desiredObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // ReplicaRevision holds the scripts for revising the desired replicas.
@@ -282,8 +365,24 @@ type ReplicaRevision struct {
 	//
 	// The returned object should be a revised configuration which will be
 	// applied to member cluster eventually.
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to revise replicas in
+	// the desired specification. It is an alternative to LuaScript, exactly one
+	// of them should be specified.
+	//
+	// The expression should return the revised fields, which will be merged into
+	// the desired object as a JSON merge patch, for example:
+	//
+	// ```
+	//   celExpression: '{"spec": {"replicas": desiredReplica}}'
+	// ```
+	//
+	// The variables desiredObj and desiredReplica are supplied by the system,
+	// which have the same meaning as the parameters of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // StatusReflection holds the scripts for getting the status.
@@ -309,8 +408,23 @@ type StatusReflection struct {
 	//
 	// The returned status could be the whole status or part of it and will
 	// be set into both Work and ResourceBinding(ClusterResourceBinding).
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to get the status from
+	// the observed specification. It is an alternative to LuaScript, exactly one
+	// of them should be specified.
+	//
+	// The expression should return the status as a map, for example:
+	//
+	// ```
+	//   celExpression: '{"readyReplicas": observedObj.status.readyReplicas}'
+	// ```
+	//
+	// The variable observedObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // StatusAggregation holds the scripts for aggregating several decentralized statuses.
@@ -338,8 +452,24 @@ type StatusAggregation struct {
 	//
 	// The returned object should be a whole object with status aggregated.
 	//
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to aggregate decentralized
+	// statuses to the desired specification. It is an alternative to LuaScript,
+	// exactly one of them should be specified.
+	//
+	// The expression should return the aggregated fields, which will be merged
+	// into the desired object as a JSON merge patch, for example:
+	//
+	// ```
+	//   celExpression: '{"status": {"readyReplicas": statusItems.map(item, item.status.readyReplicas).sum()}}'
+	// ```
+	//
+	// The variables desiredObj and statusItems are supplied by the system,
+	// which have the same meaning as the parameters of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // HealthInterpretation holds the rules for interpreting the health state of a specific resource.
@@ -366,8 +496,74 @@ type HealthInterpretation struct {
 	//
 	// The returned boolean value indicates the health status.
 	//
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to assess the health
+	// state of a specific resource. It is an alternative to LuaScript, exactly
+	// one of them should be specified.
+	//
+	// The expression should return a boolean value, for example:
+	//
+	// ```
+	//   celExpression: 'observedObj.status.readyReplicas == observedObj.spec.replicas'
+	// ```
+	//
+	// The variable observedObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
+}
+
+// RolloutStatusInterpretation holds the rules for interpreting the rollout progress of a specific resource.
+type RolloutStatusInterpretation struct {
+	// LuaScript holds the Lua script that is used to interpret the rollout progress of
+	// a specific resource.
+	// The script should implement a function as follows:
+	//
+	// ```
+	//   luaScript: >
+	//       function InterpretRolloutStatus(observedObj)
+	//           local rolloutStatus = {}
+	//           rolloutStatus.generation = observedObj.metadata.generation
+	//           rolloutStatus.observedGeneration = observedObj.status.observedGeneration
+	//           rolloutStatus.updatedReplicas = observedObj.status.updatedReplicas
+	//           if observedObj.status.observedGeneration == observedObj.metadata.generation and
+	//               observedObj.status.updatedReplicas == observedObj.spec.replicas then
+	//               rolloutStatus.state = "Done"
+	//           else
+	//               rolloutStatus.state = "Progressing"
+	//           end
+	//           return rolloutStatus
+	//       end
+	// ```
+	//
+	// The content of the LuaScript needs to be a whole function including both
+	// declaration and implementation.
+	//
+	// The parameters will be supplied by the system:
+	//   - observedObj: the object represents the configuration that is observed
+	//       from a specific member cluster.
+	//
+	// The returned value should be expressed by a RolloutStatus, of which the state
+	// is one of "Done", "Progressing" and "Failed".
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to interpret the rollout
+	// progress of a specific resource. It is an alternative to LuaScript, exactly
+	// one of them should be specified.
+	//
+	// The expression should return a RolloutStatus, for example:
+	//
+	// ```
+	//   celExpression: '{"state": observedObj.status.updatedReplicas == observedObj.spec.replicas ? "Done" : "Progressing"}'
+	// ```
+	//
+	// The variable observedObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // DependencyInterpretation holds the rules for interpreting the dependent resources
@@ -402,8 +598,23 @@ type DependencyInterpretation struct {
 	//       to the member cluster.
 	//
 	// The returned value should be expressed by a slice of DependentObjectReference.
-	// +required
-	LuaScript string `json:"luaScript"`
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// CELExpression holds the CEL expression that is used to interpret the
+	// dependencies of a specific resource. It is an alternative to LuaScript,
+	// exactly one of them should be specified.
+	//
+	// The expression should return a list of dependent object references, for example:
+	//
+	// ```
+	//   celExpression: '[{"apiVersion": "v1", "kind": "ConfigMap", "namespace": desiredObj.metadata.namespace, "name": desiredObj.spec.configName}]'
+	// ```
+	//
+	// The variable desiredObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	// +optional
+	CELExpression string `json:"celExpression,omitempty"`
 }
 
 // +kubebuilder:resource:scope="Cluster"
@@ -98,6 +98,34 @@ type ResourceInterpreterWebhook struct {
 	// include any versions known to the Karmada, calls to the webhook will fail
 	// and be subject to the failure policy.
 	InterpreterContextVersions []string `json:"interpreterContextVersions"`
+
+	// MaxBatchSize is the maximum number of objects Karmada puts into one request to the webhook.
+	// When it is greater than 1, concurrent InterpretStatus and InterpretHealth requests for the
+	// same webhook are collected for a short period and sent together in the `requests` field of
+	// `ResourceInterpreterContext`, and the webhook must reply with the `responses` field.
+	// Other operations are always sent one object per request.
+	// Default to nil, which means batch requests are not sent to the webhook.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxBatchSize *int32 `json:"maxBatchSize,omitempty"`
+
+	// BatchWindowMilliseconds specifies how long Karmada collects the requests for a batch
+	// before sending it to the webhook, a batch is sent earlier once it reaches MaxBatchSize.
+	// It is only used when MaxBatchSize is greater than 1.
+	// Default to 10 milliseconds.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchWindowMilliseconds *int32 `json:"batchWindowMilliseconds,omitempty"`
+
+	// CacheTTLSeconds specifies how long Karmada reuses the results returned by the webhook.
+	// Results are cached by the UID and resourceVersion of the object and the operation, so
+	// a cached result is only reused for the same revision of an object.
+	// Only the results of InterpretStatus, InterpretHealth and InterpretRolloutStatus are cached,
+	// and the cache is dropped once the webhook configuration changes.
+	// Default to nil, which means results are not cached.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	CacheTTLSeconds *int32 `json:"cacheTTLSeconds,omitempty"`
 }
 
 // RuleWithOperations is a tuple of Operations and Resources. It is recommended to make
@@ -158,6 +186,11 @@ const (
 	// Only necessary for those resource types that have dependencies resources and expect the dependencies be propagated
 	// together, like Deployment depends on ConfigMap/Secret.
 	InterpreterOperationInterpretDependency InterpreterOperation = "InterpretDependency"
+
+	// InterpreterOperationInterpretRolloutStatus indicates that karmada want to figure out whether a specific object
+	// has finished rolling out its latest generation.
+	// Only necessary for those resource types that roll out updates progressively, like Deployment.
+	InterpreterOperationInterpretRolloutStatus InterpreterOperation = "InterpretRolloutStatus"
 )
 
 // Rule is a tuple of APIGroups, APIVersion, and Kinds.
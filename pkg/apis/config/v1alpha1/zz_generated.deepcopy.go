@@ -81,11 +81,21 @@ func (in *CustomizationRules) DeepCopyInto(out *CustomizationRules) {
 		*out = new(HealthInterpretation)
 		**out = **in
 	}
+	if in.RolloutStatusInterpretation != nil {
+		in, out := &in.RolloutStatusInterpretation, &out.RolloutStatusInterpretation
+		*out = new(RolloutStatusInterpretation)
+		**out = **in
+	}
 	if in.DependencyInterpretation != nil {
 		in, out := &in.DependencyInterpretation, &out.DependencyInterpretation
 		*out = new(DependencyInterpretation)
 		**out = **in
 	}
+	if in.LuaScriptLimits != nil {
+		in, out := &in.LuaScriptLimits, &out.LuaScriptLimits
+		*out = new(LuaScriptLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -184,6 +194,32 @@ func (in *LocalValueRetention) DeepCopy() *LocalValueRetention {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LuaScriptLimits) DeepCopyInto(out *LuaScriptLimits) {
+	*out = *in
+	if in.TimeoutMilliseconds != nil {
+		in, out := &in.TimeoutMilliseconds, &out.TimeoutMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxInstructions != nil {
+		in, out := &in.MaxInstructions, &out.MaxInstructions
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LuaScriptLimits.
+func (in *LuaScriptLimits) DeepCopy() *LuaScriptLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(LuaScriptLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaResourceRequirement) DeepCopyInto(out *ReplicaResourceRequirement) {
 	*out = *in
@@ -246,6 +282,20 @@ func (in *ResourceInterpreterContext) DeepCopyInto(out *ResourceInterpreterConte
 		*out = new(ResourceInterpreterResponse)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make([]ResourceInterpreterRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Responses != nil {
+		in, out := &in.Responses, &out.Responses
+		*out = make([]ResourceInterpreterResponse, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -432,6 +482,11 @@ func (in *ResourceInterpreterResponse) DeepCopyInto(out *ResourceInterpreterResp
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RolloutStatus != nil {
+		in, out := &in.RolloutStatus, &out.RolloutStatus
+		*out = new(v1alpha2.RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -466,6 +521,21 @@ func (in *ResourceInterpreterWebhook) DeepCopyInto(out *ResourceInterpreterWebho
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxBatchSize != nil {
+		in, out := &in.MaxBatchSize, &out.MaxBatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchWindowMilliseconds != nil {
+		in, out := &in.BatchWindowMilliseconds, &out.BatchWindowMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CacheTTLSeconds != nil {
+		in, out := &in.CacheTTLSeconds, &out.CacheTTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -545,6 +615,22 @@ func (in *ResourceInterpreterWebhookConfigurationList) DeepCopyObject() runtime.
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatusInterpretation) DeepCopyInto(out *RolloutStatusInterpretation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatusInterpretation.
+func (in *RolloutStatusInterpretation) DeepCopy() *RolloutStatusInterpretation {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatusInterpretation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	*out = *in
@@ -56,6 +56,11 @@ func (in LocalValueRetention) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.LocalValueRetention"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LuaScriptLimits) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.LuaScriptLimits"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ReplicaResourceRequirement) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ReplicaResourceRequirement"
@@ -116,6 +121,11 @@ func (in ResourceInterpreterWebhookConfigurationList) OpenAPIModelName() string
 	return "com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ResourceInterpreterWebhookConfigurationList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RolloutStatusInterpretation) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.RolloutStatusInterpretation"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Rule) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.Rule"
@@ -106,6 +106,65 @@ type MultiClusterServiceSpec struct {
 	// If leave it empty, the service will be exposed to all clusters.
 	// +optional
 	ConsumerClusters []ClusterSelector `json:"consumerClusters,omitempty"`
+
+	// TrafficPolicy describes how the traffic from the consumer clusters is
+	// routed among the provider clusters.
+	// Only valid in case of Types contains CrossCluster.
+	// If not set, the EndpointSlices of all provider clusters will be
+	// dispatched to every consumer cluster unchanged.
+	// +optional
+	TrafficPolicy *TrafficPolicy `json:"trafficPolicy,omitempty"`
+}
+
+// TrafficPolicy describes how the traffic is routed among the provider clusters.
+type TrafficPolicy struct {
+	// LocalityPreference makes the consumer clusters prefer the provider clusters
+	// located in the same region or zone as themselves.
+	// The endpoints of the provider clusters out of the locality will be dispatched
+	// to a consumer cluster only if none of the provider clusters in its locality
+	// has ready endpoints.
+	// A cluster without the region or zone set is not considered local to any
+	// other cluster.
+	// If not set, all provider clusters are treated equally.
+	// +kubebuilder:validation:Enum=Region;Zone
+	// +optional
+	LocalityPreference LocalityPreference `json:"localityPreference,omitempty"`
+
+	// ProviderWeights specifies the weights of the provider clusters.
+	// The weights are relative: the ready endpoints of the provider clusters are
+	// dispatched in numbers in proportion to the weights, so that each provider
+	// cluster receives a share of the traffic in proportion to its weight. The
+	// provider cluster with the fewest ready endpoints relative to its weight
+	// dispatches all of them, and a provider cluster with a non-zero weight
+	// dispatches at least one endpoint.
+	// The provider clusters not listed here have a weight of 100.
+	// +optional
+	ProviderWeights []ProviderWeight `json:"providerWeights,omitempty"`
+}
+
+// LocalityPreference describes the locality of the provider clusters that is preferred.
+type LocalityPreference string
+
+const (
+	// LocalityPreferenceRegion means the provider clusters in the same region are preferred.
+	LocalityPreferenceRegion LocalityPreference = "Region"
+
+	// LocalityPreferenceZone means the provider clusters in the same zone are preferred.
+	LocalityPreferenceZone LocalityPreference = "Zone"
+)
+
+// ProviderWeight describes the weight of a provider cluster.
+type ProviderWeight struct {
+	// Name is the name of the provider cluster.
+	// +required
+	Name string `json:"name"`
+
+	// Weight is the weight of the provider cluster relative to the others.
+	// A weight of 0 means that no endpoint of the provider cluster is dispatched.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +required
+	Weight int32 `json:"weight"`
 }
 
 // ClusterSelector specifies the cluster to be selected.
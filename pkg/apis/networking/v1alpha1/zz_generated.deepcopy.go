@@ -263,6 +263,11 @@ func (in *MultiClusterServiceSpec) DeepCopyInto(out *MultiClusterServiceSpec) {
 		*out = make([]ClusterSelector, len(*in))
 		copy(*out, *in)
 	}
+	if in.TrafficPolicy != nil {
+		in, out := &in.TrafficPolicy, &out.TrafficPolicy
+		*out = new(TrafficPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -276,6 +281,22 @@ func (in *MultiClusterServiceSpec) DeepCopy() *MultiClusterServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderWeight) DeepCopyInto(out *ProviderWeight) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderWeight.
+func (in *ProviderWeight) DeepCopy() *ProviderWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceLocation) DeepCopyInto(out *ServiceLocation) {
 	*out = *in
@@ -296,3 +317,24 @@ func (in *ServiceLocation) DeepCopy() *ServiceLocation {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficPolicy) DeepCopyInto(out *TrafficPolicy) {
+	*out = *in
+	if in.ProviderWeights != nil {
+		in, out := &in.ProviderWeights, &out.ProviderWeights
+		*out = make([]ProviderWeight, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicy.
+func (in *TrafficPolicy) DeepCopy() *TrafficPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -66,7 +66,17 @@ func (in MultiClusterServiceSpec) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.MultiClusterServiceSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ProviderWeight) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.ProviderWeight"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ServiceLocation) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.ServiceLocation"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TrafficPolicy) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.TrafficPolicy"
+}
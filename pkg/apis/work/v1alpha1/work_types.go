@@ -119,6 +119,11 @@ type ManifestStatus struct {
 	// +kubebuilder:validation:Enum=Healthy;Unhealthy;Unknown
 	// +optional
 	Health ResourceHealth `json:"health,omitempty"`
+
+	// RolloutStatus represents the rollout progress of the current resource.
+	// It is only set for those resources that the rollout status can be interpreted.
+	// +optional
+	RolloutStatus *RolloutStatus `json:"rolloutStatus,omitempty"`
 }
 
 // ResourceIdentifier provides the identifiers needed to interact with any arbitrary object.
@@ -179,6 +184,50 @@ const (
 	ResourceUnknown ResourceHealth = "Unknown"
 )
 
+// RolloutStatus represents the rollout progress of a resource in a member cluster.
+type RolloutStatus struct {
+	// State represents whether the resource has finished rolling out its latest generation.
+	// +kubebuilder:validation:Enum=Done;Progressing;Failed
+	// +required
+	State RolloutState `json:"state"`
+
+	// Generation is the generation of the resource in the member cluster.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// ObservedGeneration is the generation observed by the controller of the resource in the member cluster.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// UpdatedReplicas is the number of replicas that have been updated to the latest generation.
+	// +optional
+	UpdatedReplicas *int32 `json:"updatedReplicas,omitempty"`
+
+	// ReadyReplicas is the number of replicas that are ready.
+	// +optional
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the number of replicas that are available.
+	// +optional
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+
+	// Message is a human-readable message indicating details about the rollout, e.g. the reason of a failed rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RolloutState represents the state of the rollout of a resource.
+type RolloutState string
+
+const (
+	// RolloutDone represents that the latest generation of the resource has been completely rolled out.
+	RolloutDone RolloutState = "Done"
+	// RolloutProgressing represents that the latest generation of the resource is being rolled out.
+	RolloutProgressing RolloutState = "Progressing"
+	// RolloutFailed represents that the rollout of the latest generation of the resource has failed.
+	RolloutFailed RolloutState = "Failed"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // WorkList is a collection of Work.
@@ -76,6 +76,11 @@ func (in ResourceIdentifier) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.ResourceIdentifier"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RolloutStatus) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.RolloutStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in TargetCluster) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.TargetCluster"
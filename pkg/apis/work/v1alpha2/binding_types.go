@@ -18,6 +18,7 @@ package v1alpha2
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -231,6 +232,47 @@ type ReplicaRequirements struct {
 	// PriorityClassName represents the resources priorityClassName
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// DeviceRequests represents the devices required by each replica, which are
+	// allocated through Dynamic Resource Allocation in member clusters.
+	// +optional
+	DeviceRequests []DeviceRequest `json:"deviceRequests,omitempty"`
+
+	// StorageRequests represents the persistent volumes required by each replica,
+	// e.g. the volumes claimed by the volumeClaimTemplates of a StatefulSet.
+	// +optional
+	StorageRequests []StorageRequest `json:"storageRequests,omitempty"`
+}
+
+// DeviceRequest represents a request for devices of a DeviceClass.
+type DeviceRequest struct {
+	// DeviceClassName references the DeviceClass in member clusters which
+	// the devices are selected from.
+	// +required
+	DeviceClassName string `json:"deviceClassName"`
+
+	// Count is the number of devices required.
+	// If not set, one device is required.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Count int64 `json:"count,omitempty"`
+
+	// Selectors are the CEL expressions of the request which the devices must
+	// satisfy, in addition to the selectors of the DeviceClass.
+	// +optional
+	Selectors []string `json:"selectors,omitempty"`
+}
+
+// StorageRequest represents a persistent volume required by each replica.
+type StorageRequest struct {
+	// StorageClassName is the name of the StorageClass the volume is provisioned from.
+	// If not set, the volume is provisioned from the default StorageClass of member clusters.
+	// +optional
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// Storage is the size of the volume.
+	// +required
+	Storage resource.Quantity `json:"storage"`
 }
 
 // Component represents the requirements for a specific component.
@@ -264,6 +306,11 @@ type ComponentReplicaRequirements struct {
 	// PriorityClassName represents the resources priorityClassName
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// DeviceRequests represents the devices required by each replica, which are
+	// allocated through Dynamic Resource Allocation in member clusters.
+	// +optional
+	DeviceRequests []DeviceRequest `json:"deviceRequests,omitempty"`
 }
 
 // NodeClaim represents the node claim HardNodeAffinity, NodeSelector and Tolerations required by each replica.
@@ -281,6 +328,25 @@ type NodeClaim struct {
 	// If specified, the pod's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// PodLabels is the labels of the pod, which are used to evaluate HardPodAffinity, HardPodAntiAffinity
+	// and TopologySpreadConstraints against the replicas themselves.
+	// +optional
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+	// HardPodAffinity represents the inter-pod affinity terms of the pod.
+	// Note that only PodSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	// is included here because it has a hard limit on pod scheduling.
+	// +optional
+	HardPodAffinity []corev1.PodAffinityTerm `json:"hardPodAffinity,omitempty"`
+	// HardPodAntiAffinity represents the inter-pod anti-affinity terms of the pod.
+	// Note that only PodSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	// is included here because it has a hard limit on pod scheduling.
+	// +optional
+	HardPodAntiAffinity []corev1.PodAffinityTerm `json:"hardPodAntiAffinity,omitempty"`
+	// TopologySpreadConstraints represents the topology spread constraints of the pod.
+	// Note that only the constraints with WhenUnsatisfiable DoNotSchedule are included here
+	// because they have a hard limit on pod scheduling.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // TargetCluster represents the identifier of a member cluster.
@@ -492,8 +558,57 @@ type AggregatedStatusItem struct {
 	// +kubebuilder:validation:Enum=Healthy;Unhealthy;Unknown
 	// +optional
 	Health ResourceHealth `json:"health,omitempty"`
+
+	// RolloutStatus represents the rollout progress of the current resource.
+	// It is only set for those resources that the rollout status can be interpreted.
+	// +optional
+	RolloutStatus *RolloutStatus `json:"rolloutStatus,omitempty"`
 }
 
+// RolloutStatus represents the rollout progress of a resource in a member cluster.
+type RolloutStatus struct {
+	// State represents whether the resource has finished rolling out its latest generation.
+	// +kubebuilder:validation:Enum=Done;Progressing;Failed
+	// +required
+	State RolloutState `json:"state"`
+
+	// Generation is the generation of the resource in the member cluster.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// ObservedGeneration is the generation observed by the controller of the resource in the member cluster.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// UpdatedReplicas is the number of replicas that have been updated to the latest generation.
+	// +optional
+	UpdatedReplicas *int32 `json:"updatedReplicas,omitempty"`
+
+	// ReadyReplicas is the number of replicas that are ready.
+	// +optional
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the number of replicas that are available.
+	// +optional
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+
+	// Message is a human-readable message indicating details about the rollout, e.g. the reason of a failed rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RolloutState represents the state of the rollout of a resource.
+type RolloutState string
+
+const (
+	// RolloutDone represents that the latest generation of the resource has been completely rolled out.
+	RolloutDone RolloutState = "Done"
+	// RolloutProgressing represents that the latest generation of the resource is being rolled out.
+	RolloutProgressing RolloutState = "Progressing"
+	// RolloutFailed represents that the rollout of the latest generation of the resource has failed.
+	RolloutFailed RolloutState = "Failed"
+)
+
 // Conditions definition
 const (
 	// Scheduled represents the condition that the ResourceBinding or ClusterResourceBinding has been scheduled.
@@ -502,6 +617,10 @@ const (
 	// FullyApplied represents the condition that the resource referencing by ResourceBinding or ClusterResourceBinding
 	// has been applied to all scheduled clusters.
 	FullyApplied string = "FullyApplied"
+
+	// RolloutCompleted represents the condition that the resource referencing by ResourceBinding or ClusterResourceBinding
+	// has finished rolling out its latest generation in all scheduled clusters.
+	RolloutCompleted string = "RolloutCompleted"
 )
 
 // These are reasons for a binding's transition to a Scheduled condition.
@@ -61,6 +61,17 @@ const (
 	// BindingManagedByLabel is added to ResourceBinding to represent what kind of resource manages this Binding.
 	BindingManagedByLabel = "binding.karmada.io/managed-by"
 
+	// RolloutPausedAnnotation is added to ResourceBinding or ClusterResourceBinding to pause the rollout of the
+	// resource, e.g. by `karmadactl rollout pause`. The dispatching of the binding stays suspended as long as the
+	// annotation is "true", regardless of the suspension declared by the propagation policy.
+	RolloutPausedAnnotation = "binding.karmada.io/rollout-paused"
+
+	// RollbackPlacementAnnotation is added to ResourceBinding or ClusterResourceBinding rolled back to a previous
+	// revision, e.g. by `karmadactl rollout undo`. It records the hash of the restored resource template and the
+	// target clusters of the revision, the placement of the binding stays pinned to these clusters as long as the
+	// resource template is not changed again.
+	RollbackPlacementAnnotation = "binding.karmada.io/rollback-placement"
+
 	// ResourceTemplateGenerationAnnotationKey records the generation of resource template in Karmada APIServer,
 	// It will be injected into the resource when propagating to member clusters, to denote the specific version of
 	// the resource template from which the resource is derived. It might be helpful in the following cases:
@@ -135,10 +146,16 @@ const (
 	// EvictionReasonApplicationFailure describes the eviction is triggered
 	// because the application fails and reaches the condition of ApplicationFailoverBehavior.
 	EvictionReasonApplicationFailure = "ApplicationFailure"
+
+	// EvictionReasonClusterDrained describes the eviction is triggered
+	// because the cluster is being drained, e.g. by `karmadactl drain`.
+	EvictionReasonClusterDrained = "ClusterDrained"
 )
 
 // Define eviction producers.
 const (
 	// EvictionProducerTaintManager represents the name of taint manager.
 	EvictionProducerTaintManager = "TaintManager"
+	// EvictionProducerKarmadactl represents the name of karmadactl.
+	EvictionProducerKarmadactl = "karmadactl"
 )
@@ -36,6 +36,11 @@ func (in *AggregatedStatusItem) DeepCopyInto(out *AggregatedStatusItem) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RolloutStatus != nil {
+		in, out := &in.RolloutStatus, &out.RolloutStatus
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -169,6 +174,13 @@ func (in *ComponentReplicaRequirements) DeepCopyInto(out *ComponentReplicaRequir
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.DeviceRequests != nil {
+		in, out := &in.DeviceRequests, &out.DeviceRequests
+		*out = make([]DeviceRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -182,6 +194,27 @@ func (in *ComponentReplicaRequirements) DeepCopy() *ComponentReplicaRequirements
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceRequest) DeepCopyInto(out *DeviceRequest) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceRequest.
+func (in *DeviceRequest) DeepCopy() *DeviceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GracefulEvictionTask) DeepCopyInto(out *GracefulEvictionTask) {
 	*out = *in
@@ -251,6 +284,34 @@ func (in *NodeClaim) DeepCopyInto(out *NodeClaim) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HardPodAffinity != nil {
+		in, out := &in.HardPodAffinity, &out.HardPodAffinity
+		*out = make([]v1.PodAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HardPodAntiAffinity != nil {
+		in, out := &in.HardPodAntiAffinity, &out.HardPodAntiAffinity
+		*out = make([]v1.PodAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -295,6 +356,20 @@ func (in *ReplicaRequirements) DeepCopyInto(out *ReplicaRequirements) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.DeviceRequests != nil {
+		in, out := &in.DeviceRequests, &out.DeviceRequests
+		*out = make([]DeviceRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageRequests != nil {
+		in, out := &in.StorageRequests, &out.StorageRequests
+		*out = make([]StorageRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -487,6 +562,37 @@ func (in *ResourceBindingStatus) DeepCopy() *ResourceBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.UpdatedReplicas != nil {
+		in, out := &in.UpdatedReplicas, &out.UpdatedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyReplicas != nil {
+		in, out := &in.ReadyReplicas, &out.ReadyReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AvailableReplicas != nil {
+		in, out := &in.AvailableReplicas, &out.AvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulePriority) DeepCopyInto(out *SchedulePriority) {
 	*out = *in
@@ -503,6 +609,23 @@ func (in *SchedulePriority) DeepCopy() *SchedulePriority {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageRequest) DeepCopyInto(out *StorageRequest) {
+	*out = *in
+	out.Storage = in.Storage.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageRequest.
+func (in *StorageRequest) DeepCopy() *StorageRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Suspension) DeepCopyInto(out *Suspension) {
 	*out = *in
@@ -51,6 +51,11 @@ func (in ComponentReplicaRequirements) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.ComponentReplicaRequirements"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DeviceRequest) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.DeviceRequest"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in GracefulEvictionTask) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.GracefulEvictionTask"
@@ -91,11 +96,21 @@ func (in ResourceBindingStatus) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.ResourceBindingStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RolloutStatus) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.RolloutStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SchedulePriority) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.SchedulePriority"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in StorageRequest) OpenAPIModelName() string {
+	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.StorageRequest"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Suspension) OpenAPIModelName() string {
 	return "com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.Suspension"
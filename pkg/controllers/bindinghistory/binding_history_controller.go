@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindinghistory
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
+)
+
+// ControllerName is the controller name that will be used when reporting events and metrics.
+const ControllerName = "binding-history-controller"
+
+// Controller records the revisions of ResourceBindings with ControllerRevisions. Each revision holds the
+// resource template and the target clusters of a generation of the binding, so that both of them could be
+// restored by rolling back to the revision. The overrides applied to each cluster are recorded as well.
+type Controller struct {
+	client.Client
+	DynamicClient   dynamic.Interface
+	InformerManager genericmanager.SingleClusterInformerManager
+	RESTMapper      meta.RESTMapper
+	// RevisionHistoryLimit is the number of old revisions to retain for each binding.
+	RevisionHistoryLimit int
+	RateLimiterOptions   ratelimiterflag.Options
+}
+
+// Reconcile performs a full reconciliation for the object referred to by the Request.
+// The Controller will requeue the Request to be processed again if an error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).InfoS("Reconciling ResourceBinding", "namespace", req.Namespace, "name", req.Name)
+
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := c.Client.Get(ctx, req.NamespacedName, binding); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The ControllerRevisions are owned by the binding and would be garbage collected.
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+
+	if !binding.DeletionTimestamp.IsZero() || !isBindingSettled(binding, &binding.Spec, binding.Status.SchedulerObservedGeneration) {
+		return controllerruntime.Result{}, nil
+	}
+
+	if err := c.recorder().recordRevision(ctx, binding, &binding.Spec); err != nil {
+		klog.ErrorS(err, "Failed to record revision", "namespace", binding.Namespace, "binding", binding.Name)
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{}, nil
+}
+
+func (c *Controller) recorder() *revisionRecorder {
+	return &revisionRecorder{
+		Client:               c.Client,
+		DynamicClient:        c.DynamicClient,
+		InformerManager:      c.InformerManager,
+		RESTMapper:           c.RESTMapper,
+		RevisionHistoryLimit: c.RevisionHistoryLimit,
+	}
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	bindingPredicateFunc := predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			bindingOld := e.ObjectOld.(*workv1alpha2.ResourceBinding)
+			bindingNew := e.ObjectNew.(*workv1alpha2.ResourceBinding)
+			return bindingOld.Generation != bindingNew.Generation ||
+				bindingOld.Status.SchedulerObservedGeneration != bindingNew.Status.SchedulerObservedGeneration
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&workv1alpha2.ResourceBinding{}, builder.WithPredicates(bindingPredicateFunc)).
+		Watches(&workv1alpha1.Work{}, handler.EnqueueRequestsFromMapFunc(workMapFunc), builder.WithPredicates(workPredicate)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
+
+func workMapFunc(_ context.Context, obj client.Object) []reconcile.Request {
+	namespace := util.GetAnnotationValue(obj.GetAnnotations(), workv1alpha2.ResourceBindingNamespaceAnnotationKey)
+	name := util.GetAnnotationValue(obj.GetAnnotations(), workv1alpha2.ResourceBindingNameAnnotationKey)
+	if namespace == "" || name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindinghistory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const bindingID = "93162d3c-ee8e-4995-9034-05f4d5d2c2b9"
+
+func TestReconcileRecordsRevisions(t *testing.T) {
+	deployment := newDeployment(3)
+	binding := newBinding(deployment, workv1alpha2.TargetCluster{Name: "member1", Replicas: 3})
+	work := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   names.GenerateExecutionSpaceName("member1"),
+			Name:        "nginx-work",
+			Labels:      map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: bindingID},
+			Annotations: map[string]string{util.AppliedOverrides: `[{"policyName":"op"}]`},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{deployment}, binding, work)
+	c.RevisionHistoryLimit = 1
+
+	reconcileBinding(t, c)
+	revisions := listRevisions(t, c)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, int64(1), revisions[0].Revision)
+	assert.Equal(t, `[{"clusterName":"member1","appliedOverrides":"[{\"policyName\":\"op\"}]"}]`,
+		revisions[0].Annotations[util.BindingRevisionAppliedOverridesAnnotation])
+	firstRevision, err := helper.ParseBindingRevision(revisions[0])
+	require.NoError(t, err)
+	assert.Equal(t, binding.Spec.Clusters, firstRevision.Clusters)
+	assert.Nil(t, firstRevision.Template.Object["status"])
+
+	// reconciling the same generation records nothing new
+	reconcileBinding(t, c)
+	assert.Len(t, listRevisions(t, c), 1)
+
+	// a new placement is recorded as a new revision
+	updateBindingClusters(t, c, workv1alpha2.TargetCluster{Name: "member2", Replicas: 3})
+	reconcileBinding(t, c)
+	revisions = listRevisions(t, c)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, int64(2), revisions[1].Revision)
+
+	// rolling back to the first placement bumps the first revision instead of recording a new one
+	updateBindingClusters(t, c, workv1alpha2.TargetCluster{Name: "member1", Replicas: 3})
+	reconcileBinding(t, c)
+	revisions = listRevisions(t, c)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, firstRevision.Hash(), mustParse(t, revisions[1]).Hash())
+	assert.Equal(t, int64(3), revisions[1].Revision)
+
+	// the oldest revision is pruned when exceeding the limit
+	updateBindingClusters(t, c, workv1alpha2.TargetCluster{Name: "member3", Replicas: 3})
+	reconcileBinding(t, c)
+	revisions = listRevisions(t, c)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, []int64{3, 4}, []int64{revisions[0].Revision, revisions[1].Revision})
+}
+
+func TestReconcileSkipsUnsettledBinding(t *testing.T) {
+	deployment := newDeployment(3)
+	binding := newBinding(deployment, workv1alpha2.TargetCluster{Name: "member1", Replicas: 3})
+	binding.Status.SchedulerObservedGeneration = binding.Generation - 1
+	c := newFakeController(t, []runtime.Object{deployment}, binding)
+
+	reconcileBinding(t, c)
+	assert.Empty(t, listRevisions(t, c))
+}
+
+func TestReconcileSkipsOutdatedTemplate(t *testing.T) {
+	deployment := newDeployment(3)
+	binding := newBinding(deployment, workv1alpha2.TargetCluster{Name: "member1", Replicas: 3})
+	binding.Spec.Resource.ResourceVersion = "0"
+	c := newFakeController(t, []runtime.Object{deployment}, binding)
+
+	reconcileBinding(t, c)
+	assert.Empty(t, listRevisions(t, c))
+}
+
+func TestReconcileSkipsSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx", ResourceVersion: "100"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	binding := newBinding(newDeployment(3), workv1alpha2.TargetCluster{Name: "member1"})
+	binding.Spec.Resource.APIVersion = "v1"
+	binding.Spec.Resource.Kind = "Secret"
+	c := newFakeController(t, []runtime.Object{secret}, binding)
+
+	reconcileBinding(t, c)
+	assert.Empty(t, listRevisions(t, c))
+}
+
+func TestReconcileRecordsClusterResourceBindingRevisions(t *testing.T) {
+	clusterRole := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: "reader", ResourceVersion: "100"},
+	}
+	binding := &workv1alpha2.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       names.GenerateBindingName(clusterRole.Kind, clusterRole.Name),
+			UID:        "binding-uid",
+			Generation: 2,
+			Labels:     map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: bindingID},
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{
+				APIVersion:      "rbac.authorization.k8s.io/v1",
+				Kind:            "ClusterRole",
+				Name:            clusterRole.Name,
+				ResourceVersion: clusterRole.ResourceVersion,
+			},
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}},
+		},
+		Status: workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 2},
+	}
+	controller := newFakeController(t, []runtime.Object{clusterRole}, binding)
+	c := &ClusterResourceBindingController{
+		Client:          controller.Client,
+		DynamicClient:   controller.DynamicClient,
+		InformerManager: controller.InformerManager,
+		RESTMapper:      controller.RESTMapper,
+	}
+
+	_, err := c.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: types.NamespacedName{Name: binding.Name}})
+	require.NoError(t, err)
+	revisions, err := c.recorder().listRevisions(context.TODO(), binding)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, names.NamespaceKarmadaSystem, revisions[0].Namespace)
+	assert.Equal(t, workv1alpha2.ResourceKindClusterResourceBinding, revisions[0].OwnerReferences[0].Kind)
+	assert.Equal(t, binding.Spec.Clusters, mustParse(t, revisions[0]).Clusters)
+}
+
+func newDeployment(replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx", ResourceVersion: "100"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: replicas},
+	}
+}
+
+func newBinding(deployment *appsv1.Deployment, clusters ...workv1alpha2.TargetCluster) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  deployment.Namespace,
+			Name:       names.GenerateBindingName(deployment.Kind, deployment.Name),
+			UID:        "binding-uid",
+			Generation: 2,
+			Labels:     map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: bindingID},
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{
+				APIVersion:      "apps/v1",
+				Kind:            "Deployment",
+				Namespace:       deployment.Namespace,
+				Name:            deployment.Name,
+				ResourceVersion: deployment.ResourceVersion,
+			},
+			Clusters: clusters,
+		},
+		Status: workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 2},
+	}
+}
+
+func newFakeController(t *testing.T, templates []runtime.Object, objs ...client.Object) *Controller {
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(scheme.Scheme, templates...)
+	informerManager := genericmanager.NewSingleClusterInformerManager(context.TODO(), dynamicClient, 0)
+	t.Cleanup(informerManager.Stop)
+
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	restMapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+
+	return &Controller{
+		Client:          fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(objs...).Build(),
+		DynamicClient:   dynamicClient,
+		InformerManager: informerManager,
+		RESTMapper:      restMapper,
+	}
+}
+
+func reconcileBinding(t *testing.T, c *Controller) {
+	_, err := c.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: types.NamespacedName{
+		Namespace: "default", Name: names.GenerateBindingName("Deployment", "nginx"),
+	}})
+	require.NoError(t, err)
+}
+
+func updateBindingClusters(t *testing.T, c *Controller, clusters ...workv1alpha2.TargetCluster) {
+	binding := &workv1alpha2.ResourceBinding{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: names.GenerateBindingName("Deployment", "nginx")}, binding))
+	binding.Spec.Clusters = clusters
+	require.NoError(t, c.Update(context.TODO(), binding))
+}
+
+func listRevisions(t *testing.T, c *Controller) []*appsv1.ControllerRevision {
+	revisions, err := c.recorder().listRevisions(context.TODO(), &workv1alpha2.ResourceBinding{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "default",
+		Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: bindingID},
+	}})
+	require.NoError(t, err)
+	return revisions
+}
+
+func mustParse(t *testing.T, controllerRevision *appsv1.ControllerRevision) *helper.BindingRevision {
+	revision, err := helper.ParseBindingRevision(controllerRevision)
+	require.NoError(t, err)
+	return revision
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindinghistory
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+// revisionRecorder records the revisions of a ResourceBinding or ClusterResourceBinding.
+type revisionRecorder struct {
+	client.Client
+	DynamicClient        dynamic.Interface
+	InformerManager      genericmanager.SingleClusterInformerManager
+	RESTMapper           meta.RESTMapper
+	RevisionHistoryLimit int
+}
+
+// workPredicate filters the Works whose applied overrides changed.
+var workPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool { return hasAppliedOverrides(e.Object) },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !reflect.DeepEqual(getAppliedOverrides(e.ObjectOld), getAppliedOverrides(e.ObjectNew))
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// isBindingSettled tells whether the binding has been scheduled according to its latest spec.
+func isBindingSettled(binding client.Object, spec *workv1alpha2.ResourceBindingSpec, schedulerObservedGeneration int64) bool {
+	if !helper.IsBindingRevisionRecorded(spec.Resource) {
+		return false
+	}
+	for _, value := range helper.GetBindingRevisionSelector(binding) {
+		if value == "" {
+			return false
+		}
+	}
+	return len(spec.Clusters) > 0 && schedulerObservedGeneration == binding.GetGeneration()
+}
+
+// recordRevision records the current resource template and target clusters of the binding as its latest revision.
+func (r *revisionRecorder) recordRevision(ctx context.Context, binding client.Object, spec *workv1alpha2.ResourceBindingSpec) error {
+	template, err := helper.FetchResourceTemplate(ctx, r.DynamicClient, r.InformerManager, r.RESTMapper, spec.Resource)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		klog.ErrorS(err, "Failed to fetch resource template", "namespace", binding.GetNamespace(), "binding", binding.GetName())
+		return err
+	}
+	// The binding has not caught up with the latest resource template yet, wait for the next round.
+	if template.GetResourceVersion() != spec.Resource.ResourceVersion {
+		return nil
+	}
+
+	workList := &workv1alpha1.WorkList{}
+	if err = r.Client.List(ctx, workList, client.MatchingLabels(helper.GetBindingRevisionSelector(binding))); err != nil {
+		klog.ErrorS(err, "Failed to list works", "namespace", binding.GetNamespace(), "binding", binding.GetName())
+		return err
+	}
+	overrides, err := helper.GetClusterAppliedOverrides(workList.Items)
+	if err != nil {
+		return err
+	}
+
+	return r.syncRevisions(ctx, binding, helper.NewBindingRevision(template, spec.Clusters), overrides)
+}
+
+// syncRevisions makes sure the current revision is recorded as the latest one, and prunes the revisions
+// exceeding the limit.
+func (r *revisionRecorder) syncRevisions(ctx context.Context, binding client.Object,
+	current *helper.BindingRevision, overrides []helper.ClusterAppliedOverrides) error {
+	revisions, err := r.listRevisions(ctx, binding)
+	if err != nil {
+		return err
+	}
+
+	var maxRevision int64
+	if len(revisions) > 0 {
+		maxRevision = revisions[len(revisions)-1].Revision
+	}
+
+	desired, err := helper.NewBindingControllerRevision(binding, current, maxRevision+1)
+	if err != nil {
+		return err
+	}
+	overridesData, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	desired.Annotations = map[string]string{util.BindingRevisionAppliedOverridesAnnotation: string(overridesData)}
+
+	var existing *appsv1.ControllerRevision
+	for _, revision := range revisions {
+		if revision.Name == desired.Name {
+			existing = revision
+			break
+		}
+	}
+
+	switch {
+	case existing == nil:
+		if err = r.Client.Create(ctx, desired); err != nil {
+			return err
+		}
+		klog.V(2).InfoS("Recorded new revision of binding", "namespace", binding.GetNamespace(), "binding", binding.GetName(),
+			"revision", desired.Revision, "controllerRevision", desired.Name)
+		revisions = append(revisions, desired)
+	case existing.Revision != maxRevision || existing.Annotations[util.BindingRevisionAppliedOverridesAnnotation] != string(overridesData):
+		// The binding is rolled back to an earlier revision, which becomes the latest one again.
+		if existing.Revision != maxRevision {
+			existing.Revision = maxRevision + 1
+		}
+		if existing.Annotations == nil {
+			existing.Annotations = map[string]string{}
+		}
+		existing.Annotations[util.BindingRevisionAppliedOverridesAnnotation] = string(overridesData)
+		if err = r.Client.Update(ctx, existing); err != nil {
+			return err
+		}
+		helper.SortBindingControllerRevisions(revisions)
+	}
+
+	return r.pruneRevisions(ctx, revisions)
+}
+
+// pruneRevisions deletes the oldest revisions, the latest one and RevisionHistoryLimit old ones are retained.
+func (r *revisionRecorder) pruneRevisions(ctx context.Context, revisions []*appsv1.ControllerRevision) error {
+	exceeded := len(revisions) - 1 - r.RevisionHistoryLimit
+	for i := 0; i < exceeded; i++ {
+		if err := r.Client.Delete(ctx, revisions[i]); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *revisionRecorder) listRevisions(ctx context.Context, binding client.Object) ([]*appsv1.ControllerRevision, error) {
+	revisionList := &appsv1.ControllerRevisionList{}
+	if err := r.Client.List(ctx, revisionList, client.InNamespace(helper.GetBindingRevisionNamespace(binding.GetNamespace())),
+		client.MatchingLabels(helper.GetBindingRevisionSelector(binding))); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*appsv1.ControllerRevision, 0, len(revisionList.Items))
+	for i := range revisionList.Items {
+		revisions = append(revisions, &revisionList.Items[i])
+	}
+	helper.SortBindingControllerRevisions(revisions)
+	return revisions, nil
+}
+
+func hasAppliedOverrides(obj client.Object) bool {
+	return getAppliedOverrides(obj) != [2]string{}
+}
+
+func getAppliedOverrides(obj client.Object) [2]string {
+	return [2]string{
+		util.GetAnnotationValue(obj.GetAnnotations(), util.AppliedOverrides),
+		util.GetAnnotationValue(obj.GetAnnotations(), util.AppliedClusterOverrides),
+	}
+}
@@ -100,6 +100,8 @@ type Options struct {
 	FederatedResourceQuotaOptions options.FederatedResourceQuotaOptions
 	// ClusterFailoverConfiguration is the config of cluster failover function.
 	ClusterFailoverConfiguration options.ClusterFailoverOptions
+	// BindingRevisionHistoryLimit is the number of old revisions to retain for each ResourceBinding.
+	BindingRevisionHistoryLimit int
 }
 
 // Context defines the context object for controller.
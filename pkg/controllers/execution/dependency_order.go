@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// workWaitingForDependenciesReason is the reason for the Applied condition when the Work is blocked
+// by the prerequisites which have not been applied to the member cluster yet.
+const workWaitingForDependenciesReason = "WaitingForDependencies"
+
+// kindApplyOrder lists the kinds that other resources may depend on, in the order they should be applied.
+// Kinds not listed here, e.g. workloads and custom resources, are applied after all of them.
+var kindApplyOrder = map[string]int{
+	"Namespace":                    0,
+	"ResourceQuota":                1,
+	"LimitRange":                   2,
+	"PriorityClass":                3,
+	util.CRDKind:                   4,
+	util.ServiceAccountKind:        5,
+	util.SecretKind:                6,
+	"ConfigMap":                    7,
+	"StorageClass":                 8,
+	util.PersistentVolumeKind:      9,
+	util.PersistentVolumeClaimKind: 10,
+	util.ClusterRoleKind:           11,
+	util.ClusterRoleBindingKind:    12,
+	"Role":                         13,
+	"RoleBinding":                  14,
+	util.ServiceKind:               15,
+}
+
+// sortWorkloadsByApplyOrder sorts the workloads of a Work so that the prerequisites are applied first.
+func sortWorkloadsByApplyOrder(workloads []*unstructured.Unstructured) {
+	sort.SliceStable(workloads, func(i, j int) bool {
+		return applyOrderOf(workloads[i]) < applyOrderOf(workloads[j])
+	})
+}
+
+func applyOrderOf(workload *unstructured.Unstructured) int {
+	if order, ok := kindApplyOrder[workload.GetKind()]; ok {
+		return order
+	}
+	return len(kindApplyOrder)
+}
+
+// getPendingPrerequisites returns the prerequisites of the workloads which have not been applied to the member cluster,
+// the Work should not be applied until all of them are ready. The prerequisites include:
+//   - the Namespace of a namespace-scoped workload,
+//   - the CustomResourceDefinition of a custom resource, which should be established as well,
+//   - the dependencies of the ResourceBinding which the Work derives from, which are distributed by the dependencies distributor.
+//
+// A prerequisite which is not propagated by Karmada is considered to be managed outside Karmada, so it never blocks the Work.
+func (c *Controller) getPendingPrerequisites(ctx context.Context, clusterName string, work *workv1alpha1.Work,
+	workloads []*unstructured.Unstructured) ([]string, error) {
+	var pending []string
+	checked := sets.New[string]()
+	checkWork := func(description, workName string, ready func(*workv1alpha1.Work) bool) error {
+		if workName == work.Name || checked.Has(workName) {
+			return nil
+		}
+		checked.Insert(workName)
+
+		prerequisite := &workv1alpha1.Work{}
+		if err := c.Client.Get(ctx, client.ObjectKey{Namespace: work.Namespace, Name: workName}, prerequisite); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !ready(prerequisite) {
+			pending = append(pending, description)
+		}
+		return nil
+	}
+
+	for _, workload := range workloads {
+		if namespace := workload.GetNamespace(); namespace != "" {
+			if err := checkWork(fmt.Sprintf("Namespace(%s)", namespace),
+				names.GenerateWorkName("Namespace", namespace, ""), isWorkApplied); err != nil {
+				return nil, err
+			}
+		}
+
+		if crdName, mayBeCustomResource := c.getCRDName(workload); mayBeCustomResource {
+			if err := checkWork(fmt.Sprintf("%s(%s)", util.CRDKind, crdName),
+				names.GenerateWorkName(util.CRDKind, crdName, ""), isCRDWorkEstablished); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	dependencies, err := c.getBindingDependencies(ctx, work)
+	if err != nil {
+		return nil, err
+	}
+	for _, dependency := range dependencies {
+		// The dependencies selected by label selector can not be located without listing, they are left out.
+		if dependency.Name == "" {
+			continue
+		}
+		description := fmt.Sprintf("%s(%s/%s)", dependency.Kind, dependency.Namespace, dependency.Name)
+		workName := names.GenerateWorkName(dependency.Kind, dependency.Name, dependency.Namespace)
+		dependencyPending, err := c.isDependencyWorkPending(ctx, clusterName, work.Namespace, workName, dependency)
+		if err != nil {
+			return nil, err
+		}
+		if dependencyPending {
+			checked.Insert(workName)
+			pending = append(pending, description)
+			continue
+		}
+		if err = checkWork(description, workName, isWorkApplied); err != nil {
+			return nil, err
+		}
+	}
+
+	return pending, nil
+}
+
+// getCRDName returns the name of the CustomResourceDefinition which would define the workload. Only the resources
+// out of the groups built in Kubernetes may be defined by CustomResourceDefinition. The workload which can not be
+// mapped is not ordered after any CustomResourceDefinition.
+func (c *Controller) getCRDName(workload *unstructured.Unstructured) (string, bool) {
+	gvk := workload.GroupVersionKind()
+	if gvk.Group == "" || gvk.Group == apiextensionsv1.GroupName || scheme.Scheme.IsGroupRegistered(gvk.Group) {
+		return "", false
+	}
+	mapping, err := c.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		klog.V(4).InfoS("Skip ordering the workload after its CustomResourceDefinition as it can not be mapped",
+			"gvk", gvk.String(), "namespace", workload.GetNamespace(), "name", workload.GetName(), "error", err.Error())
+		return "", false
+	}
+	return mapping.Resource.Resource + "." + gvk.Group, true
+}
+
+// getBindingDependencies returns the dependencies recorded in the ResourceBinding which the Work derives from.
+func (c *Controller) getBindingDependencies(ctx context.Context, work *workv1alpha1.Work) ([]configv1alpha1.DependentObjectReference, error) {
+	bindingNamespace := util.GetAnnotationValue(work.Annotations, workv1alpha2.ResourceBindingNamespaceAnnotationKey)
+	bindingName := util.GetAnnotationValue(work.Annotations, workv1alpha2.ResourceBindingNameAnnotationKey)
+	if bindingNamespace == "" || bindingName == "" {
+		return nil, nil
+	}
+
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := c.Client.Get(ctx, client.ObjectKey{Namespace: bindingNamespace, Name: bindingName}, binding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	dependencies := util.GetAnnotationValue(binding.Annotations, util.DependenciesAnnotationKey)
+	if dependencies == "" {
+		return nil, nil
+	}
+	var dependencyRefs []configv1alpha1.DependentObjectReference
+	if err := json.Unmarshal([]byte(dependencies), &dependencyRefs); err != nil {
+		klog.ErrorS(err, "Failed to unmarshal dependencies of binding", "namespace", binding.Namespace, "name", binding.Name)
+		return nil, err
+	}
+	return dependencyRefs, nil
+}
+
+// isDependencyWorkPending tells whether the dependency is going to be propagated to the cluster by its attached binding,
+// but the Work of it has not been created yet.
+func (c *Controller) isDependencyWorkPending(ctx context.Context, clusterName, executionSpace, workName string,
+	dependency configv1alpha1.DependentObjectReference) (bool, error) {
+	if err := c.Client.Get(ctx, client.ObjectKey{Namespace: executionSpace, Name: workName}, &workv1alpha1.Work{}); err == nil {
+		return false, nil
+	} else if !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	attachedBinding := &workv1alpha2.ResourceBinding{}
+	if err := c.Client.Get(ctx, client.ObjectKey{
+		Namespace: dependency.Namespace,
+		Name:      names.GenerateBindingName(dependency.Kind, dependency.Name),
+	}, attachedBinding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return attachedBinding.Spec.TargetContains(clusterName), nil
+}
+
+func isWorkApplied(work *workv1alpha1.Work) bool {
+	return meta.IsStatusConditionTrue(work.Status.Conditions, workv1alpha1.WorkApplied)
+}
+
+// isCRDWorkEstablished tells whether the CustomResourceDefinition of the Work has been established in the member cluster,
+// according to the status collected from the member cluster.
+func isCRDWorkEstablished(work *workv1alpha1.Work) bool {
+	if !isWorkApplied(work) {
+		return false
+	}
+	for _, manifestStatus := range work.Status.ManifestStatuses {
+		if manifestStatus.Identifier.Kind != util.CRDKind || manifestStatus.Status == nil {
+			continue
+		}
+		status := &apiextensionsv1.CustomResourceDefinitionStatus{}
+		if err := json.Unmarshal(manifestStatus.Status.Raw, status); err != nil {
+			klog.ErrorS(err, "Failed to unmarshal status of CustomResourceDefinition", "namespace", work.Namespace, "name", work.Name)
+			return false
+		}
+		for _, condition := range status.Conditions {
+			if condition.Type == apiextensionsv1.Established {
+				return condition.Status == apiextensionsv1.ConditionTrue
+			}
+		}
+	}
+	return false
+}
+
+// isBlockedByPrerequisites tells whether the Work should wait for its prerequisites, and reports the pending
+// prerequisites with the Applied condition of the Work if so.
+func (c *Controller) isBlockedByPrerequisites(ctx context.Context, clusterName string, work *workv1alpha1.Work) (bool, error) {
+	workloads := make([]*unstructured.Unstructured, 0, len(work.Spec.Workload.Manifests))
+	for _, manifest := range work.Spec.Workload.Manifests {
+		workload := &unstructured.Unstructured{}
+		// The manifests failed to be unmarshalled are reported when syncing the Work.
+		if err := workload.UnmarshalJSON(manifest.Raw); err == nil {
+			workloads = append(workloads, workload)
+		}
+	}
+
+	pending, err := c.getPendingPrerequisites(ctx, clusterName, work, workloads)
+	if err != nil || len(pending) == 0 {
+		return false, err
+	}
+
+	klog.V(4).InfoS("Work is waiting for prerequisites", "namespace", work.Namespace, "name", work.Name,
+		"cluster", clusterName, "prerequisites", pending)
+	if err = c.waitForPrerequisites(ctx, work, pending); err != nil {
+		klog.ErrorS(err, "Failed to update applied status for given work", "namespace", work.Namespace, "name", work.Name)
+		return true, err
+	}
+	return true, nil
+}
+
+// waitForPrerequisites updates the Applied condition of the Work to report the pending prerequisites.
+func (c *Controller) waitForPrerequisites(ctx context.Context, work *workv1alpha1.Work, pending []string) error {
+	message := fmt.Sprintf("Waiting for the prerequisites to be applied: %s", strings.Join(pending, ", "))
+	condition := meta.FindStatusCondition(work.Status.Conditions, workv1alpha1.WorkApplied)
+	if condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == workWaitingForDependenciesReason &&
+		condition.Message == message {
+		return nil
+	}
+	return c.updateAppliedCondition(ctx, work, metav1.ConditionFalse, workWaitingForDependenciesReason, message)
+}
+
+// newPrerequisiteEventHandler wakes up the Works waiting for prerequisites in the same execution namespace once a Work
+// turns ready as a prerequisite or goes away. The status of the Namespaces and the CustomResourceDefinitions in the
+// member cluster is collected to their Works, so watching the Works is enough to trigger the blocked ones.
+func (c *Controller) newPrerequisiteEventHandler() handler.TypedEventHandler[*workv1alpha1.Work, reconcile.Request] {
+	return handler.TypedFuncs[*workv1alpha1.Work, reconcile.Request]{
+		CreateFunc: func(ctx context.Context, e event.TypedCreateEvent[*workv1alpha1.Work], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			c.enqueueWaitingWorks(ctx, e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.TypedUpdateEvent[*workv1alpha1.Work], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if isWorkApplied(e.ObjectOld) == isWorkApplied(e.ObjectNew) &&
+				isCRDWorkEstablished(e.ObjectOld) == isCRDWorkEstablished(e.ObjectNew) {
+				return
+			}
+			c.enqueueWaitingWorks(ctx, e.ObjectNew, q)
+		},
+		DeleteFunc: func(ctx context.Context, e event.TypedDeleteEvent[*workv1alpha1.Work], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			c.enqueueWaitingWorks(ctx, e.Object, q)
+		},
+	}
+}
+
+func (c *Controller) enqueueWaitingWorks(ctx context.Context, prerequisite *workv1alpha1.Work, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	workList := &workv1alpha1.WorkList{}
+	if err := c.Client.List(ctx, workList, client.InNamespace(prerequisite.Namespace)); err != nil {
+		klog.ErrorS(err, "Failed to list works waiting for prerequisites", "namespace", prerequisite.Namespace)
+		return
+	}
+	for index := range workList.Items {
+		work := &workList.Items[index]
+		if work.Name == prerequisite.Name || !isWaitingForPrerequisites(work) {
+			continue
+		}
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: work.Namespace, Name: work.Name}})
+	}
+}
+
+func isWaitingForPrerequisites(work *workv1alpha1.Work) bool {
+	condition := meta.FindStatusCondition(work.Status.Conditions, workv1alpha1.WorkApplied)
+	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == workWaitingForDependenciesReason
+}
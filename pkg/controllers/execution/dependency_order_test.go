@@ -0,0 +1,401 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const executionSpace = "karmada-es-cluster"
+
+var (
+	fooGVK           = schema.GroupVersionKind{Group: "example.io", Version: "v1", Kind: "Foo"}
+	appsv1Deployment = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+)
+
+func newPrerequisiteWork(kind, name, namespace string, applied bool) *workv1alpha1.Work {
+	work := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: executionSpace,
+			Name:      names.GenerateWorkName(kind, name, namespace),
+		},
+	}
+	if applied {
+		meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{Type: workv1alpha1.WorkApplied, Status: metav1.ConditionTrue, Reason: "AppliedSuccessful"})
+	}
+	return work
+}
+
+func newCRDWork(t *testing.T, established apiextensionsv1.ConditionStatus) *workv1alpha1.Work {
+	work := newPrerequisiteWork(util.CRDKind, "foos.example.io", "", true)
+	status, err := helper.BuildStatusRawExtension(apiextensionsv1.CustomResourceDefinitionStatus{
+		Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{{Type: apiextensionsv1.Established, Status: established}},
+	})
+	require.NoError(t, err)
+	work.Status.ManifestStatuses = []workv1alpha1.ManifestStatus{{
+		Identifier: workv1alpha1.ResourceIdentifier{Group: "apiextensions.k8s.io", Version: "v1", Kind: util.CRDKind, Name: "foos.example.io"},
+		Status:     status,
+	}}
+	return work
+}
+
+func newDependingWork(namespace string, gvk schema.GroupVersionKind) (*workv1alpha1.Work, *unstructured.Unstructured) {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(gvk)
+	workload.SetNamespace(namespace)
+	workload.SetName("test")
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: executionSpace,
+			Name:      "work",
+			Annotations: map[string]string{
+				workv1alpha2.ResourceBindingNamespaceAnnotationKey: "default",
+				workv1alpha2.ResourceBindingNameAnnotationKey:      "test-deployment",
+			},
+		},
+	}, workload
+}
+
+func newIndependentBinding(dependencies string) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "test-deployment",
+			Annotations: map[string]string{util.DependenciesAnnotationKey: dependencies},
+		},
+	}
+}
+
+func newAttachedBinding(clusters ...string) *workv1alpha2.ResourceBinding {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: names.GenerateBindingName("ConfigMap", "config")},
+	}
+	for _, cluster := range clusters {
+		binding.Spec.Clusters = append(binding.Spec.Clusters, workv1alpha2.TargetCluster{Name: cluster})
+	}
+	return binding
+}
+
+func newDependencyOrderRESTMapper() meta.RESTMapper {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	restMapper.Add(fooGVK, meta.RESTScopeNamespace)
+	return restMapper
+}
+
+func TestSortWorkloadsByApplyOrder(t *testing.T) {
+	var workloads []*unstructured.Unstructured
+	for _, kind := range []string{"Deployment", "Foo", "RoleBinding", "ConfigMap", "CustomResourceDefinition", "Service", "Namespace", "Job"} {
+		workload := &unstructured.Unstructured{}
+		workload.SetKind(kind)
+		workloads = append(workloads, workload)
+	}
+
+	sortWorkloadsByApplyOrder(workloads)
+	var kinds []string
+	for _, workload := range workloads {
+		kinds = append(kinds, workload.GetKind())
+	}
+	assert.Equal(t, []string{"Namespace", "CustomResourceDefinition", "ConfigMap", "RoleBinding", "Service", "Deployment", "Foo", "Job"}, kinds)
+}
+
+func TestGetPendingPrerequisites(t *testing.T) {
+	configMapDependency := `[{"apiVersion":"v1","kind":"ConfigMap","namespace":"default","name":"config"}]`
+
+	tests := []struct {
+		name            string
+		gvk             schema.GroupVersionKind
+		namespace       string
+		objects         []client.Object
+		expectedPending []string
+	}{
+		{
+			name:      "namespace not propagated by karmada",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+		},
+		{
+			name:            "namespace not applied",
+			gvk:             appsv1Deployment,
+			namespace:       "default",
+			objects:         []client.Object{newPrerequisiteWork("Namespace", "default", "", false)},
+			expectedPending: []string{"Namespace(default)"},
+		},
+		{
+			name:      "namespace applied",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+			objects:   []client.Object{newPrerequisiteWork("Namespace", "default", "", true)},
+		},
+		{
+			name:            "CRD not established",
+			gvk:             fooGVK,
+			namespace:       "default",
+			objects:         []client.Object{newCRDWork(t, apiextensionsv1.ConditionFalse)},
+			expectedPending: []string{"CustomResourceDefinition(foos.example.io)"},
+		},
+		{
+			name:            "CRD not applied",
+			gvk:             fooGVK,
+			namespace:       "default",
+			objects:         []client.Object{newPrerequisiteWork(util.CRDKind, "foos.example.io", "", false)},
+			expectedPending: []string{"CustomResourceDefinition(foos.example.io)"},
+		},
+		{
+			name:      "CRD established",
+			gvk:       fooGVK,
+			namespace: "default",
+			objects:   []client.Object{newCRDWork(t, apiextensionsv1.ConditionTrue)},
+		},
+		{
+			name:            "work of dependency not created yet",
+			gvk:             appsv1Deployment,
+			namespace:       "default",
+			objects:         []client.Object{newIndependentBinding(configMapDependency), newAttachedBinding(clusterName)},
+			expectedPending: []string{"ConfigMap(default/config)"},
+		},
+		{
+			name:      "dependency not propagated to the cluster",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+			objects:   []client.Object{newIndependentBinding(configMapDependency), newAttachedBinding("other")},
+		},
+		{
+			name:      "dependency without attached binding",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+			objects:   []client.Object{newIndependentBinding(configMapDependency)},
+		},
+		{
+			name:      "dependency not applied",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+			objects: []client.Object{
+				newIndependentBinding(configMapDependency),
+				newAttachedBinding(clusterName),
+				newPrerequisiteWork("ConfigMap", "config", "default", false),
+			},
+			expectedPending: []string{"ConfigMap(default/config)"},
+		},
+		{
+			name:      "dependency applied",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+			objects: []client.Object{
+				newIndependentBinding(configMapDependency),
+				newAttachedBinding(clusterName),
+				newPrerequisiteWork("ConfigMap", "config", "default", true),
+			},
+		},
+		{
+			name:      "dependency selected by label selector is ignored",
+			gvk:       appsv1Deployment,
+			namespace: "default",
+			objects: []client.Object{
+				newIndependentBinding(`[{"apiVersion":"v1","kind":"ConfigMap","namespace":"default","labelSelector":{"matchLabels":{"app":"test"}}}]`),
+			},
+		},
+		{
+			name:      "all prerequisites pending",
+			gvk:       fooGVK,
+			namespace: "default",
+			objects: []client.Object{
+				newPrerequisiteWork("Namespace", "default", "", false),
+				newCRDWork(t, apiextensionsv1.ConditionFalse),
+				newIndependentBinding(configMapDependency),
+				newAttachedBinding(clusterName),
+			},
+			expectedPending: []string{"Namespace(default)", "CustomResourceDefinition(foos.example.io)", "ConfigMap(default/config)"},
+		},
+		{
+			name: "cluster-scoped resource in the core group",
+			gvk:  schema.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+		},
+		{
+			name:      "resource in a built-in group is not mapped",
+			gvk:       schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+			namespace: "default",
+			objects:   []client.Object{newPrerequisiteWork("Namespace", "default", "", true)},
+		},
+		{
+			name:      "custom resource can not be mapped",
+			gvk:       schema.GroupVersionKind{Group: "example.io", Version: "v1", Kind: "Bar"},
+			namespace: "default",
+			objects:   []client.Object{newPrerequisiteWork("Namespace", "default", "", true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			work, workload := newDependingWork(tt.namespace, tt.gvk)
+			c := &Controller{
+				Client:     fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(tt.objects...).Build(),
+				RESTMapper: newDependencyOrderRESTMapper(),
+			}
+
+			pending, err := c.getPendingPrerequisites(context.TODO(), clusterName, work, []*unstructured.Unstructured{workload})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPending, pending)
+		})
+	}
+}
+
+func TestExecutionController_ReconcileWaitsForPrerequisites(t *testing.T) {
+	require.NoError(t, features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DependencyOrderedApply, true)))
+	t.Cleanup(func() {
+		_ = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DependencyOrderedApply, false))
+	})
+
+	work := newWork(nil)
+	c := newController(work, record.NewFakeRecorder(10))
+	namespaceWork := newPrerequisiteWork("Namespace", podNamespace, "", false)
+	cluster := newCluster(clusterName, "Ready", metav1.ConditionTrue)
+	c.Client = fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+		WithObjects(cluster, work, namespaceWork).WithStatusSubresource(work, namespaceWork).Build()
+
+	req := controllerruntime.Request{NamespacedName: types.NamespacedName{Namespace: executionSpace, Name: "work"}}
+	res, err := c.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, controllerruntime.Result{}, res)
+
+	got := &workv1alpha1.Work{}
+	require.NoError(t, c.Client.Get(context.TODO(), req.NamespacedName, got))
+	condition := meta.FindStatusCondition(got.Status.Conditions, workv1alpha1.WorkApplied)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, workWaitingForDependenciesReason, condition.Reason)
+	assert.Equal(t, "Waiting for the prerequisites to be applied: Namespace(default)", condition.Message)
+
+	// the work is applied once the namespace is applied
+	meta.SetStatusCondition(&namespaceWork.Status.Conditions, metav1.Condition{Type: workv1alpha1.WorkApplied, Status: metav1.ConditionTrue, Reason: "AppliedSuccessful"})
+	require.NoError(t, c.Client.Status().Update(context.TODO(), namespaceWork))
+	res, err = c.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, controllerruntime.Result{}, res)
+	require.NoError(t, c.Client.Get(context.TODO(), req.NamespacedName, got))
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, workv1alpha1.WorkApplied))
+}
+
+func TestController_newPrerequisiteEventHandler(t *testing.T) {
+	waiting := func(name string) *workv1alpha1.Work {
+		work := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{Namespace: executionSpace, Name: name}}
+		meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{Type: workv1alpha1.WorkApplied, Status: metav1.ConditionFalse, Reason: workWaitingForDependenciesReason})
+		return work
+	}
+	failed := &workv1alpha1.Work{ObjectMeta: metav1.ObjectMeta{Namespace: executionSpace, Name: "failed"}}
+	meta.SetStatusCondition(&failed.Status.Conditions, metav1.Condition{Type: workv1alpha1.WorkApplied, Status: metav1.ConditionFalse, Reason: "AppliedFailed"})
+	otherSpace := waiting("other")
+	otherSpace.Namespace = "karmada-es-other"
+
+	notApplied := newPrerequisiteWork("Namespace", "default", "", false)
+	applied := newPrerequisiteWork("Namespace", "default", "", true)
+	c := &Controller{
+		Client: fake.NewClientBuilder().WithScheme(gclient.NewSchema()).
+			WithObjects(waiting("waiting"), failed, otherSpace, notApplied).Build(),
+	}
+	h := c.newPrerequisiteEventHandler()
+
+	tests := []struct {
+		name     string
+		trigger  func(q workqueue.TypedRateLimitingInterface[reconcile.Request])
+		expected []string
+	}{
+		{
+			name: "prerequisite turns applied",
+			trigger: func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Update(context.TODO(), event.TypedUpdateEvent[*workv1alpha1.Work]{ObjectOld: notApplied, ObjectNew: applied}, q)
+			},
+			expected: []string{"waiting"},
+		},
+		{
+			name: "prerequisite status not changed",
+			trigger: func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Update(context.TODO(), event.TypedUpdateEvent[*workv1alpha1.Work]{ObjectOld: applied, ObjectNew: applied}, q)
+			},
+		},
+		{
+			name: "CRD turns established",
+			trigger: func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Update(context.TODO(), event.TypedUpdateEvent[*workv1alpha1.Work]{
+					ObjectOld: newCRDWork(t, apiextensionsv1.ConditionFalse), ObjectNew: newCRDWork(t, apiextensionsv1.ConditionTrue)}, q)
+			},
+			expected: []string{"waiting"},
+		},
+		{
+			name: "prerequisite created",
+			trigger: func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Create(context.TODO(), event.TypedCreateEvent[*workv1alpha1.Work]{Object: applied}, q)
+			},
+			expected: []string{"waiting"},
+		},
+		{
+			name: "prerequisite deleted",
+			trigger: func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Delete(context.TODO(), event.TypedDeleteEvent[*workv1alpha1.Work]{Object: notApplied}, q)
+			},
+			expected: []string{"waiting"},
+		},
+		{
+			name: "waiting work itself is not enqueued",
+			trigger: func(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				h.Create(context.TODO(), event.TypedCreateEvent[*workv1alpha1.Work]{Object: waiting("waiting")}, q)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer q.ShutDown()
+
+			tt.trigger(q)
+			var got []string
+			for q.Len() > 0 {
+				req, _ := q.Get()
+				assert.Equal(t, executionSpace, req.Namespace)
+				got = append(got, req.Name)
+				q.Done(req)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
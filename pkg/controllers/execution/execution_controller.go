@@ -50,6 +50,7 @@ import (
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/detector"
 	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native/prune"
 	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
@@ -178,6 +179,11 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 		ctrlBuilder.For(&workv1alpha1.Work{})
 	}
 
+	if features.FeatureGate.Enabled(features.DependencyOrderedApply) {
+		// The raw source is not filtered by the event filter above, which drops the status changes of the Works.
+		ctrlBuilder.WatchesRawSource(source.Kind[*workv1alpha1.Work](mgr.GetCache(), &workv1alpha1.Work{}, c.newPrerequisiteEventHandler()))
+	}
+
 	return ctrlBuilder.WatchesRawSource(source.Channel[client.ObjectKey](
 		c.eventChannel,
 		handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, objectKey client.ObjectKey) []reconcile.Request {
@@ -187,6 +193,18 @@ func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
 }
 
 func (c *Controller) syncWork(ctx context.Context, clusterName string, work *workv1alpha1.Work) (controllerruntime.Result, error) {
+	if features.FeatureGate.Enabled(features.DependencyOrderedApply) {
+		blocked, err := c.isBlockedByPrerequisites(ctx, clusterName, work)
+		if err != nil {
+			klog.ErrorS(err, "Failed to check prerequisites of work", "namespace", work.Namespace, "name", work.Name, "cluster", clusterName)
+			return controllerruntime.Result{}, err
+		}
+		if blocked {
+			// The Work is requeued once its prerequisites turn ready, see newPrerequisiteEventHandler.
+			return controllerruntime.Result{}, nil
+		}
+	}
+
 	start := time.Now()
 	err := c.syncToClusters(ctx, clusterName, work)
 	metrics.ObserveSyncWorkloadLatency(err, start)
@@ -304,6 +322,7 @@ func (c *Controller) removeFinalizer(ctx context.Context, work *workv1alpha1.Wor
 func (c *Controller) syncToClusters(ctx context.Context, clusterName string, work *workv1alpha1.Work) error {
 	var errs []error
 	syncSucceedNum := 0
+	workloads := make([]*unstructured.Unstructured, 0, len(work.Spec.Workload.Manifests))
 	for _, manifest := range work.Spec.Workload.Manifests {
 		workload := &unstructured.Unstructured{}
 		err := workload.UnmarshalJSON(manifest.Raw)
@@ -312,8 +331,14 @@ func (c *Controller) syncToClusters(ctx context.Context, clusterName string, wor
 			errs = append(errs, err)
 			continue
 		}
+		workloads = append(workloads, workload)
+	}
+	if features.FeatureGate.Enabled(features.DependencyOrderedApply) {
+		sortWorkloadsByApplyOrder(workloads)
+	}
 
-		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	for _, workload := range workloads {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 			return c.tryCreateOrUpdateWorkload(ctx, clusterName, workload)
 		})
 		if err != nil {
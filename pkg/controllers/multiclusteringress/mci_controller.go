@@ -0,0 +1,520 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/controllers/ctrlutil"
+	"github.com/karmada-io/karmada/pkg/events"
+	"github.com/karmada-io/karmada/pkg/sharedcli/ratelimiterflag"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// ControllerName is the controller name that will be used when reporting events and metrics.
+const ControllerName = "multiclusteringress-controller"
+
+// MCIController is to sync MultiClusterIngress.
+// It resolves the clusters where the backend Services are located, programs an Ingress
+// through Work in each of these entry clusters except the ones that are traffic-blocked
+// by Remedy, and aggregates the load balancer status of the Ingresses back.
+type MCIController struct {
+	client.Client
+	EventRecorder      record.EventRecorder
+	RateLimiterOptions ratelimiterflag.Options
+}
+
+// Reconcile performs a full reconciliation for the object referred to by the Request.
+// The Controller will requeue the Request to be processed again if an error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (c *MCIController) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).InfoS("Reconciling MultiClusterIngress", "namespace", req.Namespace, "name", req.Name)
+
+	mci := &networkingv1alpha1.MultiClusterIngress{}
+	if err := c.Client.Get(ctx, req.NamespacedName, mci); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get MultiClusterIngress object", "namespacedName", req.NamespacedName)
+		return controllerruntime.Result{}, err
+	}
+
+	if !mci.DeletionTimestamp.IsZero() {
+		return c.handleMultiClusterIngressDelete(ctx, mci)
+	}
+
+	if err := c.handleMultiClusterIngressCreateOrUpdate(ctx, mci); err != nil {
+		c.EventRecorder.Eventf(mci, corev1.EventTypeWarning, events.EventReasonSyncIngressFailed, "%s", err.Error())
+		return controllerruntime.Result{}, err
+	}
+	c.EventRecorder.Eventf(mci, corev1.EventTypeNormal, events.EventReasonSyncIngressSucceed, "Ingress is propagated to entry clusters.")
+	return controllerruntime.Result{}, nil
+}
+
+func (c *MCIController) handleMultiClusterIngressDelete(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress) (controllerruntime.Result, error) {
+	klog.V(4).InfoS("Begin to handle MultiClusterIngress delete event", "namespace", mci.Namespace, "name", mci.Name)
+
+	if err := c.cleanUpWorks(ctx, mci, nil); err != nil {
+		c.EventRecorder.Eventf(mci, corev1.EventTypeWarning, events.EventReasonSyncIngressFailed,
+			"failed to delete ingress works: %v", err)
+		return controllerruntime.Result{}, err
+	}
+
+	if controllerutil.RemoveFinalizer(mci, util.MCIControllerFinalizer) {
+		if err := c.Client.Update(ctx, mci); err != nil {
+			klog.ErrorS(err, "Failed to remove finalizer from MultiClusterIngress", "namespace", mci.Namespace, "name", mci.Name)
+			return controllerruntime.Result{}, err
+		}
+	}
+
+	klog.V(4).InfoS("Success to delete MultiClusterIngress", "namespace", mci.Namespace, "name", mci.Name)
+	return controllerruntime.Result{}, nil
+}
+
+func (c *MCIController) handleMultiClusterIngressCreateOrUpdate(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress) error {
+	klog.V(4).InfoS("Begin to handle MultiClusterIngress create or update event", "namespace", mci.Namespace, "name", mci.Name)
+
+	// 1. add finalizer if needed
+	if controllerutil.AddFinalizer(mci, util.MCIControllerFinalizer) {
+		if err := c.Client.Update(ctx, mci); err != nil {
+			klog.ErrorS(err, "Failed to add finalizer to MultiClusterIngress", "finalizer", util.MCIControllerFinalizer, "namespace", mci.Namespace, "name", mci.Name)
+			return err
+		}
+	}
+
+	// 2. resolve where the backend services are located, these clusters are the entry clusters
+	serviceLocations, err := c.getServiceLocations(ctx, mci)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get service locations for MultiClusterIngress", "namespace", mci.Namespace, "name", mci.Name)
+		return err
+	}
+	entryClusters := sets.New[string]()
+	for _, location := range serviceLocations {
+		entryClusters.Insert(location.Clusters...)
+	}
+
+	// 3. exclude the clusters that a Remedy asks to block the traffic
+	trafficBlockClusters, err := c.getTrafficBlockClusters(ctx, entryClusters)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get traffic block clusters for MultiClusterIngress", "namespace", mci.Namespace, "name", mci.Name)
+		return err
+	}
+	targetClusters := entryClusters.Difference(sets.New(trafficBlockClusters...))
+
+	// 4. generate the Ingress work in target clusters' namespace and delete the stale ones
+	if err = c.propagateIngress(ctx, mci, serviceLocations, targetClusters); err != nil {
+		return err
+	}
+	if err = c.cleanUpWorks(ctx, mci, targetClusters); err != nil {
+		klog.ErrorS(err, "Failed to cleanup orphan works for MultiClusterIngress", "namespace", mci.Namespace, "name", mci.Name)
+		return err
+	}
+
+	// 5. aggregate the status reported by member clusters
+	ingressStatus, err := c.aggregateIngressStatus(ctx, mci)
+	if err != nil {
+		klog.ErrorS(err, "Failed to aggregate ingress status for MultiClusterIngress", "namespace", mci.Namespace, "name", mci.Name)
+		return err
+	}
+
+	return c.updateMultiClusterIngressStatus(ctx, mci, networkingv1alpha1.MultiClusterIngressStatus{
+		IngressStatus:        ingressStatus,
+		TrafficBlockClusters: trafficBlockClusters,
+		ServiceLocations:     serviceLocations,
+	})
+}
+
+// getServiceLocations resolves the backend Services to the clusters hosting them according to
+// the scheduling result of the Services' ResourceBinding.
+func (c *MCIController) getServiceLocations(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress) ([]networkingv1alpha1.ServiceLocation, error) {
+	var serviceLocations []networkingv1alpha1.ServiceLocation
+	for _, svcName := range getBackendServiceNames(mci) {
+		location := networkingv1alpha1.ServiceLocation{Name: svcName}
+
+		binding := &workv1alpha2.ResourceBinding{}
+		err := c.Client.Get(ctx, types.NamespacedName{Namespace: mci.Namespace, Name: names.GenerateBindingName(util.ServiceKind, svcName)}, binding)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			for _, targetCluster := range binding.Spec.Clusters {
+				location.Clusters = append(location.Clusters, targetCluster.Name)
+			}
+			slices.Sort(location.Clusters)
+		}
+		serviceLocations = append(serviceLocations, location)
+	}
+	return serviceLocations, nil
+}
+
+// getTrafficBlockClusters returns the sorted clusters, among the given ones, on which the TrafficControl
+// remedy action should be performed.
+func (c *MCIController) getTrafficBlockClusters(ctx context.Context, clusters sets.Set[string]) ([]string, error) {
+	var blockClusters []string
+	for _, clusterName := range sets.List(clusters) {
+		cluster := &clusterv1alpha1.Cluster{}
+		if err := c.Client.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if slices.Contains(cluster.Status.RemedyActions, string(remedyv1alpha1.TrafficControl)) {
+			blockClusters = append(blockClusters, clusterName)
+		}
+	}
+	return blockClusters, nil
+}
+
+func (c *MCIController) propagateIngress(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress,
+	serviceLocations []networkingv1alpha1.ServiceLocation, targetClusters sets.Set[string]) error {
+	var errs []error
+	for _, clusterName := range sets.List(targetClusters) {
+		ingress := buildIngress(mci, serviceLocations, clusterName)
+		ingressObj, err := helper.ToUnstructured(ingress)
+		if err != nil {
+			klog.ErrorS(err, "Failed to convert Ingress to unstructured object", "namespace", mci.Namespace, "name", mci.Name)
+			return err
+		}
+
+		workMeta := metav1.ObjectMeta{
+			Name:       generateWorkName(mci),
+			Namespace:  names.GenerateExecutionSpaceName(clusterName),
+			Finalizers: []string{util.ExecutionControllerFinalizer},
+			Labels: map[string]string{
+				util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+				util.MultiClusterIngressNameLabel:      mci.Name,
+			},
+		}
+		if err = ctrlutil.CreateOrUpdateWork(ctx, c.Client, workMeta, ingressObj); err != nil {
+			klog.ErrorS(err, "Failed to create or update Ingress work in the given member cluster",
+				"namespace", mci.Namespace, "name", mci.Name, "cluster", clusterName)
+			errs = append(errs, err)
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// generateWorkName returns the name of the Ingress works of the MultiClusterIngress. It's keyed on the kind of
+// MultiClusterIngress, so that it doesn't collide with the work of an Ingress of the same name propagated by policies.
+func generateWorkName(mci *networkingv1alpha1.MultiClusterIngress) string {
+	return names.GenerateWorkName(networkingv1alpha1.ResourceKindMultiClusterIngress, mci.Name, mci.Namespace)
+}
+
+// cleanUpWorks deletes the Ingress works of the MultiClusterIngress that are not in the reserved clusters,
+// as well as the works not named by generateWorkName, e.g. the ones created by former versions.
+func (c *MCIController) cleanUpWorks(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress, reservedClusters sets.Set[string]) error {
+	workList := &workv1alpha1.WorkList{}
+	if err := c.List(ctx, workList, client.MatchingLabels{
+		util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+		util.MultiClusterIngressNameLabel:      mci.Name,
+	}); err != nil {
+		klog.ErrorS(err, "Failed to list works")
+		return err
+	}
+
+	var errs []error
+	for index := range workList.Items {
+		work := &workList.Items[index]
+		clusterName, err := names.GetClusterName(work.Namespace)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get member cluster name for work", "namespace", work.Namespace, "name", work.Name)
+			continue
+		}
+		if reservedClusters.Has(clusterName) && work.Name == generateWorkName(mci) {
+			continue
+		}
+		if err = c.Delete(ctx, work); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete work", "work", klog.KObj(work).String())
+			errs = append(errs, err)
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// aggregateIngressStatus collects the load balancer status of the Ingresses reflected to the works.
+func (c *MCIController) aggregateIngressStatus(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress) (networkingv1.IngressStatus, error) {
+	newStatus := networkingv1.IngressStatus{}
+
+	workList := &workv1alpha1.WorkList{}
+	if err := c.List(ctx, workList, client.MatchingLabels{
+		util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+		util.MultiClusterIngressNameLabel:      mci.Name,
+	}); err != nil {
+		return newStatus, err
+	}
+
+	for _, work := range workList.Items {
+		for _, manifestStatus := range work.Status.ManifestStatuses {
+			if manifestStatus.Identifier.Kind != util.IngressKind || manifestStatus.Status == nil {
+				continue
+			}
+			temp := &networkingv1.IngressStatus{}
+			if err := json.Unmarshal(manifestStatus.Status.Raw, temp); err != nil {
+				klog.ErrorS(err, "Failed to unmarshal ingress status", "work", klog.KObj(&work).String())
+				return newStatus, err
+			}
+			newStatus.LoadBalancer.Ingress = append(newStatus.LoadBalancer.Ingress, temp.LoadBalancer.Ingress...)
+		}
+	}
+	newStatus.LoadBalancer.Ingress = helper.DedupeAndSortIngressLoadBalancerIngress(newStatus.LoadBalancer.Ingress)
+	return newStatus, nil
+}
+
+func (c *MCIController) updateMultiClusterIngressStatus(ctx context.Context, mci *networkingv1alpha1.MultiClusterIngress,
+	newStatus networkingv1alpha1.MultiClusterIngressStatus) error {
+	if equality.Semantic.DeepEqual(mci.Status, newStatus) {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		_, err = helper.UpdateStatus(ctx, c.Client, mci, func() error {
+			mci.Status = newStatus
+			return nil
+		})
+		return err
+	})
+}
+
+// getBackendServiceNames returns the sorted names of all Services referenced by the MultiClusterIngress.
+func getBackendServiceNames(mci *networkingv1alpha1.MultiClusterIngress) []string {
+	svcNames := sets.New[string]()
+	if backend := mci.Spec.DefaultBackend; backend != nil && backend.Service != nil {
+		svcNames.Insert(backend.Service.Name)
+	}
+	for _, rule := range mci.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil {
+				svcNames.Insert(path.Backend.Service.Name)
+			}
+		}
+	}
+	return sets.List(svcNames)
+}
+
+// buildIngress generates the Ingress to be applied to the given cluster. The backends referring to
+// Services that are not located in the cluster are pruned, so that the Ingress only routes traffic
+// to the local Services.
+func buildIngress(mci *networkingv1alpha1.MultiClusterIngress, serviceLocations []networkingv1alpha1.ServiceLocation, clusterName string) *networkingv1.Ingress {
+	localServices := sets.New[string]()
+	for _, location := range serviceLocations {
+		if slices.Contains(location.Clusters, clusterName) {
+			localServices.Insert(location.Name)
+		}
+	}
+	isLocalBackend := func(backend *networkingv1.IngressBackend) bool {
+		return backend.Service == nil || localServices.Has(backend.Service.Name)
+	}
+
+	spec := mci.Spec.DeepCopy()
+	if spec.DefaultBackend != nil && !isLocalBackend(spec.DefaultBackend) {
+		spec.DefaultBackend = nil
+	}
+	rules := make([]networkingv1.IngressRule, 0, len(spec.Rules))
+	for _, rule := range spec.Rules {
+		if rule.HTTP != nil {
+			paths := make([]networkingv1.HTTPIngressPath, 0, len(rule.HTTP.Paths))
+			for _, path := range rule.HTTP.Paths {
+				if isLocalBackend(&path.Backend) {
+					paths = append(paths, path)
+				}
+			}
+			if len(paths) == 0 {
+				continue
+			}
+			rule.HTTP.Paths = paths
+		}
+		rules = append(rules, rule)
+	}
+	spec.Rules = rules
+
+	annotations := make(map[string]string, len(mci.Annotations))
+	for key, value := range mci.Annotations {
+		if key == corev1.LastAppliedConfigAnnotation {
+			continue
+		}
+		annotations[key] = value
+	}
+
+	return &networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       util.IngressKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   mci.Namespace,
+			Name:        mci.Name,
+			Labels:      mci.Labels,
+			Annotations: annotations,
+		},
+		Spec: *spec,
+	}
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *MCIController) SetupWithManager(mgr controllerruntime.Manager) error {
+	mciPredicateFunc := predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			mciOld := e.ObjectOld.(*networkingv1alpha1.MultiClusterIngress)
+			mciNew := e.ObjectNew.(*networkingv1alpha1.MultiClusterIngress)
+			// We only care about the update events below and do not care about the status updating
+			return !equality.Semantic.DeepEqual(mciOld.Annotations, mciNew.Annotations) ||
+				!equality.Semantic.DeepEqual(mciOld.Labels, mciNew.Labels) ||
+				!equality.Semantic.DeepEqual(mciOld.Spec, mciNew.Spec) ||
+				mciOld.DeletionTimestamp.IsZero() != mciNew.DeletionTimestamp.IsZero()
+		},
+		// Since finalizer is added to the MultiClusterIngress object,
+		// the delete event is processed by the update event.
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	workPredicateFunc := predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			workOld := e.ObjectOld.(*workv1alpha1.Work)
+			workNew := e.ObjectNew.(*workv1alpha1.Work)
+			return !reflect.DeepEqual(workOld.Status.ManifestStatuses, workNew.Status.ManifestStatuses)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	bindingPredicateFunc := predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return e.Object.(*workv1alpha2.ResourceBinding).Spec.Resource.Kind == util.ServiceKind
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			bindingOld := e.ObjectOld.(*workv1alpha2.ResourceBinding)
+			bindingNew := e.ObjectNew.(*workv1alpha2.ResourceBinding)
+			return bindingNew.Spec.Resource.Kind == util.ServiceKind &&
+				!reflect.DeepEqual(bindingOld.Spec.Clusters, bindingNew.Spec.Clusters)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return e.Object.(*workv1alpha2.ResourceBinding).Spec.Resource.Kind == util.ServiceKind
+		},
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	clusterPredicateFunc := predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			clusterOld := e.ObjectOld.(*clusterv1alpha1.Cluster)
+			clusterNew := e.ObjectNew.(*clusterv1alpha1.Cluster)
+			return !reflect.DeepEqual(clusterOld.Status.RemedyActions, clusterNew.Status.RemedyActions)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&networkingv1alpha1.MultiClusterIngress{}, builder.WithPredicates(mciPredicateFunc)).
+		Watches(&workv1alpha1.Work{}, handler.EnqueueRequestsFromMapFunc(workMapFunc), builder.WithPredicates(workPredicateFunc)).
+		Watches(&workv1alpha2.ResourceBinding{}, handler.EnqueueRequestsFromMapFunc(c.bindingMapFunc()), builder.WithPredicates(bindingPredicateFunc)).
+		Watches(&clusterv1alpha1.Cluster{}, handler.EnqueueRequestsFromMapFunc(c.clusterMapFunc()), builder.WithPredicates(clusterPredicateFunc)).
+		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
+		Complete(c)
+}
+
+func workMapFunc(_ context.Context, obj client.Object) []reconcile.Request {
+	namespace := util.GetLabelValue(obj.GetLabels(), util.MultiClusterIngressNamespaceLabel)
+	name := util.GetLabelValue(obj.GetLabels(), util.MultiClusterIngressNameLabel)
+	if namespace == "" || name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
+
+// bindingMapFunc enqueues the MultiClusterIngresses which refer to the Service of the binding.
+func (c *MCIController) bindingMapFunc() handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		binding, ok := obj.(*workv1alpha2.ResourceBinding)
+		if !ok {
+			return nil
+		}
+
+		mciList := &networkingv1alpha1.MultiClusterIngressList{}
+		if err := c.Client.List(ctx, mciList, client.InNamespace(binding.Namespace)); err != nil {
+			klog.ErrorS(err, "Failed to list MultiClusterIngress", "namespace", binding.Namespace)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for index := range mciList.Items {
+			if !slices.Contains(getBackendServiceNames(&mciList.Items[index]), binding.Spec.Resource.Name) {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: mciList.Items[index].Namespace, Name: mciList.Items[index].Name}})
+		}
+		return requests
+	}
+}
+
+// clusterMapFunc enqueues all the MultiClusterIngresses once the remedy actions of a cluster change.
+func (c *MCIController) clusterMapFunc() handler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		mciList := &networkingv1alpha1.MultiClusterIngressList{}
+		if err := c.Client.List(ctx, mciList); err != nil {
+			klog.ErrorS(err, "Failed to list MultiClusterIngress")
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(mciList.Items))
+		for index := range mciList.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: mciList.Items[index].Namespace, Name: mciList.Items[index].Name}})
+		}
+		return requests
+	}
+}
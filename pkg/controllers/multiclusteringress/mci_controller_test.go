@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func TestReconcile(t *testing.T) {
+	mci := newMultiClusterIngress("svc-a", "svc-b")
+	objs := []runtime.Object{
+		mci,
+		newServiceBinding("svc-a", "member1", "member2"),
+		newServiceBinding("svc-b", "member2"),
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		&clusterv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "member2"},
+			Status:     clusterv1alpha1.ClusterStatus{RemedyActions: []string{string(remedyv1alpha1.TrafficControl)}},
+		},
+		// stale work in a cluster no longer hosting any backend
+		&workv1alpha1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: names.GenerateExecutionSpaceName("member3"),
+				Name:      generateWorkName(mci),
+				Labels: map[string]string{
+					util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+					util.MultiClusterIngressNameLabel:      mci.Name,
+				},
+			},
+		},
+	}
+	c := newFakeController(objs...)
+
+	_, err := c.Reconcile(context.Background(), controllerruntime.Request{NamespacedName: types.NamespacedName{Namespace: mci.Namespace, Name: mci.Name}})
+	assert.NoError(t, err)
+
+	workList := &workv1alpha1.WorkList{}
+	assert.NoError(t, c.List(context.Background(), workList, client.MatchingLabels{util.MultiClusterIngressNameLabel: mci.Name}))
+	assert.Len(t, workList.Items, 1)
+	assert.Equal(t, names.GenerateExecutionSpaceName("member1"), workList.Items[0].Namespace)
+
+	updated := &networkingv1alpha1.MultiClusterIngress{}
+	assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: mci.Namespace, Name: mci.Name}, updated))
+	assert.Contains(t, updated.Finalizers, util.MCIControllerFinalizer)
+	assert.Equal(t, []string{"member2"}, updated.Status.TrafficBlockClusters)
+	assert.Equal(t, []networkingv1alpha1.ServiceLocation{
+		{Name: "svc-a", Clusters: []string{"member1", "member2"}},
+		{Name: "svc-b", Clusters: []string{"member2"}},
+	}, updated.Status.ServiceLocations)
+}
+
+func TestReconcile_ingressOfSameName(t *testing.T) {
+	mci := newMultiClusterIngress("svc-a")
+	// the work of an Ingress of the same name propagated by a policy
+	bindingWork := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: names.GenerateExecutionSpaceName("member1"),
+			Name:      names.GenerateWorkName(util.IngressKind, mci.Name, mci.Namespace),
+			Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "binding-id"},
+		},
+	}
+	// the work created by a former version, named after the Ingress kind
+	legacyWork := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: names.GenerateExecutionSpaceName("member2"),
+			Name:      names.GenerateWorkName(util.IngressKind, mci.Name, mci.Namespace),
+			Labels: map[string]string{
+				util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+				util.MultiClusterIngressNameLabel:      mci.Name,
+			},
+		},
+	}
+	c := newFakeController(mci, newServiceBinding("svc-a", "member1", "member2"),
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member2"}},
+		bindingWork, legacyWork)
+
+	_, err := c.Reconcile(context.Background(), controllerruntime.Request{NamespacedName: types.NamespacedName{Namespace: mci.Namespace, Name: mci.Name}})
+	assert.NoError(t, err)
+
+	got := &workv1alpha1.Work{}
+	assert.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(bindingWork), got))
+	assert.Equal(t, bindingWork.Labels, got.Labels, "the work of the Ingress should not be taken over")
+	assert.Empty(t, got.Spec.Workload.Manifests)
+
+	workList := &workv1alpha1.WorkList{}
+	assert.NoError(t, c.List(context.Background(), workList, client.MatchingLabels{util.MultiClusterIngressNameLabel: mci.Name}))
+	var workKeys []string
+	for _, work := range workList.Items {
+		workKeys = append(workKeys, work.Namespace+"/"+work.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		names.GenerateExecutionSpaceName("member1") + "/" + generateWorkName(mci),
+		names.GenerateExecutionSpaceName("member2") + "/" + generateWorkName(mci),
+	}, workKeys)
+}
+
+func TestHandleMultiClusterIngressDelete(t *testing.T) {
+	mci := newMultiClusterIngress("svc-a")
+	mci.Finalizers = []string{util.MCIControllerFinalizer}
+	work := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: names.GenerateExecutionSpaceName("member1"),
+			Name:      generateWorkName(mci),
+			Labels: map[string]string{
+				util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+				util.MultiClusterIngressNameLabel:      mci.Name,
+			},
+		},
+	}
+	c := newFakeController(mci, work)
+
+	_, err := c.handleMultiClusterIngressDelete(context.Background(), mci)
+	assert.NoError(t, err)
+
+	workList := &workv1alpha1.WorkList{}
+	assert.NoError(t, c.List(context.Background(), workList))
+	assert.Empty(t, workList.Items)
+
+	updated := &networkingv1alpha1.MultiClusterIngress{}
+	assert.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: mci.Namespace, Name: mci.Name}, updated))
+	assert.NotContains(t, updated.Finalizers, util.MCIControllerFinalizer)
+}
+
+func TestAggregateIngressStatus(t *testing.T) {
+	mci := newMultiClusterIngress("svc-a")
+	newWork := func(cluster, ip string) *workv1alpha1.Work {
+		raw, _ := json.Marshal(networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: ip}},
+		}})
+		return &workv1alpha1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: names.GenerateExecutionSpaceName(cluster),
+				Name:      generateWorkName(mci),
+				Labels: map[string]string{
+					util.MultiClusterIngressNamespaceLabel: mci.Namespace,
+					util.MultiClusterIngressNameLabel:      mci.Name,
+				},
+			},
+			Status: workv1alpha1.WorkStatus{ManifestStatuses: []workv1alpha1.ManifestStatus{{
+				Identifier: workv1alpha1.ResourceIdentifier{Kind: util.IngressKind, Name: mci.Name, Namespace: mci.Namespace},
+				Status:     &runtime.RawExtension{Raw: raw},
+			}}},
+		}
+	}
+	c := newFakeController(mci, newWork("member2", "10.0.0.2"), newWork("member1", "10.0.0.1"), newWork("member3", "10.0.0.1"))
+
+	status, err := c.aggregateIngressStatus(context.Background(), mci)
+	assert.NoError(t, err)
+	assert.Equal(t, []networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}}, status.LoadBalancer.Ingress)
+}
+
+func TestBuildIngress(t *testing.T) {
+	mci := newMultiClusterIngress("svc-a", "svc-b")
+	mci.Annotations = map[string]string{"kubernetes.io/ingress.class": "nginx"}
+	mci.Spec.DefaultBackend = &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "svc-b"}}
+	locations := []networkingv1alpha1.ServiceLocation{
+		{Name: "svc-a", Clusters: []string{"member1", "member2"}},
+		{Name: "svc-b", Clusters: []string{"member2"}},
+	}
+
+	ingress := buildIngress(mci, locations, "member1")
+	assert.Equal(t, util.IngressKind, ingress.Kind)
+	assert.Equal(t, mci.Annotations, ingress.Annotations)
+	assert.Nil(t, ingress.Spec.DefaultBackend)
+	assert.Len(t, ingress.Spec.Rules, 1)
+	assert.Equal(t, "svc-a", ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+
+	ingress = buildIngress(mci, locations, "member2")
+	assert.NotNil(t, ingress.Spec.DefaultBackend)
+	assert.Len(t, ingress.Spec.Rules, 2)
+}
+
+func TestGetBackendServiceNames(t *testing.T) {
+	mci := newMultiClusterIngress("svc-b", "svc-a", "svc-b")
+	mci.Spec.DefaultBackend = &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "svc-c"}}
+	assert.Equal(t, []string{"svc-a", "svc-b", "svc-c"}, getBackendServiceNames(mci))
+}
+
+func newMultiClusterIngress(services ...string) *networkingv1alpha1.MultiClusterIngress {
+	mci := &networkingv1alpha1.MultiClusterIngress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-mci"},
+	}
+	pathType := networkingv1.PathTypePrefix
+	for _, svc := range services {
+		mci.Spec.Rules = append(mci.Spec.Rules, networkingv1.IngressRule{
+			Host: svc + ".example.com",
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{{
+					Path:     "/",
+					PathType: &pathType,
+					Backend:  networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: svc}},
+				}},
+			}},
+		})
+	}
+	return mci
+}
+
+func newServiceBinding(svc string, clusters ...string) *workv1alpha2.ResourceBinding {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: names.GenerateBindingName(util.ServiceKind, svc)},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "v1", Kind: util.ServiceKind, Namespace: "default", Name: svc},
+		},
+	}
+	for _, cluster := range clusters {
+		binding.Spec.Clusters = append(binding.Spec.Clusters, workv1alpha2.TargetCluster{Name: cluster})
+	}
+	return binding
+}
+
+func newFakeController(objs ...runtime.Object) *MCIController {
+	s := runtime.NewScheme()
+	_ = scheme.AddToScheme(s)
+	_ = clusterv1alpha1.Install(s)
+	_ = networkingv1alpha1.Install(s)
+	_ = workv1alpha1.Install(s)
+	_ = workv1alpha2.Install(s)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha1.MultiClusterIngress{}).Build()
+	return &MCIController{
+		Client:        fakeClient,
+		EventRecorder: record.NewFakeRecorder(100),
+	}
+}
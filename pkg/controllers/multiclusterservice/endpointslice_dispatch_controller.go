@@ -18,6 +18,7 @@ package multiclusterservice
 
 import (
 	"context"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
@@ -27,8 +28,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -114,13 +117,99 @@ func (c *EndpointsliceDispatchController) Reconcile(ctx context.Context, req con
 		return controllerruntime.Result{}, err
 	}
 
-	if err = c.dispatchEndpointSlice(ctx, work.DeepCopy(), mcs); err != nil {
+	providerWorks, err := c.getProviderEndpointSliceWorks(ctx, mcs)
+	if err != nil {
 		return controllerruntime.Result{}, err
 	}
+	router, err := c.buildTrafficRouter(mcs, providerWorks)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	if err = c.dispatchEndpointSlice(ctx, work.DeepCopy(), mcs, router); err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	// With locality preference, whether the EndpointSlices from a provider cluster are dispatched
+	// depends on the readiness of the other provider clusters, and with provider weights, the share of a
+	// provider cluster is taken from all of its EndpointSlices, so re-evaluate all of them.
+	if mcs.Spec.TrafficPolicy != nil && (mcs.Spec.TrafficPolicy.LocalityPreference != "" || len(mcs.Spec.TrafficPolicy.ProviderWeights) > 0) {
+		for index := range providerWorks {
+			if providerWorks[index].Namespace == work.Namespace && providerWorks[index].Name == work.Name {
+				continue
+			}
+			if err = c.dispatchEndpointSlice(ctx, providerWorks[index].DeepCopy(), mcs, router); err != nil {
+				return controllerruntime.Result{}, err
+			}
+		}
+	}
 
 	return controllerruntime.Result{}, nil
 }
 
+// getProviderEndpointSliceWorks returns the EndpointSlice works collected from the provider clusters of the MultiClusterService.
+func (c *EndpointsliceDispatchController) getProviderEndpointSliceWorks(ctx context.Context, mcs *networkingv1alpha1.MultiClusterService) ([]workv1alpha1.Work, error) {
+	workList, err := c.getClusterEndpointSliceWorks(ctx, mcs.Namespace, mcs.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var providerWorks []workv1alpha1.Work
+	for _, work := range workList {
+		// This annotation is only added to the EndpointSlice work in consumer clusters' execution namespace
+		if util.GetAnnotationValue(work.Annotations, util.EndpointSliceProvisionClusterAnnotation) != "" {
+			continue
+		}
+		if !work.DeletionTimestamp.IsZero() || !util.IsWorkContains(work.Spec.Workload.Manifests, util.EndpointSliceGVK) {
+			continue
+		}
+		providerWorks = append(providerWorks, work)
+	}
+	return providerWorks, nil
+}
+
+// buildTrafficRouter builds the router applying the TrafficPolicy of the MultiClusterService, it returns nil
+// if the MultiClusterService has no TrafficPolicy.
+func (c *EndpointsliceDispatchController) buildTrafficRouter(mcs *networkingv1alpha1.MultiClusterService, providerWorks []workv1alpha1.Work) (*trafficRouter, error) {
+	if mcs.Spec.TrafficPolicy == nil {
+		return nil, nil
+	}
+
+	readyProviderNames := sets.New[string]()
+	providerEndpoints := make(map[string][]discoveryv1.Endpoint)
+	for index := range providerWorks {
+		endpoints, err := endpointsOf(&providerWorks[index])
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse EndpointSlice work", "namespace", providerWorks[index].Namespace, "name", providerWorks[index].Name)
+			return nil, err
+		}
+		clusterName, err := names.GetClusterName(providerWorks[index].Namespace)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get cluster name for work", "namespace", providerWorks[index].Namespace, "name", providerWorks[index].Name)
+			return nil, err
+		}
+		providerEndpoints[clusterName] = append(providerEndpoints[clusterName], endpoints...)
+		if slices.ContainsFunc(endpoints, isEndpointReady) {
+			readyProviderNames.Insert(clusterName)
+		}
+	}
+
+	readyProviders := make([]*clusterv1alpha1.Cluster, 0, readyProviderNames.Len())
+	for _, clusterName := range sets.List(readyProviderNames) {
+		clusterObj, err := util.GetCluster(c.Client, clusterName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			klog.ErrorS(err, "Failed to get cluster", "cluster", clusterName)
+			return nil, err
+		}
+		readyProviders = append(readyProviders, clusterObj)
+	}
+
+	return newTrafficRouter(mcs.Spec.TrafficPolicy, readyProviders, providerEndpoints), nil
+}
+
 func (c *EndpointsliceDispatchController) updateEndpointSliceDispatched(ctx context.Context, mcs *networkingv1alpha1.MultiClusterService, status metav1.ConditionStatus, reason, message string) error {
 	EndpointSliceCollected := metav1.Condition{
 		Type:               networkingv1alpha1.EndpointSliceDispatched,
@@ -169,6 +258,7 @@ func (c *EndpointsliceDispatchController) SetupWithManager(mgr controllerruntime
 		For(&workv1alpha1.Work{}, builder.WithPredicates(workPredicateFun)).
 		Watches(&networkingv1alpha1.MultiClusterService{}, handler.EnqueueRequestsFromMapFunc(c.newMultiClusterServiceFunc())).
 		Watches(&clusterv1alpha1.Cluster{}, handler.EnqueueRequestsFromMapFunc(c.newClusterFunc())).
+		Watches(&clusterv1alpha1.Cluster{}, c.newClusterLocalityEventHandler()).
 		WithOptions(controller.Options{RateLimiter: ratelimiterflag.DefaultControllerRateLimiter[controllerruntime.Request](c.RateLimiterOptions)}).
 		Complete(c)
 }
@@ -221,6 +311,53 @@ func (c *EndpointsliceDispatchController) newClusterFunc() handler.MapFunc {
 	}
 }
 
+// newClusterLocalityEventHandler re-dispatches the EndpointSlices of the MultiClusterServices with locality
+// preference when the region or zones of a cluster change, since the locality decides where they are dispatched.
+func (c *EndpointsliceDispatchController) newClusterLocalityEventHandler() handler.EventHandler {
+	return handler.Funcs{
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldCluster, ok := e.ObjectOld.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return
+			}
+			newCluster, ok := e.ObjectNew.(*clusterv1alpha1.Cluster)
+			if !ok || !clusterLocalityChanged(oldCluster, newCluster) {
+				return
+			}
+			for _, request := range c.localityPreferredProviderWorks(ctx) {
+				q.Add(request)
+			}
+		},
+	}
+}
+
+// localityPreferredProviderWorks returns the EndpointSlice works collected from the provider clusters of the
+// MultiClusterServices with locality preference.
+func (c *EndpointsliceDispatchController) localityPreferredProviderWorks(ctx context.Context) []reconcile.Request {
+	mcsList := &networkingv1alpha1.MultiClusterServiceList{}
+	if err := c.Client.List(ctx, mcsList); err != nil {
+		klog.ErrorS(err, "Failed to list MultiClusterService")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for index := range mcsList.Items {
+		mcs := &mcsList.Items[index]
+		if mcs.Spec.TrafficPolicy == nil || mcs.Spec.TrafficPolicy.LocalityPreference == "" {
+			continue
+		}
+		providerWorks, err := c.getProviderEndpointSliceWorks(ctx, mcs)
+		if err != nil {
+			klog.ErrorS(err, "Failed to list provider EndpointSlice works of MultiClusterService", "namespace", mcs.Namespace, "name", mcs.Name)
+			continue
+		}
+		for _, work := range providerWorks {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: work.Namespace, Name: work.Name}})
+		}
+	}
+	return requests
+}
+
 func (c *EndpointsliceDispatchController) getClusterEndpointSliceWorks(ctx context.Context, mcsNamespace, mcsName string) ([]workv1alpha1.Work, error) {
 	workList := &workv1alpha1.WorkList{}
 	if err := c.Client.List(ctx, workList, &client.ListOptions{
@@ -308,13 +445,21 @@ func (c *EndpointsliceDispatchController) cleanOrphanDispatchedEndpointSlice(ctx
 	return nil
 }
 
-func (c *EndpointsliceDispatchController) dispatchEndpointSlice(ctx context.Context, work *workv1alpha1.Work, mcs *networkingv1alpha1.MultiClusterService) error {
+func (c *EndpointsliceDispatchController) dispatchEndpointSlice(ctx context.Context, work *workv1alpha1.Work, mcs *networkingv1alpha1.MultiClusterService, router *trafficRouter) error {
 	epsSourceCluster, err := names.GetClusterName(work.Namespace)
 	if err != nil {
 		klog.ErrorS(err, "Failed to get EndpointSlice source cluster name for work", "namespace", work.Namespace, "name", work.Name)
 		return err
 	}
 
+	providerClusterObj := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: epsSourceCluster}}
+	if router != nil {
+		if providerClusterObj, err = util.GetCluster(c.Client, epsSourceCluster); err != nil {
+			klog.ErrorS(err, "Failed to get cluster", "cluster", epsSourceCluster)
+			return err
+		}
+	}
+
 	consumerClusters, err := helper.GetConsumerClusters(c.Client, mcs)
 	if err != nil {
 		klog.ErrorS(err, "Failed to get consumer clusters")
@@ -344,7 +489,7 @@ func (c *EndpointsliceDispatchController) dispatchEndpointSlice(ctx context.Cont
 			continue
 		}
 
-		if err = c.ensureEndpointSliceWork(ctx, mcs, work, epsSourceCluster, clusterName); err != nil {
+		if err = c.ensureEndpointSliceWork(ctx, mcs, work, providerClusterObj, clusterObj, router); err != nil {
 			return err
 		}
 	}
@@ -352,7 +497,8 @@ func (c *EndpointsliceDispatchController) dispatchEndpointSlice(ctx context.Cont
 }
 
 func (c *EndpointsliceDispatchController) ensureEndpointSliceWork(ctx context.Context, mcs *networkingv1alpha1.MultiClusterService,
-	work *workv1alpha1.Work, providerCluster, consumerCluster string) error {
+	work *workv1alpha1.Work, providerClusterObj, consumerClusterObj *clusterv1alpha1.Cluster, router *trafficRouter) error {
+	providerCluster, consumerCluster := providerClusterObj.Name, consumerClusterObj.Name
 	// It couldn't happen here
 	if len(work.Spec.Workload.Manifests) == 0 {
 		return nil
@@ -372,6 +518,8 @@ func (c *EndpointsliceDispatchController) ensureEndpointSliceWork(ctx context.Co
 		return err
 	}
 
+	router.route(endpointSlice, providerClusterObj, consumerClusterObj)
+
 	// Use this name to avoid naming conflicts and locate the EPS source cluster.
 	endpointSlice.Name = providerCluster + "-" + endpointSlice.Name
 	clusterNamespace := names.GenerateExecutionSpaceName(consumerCluster)
@@ -31,8 +31,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -661,7 +663,9 @@ func TestEnsureEndpointSliceWork(t *testing.T) {
 				Client: fakeClient,
 			}
 
-			err := c.ensureEndpointSliceWork(context.Background(), tt.mcs, tt.work, tt.providerCluster, tt.consumerCluster)
+			providerCluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: tt.providerCluster}}
+			consumerCluster := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: tt.consumerCluster}}
+			err := c.ensureEndpointSliceWork(context.Background(), tt.mcs, tt.work, providerCluster, consumerCluster, nil)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -918,3 +922,77 @@ func (f *fakeClient) List(ctx context.Context, list client.ObjectList, opts ...c
 	}
 	return f.Client.List(ctx, list, opts...)
 }
+
+func TestNewClusterLocalityEventHandler(t *testing.T) {
+	newEndpointSliceWork := func(namespace, name, mcsName string, annotations map[string]string) *workv1alpha1.Work {
+		return &workv1alpha1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: annotations,
+				Labels: map[string]string{
+					util.MultiClusterServiceNameLabel:      mcsName,
+					util.MultiClusterServiceNamespaceLabel: "default",
+				},
+			},
+			Spec: workv1alpha1.WorkSpec{Workload: workv1alpha1.WorkloadTemplate{Manifests: []workv1alpha1.Manifest{{
+				RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"discovery.k8s.io/v1","kind":"EndpointSlice","metadata":{"name":"eps"}}`)},
+			}}}},
+		}
+	}
+	existingObjs := []client.Object{
+		&networkingv1alpha1.MultiClusterService{
+			ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "default"},
+			Spec: networkingv1alpha1.MultiClusterServiceSpec{
+				TrafficPolicy: &networkingv1alpha1.TrafficPolicy{LocalityPreference: networkingv1alpha1.LocalityPreferenceRegion},
+			},
+		},
+		&networkingv1alpha1.MultiClusterService{
+			ObjectMeta: metav1.ObjectMeta{Name: "global", Namespace: "default"},
+		},
+		newEndpointSliceWork("karmada-es-member1", "local-eps", "local", nil),
+		newEndpointSliceWork("karmada-es-member2", "local-eps", "local",
+			map[string]string{util.EndpointSliceProvisionClusterAnnotation: "member1"}),
+		newEndpointSliceWork("karmada-es-member1", "global-eps", "global", nil),
+	}
+	c := &EndpointsliceDispatchController{
+		Client: fake.NewClientBuilder().WithScheme(setupSchemeEndpointDispatch()).WithObjects(existingObjs...).Build(),
+	}
+	eventHandler := c.newClusterLocalityEventHandler()
+
+	tests := []struct {
+		name             string
+		oldCluster       *clusterv1alpha1.Cluster
+		newCluster       *clusterv1alpha1.Cluster
+		expectedRequests []reconcile.Request
+	}{
+		{
+			name:       "locality unchanged",
+			oldCluster: &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}, Spec: clusterv1alpha1.ClusterSpec{Region: "east"}},
+			newCluster: &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}, Spec: clusterv1alpha1.ClusterSpec{Region: "east"}},
+		},
+		{
+			name:       "region changed",
+			oldCluster: &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}, Spec: clusterv1alpha1.ClusterSpec{Region: "east"}},
+			newCluster: &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}, Spec: clusterv1alpha1.ClusterSpec{Region: "west"}},
+			expectedRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "karmada-es-member1", Name: "local-eps"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := workqueue.NewTypedRateLimitingQueue[reconcile.Request](workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+
+			eventHandler.Update(context.TODO(), event.UpdateEvent{ObjectOld: tt.oldCluster, ObjectNew: tt.newCluster}, queue)
+			var requests []reconcile.Request
+			for queue.Len() > 0 {
+				request, _ := queue.Get()
+				requests = append(requests, request)
+				queue.Done(request)
+			}
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"slices"
+	"strings"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+const defaultProviderWeight int32 = 100
+
+// trafficRouter applies the TrafficPolicy of a MultiClusterService to the EndpointSlices
+// dispatched from the provider clusters to the consumer clusters.
+type trafficRouter struct {
+	policy *networkingv1alpha1.TrafficPolicy
+	// readyProviders are the provider clusters which have at least one ready endpoint.
+	readyProviders []*clusterv1alpha1.Cluster
+	// selectedEndpoints are the ready endpoints kept for the provider clusters if any weight is specified,
+	// keyed by the cluster name. They are selected from all the endpoints of the provider cluster rather than
+	// each EndpointSlice, so that the weight still holds when the endpoints spread over many EndpointSlices.
+	selectedEndpoints map[string]sets.Set[string]
+}
+
+// newTrafficRouter builds a trafficRouter, the readyProviders and the providerEndpoints should be built from
+// the EndpointSlice works collected from the provider clusters.
+func newTrafficRouter(policy *networkingv1alpha1.TrafficPolicy, readyProviders []*clusterv1alpha1.Cluster,
+	providerEndpoints map[string][]discoveryv1.Endpoint) *trafficRouter {
+	if policy == nil {
+		return nil
+	}
+
+	return &trafficRouter{
+		policy:            policy,
+		readyProviders:    readyProviders,
+		selectedEndpoints: selectWeightedEndpoints(policy.ProviderWeights, providerEndpoints),
+	}
+}
+
+// selectWeightedEndpoints selects the ready endpoints of each provider cluster, so that the numbers of the selected
+// endpoints, which the traffic spreads over evenly, are in proportion to the weights of the provider clusters.
+// The provider cluster with the fewest ready endpoints relative to its weight keeps all of them, and the others
+// keep as many as the proportion allows. It returns nil if no weight is specified.
+func selectWeightedEndpoints(providerWeights []networkingv1alpha1.ProviderWeight,
+	providerEndpoints map[string][]discoveryv1.Endpoint) map[string]sets.Set[string] {
+	if len(providerWeights) == 0 {
+		return nil
+	}
+	weightOf := func(cluster string) int64 {
+		for _, providerWeight := range providerWeights {
+			if providerWeight.Name == cluster {
+				return int64(providerWeight.Weight)
+			}
+		}
+		return int64(defaultProviderWeight)
+	}
+
+	readyEndpoints := make(map[string][]string, len(providerEndpoints))
+	// baseCount and baseWeight are of the provider cluster with the fewest ready endpoints relative to its weight.
+	var baseCount, baseWeight int64
+	for cluster, endpoints := range providerEndpoints {
+		ready := sets.New[string]()
+		for _, endpoint := range endpoints {
+			if isEndpointReady(endpoint) {
+				ready.Insert(endpointKey(endpoint))
+			}
+		}
+		// Sort endpoints to make the selection stable across reconciliations.
+		readyEndpoints[cluster] = sets.List(ready)
+
+		count, weight := int64(ready.Len()), weightOf(cluster)
+		if count == 0 || weight == 0 {
+			continue
+		}
+		if baseWeight == 0 || count*baseWeight < baseCount*weight {
+			baseCount, baseWeight = count, weight
+		}
+	}
+
+	selectedEndpoints := make(map[string]sets.Set[string], len(readyEndpoints))
+	for cluster, ready := range readyEndpoints {
+		keep := 0
+		if weight := weightOf(cluster); weight > 0 && baseWeight > 0 {
+			// Round to the nearest, and keep at least one endpoint for a provider cluster with a non-zero weight.
+			keep = int((2*baseCount*weight + baseWeight) / (2 * baseWeight))
+			keep = min(max(keep, 1), len(ready))
+		}
+		selectedEndpoints[cluster] = sets.New(ready[:keep]...)
+	}
+	return selectedEndpoints
+}
+
+// route filters the endpoints of the EndpointSlice, which is collected from the provider cluster,
+// before it is dispatched to the consumer cluster.
+func (r *trafficRouter) route(endpointSlice *discoveryv1.EndpointSlice, provider, consumer *clusterv1alpha1.Cluster) {
+	if r == nil {
+		return
+	}
+
+	if !r.preferred(provider, consumer) {
+		endpointSlice.Endpoints = nil
+		return
+	}
+
+	selected, weighted := r.selectedEndpoints[provider.Name]
+	if !weighted {
+		return
+	}
+
+	var endpoints []discoveryv1.Endpoint
+	for _, endpoint := range endpointSlice.Endpoints {
+		// The endpoints which are not ready receive no traffic, keep them along with their conditions
+		// as the consumers of the EndpointSlice expect, e.g. to drain the terminating endpoints.
+		if !isEndpointReady(endpoint) || selected.Has(endpointKey(endpoint)) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	endpointSlice.Endpoints = endpoints
+}
+
+// preferred tells whether the endpoints of the provider cluster should be dispatched to the consumer cluster
+// according to the locality preference. The provider out of the consumer's locality is only used when none
+// of the providers in the locality has ready endpoints.
+func (r *trafficRouter) preferred(provider, consumer *clusterv1alpha1.Cluster) bool {
+	if r.policy.LocalityPreference == "" || inSameLocality(r.policy.LocalityPreference, provider, consumer) {
+		return true
+	}
+
+	for _, readyProvider := range r.readyProviders {
+		if inSameLocality(r.policy.LocalityPreference, readyProvider, consumer) {
+			return false
+		}
+	}
+	return true
+}
+
+// inSameLocality tells whether the two clusters are located in the same region or zone.
+func inSameLocality(preference networkingv1alpha1.LocalityPreference, a, b *clusterv1alpha1.Cluster) bool {
+	switch preference {
+	case networkingv1alpha1.LocalityPreferenceRegion:
+		return a.Spec.Region != "" && a.Spec.Region == b.Spec.Region
+	case networkingv1alpha1.LocalityPreferenceZone:
+		zonesA, zonesB := clusterZones(a), clusterZones(b)
+		for _, zone := range zonesA {
+			if slices.Contains(zonesB, zone) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+func clusterZones(cluster *clusterv1alpha1.Cluster) []string {
+	if len(cluster.Spec.Zones) > 0 {
+		return cluster.Spec.Zones
+	}
+	if cluster.Spec.Zone != "" {
+		return []string{cluster.Spec.Zone}
+	}
+	return nil
+}
+
+func isEndpointReady(endpoint discoveryv1.Endpoint) bool {
+	return endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+}
+
+func endpointKey(endpoint discoveryv1.Endpoint) string {
+	return strings.Join(endpoint.Addresses, ",")
+}
+
+// endpointsOf returns the endpoints of the EndpointSlices held by the work.
+func endpointsOf(work *workv1alpha1.Work) ([]discoveryv1.Endpoint, error) {
+	var endpoints []discoveryv1.Endpoint
+	for _, manifest := range work.Spec.Workload.Manifests {
+		unstructuredObj := &unstructured.Unstructured{}
+		if err := unstructuredObj.UnmarshalJSON(manifest.Raw); err != nil {
+			return nil, err
+		}
+		endpointSlice := &discoveryv1.EndpointSlice{}
+		if err := helper.ConvertToTypedObject(unstructuredObj, endpointSlice); err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, endpointSlice.Endpoints...)
+	}
+	return endpoints, nil
+}
+
+// clusterLocalityChanged tells whether the region or zones of the cluster changed.
+func clusterLocalityChanged(oldCluster, newCluster *clusterv1alpha1.Cluster) bool {
+	return oldCluster.Spec.Region != newCluster.Spec.Region ||
+		!slices.Equal(clusterZones(oldCluster), clusterZones(newCluster))
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+)
+
+func newLocatedCluster(name, region string, zones ...string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       clusterv1alpha1.ClusterSpec{Region: region, Zones: zones},
+	}
+}
+
+func newEndpointSlice(addresses ...string) *discoveryv1.EndpointSlice {
+	eps := &discoveryv1.EndpointSlice{}
+	for _, address := range addresses {
+		eps.Endpoints = append(eps.Endpoints, discoveryv1.Endpoint{Addresses: []string{address}})
+	}
+	return eps
+}
+
+func TestTrafficRouterRoute(t *testing.T) {
+	east1 := newLocatedCluster("east1", "east", "east-a")
+	east2 := newLocatedCluster("east2", "east", "east-b")
+	west1 := newLocatedCluster("west1", "west", "west-a")
+
+	tests := []struct {
+		name              string
+		router            *trafficRouter
+		provider          *clusterv1alpha1.Cluster
+		consumer          *clusterv1alpha1.Cluster
+		endpointSlice     *discoveryv1.EndpointSlice
+		expectedAddresses []string
+	}{
+		{
+			name:              "nil router keeps all endpoints",
+			router:            nil,
+			provider:          west1,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.1", "10.0.0.2"),
+			expectedAddresses: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name: "remote provider is filtered if local provider is ready",
+			router: newTrafficRouter(&networkingv1alpha1.TrafficPolicy{LocalityPreference: networkingv1alpha1.LocalityPreferenceRegion},
+				[]*clusterv1alpha1.Cluster{east2, west1}, nil),
+			provider:          west1,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.1"),
+			expectedAddresses: nil,
+		},
+		{
+			name: "local provider is dispatched",
+			router: newTrafficRouter(&networkingv1alpha1.TrafficPolicy{LocalityPreference: networkingv1alpha1.LocalityPreferenceRegion},
+				[]*clusterv1alpha1.Cluster{east2, west1}, nil),
+			provider:          east2,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.1"),
+			expectedAddresses: []string{"10.0.0.1"},
+		},
+		{
+			name: "remote provider is dispatched if no local provider is ready",
+			router: newTrafficRouter(&networkingv1alpha1.TrafficPolicy{LocalityPreference: networkingv1alpha1.LocalityPreferenceRegion},
+				[]*clusterv1alpha1.Cluster{west1}, nil),
+			provider:          west1,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.1"),
+			expectedAddresses: []string{"10.0.0.1"},
+		},
+		{
+			name: "zone preference filters provider in the same region but another zone",
+			router: newTrafficRouter(&networkingv1alpha1.TrafficPolicy{LocalityPreference: networkingv1alpha1.LocalityPreferenceZone},
+				[]*clusterv1alpha1.Cluster{east1, east2}, nil),
+			provider:          east2,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.1"),
+			expectedAddresses: nil,
+		},
+		{
+			name: "weight keeps a share of the ready endpoints",
+			router: newTrafficRouter(&networkingv1alpha1.TrafficPolicy{
+				ProviderWeights: []networkingv1alpha1.ProviderWeight{{Name: "west1", Weight: 50}},
+			}, nil, map[string][]discoveryv1.Endpoint{
+				"west1": newEndpointSlice("10.0.0.3", "10.0.0.1", "10.0.0.2").Endpoints,
+				"east2": newEndpointSlice("10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4").Endpoints,
+			}),
+			provider:          west1,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.3", "10.0.0.1", "10.0.0.2"),
+			expectedAddresses: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name: "zero weight drops all endpoints",
+			router: newTrafficRouter(&networkingv1alpha1.TrafficPolicy{
+				ProviderWeights: []networkingv1alpha1.ProviderWeight{{Name: "west1", Weight: 0}},
+			}, nil, map[string][]discoveryv1.Endpoint{"west1": newEndpointSlice("10.0.0.1").Endpoints}),
+			provider:          west1,
+			consumer:          east1,
+			endpointSlice:     newEndpointSlice("10.0.0.1"),
+			expectedAddresses: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.router.route(tt.endpointSlice, tt.provider, tt.consumer)
+			var addresses []string
+			for _, endpoint := range tt.endpointSlice.Endpoints {
+				addresses = append(addresses, endpoint.Addresses...)
+			}
+			assert.Equal(t, tt.expectedAddresses, addresses)
+		})
+	}
+}
+
+func TestTrafficRouterRouteAcrossEndpointSlices(t *testing.T) {
+	// The endpoints of the provider cluster spread over four EndpointSlices with one endpoint each.
+	var endpointSlices []*discoveryv1.EndpointSlice
+	var endpoints []discoveryv1.Endpoint
+	for _, address := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"} {
+		eps := newEndpointSlice(address)
+		endpointSlices = append(endpointSlices, eps)
+		endpoints = append(endpoints, eps.Endpoints...)
+	}
+	router := newTrafficRouter(&networkingv1alpha1.TrafficPolicy{
+		ProviderWeights: []networkingv1alpha1.ProviderWeight{{Name: "member1", Weight: 50}},
+	}, nil, map[string][]discoveryv1.Endpoint{
+		"member1": endpoints,
+		"member3": newEndpointSlice("10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4").Endpoints,
+	})
+
+	var addresses []string
+	for _, eps := range endpointSlices {
+		router.route(eps, newLocatedCluster("member1", ""), newLocatedCluster("member2", ""))
+		for _, endpoint := range eps.Endpoints {
+			addresses = append(addresses, endpoint.Addresses...)
+		}
+	}
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, addresses)
+}
+
+func TestTrafficRouterRouteKeepsUnreadyEndpoints(t *testing.T) {
+	eps := newEndpointSlice("10.0.0.1", "10.0.0.2", "10.0.0.3")
+	eps.Endpoints[0].Conditions.Ready = ptr.To(false)
+	router := newTrafficRouter(&networkingv1alpha1.TrafficPolicy{
+		ProviderWeights: []networkingv1alpha1.ProviderWeight{{Name: "member1", Weight: 100}, {Name: "member2", Weight: 50}},
+	}, nil, map[string][]discoveryv1.Endpoint{
+		"member1": newEndpointSlice("10.0.1.1", "10.0.1.2").Endpoints,
+		"member2": eps.Endpoints,
+	})
+
+	router.route(eps, newLocatedCluster("member2", ""), newLocatedCluster("member3", ""))
+	assert.Len(t, eps.Endpoints, 2)
+	assert.Equal(t, []string{"10.0.0.1"}, eps.Endpoints[0].Addresses)
+	assert.Equal(t, ptr.To(false), eps.Endpoints[0].Conditions.Ready)
+	assert.Equal(t, []string{"10.0.0.2"}, eps.Endpoints[1].Addresses)
+}
+
+func TestSelectWeightedEndpoints(t *testing.T) {
+	tests := []struct {
+		name              string
+		providerWeights   []networkingv1alpha1.ProviderWeight
+		providerEndpoints map[string][]discoveryv1.Endpoint
+		expected          map[string]int
+	}{
+		{
+			name: "no weights",
+			providerEndpoints: map[string][]discoveryv1.Endpoint{
+				"member1": newEndpointSlice("10.0.0.1").Endpoints,
+			},
+			expected: nil,
+		},
+		{
+			name:            "equal weights keep equal numbers of endpoints",
+			providerWeights: []networkingv1alpha1.ProviderWeight{{Name: "member1", Weight: 50}, {Name: "member2", Weight: 50}},
+			providerEndpoints: map[string][]discoveryv1.Endpoint{
+				"member1": newEndpointSlice("10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5",
+					"10.0.0.6", "10.0.0.7", "10.0.0.8", "10.0.0.9", "10.0.0.10").Endpoints,
+				"member2": newEndpointSlice("10.0.1.1", "10.0.1.2").Endpoints,
+			},
+			expected: map[string]int{"member1": 2, "member2": 2},
+		},
+		{
+			name:            "endpoints in proportion to the weights",
+			providerWeights: []networkingv1alpha1.ProviderWeight{{Name: "member1", Weight: 75}, {Name: "member2", Weight: 25}},
+			providerEndpoints: map[string][]discoveryv1.Endpoint{
+				"member1": newEndpointSlice("10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6").Endpoints,
+				"member2": newEndpointSlice("10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4").Endpoints,
+			},
+			expected: map[string]int{"member1": 6, "member2": 2},
+		},
+		{
+			name:            "unlisted provider has the default weight",
+			providerWeights: []networkingv1alpha1.ProviderWeight{{Name: "member1", Weight: 50}},
+			providerEndpoints: map[string][]discoveryv1.Endpoint{
+				"member1": newEndpointSlice("10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4").Endpoints,
+				"member2": newEndpointSlice("10.0.1.1", "10.0.1.2", "10.0.1.3", "10.0.1.4").Endpoints,
+			},
+			expected: map[string]int{"member1": 2, "member2": 4},
+		},
+		{
+			name:            "provider with a non-zero weight keeps at least one endpoint",
+			providerWeights: []networkingv1alpha1.ProviderWeight{{Name: "member1", Weight: 1}, {Name: "member2", Weight: 0}},
+			providerEndpoints: map[string][]discoveryv1.Endpoint{
+				"member1": newEndpointSlice("10.0.0.1", "10.0.0.2").Endpoints,
+				"member2": newEndpointSlice("10.0.1.1").Endpoints,
+				"member3": newEndpointSlice("10.0.2.1", "10.0.2.2").Endpoints,
+				"member4": nil,
+			},
+			expected: map[string]int{"member1": 1, "member2": 0, "member3": 2, "member4": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := selectWeightedEndpoints(tt.providerWeights, tt.providerEndpoints)
+			if tt.expected == nil {
+				assert.Nil(t, selected)
+				return
+			}
+			counts := make(map[string]int, len(selected))
+			for cluster, endpoints := range selected {
+				counts[cluster] = endpoints.Len()
+			}
+			assert.Equal(t, tt.expected, counts)
+		})
+	}
+}
+
+func TestInSameLocality(t *testing.T) {
+	assert.True(t, inSameLocality(networkingv1alpha1.LocalityPreferenceRegion, newLocatedCluster("a", "east"), newLocatedCluster("b", "east")))
+	assert.False(t, inSameLocality(networkingv1alpha1.LocalityPreferenceRegion, newLocatedCluster("a", ""), newLocatedCluster("b", "")))
+	assert.True(t, inSameLocality(networkingv1alpha1.LocalityPreferenceZone, newLocatedCluster("a", "", "z1", "z2"), newLocatedCluster("b", "", "z2")))
+
+	legacy := newLocatedCluster("c", "")
+	legacy.Spec.Zone = "z1"
+	assert.True(t, inSameLocality(networkingv1alpha1.LocalityPreferenceZone, legacy, newLocatedCluster("a", "", "z1")))
+	assert.False(t, inSameLocality(networkingv1alpha1.LocalityPreferenceZone, legacy, newLocatedCluster("b", "", "z2")))
+}
+
+func TestEndpointsOf(t *testing.T) {
+	newWork := func(raw string) *workv1alpha1.Work {
+		return &workv1alpha1.Work{Spec: workv1alpha1.WorkSpec{Workload: workv1alpha1.WorkloadTemplate{
+			Manifests: []workv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte(raw)}}},
+		}}}
+	}
+
+	endpoints, err := endpointsOf(newWork(`{"apiVersion":"discovery.k8s.io/v1","kind":"EndpointSlice","metadata":{"name":"eps"},"addressType":"IPv4",
+		"endpoints":[{"addresses":["10.0.0.1"],"conditions":{"ready":false}},{"addresses":["10.0.0.2"]}]}`))
+	assert.NoError(t, err)
+	assert.Len(t, endpoints, 2)
+	assert.False(t, isEndpointReady(endpoints[0]))
+	assert.True(t, isEndpointReady(endpoints[1]))
+
+	_, err = endpointsOf(newWork(`{`))
+	assert.Error(t, err)
+}
+
+func TestClusterLocalityChanged(t *testing.T) {
+	assert.False(t, clusterLocalityChanged(newLocatedCluster("a", "east", "z1"), newLocatedCluster("a", "east", "z1")))
+	assert.True(t, clusterLocalityChanged(newLocatedCluster("a", "east", "z1"), newLocatedCluster("a", "west", "z1")))
+	assert.True(t, clusterLocalityChanged(newLocatedCluster("a", "east", "z1"), newLocatedCluster("a", "east", "z2")))
+
+	legacy := newLocatedCluster("a", "east")
+	legacy.Spec.Zone = "z1"
+	assert.False(t, clusterLocalityChanged(legacy, newLocatedCluster("a", "east", "z1")))
+}
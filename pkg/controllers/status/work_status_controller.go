@@ -246,6 +246,7 @@ func (c *WorkStatusController) reflectStatus(ctx context.Context, work *workv1al
 	c.EventRecorder.Eventf(work, corev1.EventTypeNormal, events.EventReasonReflectStatusSucceed, "Reflect status for object(%s/%s/%s) succeed.", clusterObj.GetKind(), clusterObj.GetNamespace(), clusterObj.GetName())
 
 	resourceHealth := c.interpretHealth(clusterObj, work)
+	rolloutStatus := c.interpretRolloutStatus(clusterObj, work)
 
 	identifier, err := c.buildStatusIdentifier(work, clusterObj)
 	if err != nil {
@@ -253,9 +254,10 @@ func (c *WorkStatusController) reflectStatus(ctx context.Context, work *workv1al
 	}
 
 	manifestStatus := workv1alpha1.ManifestStatus{
-		Identifier: *identifier,
-		Status:     statusRaw,
-		Health:     resourceHealth,
+		Identifier:    *identifier,
+		Status:        statusRaw,
+		Health:        resourceHealth,
+		RolloutStatus: rolloutStatus,
 	}
 
 	return retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
@@ -289,6 +291,57 @@ func (c *WorkStatusController) interpretHealth(clusterObj *unstructured.Unstruct
 	return resourceHealth
 }
 
+// interpretRolloutStatus returns the rollout status of the object, or nil if the rollout status of the kind can not be interpreted.
+func (c *WorkStatusController) interpretRolloutStatus(clusterObj *unstructured.Unstructured, work *workv1alpha1.Work) *workv1alpha1.RolloutStatus {
+	if !c.ResourceInterpreter.HookEnabled(clusterObj.GroupVersionKind(), configv1alpha1.InterpreterOperationInterpretRolloutStatus) {
+		return nil
+	}
+
+	rolloutStatus, err := c.ResourceInterpreter.InterpretRolloutStatus(clusterObj)
+	if err != nil {
+		klog.ErrorS(err, "Failed to interpret rollout status for object", "kind", clusterObj.GetKind(), "resource", clusterObj.GetNamespace()+"/"+clusterObj.GetName())
+		c.EventRecorder.Eventf(work, corev1.EventTypeWarning, events.EventReasonInterpretRolloutStatusFailed, "Interpret rollout status of object(%s/%s/%s) failed, err: %s.", clusterObj.GetKind(), clusterObj.GetNamespace(), clusterObj.GetName(), err.Error())
+		return nil
+	}
+	status := &workv1alpha1.RolloutStatus{
+		State:              workv1alpha1.RolloutState(rolloutStatus.State),
+		Generation:         rolloutStatus.Generation,
+		ObservedGeneration: rolloutStatus.ObservedGeneration,
+		UpdatedReplicas:    rolloutStatus.UpdatedReplicas,
+		ReadyReplicas:      rolloutStatus.ReadyReplicas,
+		AvailableReplicas:  rolloutStatus.AvailableReplicas,
+		Message:            rolloutStatus.Message,
+	}
+
+	// The rollout is not done until the object in member cluster is derived from the resource template
+	// generation that the work carries.
+	if status.State == workv1alpha1.RolloutDone {
+		desiredGeneration := desiredTemplateGeneration(work, clusterObj)
+		observedGeneration := util.GetAnnotationValue(clusterObj.GetAnnotations(), workv1alpha2.ResourceTemplateGenerationAnnotationKey)
+		if desiredGeneration != observedGeneration {
+			status.State = workv1alpha1.RolloutProgressing
+			status.Message = fmt.Sprintf("Waiting for resource template generation %s to be applied, current: %s", desiredGeneration, observedGeneration)
+		}
+	}
+	return status
+}
+
+// desiredTemplateGeneration returns the resource template generation recorded in the manifest of the work
+// that the object is derived from.
+func desiredTemplateGeneration(work *workv1alpha1.Work, clusterObj *unstructured.Unstructured) string {
+	manifestRef := helper.ManifestReference{APIVersion: clusterObj.GetAPIVersion(), Kind: clusterObj.GetKind(),
+		Namespace: clusterObj.GetNamespace(), Name: clusterObj.GetName()}
+	index, err := helper.GetManifestIndex(work.Spec.Workload.Manifests, &manifestRef)
+	if err != nil {
+		return ""
+	}
+	manifest := &unstructured.Unstructured{}
+	if err = manifest.UnmarshalJSON(work.Spec.Workload.Manifests[index].Raw); err != nil {
+		return ""
+	}
+	return util.GetAnnotationValue(manifest.GetAnnotations(), workv1alpha2.ResourceTemplateGenerationAnnotationKey)
+}
+
 func (c *WorkStatusController) buildStatusIdentifier(work *workv1alpha1.Work, clusterObj *unstructured.Unstructured) (*workv1alpha1.ResourceIdentifier, error) {
 	manifestRef := helper.ManifestReference{APIVersion: clusterObj.GetAPIVersion(), Kind: clusterObj.GetKind(),
 		Namespace: clusterObj.GetNamespace(), Name: clusterObj.GetName()}
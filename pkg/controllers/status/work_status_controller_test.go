@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -940,6 +942,82 @@ func TestWorkStatusController_interpretHealth(t *testing.T) {
 	}
 }
 
+func TestWorkStatusController_interpretRolloutStatus(t *testing.T) {
+	newRolledOutDeployment := func(templateGeneration string) *appsv1.Deployment {
+		deploy := testhelper.NewDeployment("foo", "bar")
+		deploy.Annotations = map[string]string{workv1alpha2.ResourceTemplateGenerationAnnotationKey: templateGeneration}
+		deploy.Status = appsv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3}
+		return deploy
+	}
+
+	tests := []struct {
+		name                  string
+		clusterObj            client.Object
+		manifest              client.Object
+		expectedRolloutStatus *workv1alpha1.RolloutStatus
+	}{
+		{
+			name:       "deployment without status is interpreted as progressing",
+			clusterObj: testhelper.NewDeployment("foo", "bar"),
+			expectedRolloutStatus: &workv1alpha1.RolloutStatus{
+				State:             workv1alpha1.RolloutProgressing,
+				Message:           "0 out of 3 new replicas have been updated",
+				UpdatedReplicas:   ptr.To[int32](0),
+				ReadyReplicas:     ptr.To[int32](0),
+				AvailableReplicas: ptr.To[int32](0),
+			},
+		},
+		{
+			name:       "deployment derived from the latest resource template is interpreted as done",
+			clusterObj: newRolledOutDeployment("2"),
+			manifest:   newRolledOutDeployment("2"),
+			expectedRolloutStatus: &workv1alpha1.RolloutStatus{
+				State:             workv1alpha1.RolloutDone,
+				UpdatedReplicas:   ptr.To[int32](3),
+				ReadyReplicas:     ptr.To[int32](3),
+				AvailableReplicas: ptr.To[int32](3),
+			},
+		},
+		{
+			name:       "deployment derived from a stale resource template is interpreted as progressing",
+			clusterObj: newRolledOutDeployment("1"),
+			manifest:   newRolledOutDeployment("2"),
+			expectedRolloutStatus: &workv1alpha1.RolloutStatus{
+				State:             workv1alpha1.RolloutProgressing,
+				Message:           "Waiting for resource template generation 2 to be applied, current: 1",
+				UpdatedReplicas:   ptr.To[int32](3),
+				ReadyReplicas:     ptr.To[int32](3),
+				AvailableReplicas: ptr.To[int32](3),
+			},
+		},
+		{
+			name:       "cluster role has no rollout status",
+			clusterObj: testhelper.NewClusterRole("foo", []rbacv1.PolicyRule{}),
+		},
+	}
+
+	cluster := newCluster("cluster", clusterv1alpha1.ClusterConditionReady, metav1.ConditionTrue)
+	c := newWorkStatusController(cluster)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw []byte
+			if tt.manifest != nil {
+				var err error
+				raw, err = json.Marshal(tt.manifest)
+				assert.NoError(t, err)
+			}
+			work := testhelper.NewWork(tt.clusterObj.GetName(), tt.clusterObj.GetNamespace(), string(uuid.NewUUID()), raw)
+			obj, err := helper.ToUnstructured(tt.clusterObj)
+			assert.NoError(t, err)
+
+			rolloutStatus := c.interpretRolloutStatus(obj, work)
+			assert.Equal(t, tt.expectedRolloutStatus, rolloutStatus)
+			assert.Empty(t, c.EventRecorder.(*record.FakeRecorder).Events, "expected no events to get recorded")
+		})
+	}
+}
+
 type TestObject struct {
 	metav1.TypeMeta
 	metav1.ObjectMeta
@@ -75,6 +75,8 @@ type Descheduler struct {
 	unschedulableThreshold time.Duration
 	deschedulingInterval   time.Duration
 	deschedulerWorker      util.AsyncWorker
+	// deschedulerWorkers is the number of workers descheduling the bindings concurrently.
+	deschedulerWorkers int
 }
 
 // NewDescheduler instantiates a descheduler
@@ -100,6 +102,8 @@ func NewDescheduler(karmadaClient karmadaclientset.Interface, kubeClient kuberne
 		schedulerEstimatorServicePrefix:    opts.SchedulerEstimatorServicePrefix,
 		unschedulableThreshold:             opts.UnschedulableThreshold.Duration,
 		deschedulingInterval:               opts.DeschedulingInterval.Duration,
+		// The estimation requests of the bindings are coalesced only if the bindings are descheduled concurrently.
+		deschedulerWorkers: estimatorclient.BatchWorkers(opts.SchedulerEstimatorBatchWindow.Duration, opts.SchedulerEstimatorMaxBatchSize),
 	}
 	// ignore the error here because the informers haven't been started
 	_ = desched.bindingInformer.SetTransform(fedinformer.StripUnusedFields)
@@ -111,7 +115,8 @@ func NewDescheduler(karmadaClient karmadaclientset.Interface, kubeClient kuberne
 		ReconcileFunc: desched.reconcileEstimatorConnection,
 	}
 	desched.schedulerEstimatorWorker = util.NewAsyncWorker(schedulerEstimatorWorkerOptions)
-	schedulerEstimator := estimatorclient.NewSchedulerEstimator(desched.schedulerEstimatorCache, opts.SchedulerEstimatorTimeout.Duration)
+	schedulerEstimator := estimatorclient.NewSchedulerEstimator(desched.schedulerEstimatorCache, opts.SchedulerEstimatorTimeout.Duration,
+		estimatorclient.WithBatch(opts.SchedulerEstimatorBatchWindow.Duration, opts.SchedulerEstimatorMaxBatchSize))
 	estimatorclient.RegisterSchedulerEstimator(schedulerEstimator)
 	deschedulerWorkerOptions := util.Options{
 		Name:          "descheduler",
@@ -154,7 +159,7 @@ func (d *Descheduler) Run(ctx context.Context) {
 	}
 
 	go wait.Until(d.descheduleOnce, d.deschedulingInterval, ctx.Done())
-	d.deschedulerWorker.Run(ctx, 1)
+	d.deschedulerWorker.Run(ctx, d.deschedulerWorkers)
 
 	<-ctx.Done()
 }
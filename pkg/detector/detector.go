@@ -493,12 +493,13 @@ func (d *ResourceDetector) ApplyPolicy(object *unstructured.Unstructured, object
 			bindingCopy.Spec.Components = binding.Spec.Components
 			bindingCopy.Spec.PropagateDeps = binding.Spec.PropagateDeps
 			bindingCopy.Spec.SchedulerName = binding.Spec.SchedulerName
-			bindingCopy.Spec.Placement = binding.Spec.Placement
+			bindingCopy.Spec.Placement = helper.RetainRollbackPlacement(bindingCopy, binding.Spec.Placement, object)
 			bindingCopy.Spec.Failover = binding.Spec.Failover
 			bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
 			bindingCopy.Spec.PreserveResourcesOnDeletion = binding.Spec.PreserveResourcesOnDeletion
 			bindingCopy.Spec.SchedulePriority = binding.Spec.SchedulePriority
-			bindingCopy.Spec.Suspension = util.MergePolicySuspension(bindingCopy.Spec.Suspension, policy.Spec.Suspension)
+			bindingCopy.Spec.Suspension = util.RetainRolloutPause(bindingCopy.Annotations,
+				util.MergePolicySuspension(bindingCopy.Spec.Suspension, policy.Spec.Suspension))
 			bindingCopy.Spec.WorkloadAffinityGroups = binding.Spec.WorkloadAffinityGroups
 			excludeClusterPolicy(bindingCopy)
 			return nil
@@ -586,12 +587,13 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 				bindingCopy.Spec.Components = binding.Spec.Components
 				bindingCopy.Spec.PropagateDeps = binding.Spec.PropagateDeps
 				bindingCopy.Spec.SchedulerName = binding.Spec.SchedulerName
-				bindingCopy.Spec.Placement = binding.Spec.Placement
+				bindingCopy.Spec.Placement = helper.RetainRollbackPlacement(bindingCopy, binding.Spec.Placement, object)
 				bindingCopy.Spec.Failover = binding.Spec.Failover
 				bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
 				bindingCopy.Spec.PreserveResourcesOnDeletion = binding.Spec.PreserveResourcesOnDeletion
 				bindingCopy.Spec.SchedulePriority = binding.Spec.SchedulePriority
-				bindingCopy.Spec.Suspension = util.MergePolicySuspension(bindingCopy.Spec.Suspension, policy.Spec.Suspension)
+				bindingCopy.Spec.Suspension = util.RetainRolloutPause(bindingCopy.Annotations,
+					util.MergePolicySuspension(bindingCopy.Spec.Suspension, policy.Spec.Suspension))
 				bindingCopy.Spec.WorkloadAffinityGroups = binding.Spec.WorkloadAffinityGroups
 				return nil
 			})
@@ -637,11 +639,12 @@ func (d *ResourceDetector) ApplyClusterPolicy(object *unstructured.Unstructured,
 				bindingCopy.Spec.Replicas = binding.Spec.Replicas
 				bindingCopy.Spec.Components = binding.Spec.Components
 				bindingCopy.Spec.SchedulerName = binding.Spec.SchedulerName
-				bindingCopy.Spec.Placement = binding.Spec.Placement
+				bindingCopy.Spec.Placement = helper.RetainRollbackPlacement(bindingCopy, binding.Spec.Placement, object)
 				bindingCopy.Spec.Failover = binding.Spec.Failover
 				bindingCopy.Spec.ConflictResolution = binding.Spec.ConflictResolution
 				bindingCopy.Spec.PreserveResourcesOnDeletion = binding.Spec.PreserveResourcesOnDeletion
-				bindingCopy.Spec.Suspension = util.MergePolicySuspension(bindingCopy.Spec.Suspension, policy.Spec.Suspension)
+				bindingCopy.Spec.Suspension = util.RetainRolloutPause(bindingCopy.Annotations,
+					util.MergePolicySuspension(bindingCopy.Spec.Suspension, policy.Spec.Suspension))
 				return nil
 			})
 			return err
@@ -1525,7 +1528,9 @@ func (d *ResourceDetector) applyReplicaInterpretation(object *unstructured.Unstr
 		// Therefore, we uniformly check and set the namespace here.
 		// Note: The replicaRequirements.Namespace field is somewhat redundant and could be deprecated in the future,
 		// as the namespace can be obtained directly from ResourceBinding.
-		if features.FeatureGate.Enabled(features.ResourceQuotaEstimate) && replicaRequirements != nil && len(replicaRequirements.Namespace) == 0 {
+		// The namespace is also the default namespace of the pod (anti-)affinity terms.
+		namespaceRequired := features.FeatureGate.Enabled(features.ResourceQuotaEstimate) || features.FeatureGate.Enabled(features.PodTopologyEstimate)
+		if namespaceRequired && replicaRequirements != nil && len(replicaRequirements.Namespace) == 0 {
 			replicaRequirements.Namespace = object.GetNamespace()
 		}
 
@@ -1382,3 +1382,7 @@ func (m *mockResourceInterpreter) ReflectStatus(_ *unstructured.Unstructured) (*
 func (m *mockResourceInterpreter) InterpretHealth(_ *unstructured.Unstructured) (bool, error) {
 	return true, nil
 }
+
+func (m *mockResourceInterpreter) InterpretRolloutStatus(_ *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error) {
+	return nil, nil
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -42,14 +43,41 @@ type getClusterReplicasFunc func(ctx context.Context, cluster string) (int32, er
 type SchedulerEstimator struct {
 	cache   *SchedulerEstimatorCache
 	timeout time.Duration
+
+	batchWindow           time.Duration
+	maxBatchSize          int
+	replicasBatcher       *coalescer[*pb.MaxAvailableReplicasRequest]
+	unschedulablesBatcher *coalescer[*pb.UnschedulableReplicasRequest]
+}
+
+// Option configures a SchedulerEstimator.
+type Option func(*SchedulerEstimator)
+
+// WithBatch coalesces the estimation requests towards the same cluster within the window into one batch RPC,
+// a batch carries at most maxBatchSize requests. Batching is disabled if the window is zero.
+func WithBatch(window time.Duration, maxBatchSize int) Option {
+	return func(se *SchedulerEstimator) {
+		se.batchWindow = window
+		se.maxBatchSize = maxBatchSize
+	}
 }
 
 // NewSchedulerEstimator builds a new SchedulerEstimator.
-func NewSchedulerEstimator(cache *SchedulerEstimatorCache, timeout time.Duration) *SchedulerEstimator {
-	return &SchedulerEstimator{
+func NewSchedulerEstimator(cache *SchedulerEstimatorCache, timeout time.Duration, opts ...Option) *SchedulerEstimator {
+	se := &SchedulerEstimator{
 		cache:   cache,
 		timeout: timeout,
 	}
+	for _, opt := range opts {
+		opt(se)
+	}
+	if se.batchWindow > 0 {
+		se.replicasBatcher = newCoalescer(se.batchWindow, se.maxBatchSize, se.timeout,
+			se.batchMaxAvailableReplicas, se.callMaxAvailableReplicas)
+		se.unschedulablesBatcher = newCoalescer(se.batchWindow, se.maxBatchSize, se.timeout,
+			se.batchGetUnschedulableReplicas, se.callGetUnschedulableReplicas)
+	}
+	return se
 }
 
 // MaxAvailableReplicas estimates the maximum replicas that can be applied to the target cluster by calling karmada-scheduler-estimator.
@@ -159,30 +187,88 @@ func toPBReplicaRequirements(cr *workv1alpha2.ComponentReplicaRequirements) (*pb
 	}
 	out := &pb.ComponentReplicaRequirements{
 		PriorityClassName: cr.PriorityClassName,
+		DeviceRequests:    toPBDeviceRequests(cr.DeviceRequests),
 	}
 	if err := out.SetResourceRequest(cr.ResourceRequest); err != nil {
 		return nil, err
 	}
-	if cr.NodeClaim != nil {
-		out.NodeClaim = &pb.NodeClaim{
-			NodeSelector: cr.NodeClaim.NodeSelector,
-		}
-		if err := out.NodeClaim.SetNodeAffinity(cr.NodeClaim.HardNodeAffinity); err != nil {
-			return nil, err
-		}
-		if err := out.NodeClaim.SetTolerations(cr.NodeClaim.Tolerations); err != nil {
-			return nil, err
-		}
+	nodeClaim, err := toPBNodeClaim(cr.NodeClaim)
+	if err != nil {
+		return nil, err
 	}
+	out.NodeClaim = nodeClaim
 	return out, nil
 }
 
+// toPBNodeClaim converts the API NodeClaim to the pb.NodeClaim pointer.
+func toPBNodeClaim(nc *workv1alpha2.NodeClaim) (*pb.NodeClaim, error) {
+	if nc == nil {
+		return nil, nil
+	}
+	out := &pb.NodeClaim{
+		NodeSelector: nc.NodeSelector,
+		PodLabels:    nc.PodLabels,
+	}
+	if err := out.SetNodeAffinity(nc.HardNodeAffinity); err != nil {
+		return nil, err
+	}
+	if err := out.SetTolerations(nc.Tolerations); err != nil {
+		return nil, err
+	}
+	if err := out.SetPodAffinity(nc.HardPodAffinity); err != nil {
+		return nil, err
+	}
+	if err := out.SetPodAntiAffinity(nc.HardPodAntiAffinity); err != nil {
+		return nil, err
+	}
+	if err := out.SetTopologySpreadConstraints(nc.TopologySpreadConstraints); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// toPBDeviceRequests converts the API DeviceRequests to the pb.DeviceRequest pointers.
+func toPBDeviceRequests(requests []workv1alpha2.DeviceRequest) []*pb.DeviceRequest {
+	if len(requests) == 0 {
+		return nil
+	}
+	out := make([]*pb.DeviceRequest, 0, len(requests))
+	for _, request := range requests {
+		count := request.Count
+		if count <= 0 {
+			count = 1
+		}
+		out = append(out, &pb.DeviceRequest{DeviceClassName: request.DeviceClassName, Count: count, Selectors: request.Selectors})
+	}
+	return out
+}
+
+// toPBStorageRequests converts the API StorageRequests to the pb.StorageRequest pointers.
+func toPBStorageRequests(requests []workv1alpha2.StorageRequest) []*pb.StorageRequest {
+	if len(requests) == 0 {
+		return nil
+	}
+	out := make([]*pb.StorageRequest, 0, len(requests))
+	for _, request := range requests {
+		out = append(out, &pb.StorageRequest{StorageClassName: request.StorageClassName, Storage: request.Storage.Value()})
+	}
+	return out
+}
+
 func (se *SchedulerEstimator) maxAvailableReplicas(ctx context.Context, cluster string, replicaRequirements *workv1alpha2.ReplicaRequirements, assumedWorkloads []AssumedWorkload) (int32, error) {
-	client, err := se.cache.GetClient(cluster)
+	req, err := newMaxAvailableReplicasRequest(cluster, replicaRequirements, assumedWorkloads)
 	if err != nil {
 		return UnauthenticReplica, err
 	}
+	if se.replicasBatcher != nil {
+		return se.replicasBatcher.do(ctx, cluster, req)
+	}
+	return se.callMaxAvailableReplicas(ctx, cluster, req)
+}
 
+// newMaxAvailableReplicasRequest builds the gRPC request of MaxAvailableReplicas.
+func newMaxAvailableReplicasRequest(cluster string, replicaRequirements *workv1alpha2.ReplicaRequirements, assumedWorkloads []AssumedWorkload) (*pb.MaxAvailableReplicasRequest, error) {
+	var err error
 	req := &pb.MaxAvailableReplicasRequest{
 		Cluster: cluster,
 	}
@@ -190,20 +276,14 @@ func (se *SchedulerEstimator) maxAvailableReplicas(ctx context.Context, cluster
 		req.ReplicaRequirements = &pb.ReplicaRequirements{
 			Namespace:         replicaRequirements.Namespace,
 			PriorityClassName: replicaRequirements.PriorityClassName,
+			DeviceRequests:    toPBDeviceRequests(replicaRequirements.DeviceRequests),
+			StorageRequests:   toPBStorageRequests(replicaRequirements.StorageRequests),
 		}
 		if err = req.ReplicaRequirements.SetResourceRequest(replicaRequirements.ResourceRequest); err != nil {
-			return UnauthenticReplica, err
+			return nil, err
 		}
-		if replicaRequirements.NodeClaim != nil {
-			req.ReplicaRequirements.NodeClaim = &pb.NodeClaim{
-				NodeSelector: replicaRequirements.NodeClaim.NodeSelector,
-			}
-			if err = req.ReplicaRequirements.NodeClaim.SetNodeAffinity(replicaRequirements.NodeClaim.HardNodeAffinity); err != nil {
-				return UnauthenticReplica, err
-			}
-			if err = req.ReplicaRequirements.NodeClaim.SetTolerations(replicaRequirements.NodeClaim.Tolerations); err != nil {
-				return UnauthenticReplica, err
-			}
+		if req.ReplicaRequirements.NodeClaim, err = toPBNodeClaim(replicaRequirements.NodeClaim); err != nil {
+			return nil, err
 		}
 	}
 	if len(assumedWorkloads) > 0 {
@@ -211,11 +291,19 @@ func (se *SchedulerEstimator) maxAvailableReplicas(ctx context.Context, cluster
 		for _, aw := range assumedWorkloads {
 			pbAW, err := toAssumedWorkload(aw)
 			if err != nil {
-				return UnauthenticReplica, err
+				return nil, err
 			}
 			req.AssumedWorkloads = append(req.AssumedWorkloads, pbAW)
 		}
 	}
+	return req, nil
+}
+
+func (se *SchedulerEstimator) callMaxAvailableReplicas(ctx context.Context, cluster string, req *pb.MaxAvailableReplicasRequest) (int32, error) {
+	client, err := se.cache.GetClient(cluster)
+	if err != nil {
+		return UnauthenticReplica, err
+	}
 	res, err := client.MaxAvailableReplicas(ctx, req)
 	if err != nil {
 		return UnauthenticReplica, fmt.Errorf("gRPC request cluster(%s) estimator error when calling MaxAvailableReplicas: %v", cluster, err)
@@ -223,17 +311,33 @@ func (se *SchedulerEstimator) maxAvailableReplicas(ctx context.Context, cluster
 	return res.MaxReplicas, nil
 }
 
+func (se *SchedulerEstimator) batchMaxAvailableReplicas(ctx context.Context, cluster string, requests []*pb.MaxAvailableReplicasRequest) ([]batchResult, error) {
+	client, err := se.cache.GetClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.BatchMaxAvailableReplicas(ctx, &pb.BatchMaxAvailableReplicasRequest{Cluster: cluster, Requests: requests})
+	if err != nil {
+		// keep the status of the error so that the coalescer is able to tell whether the RPC is implemented.
+		return nil, status.Errorf(status.Code(err), "gRPC request cluster(%s) estimator error when calling BatchMaxAvailableReplicas: %v", cluster, err)
+	}
+	results := make([]batchResult, len(res.Results))
+	for i, result := range res.Results {
+		if result.Error != "" {
+			results[i] = batchResult{replicas: UnauthenticReplica, err: fmt.Errorf("cluster(%s) estimator failed to estimate replicas: %s", cluster, result.Error)}
+			continue
+		}
+		results[i] = batchResult{replicas: result.MaxReplicas}
+	}
+	return results, nil
+}
+
 func (se *SchedulerEstimator) maxUnscheduableReplicas(
 	ctx context.Context,
 	cluster string,
 	reference *workv1alpha2.ObjectReference,
 	threshold time.Duration,
 ) (int32, error) {
-	client, err := se.cache.GetClient(cluster)
-	if err != nil {
-		return UnauthenticReplica, err
-	}
-
 	req := &pb.UnschedulableReplicasRequest{
 		Cluster: cluster,
 		Resource: &pb.ObjectReference{
@@ -244,6 +348,17 @@ func (se *SchedulerEstimator) maxUnscheduableReplicas(
 		},
 		UnschedulableThreshold: int64(threshold),
 	}
+	if se.unschedulablesBatcher != nil {
+		return se.unschedulablesBatcher.do(ctx, cluster, req)
+	}
+	return se.callGetUnschedulableReplicas(ctx, cluster, req)
+}
+
+func (se *SchedulerEstimator) callGetUnschedulableReplicas(ctx context.Context, cluster string, req *pb.UnschedulableReplicasRequest) (int32, error) {
+	client, err := se.cache.GetClient(cluster)
+	if err != nil {
+		return UnauthenticReplica, err
+	}
 	res, err := client.GetUnschedulableReplicas(ctx, req)
 	if err != nil {
 		return UnauthenticReplica, fmt.Errorf("gRPC request cluster(%s) estimator error when calling UnschedulableReplicas: %v", cluster, err)
@@ -251,6 +366,27 @@ func (se *SchedulerEstimator) maxUnscheduableReplicas(
 	return res.UnschedulableReplicas, nil
 }
 
+func (se *SchedulerEstimator) batchGetUnschedulableReplicas(ctx context.Context, cluster string, requests []*pb.UnschedulableReplicasRequest) ([]batchResult, error) {
+	client, err := se.cache.GetClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.BatchGetUnschedulableReplicas(ctx, &pb.BatchUnschedulableReplicasRequest{Cluster: cluster, Requests: requests})
+	if err != nil {
+		// keep the status of the error so that the coalescer is able to tell whether the RPC is implemented.
+		return nil, status.Errorf(status.Code(err), "gRPC request cluster(%s) estimator error when calling BatchGetUnschedulableReplicas: %v", cluster, err)
+	}
+	results := make([]batchResult, len(res.Results))
+	for i, result := range res.Results {
+		if result.Error != "" {
+			results[i] = batchResult{replicas: UnauthenticReplica, err: fmt.Errorf("cluster(%s) estimator failed to detect unschedulable replicas: %s", cluster, result.Error)}
+			continue
+		}
+		results[i] = batchResult{replicas: result.UnschedulableReplicas}
+	}
+	return results, nil
+}
+
 func getClusterReplicasConcurrently(parentCtx context.Context, clusters []string,
 	timeout time.Duration, getClusterReplicas getClusterReplicasFunc) ([]workv1alpha2.TargetCluster, error) {
 	// add object information into gRPC metadata
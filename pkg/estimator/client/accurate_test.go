@@ -18,31 +18,52 @@ package client
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/estimator/pb"
 	estimatorservice "github.com/karmada-io/karmada/pkg/estimator/service"
+	"github.com/karmada-io/karmada/pkg/util"
 )
 
 // fakeEstimatorClient is a hand-written stub for estimatorservice.EstimatorClient.
 // It avoids testify/mock's Arguments.Diff, which formats proto messages via %v and
 // triggers a lazyInitOnce deadlock caused by the incomplete rawDescGZIP in estimator.pb.go.
 type fakeEstimatorClient struct {
+	lock        sync.Mutex
 	capturedReq *pb.MaxAvailableReplicasRequest
 	maxReplicas int32
 	err         error
+
+	// unschedulableReplicas is the response of GetUnschedulableReplicas.
+	unschedulableReplicas int32
+	// batchErr is returned by the batch RPCs if set.
+	batchErr error
+	// batchSizes records the number of requests of each batch RPC.
+	batchSizes []int
+	// batchObjects records the objects carried in the metadata of each batch RPC.
+	batchObjects [][]string
+	// batchDeadlines records the deadline of each batch RPC.
+	batchDeadlines []time.Time
+	// unaryCalls counts the unary RPCs.
+	unaryCalls int
 }
 
 func (f *fakeEstimatorClient) MaxAvailableReplicas(_ context.Context, in *pb.MaxAvailableReplicasRequest, _ ...grpc.CallOption) (*pb.MaxAvailableReplicasResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	f.capturedReq = in
+	f.unaryCalls++
 	if f.err != nil {
 		return nil, f.err
 	}
@@ -54,7 +75,52 @@ func (f *fakeEstimatorClient) MaxAvailableComponentSets(_ context.Context, _ *pb
 }
 
 func (f *fakeEstimatorClient) GetUnschedulableReplicas(_ context.Context, _ *pb.UnschedulableReplicasRequest, _ ...grpc.CallOption) (*pb.UnschedulableReplicasResponse, error) {
-	return nil, nil
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.unaryCalls++
+	return &pb.UnschedulableReplicasResponse{UnschedulableReplicas: f.unschedulableReplicas}, nil
+}
+
+// BatchMaxAvailableReplicas returns the replicas in the namespace of each request, or an error if the namespace is empty.
+func (f *fakeEstimatorClient) BatchMaxAvailableReplicas(ctx context.Context, in *pb.BatchMaxAvailableReplicasRequest, _ ...grpc.CallOption) (*pb.BatchMaxAvailableReplicasResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.recordBatch(ctx, len(in.Requests))
+	if f.batchErr != nil {
+		return nil, f.batchErr
+	}
+	res := &pb.BatchMaxAvailableReplicasResponse{}
+	for _, req := range in.Requests {
+		result := &pb.MaxAvailableReplicasResult{MaxReplicas: f.maxReplicas}
+		if req.GetReplicaRequirements().GetNamespace() == "" {
+			result = &pb.MaxAvailableReplicasResult{Error: "namespace is empty"}
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+func (f *fakeEstimatorClient) BatchGetUnschedulableReplicas(ctx context.Context, in *pb.BatchUnschedulableReplicasRequest, _ ...grpc.CallOption) (*pb.BatchUnschedulableReplicasResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.recordBatch(ctx, len(in.Requests))
+	if f.batchErr != nil {
+		return nil, f.batchErr
+	}
+	res := &pb.BatchUnschedulableReplicasResponse{}
+	for range in.Requests {
+		res.Results = append(res.Results, &pb.UnschedulableReplicasResult{UnschedulableReplicas: f.unschedulableReplicas})
+	}
+	return res, nil
+}
+
+// recordBatch records the size, the objects and the deadline of a batch RPC.
+func (f *fakeEstimatorClient) recordBatch(ctx context.Context, size int) {
+	f.batchSizes = append(f.batchSizes, size)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.batchObjects = append(f.batchObjects, md.Get(string(util.ContextKeyObject)))
+	deadline, _ := ctx.Deadline()
+	f.batchDeadlines = append(f.batchDeadlines, deadline)
 }
 
 // compile-time check
@@ -221,3 +287,100 @@ func Test_maxAvailableReplicas_assumedWorkloads(t *testing.T) {
 		})
 	}
 }
+
+func Test_maxAvailableReplicas_deviceRequests(t *testing.T) {
+	fake := &fakeEstimatorClient{maxReplicas: 2}
+	c := NewSchedulerEstimatorCache()
+	c.AddCluster("cluster-a", nil, fake)
+	se := NewSchedulerEstimator(c, 5*time.Second)
+
+	_, err := se.maxAvailableReplicas(context.Background(), "cluster-a", &workv1alpha2.ReplicaRequirements{
+		DeviceRequests: []workv1alpha2.DeviceRequest{
+			{DeviceClassName: "gpu.example.com", Count: 2},
+			{DeviceClassName: "nic.example.com"},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.capturedReq.ReplicaRequirements)
+	deviceRequests := fake.capturedReq.ReplicaRequirements.DeviceRequests
+	require.Len(t, deviceRequests, 2)
+	assert.Equal(t, "gpu.example.com", deviceRequests[0].DeviceClassName)
+	assert.Equal(t, int64(2), deviceRequests[0].Count)
+	assert.Equal(t, "nic.example.com", deviceRequests[1].DeviceClassName)
+	assert.Equal(t, int64(1), deviceRequests[1].Count, "count should default to 1")
+}
+
+func Test_toPBReplicaRequirements_deviceRequests(t *testing.T) {
+	got, err := toPBReplicaRequirements(&workv1alpha2.ComponentReplicaRequirements{
+		DeviceRequests: []workv1alpha2.DeviceRequest{{DeviceClassName: "gpu.example.com", Count: 2, Selectors: []string{"device.driver == 'gpu.example.com'"}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, got.DeviceRequests, 1)
+	assert.Equal(t, "gpu.example.com", got.DeviceRequests[0].DeviceClassName)
+	assert.Equal(t, int64(2), got.DeviceRequests[0].Count)
+	assert.Equal(t, []string{"device.driver == 'gpu.example.com'"}, got.DeviceRequests[0].Selectors)
+}
+
+func Test_maxAvailableReplicas_storageRequests(t *testing.T) {
+	fake := &fakeEstimatorClient{maxReplicas: 2}
+	c := NewSchedulerEstimatorCache()
+	c.AddCluster("cluster-a", nil, fake)
+	se := NewSchedulerEstimator(c, 5*time.Second)
+
+	_, err := se.maxAvailableReplicas(context.Background(), "cluster-a", &workv1alpha2.ReplicaRequirements{
+		StorageRequests: []workv1alpha2.StorageRequest{
+			{StorageClassName: "fast", Storage: resource.MustParse("10Gi")},
+			{Storage: resource.MustParse("1Gi")},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.capturedReq.ReplicaRequirements)
+	storageRequests := fake.capturedReq.ReplicaRequirements.StorageRequests
+	require.Len(t, storageRequests, 2)
+	assert.Equal(t, "fast", storageRequests[0].StorageClassName)
+	assert.Equal(t, int64(10*1024*1024*1024), storageRequests[0].Storage)
+	assert.Equal(t, "", storageRequests[1].StorageClassName)
+	assert.Equal(t, int64(1024*1024*1024), storageRequests[1].Storage)
+}
+
+func Test_maxAvailableReplicas_podTopology(t *testing.T) {
+	fake := &fakeEstimatorClient{maxReplicas: 2}
+	c := NewSchedulerEstimatorCache()
+	c.AddCluster("cluster-a", nil, fake)
+	se := NewSchedulerEstimator(c, 5*time.Second)
+
+	antiAffinityTerm := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		TopologyKey:   corev1.LabelHostname,
+	}
+	constraint := corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+	}
+	_, err := se.maxAvailableReplicas(context.Background(), "cluster-a", &workv1alpha2.ReplicaRequirements{
+		NodeClaim: &workv1alpha2.NodeClaim{
+			PodLabels:                 map[string]string{"app": "foo"},
+			HardPodAntiAffinity:       []corev1.PodAffinityTerm{antiAffinityTerm},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{constraint},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.capturedReq.ReplicaRequirements)
+	nodeClaim := fake.capturedReq.ReplicaRequirements.NodeClaim
+	require.NotNil(t, nodeClaim)
+	assert.Equal(t, map[string]string{"app": "foo"}, nodeClaim.PodLabels)
+
+	podAffinity, err := nodeClaim.UnmarshalPodAffinity()
+	require.NoError(t, err)
+	assert.Empty(t, podAffinity)
+	podAntiAffinity, err := nodeClaim.UnmarshalPodAntiAffinity()
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.PodAffinityTerm{antiAffinityTerm}, podAntiAffinity)
+	constraints, err := nodeClaim.UnmarshalTopologySpreadConstraints()
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.TopologySpreadConstraint{constraint}, constraints)
+}
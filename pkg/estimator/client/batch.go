@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+// batchUnsupportedRetryInterval is the interval after which a batch RPC is retried against an estimator
+// which didn't implement it, in case the estimator has been upgraded since then.
+const batchUnsupportedRetryInterval = 10 * time.Minute
+
+// batchResult is the estimation result of a single request in a batch.
+type batchResult struct {
+	replicas int32
+	err      error
+}
+
+// batchFunc sends the requests towards a cluster in one batch RPC, the results must be in the order of the requests.
+type batchFunc[Req any] func(ctx context.Context, cluster string, requests []Req) ([]batchResult, error)
+
+// unaryFunc sends a single request towards a cluster.
+type unaryFunc[Req any] func(ctx context.Context, cluster string, request Req) (int32, error)
+
+// pendingCall is a request waiting for its batch to be sent.
+type pendingCall[Req any] struct {
+	// ctx is the context of the caller, which carries the gRPC metadata and the deadline of the request.
+	ctx     context.Context
+	request Req
+	done    chan batchResult
+}
+
+// pendingBatch is the batch of requests towards a cluster which has not been sent yet.
+type pendingBatch[Req any] struct {
+	calls []*pendingCall[Req]
+}
+
+// coalescer coalesces the requests towards the same cluster into batch RPCs.
+// A batch is sent once it reaches maxBatchSize, or the window has elapsed since its first request was queued,
+// so that the estimator evaluates the requests of many bindings against one snapshot of the cluster.
+// If the estimator of a cluster doesn't implement the batch RPC, the requests fall back to unary RPCs.
+type coalescer[Req any] struct {
+	window       time.Duration
+	maxBatchSize int
+	timeout      time.Duration
+	batch        batchFunc[Req]
+	unary        unaryFunc[Req]
+
+	lock    sync.Mutex
+	pending map[string]*pendingBatch[Req]
+	// unsupported records the time when the estimator of a cluster is found not implementing the batch RPC.
+	unsupported map[string]time.Time
+}
+
+func newCoalescer[Req any](window time.Duration, maxBatchSize int, timeout time.Duration, batch batchFunc[Req], unary unaryFunc[Req]) *coalescer[Req] {
+	if maxBatchSize <= 0 {
+		maxBatchSize = 1
+	}
+	return &coalescer[Req]{
+		window:       window,
+		maxBatchSize: maxBatchSize,
+		timeout:      timeout,
+		batch:        batch,
+		unary:        unary,
+		pending:      make(map[string]*pendingBatch[Req]),
+		unsupported:  make(map[string]time.Time),
+	}
+}
+
+// do queues the request into the pending batch of the cluster and waits for its result.
+func (c *coalescer[Req]) do(ctx context.Context, cluster string, request Req) (int32, error) {
+	c.lock.Lock()
+	if since, ok := c.unsupported[cluster]; ok {
+		if time.Since(since) < batchUnsupportedRetryInterval {
+			c.lock.Unlock()
+			return c.unary(ctx, cluster, request)
+		}
+		delete(c.unsupported, cluster)
+	}
+
+	call := &pendingCall[Req]{ctx: ctx, request: request, done: make(chan batchResult, 1)}
+	b, exist := c.pending[cluster]
+	if !exist {
+		b = &pendingBatch[Req]{}
+		c.pending[cluster] = b
+		time.AfterFunc(c.window, func() { c.flush(cluster, b) })
+	}
+	b.calls = append(b.calls, call)
+	full := len(b.calls) >= c.maxBatchSize
+	c.lock.Unlock()
+
+	if full {
+		c.flush(cluster, b)
+	}
+
+	select {
+	case result := <-call.done:
+		return result.replicas, result.err
+	case <-ctx.Done():
+		return UnauthenticReplica, ctx.Err()
+	}
+}
+
+// flush sends the batch if it is still pending, it's a no-op if the batch has been sent.
+func (c *coalescer[Req]) flush(cluster string, b *pendingBatch[Req]) {
+	c.lock.Lock()
+	if c.pending[cluster] != b {
+		c.lock.Unlock()
+		return
+	}
+	delete(c.pending, cluster)
+	c.lock.Unlock()
+
+	go c.send(cluster, b.calls)
+}
+
+func (c *coalescer[Req]) send(cluster string, calls []*pendingCall[Req]) {
+	// Drop the requests whose callers have given up waiting, so that their deadlines don't cut the batch short.
+	pending := calls[:0]
+	for _, call := range calls {
+		if call.ctx.Err() != nil {
+			continue
+		}
+		pending = append(pending, call)
+	}
+	calls = pending
+	if len(calls) == 0 {
+		return
+	}
+
+	ctx, cancel := batchContext(calls, c.timeout)
+	defer cancel()
+
+	requests := make([]Req, len(calls))
+	for i, call := range calls {
+		requests[i] = call.request
+	}
+	results, err := c.batch(ctx, cluster, requests)
+	if status.Code(err) == codes.Unimplemented {
+		klog.V(2).Infof("Estimator of cluster(%s) doesn't support batch estimation, fall back to unary requests.", cluster)
+		c.lock.Lock()
+		c.unsupported[cluster] = time.Now()
+		c.lock.Unlock()
+
+		var wg sync.WaitGroup
+		for _, call := range calls {
+			wg.Go(func() {
+				replicas, err := c.unary(call.ctx, cluster, call.request)
+				call.done <- batchResult{replicas: replicas, err: err}
+			})
+		}
+		wg.Wait()
+		return
+	}
+	if err == nil && len(results) != len(calls) {
+		err = fmt.Errorf("estimator of cluster(%s) returns %d results for %d requests", cluster, len(results), len(calls))
+	}
+	for i, call := range calls {
+		if err != nil {
+			call.done <- batchResult{replicas: UnauthenticReplica, err: err}
+			continue
+		}
+		call.done <- results[i]
+	}
+}
+
+// batchContext returns the context of the batch RPC for the calls. The deadline is the earliest one of the callers,
+// bounded by the timeout. The object of each call is carried in the gRPC metadata in the order of the calls, with
+// an empty value for a call without one, so that the estimator is able to tell the object of each request.
+func batchContext[Req any](calls []*pendingCall[Req], timeout time.Duration) (context.Context, context.CancelFunc) {
+	deadline := time.Now().Add(timeout)
+	objects := make([]string, len(calls))
+	for i, call := range calls {
+		if d, ok := call.ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		if md, ok := metadata.FromOutgoingContext(call.ctx); ok {
+			if values := md.Get(string(util.ContextKeyObject)); len(values) > 0 {
+				objects[i] = values[0]
+			}
+		}
+	}
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.MD{string(util.ContextKeyObject): objects})
+	return context.WithDeadline(ctx, deadline)
+}
+
+// BatchWorkers returns the number of workers a component should process the bindings with, so that the
+// estimation requests of different bindings overlap and are able to be coalesced into batches.
+// It's the maximum batch size if batching is enabled, otherwise 1.
+func BatchWorkers(window time.Duration, maxBatchSize int) int {
+	if window <= 0 || maxBatchSize <= 1 {
+		return 1
+	}
+	return maxBatchSize
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+)
+
+func newBatchSchedulerEstimator(fake *fakeEstimatorClient, window time.Duration, maxBatchSize int) *SchedulerEstimator {
+	c := NewSchedulerEstimatorCache()
+	c.AddCluster("cluster-a", nil, fake)
+	return NewSchedulerEstimator(c, 5*time.Second, WithBatch(window, maxBatchSize))
+}
+
+// estimateConcurrently calls maxAvailableReplicas concurrently with the given namespaces.
+func estimateConcurrently(se *SchedulerEstimator, namespaces []string) ([]int32, []error) {
+	replicas := make([]int32, len(namespaces))
+	errs := make([]error, len(namespaces))
+	funcs := make([]func() error, len(namespaces))
+	for i, namespace := range namespaces {
+		funcs[i] = func() error {
+			replicas[i], errs[i] = se.maxAvailableReplicas(context.Background(), "cluster-a",
+				&workv1alpha2.ReplicaRequirements{Namespace: namespace}, nil)
+			return nil
+		}
+	}
+	_ = utilerrors.AggregateGoroutines(funcs...)
+	return replicas, errs
+}
+
+func TestSchedulerEstimator_batchMaxAvailableReplicas(t *testing.T) {
+	t.Run("coalesce requests within the window", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3}
+		se := newBatchSchedulerEstimator(fake, time.Second, 100)
+
+		replicas, errs := estimateConcurrently(se, []string{"ns1", "ns2", "ns3"})
+		for i := range replicas {
+			require.NoError(t, errs[i])
+			assert.Equal(t, int32(3), replicas[i])
+		}
+		assert.Equal(t, []int{3}, fake.batchSizes)
+		assert.Equal(t, 0, fake.unaryCalls)
+	})
+
+	t.Run("flush when the batch is full", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3}
+		// the window is long enough that only the full batches are sent before the timeout.
+		se := newBatchSchedulerEstimator(fake, time.Hour, 2)
+
+		_, errs := estimateConcurrently(se, []string{"ns1", "ns2", "ns3", "ns4"})
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []int{2, 2}, fake.batchSizes)
+	})
+
+	t.Run("error of a single request", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3}
+		se := newBatchSchedulerEstimator(fake, 10*time.Millisecond, 100)
+
+		replicas, errs := estimateConcurrently(se, []string{"ns1", ""})
+		require.NoError(t, errs[0])
+		assert.Equal(t, int32(3), replicas[0])
+		assert.ErrorContains(t, errs[1], "namespace is empty")
+		assert.Equal(t, int32(UnauthenticReplica), replicas[1])
+	})
+
+	t.Run("error of the batch", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3, batchErr: status.Error(codes.Internal, "boom")}
+		se := newBatchSchedulerEstimator(fake, 10*time.Millisecond, 100)
+
+		replicas, errs := estimateConcurrently(se, []string{"ns1", "ns2"})
+		for i := range replicas {
+			assert.ErrorContains(t, errs[i], "boom")
+			assert.Equal(t, int32(UnauthenticReplica), replicas[i])
+		}
+	})
+
+	t.Run("fall back to unary requests", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3, batchErr: status.Error(codes.Unimplemented, "unknown method")}
+		se := newBatchSchedulerEstimator(fake, 10*time.Millisecond, 100)
+
+		replicas, errs := estimateConcurrently(se, []string{"ns1", "ns2"})
+		for i := range replicas {
+			require.NoError(t, errs[i])
+			assert.Equal(t, int32(3), replicas[i])
+		}
+		assert.Equal(t, 2, fake.unaryCalls)
+
+		// the batch RPC is not tried again.
+		replicas, errs = estimateConcurrently(se, []string{"ns1"})
+		require.NoError(t, errs[0])
+		assert.Equal(t, int32(3), replicas[0])
+		assert.Equal(t, []int{2}, fake.batchSizes)
+		assert.Equal(t, 3, fake.unaryCalls)
+	})
+
+	t.Run("bounded by the earliest caller deadline", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3}
+		se := newBatchSchedulerEstimator(fake, 10*time.Millisecond, 100)
+
+		deadline := time.Now().Add(2 * time.Second)
+		funcs := []func() error{
+			func() error {
+				ctx, cancel := context.WithDeadline(context.Background(), deadline)
+				defer cancel()
+				_, err := se.maxAvailableReplicas(ctx, "cluster-a", &workv1alpha2.ReplicaRequirements{Namespace: "ns1"}, nil)
+				return err
+			},
+			func() error {
+				_, err := se.maxAvailableReplicas(context.Background(), "cluster-a", &workv1alpha2.ReplicaRequirements{Namespace: "ns2"}, nil)
+				return err
+			},
+		}
+		require.NoError(t, utilerrors.AggregateGoroutines(funcs...))
+		assert.Equal(t, []int{2}, fake.batchSizes)
+		assert.Equal(t, deadline, fake.batchDeadlines[0])
+	})
+
+	t.Run("caller context canceled", func(t *testing.T) {
+		fake := &fakeEstimatorClient{maxReplicas: 3}
+		se := newBatchSchedulerEstimator(fake, time.Hour, 100)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		replicas, err := se.maxAvailableReplicas(ctx, "cluster-a", &workv1alpha2.ReplicaRequirements{Namespace: "ns1"}, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(UnauthenticReplica), replicas)
+	})
+}
+
+func TestSchedulerEstimator_batchGetUnschedulableReplicas(t *testing.T) {
+	fake := &fakeEstimatorClient{unschedulableReplicas: 2}
+	se := newBatchSchedulerEstimator(fake, 10*time.Millisecond, 100)
+
+	reference := &workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "foo"}
+	results, err := se.GetUnschedulableReplicas(context.Background(), []string{"cluster-a"}, reference, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, []workv1alpha2.TargetCluster{{Name: "cluster-a", Replicas: 2}}, results)
+	assert.Equal(t, []int{1}, fake.batchSizes)
+	assert.Equal(t, 0, fake.unaryCalls)
+}
+
+func TestSchedulerEstimator_MaxAvailableReplicas_bindingsShareBatch(t *testing.T) {
+	fake := &fakeEstimatorClient{maxReplicas: 3}
+	c := NewSchedulerEstimatorCache()
+	c.AddCluster("cluster-a", nil, fake)
+	c.AddCluster("cluster-b", nil, fake)
+	se := NewSchedulerEstimator(c, 5*time.Second, WithBatch(50*time.Millisecond, 100))
+
+	// The bindings are scheduled concurrently by the workers, each estimating against all the candidate clusters.
+	clusters := []*clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-b"}},
+	}
+	funcs := make([]func() error, 2)
+	for i := range funcs {
+		funcs[i] = func() error {
+			results, err := se.MaxAvailableReplicas(context.Background(), ReplicaEstimationRequest{
+				Clusters:            clusters,
+				ReplicaRequirements: &workv1alpha2.ReplicaRequirements{Namespace: fmt.Sprintf("ns%d", i)},
+			})
+			if err != nil {
+				return err
+			}
+			if !assert.ElementsMatch(t, []workv1alpha2.TargetCluster{{Name: "cluster-a", Replicas: 3}, {Name: "cluster-b", Replicas: 3}}, results) {
+				return fmt.Errorf("unexpected results of binding %d", i)
+			}
+			return nil
+		}
+	}
+	require.NoError(t, utilerrors.AggregateGoroutines(funcs...))
+
+	assert.Equal(t, []int{2, 2}, fake.batchSizes, "requests of the bindings should share one batch per cluster")
+	assert.Equal(t, 0, fake.unaryCalls)
+}
+
+func TestSchedulerEstimator_GetUnschedulableReplicas_bindingsShareBatch(t *testing.T) {
+	fake := &fakeEstimatorClient{unschedulableReplicas: 2}
+	se := newBatchSchedulerEstimator(fake, 50*time.Millisecond, 100)
+
+	// The bindings are descheduled concurrently by the workers, each carrying its object in the context.
+	objects := []string{"kind=Deployment, name=default/foo", "kind=Deployment, name=default/bar"}
+	funcs := make([]func() error, len(objects))
+	for i, object := range objects {
+		funcs[i] = func() error {
+			ctx := context.WithValue(context.Background(), util.ContextKeyObject, object)
+			reference := &workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: fmt.Sprintf("binding-%d", i)}
+			results, err := se.GetUnschedulableReplicas(ctx, []string{"cluster-a"}, reference, time.Minute)
+			if err != nil {
+				return err
+			}
+			if !assert.Equal(t, []workv1alpha2.TargetCluster{{Name: "cluster-a", Replicas: 2}}, results) {
+				return fmt.Errorf("unexpected results of %s", object)
+			}
+			return nil
+		}
+	}
+	require.NoError(t, utilerrors.AggregateGoroutines(funcs...))
+
+	assert.Equal(t, []int{2}, fake.batchSizes, "requests of the bindings should share one batch")
+	require.Len(t, fake.batchObjects, 1)
+	assert.ElementsMatch(t, objects, fake.batchObjects[0], "object of each request should be carried in the metadata")
+	assert.Equal(t, 0, fake.unaryCalls)
+}
+
+func TestBatchWorkers(t *testing.T) {
+	tests := []struct {
+		name         string
+		window       time.Duration
+		maxBatchSize int
+		want         int
+	}{
+		{name: "batching disabled", window: 0, maxBatchSize: 100, want: 1},
+		{name: "batching enabled", window: 10 * time.Millisecond, maxBatchSize: 100, want: 100},
+		{name: "batch of one request", window: 10 * time.Millisecond, maxBatchSize: 1, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BatchWorkers(tt.window, tt.maxBatchSize))
+		})
+	}
+}
@@ -121,8 +121,12 @@ type ComponentReplicaRequirements struct {
 	// Convertible to a resource.Quantity object by calling resource.Quantity#Unmarshal().
 	// +optional
 	ResourceRequestBytes map[string][]byte `protobuf:"bytes,4,rep,name=resourceRequestBytes,proto3" json:"resourceRequestBytes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// DeviceRequests represents the devices required by each replica, which are
+	// allocated through Dynamic Resource Allocation.
+	// +optional
+	DeviceRequests []*DeviceRequest `protobuf:"bytes,5,rep,name=deviceRequests,proto3" json:"deviceRequests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ComponentReplicaRequirements) Reset() {
@@ -176,6 +180,13 @@ func (x *ComponentReplicaRequirements) GetResourceRequestBytes() map[string][]by
 	return nil
 }
 
+func (x *ComponentReplicaRequirements) GetDeviceRequests() []*DeviceRequest {
+	if x != nil {
+		return x.DeviceRequests
+	}
+	return nil
+}
+
 // AssumedWorkload represents an in-flight workload that has already been assigned
 // to a cluster by the scheduler but whose pods have not yet been bound to nodes.
 // It is included in estimation requests so the estimator can deduct the assumed
@@ -488,6 +499,171 @@ func (x *MaxAvailableReplicasResponse) GetMaxReplicas() int32 {
 	return 0
 }
 
+// BatchMaxAvailableReplicasRequest represents the request that sent by gRPC client to calculate max available replicas
+// of several workloads at once. All the requests are evaluated against the same snapshot of the cluster.
+type BatchMaxAvailableReplicasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cluster represents the cluster name.
+	// +required
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Requests are the requests to calculate max available replicas. The cluster of each request
+	// should be the same as the cluster of the batch request.
+	// +required
+	Requests      []*MaxAvailableReplicasRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMaxAvailableReplicasRequest) Reset() {
+	*x = BatchMaxAvailableReplicasRequest{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMaxAvailableReplicasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMaxAvailableReplicasRequest) ProtoMessage() {}
+
+func (x *BatchMaxAvailableReplicasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMaxAvailableReplicasRequest.ProtoReflect.Descriptor instead.
+func (*BatchMaxAvailableReplicasRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchMaxAvailableReplicasRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *BatchMaxAvailableReplicasRequest) GetRequests() []*MaxAvailableReplicasRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// BatchMaxAvailableReplicasResponse represents the response that sent by gRPC server to calculate max available
+// replicas of several workloads at once.
+type BatchMaxAvailableReplicasResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results are the results of the requests, in the same order as the requests.
+	// +required
+	Results       []*MaxAvailableReplicasResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMaxAvailableReplicasResponse) Reset() {
+	*x = BatchMaxAvailableReplicasResponse{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMaxAvailableReplicasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMaxAvailableReplicasResponse) ProtoMessage() {}
+
+func (x *BatchMaxAvailableReplicasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMaxAvailableReplicasResponse.ProtoReflect.Descriptor instead.
+func (*BatchMaxAvailableReplicasResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchMaxAvailableReplicasResponse) GetResults() []*MaxAvailableReplicasResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// MaxAvailableReplicasResult represents the result of a request in a BatchMaxAvailableReplicasRequest.
+type MaxAvailableReplicasResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MaxReplicas represents the max replica that the cluster can produce.
+	// +optional
+	MaxReplicas int32 `protobuf:"varint,1,opt,name=maxReplicas,proto3" json:"maxReplicas,omitempty"`
+	// Error represents the error occurred when calculating the max available replicas.
+	// MaxReplicas is meaningless if it is not empty.
+	// +optional
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaxAvailableReplicasResult) Reset() {
+	*x = MaxAvailableReplicasResult{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxAvailableReplicasResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxAvailableReplicasResult) ProtoMessage() {}
+
+func (x *MaxAvailableReplicasResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxAvailableReplicasResult.ProtoReflect.Descriptor instead.
+func (*MaxAvailableReplicasResult) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MaxAvailableReplicasResult) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *MaxAvailableReplicasResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // NodeClaim represents the NodeAffinity, NodeSelector and Tolerations required by each replica.
 type NodeClaim struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -507,13 +683,29 @@ type NodeClaim struct {
 	// Convertible to a corev1.Toleration object by calling corev1.Toleration#Unmarshal().
 	// +optional
 	TolerationsBytes [][]byte `protobuf:"bytes,5,rep,name=tolerationsBytes,proto3" json:"tolerationsBytes,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// PodLabels is the labels of the pod, which are used to evaluate the pod affinity terms
+	// and topology spread constraints against the replicas themselves.
+	// +optional
+	PodLabels map[string]string `protobuf:"bytes,6,rep,name=podLabels,proto3" json:"podLabels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// PodAffinityBytes represents the required inter-pod affinity terms in bytes.
+	// Each item of podAffinityBytes is a proto-serialized corev1.PodAffinityTerm object.
+	// +optional
+	PodAffinityBytes [][]byte `protobuf:"bytes,7,rep,name=podAffinityBytes,proto3" json:"podAffinityBytes,omitempty"`
+	// PodAntiAffinityBytes represents the required inter-pod anti-affinity terms in bytes.
+	// Each item of podAntiAffinityBytes is a proto-serialized corev1.PodAffinityTerm object.
+	// +optional
+	PodAntiAffinityBytes [][]byte `protobuf:"bytes,8,rep,name=podAntiAffinityBytes,proto3" json:"podAntiAffinityBytes,omitempty"`
+	// TopologySpreadConstraintsBytes represents the hard topology spread constraints in bytes.
+	// Each item of topologySpreadConstraintsBytes is a proto-serialized corev1.TopologySpreadConstraint object.
+	// +optional
+	TopologySpreadConstraintsBytes [][]byte `protobuf:"bytes,9,rep,name=topologySpreadConstraintsBytes,proto3" json:"topologySpreadConstraintsBytes,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
 }
 
 func (x *NodeClaim) Reset() {
 	*x = NodeClaim{}
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[7]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +717,7 @@ func (x *NodeClaim) String() string {
 func (*NodeClaim) ProtoMessage() {}
 
 func (x *NodeClaim) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[7]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +730,7 @@ func (x *NodeClaim) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeClaim.ProtoReflect.Descriptor instead.
 func (*NodeClaim) Descriptor() ([]byte, []int) {
-	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{7}
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NodeClaim) GetNodeSelector() map[string]string {
@@ -562,6 +754,34 @@ func (x *NodeClaim) GetTolerationsBytes() [][]byte {
 	return nil
 }
 
+func (x *NodeClaim) GetPodLabels() map[string]string {
+	if x != nil {
+		return x.PodLabels
+	}
+	return nil
+}
+
+func (x *NodeClaim) GetPodAffinityBytes() [][]byte {
+	if x != nil {
+		return x.PodAffinityBytes
+	}
+	return nil
+}
+
+func (x *NodeClaim) GetPodAntiAffinityBytes() [][]byte {
+	if x != nil {
+		return x.PodAntiAffinityBytes
+	}
+	return nil
+}
+
+func (x *NodeClaim) GetTopologySpreadConstraintsBytes() [][]byte {
+	if x != nil {
+		return x.TopologySpreadConstraintsBytes
+	}
+	return nil
+}
+
 // ObjectReference contains enough information to locate the referenced object inside current cluster.
 type ObjectReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -586,7 +806,7 @@ type ObjectReference struct {
 
 func (x *ObjectReference) Reset() {
 	*x = ObjectReference{}
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[8]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +818,7 @@ func (x *ObjectReference) String() string {
 func (*ObjectReference) ProtoMessage() {}
 
 func (x *ObjectReference) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[8]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +831,7 @@ func (x *ObjectReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectReference.ProtoReflect.Descriptor instead.
 func (*ObjectReference) Descriptor() ([]byte, []int) {
-	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{8}
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ObjectReference) GetApiVersion() string {
@@ -661,13 +881,20 @@ type ReplicaRequirements struct {
 	// Convertible to a resource.Quantity object by calling resource.Quantity#Unmarshal().
 	// +optional
 	ResourceRequestBytes map[string][]byte `protobuf:"bytes,5,rep,name=resourceRequestBytes,proto3" json:"resourceRequestBytes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// DeviceRequests represents the devices required by each replica, which are
+	// allocated through Dynamic Resource Allocation.
+	// +optional
+	DeviceRequests []*DeviceRequest `protobuf:"bytes,6,rep,name=deviceRequests,proto3" json:"deviceRequests,omitempty"`
+	// StorageRequests represents the persistent volumes required by each replica.
+	// +optional
+	StorageRequests []*StorageRequest `protobuf:"bytes,7,rep,name=storageRequests,proto3" json:"storageRequests,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ReplicaRequirements) Reset() {
 	*x = ReplicaRequirements{}
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[9]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +906,7 @@ func (x *ReplicaRequirements) String() string {
 func (*ReplicaRequirements) ProtoMessage() {}
 
 func (x *ReplicaRequirements) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[9]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +919,7 @@ func (x *ReplicaRequirements) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplicaRequirements.ProtoReflect.Descriptor instead.
 func (*ReplicaRequirements) Descriptor() ([]byte, []int) {
-	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{9}
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReplicaRequirements) GetNodeClaim() *NodeClaim {
@@ -723,6 +950,146 @@ func (x *ReplicaRequirements) GetResourceRequestBytes() map[string][]byte {
 	return nil
 }
 
+func (x *ReplicaRequirements) GetDeviceRequests() []*DeviceRequest {
+	if x != nil {
+		return x.DeviceRequests
+	}
+	return nil
+}
+
+func (x *ReplicaRequirements) GetStorageRequests() []*StorageRequest {
+	if x != nil {
+		return x.StorageRequests
+	}
+	return nil
+}
+
+// DeviceRequest represents a request for devices of a DeviceClass.
+type DeviceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DeviceClassName references the DeviceClass which the devices are selected from.
+	// +required
+	DeviceClassName string `protobuf:"bytes,1,opt,name=deviceClassName,proto3" json:"deviceClassName,omitempty"`
+	// Count is the number of devices required.
+	// +required
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Selectors are the CEL expressions which the devices must satisfy, in addition
+	// to the selectors of the DeviceClass.
+	// +optional
+	Selectors     []string `protobuf:"bytes,3,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceRequest) Reset() {
+	*x = DeviceRequest{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRequest) ProtoMessage() {}
+
+func (x *DeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeviceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeviceRequest) GetDeviceClassName() string {
+	if x != nil {
+		return x.DeviceClassName
+	}
+	return ""
+}
+
+func (x *DeviceRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DeviceRequest) GetSelectors() []string {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+// StorageRequest represents a persistent volume required by each replica.
+type StorageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// StorageClassName is the name of the StorageClass the volume is provisioned from.
+	// If empty, the volume is provisioned from the default StorageClass.
+	// +optional
+	StorageClassName string `protobuf:"bytes,1,opt,name=storageClassName,proto3" json:"storageClassName,omitempty"`
+	// Storage is the size of the volume in bytes.
+	// +required
+	Storage       int64 `protobuf:"varint,2,opt,name=storage,proto3" json:"storage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageRequest) Reset() {
+	*x = StorageRequest{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageRequest) ProtoMessage() {}
+
+func (x *StorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageRequest.ProtoReflect.Descriptor instead.
+func (*StorageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StorageRequest) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *StorageRequest) GetStorage() int64 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
 // UnschedulableReplicasRequest represents the request that sent by gRPC client to calculate unschedulable replicas.
 type UnschedulableReplicasRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -742,7 +1109,7 @@ type UnschedulableReplicasRequest struct {
 
 func (x *UnschedulableReplicasRequest) Reset() {
 	*x = UnschedulableReplicasRequest{}
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[10]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -754,7 +1121,7 @@ func (x *UnschedulableReplicasRequest) String() string {
 func (*UnschedulableReplicasRequest) ProtoMessage() {}
 
 func (x *UnschedulableReplicasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[10]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,7 +1134,7 @@ func (x *UnschedulableReplicasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnschedulableReplicasRequest.ProtoReflect.Descriptor instead.
 func (*UnschedulableReplicasRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{10}
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UnschedulableReplicasRequest) GetCluster() string {
@@ -803,7 +1170,7 @@ type UnschedulableReplicasResponse struct {
 
 func (x *UnschedulableReplicasResponse) Reset() {
 	*x = UnschedulableReplicasResponse{}
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[11]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -815,7 +1182,7 @@ func (x *UnschedulableReplicasResponse) String() string {
 func (*UnschedulableReplicasResponse) ProtoMessage() {}
 
 func (x *UnschedulableReplicasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[11]
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -828,7 +1195,7 @@ func (x *UnschedulableReplicasResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnschedulableReplicasResponse.ProtoReflect.Descriptor instead.
 func (*UnschedulableReplicasResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{11}
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UnschedulableReplicasResponse) GetUnschedulableReplicas() int32 {
@@ -838,6 +1205,171 @@ func (x *UnschedulableReplicasResponse) GetUnschedulableReplicas() int32 {
 	return 0
 }
 
+// BatchUnschedulableReplicasRequest represents the request that sent by gRPC client to calculate unschedulable
+// replicas of several workloads at once.
+type BatchUnschedulableReplicasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cluster represents the cluster name.
+	// +required
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Requests are the requests to calculate unschedulable replicas. The cluster of each request
+	// should be the same as the cluster of the batch request.
+	// +required
+	Requests      []*UnschedulableReplicasRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUnschedulableReplicasRequest) Reset() {
+	*x = BatchUnschedulableReplicasRequest{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUnschedulableReplicasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUnschedulableReplicasRequest) ProtoMessage() {}
+
+func (x *BatchUnschedulableReplicasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUnschedulableReplicasRequest.ProtoReflect.Descriptor instead.
+func (*BatchUnschedulableReplicasRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchUnschedulableReplicasRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *BatchUnschedulableReplicasRequest) GetRequests() []*UnschedulableReplicasRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// BatchUnschedulableReplicasResponse represents the response that sent by gRPC server to calculate unschedulable
+// replicas of several workloads at once.
+type BatchUnschedulableReplicasResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results are the results of the requests, in the same order as the requests.
+	// +required
+	Results       []*UnschedulableReplicasResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUnschedulableReplicasResponse) Reset() {
+	*x = BatchUnschedulableReplicasResponse{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUnschedulableReplicasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUnschedulableReplicasResponse) ProtoMessage() {}
+
+func (x *BatchUnschedulableReplicasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUnschedulableReplicasResponse.ProtoReflect.Descriptor instead.
+func (*BatchUnschedulableReplicasResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchUnschedulableReplicasResponse) GetResults() []*UnschedulableReplicasResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// UnschedulableReplicasResult represents the result of a request in a BatchUnschedulableReplicasRequest.
+type UnschedulableReplicasResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UnschedulableReplicas represents the unschedulable replicas that the object contains.
+	// +optional
+	UnschedulableReplicas int32 `protobuf:"varint,1,opt,name=unschedulableReplicas,proto3" json:"unschedulableReplicas,omitempty"`
+	// Error represents the error occurred when calculating the unschedulable replicas.
+	// UnschedulableReplicas is meaningless if it is not empty.
+	// +optional
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnschedulableReplicasResult) Reset() {
+	*x = UnschedulableReplicasResult{}
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnschedulableReplicasResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnschedulableReplicasResult) ProtoMessage() {}
+
+func (x *UnschedulableReplicasResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_estimator_pb_estimator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnschedulableReplicasResult.ProtoReflect.Descriptor instead.
+func (*UnschedulableReplicasResult) Descriptor() ([]byte, []int) {
+	return file_pkg_estimator_pb_estimator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnschedulableReplicasResult) GetUnschedulableReplicas() int32 {
+	if x != nil {
+		return x.UnschedulableReplicas
+	}
+	return 0
+}
+
+func (x *UnschedulableReplicasResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_pkg_estimator_pb_estimator_proto protoreflect.FileDescriptor
 
 const file_pkg_estimator_pb_estimator_proto_rawDesc = "" +
@@ -846,11 +1378,12 @@ const file_pkg_estimator_pb_estimator_proto_rawDesc = "" +
 	"\tComponent\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12~\n" +
 	"\x13replicaRequirements\x18\x02 \x01(\v2L.github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirementsR\x13replicaRequirements\x12\x1a\n" +
-	"\breplicas\x18\x03 \x01(\x05R\breplicas\"\xb5\x03\n" +
+	"\breplicas\x18\x03 \x01(\x05R\breplicas\"\x9c\x04\n" +
 	"\x1cComponentReplicaRequirements\x12\\\n" +
 	"\tnodeClaim\x18\x01 \x01(\v29.github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaimH\x00R\tnodeClaim\x88\x01\x01\x12,\n" +
 	"\x11priorityClassName\x18\x03 \x01(\tR\x11priorityClassName\x12\x9a\x01\n" +
-	"\x14resourceRequestBytes\x18\x04 \x03(\v2f.github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.ResourceRequestBytesEntryR\x14resourceRequestBytes\x1aG\n" +
+	"\x14resourceRequestBytes\x18\x04 \x03(\v2f.github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.ResourceRequestBytesEntryR\x14resourceRequestBytes\x12e\n" +
+	"\x0edeviceRequests\x18\x05 \x03(\v2=.github.com.karmada_io.karmada.pkg.estimator.pb.DeviceRequestR\x0edeviceRequests\x1aG\n" +
 	"\x19ResourceRequestBytesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01B\f\n" +
@@ -875,13 +1408,28 @@ const file_pkg_estimator_pb_estimator_proto_rawDesc = "" +
 	"\x13replicaRequirements\x18\x02 \x01(\v2C.github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirementsR\x13replicaRequirements\x12k\n" +
 	"\x10assumedWorkloads\x18\x03 \x03(\v2?.github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkloadR\x10assumedWorkloads\"@\n" +
 	"\x1cMaxAvailableReplicasResponse\x12 \n" +
-	"\vmaxReplicas\x18\x01 \x01(\x05R\vmaxReplicas\"\xbe\x02\n" +
+	"\vmaxReplicas\x18\x01 \x01(\x05R\vmaxReplicas\"\xa5\x01\n" +
+	" BatchMaxAvailableReplicasRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12g\n" +
+	"\brequests\x18\x02 \x03(\v2K.github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequestR\brequests\"\x89\x01\n" +
+	"!BatchMaxAvailableReplicasResponse\x12d\n" +
+	"\aresults\x18\x01 \x03(\v2J.github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResultR\aresults\"T\n" +
+	"\x1aMaxAvailableReplicasResult\x12 \n" +
+	"\vmaxReplicas\x18\x01 \x01(\x05R\vmaxReplicas\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x8c\x05\n" +
 	"\tNodeClaim\x12o\n" +
 	"\fnodeSelector\x18\x02 \x03(\v2K.github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.NodeSelectorEntryR\fnodeSelector\x12,\n" +
 	"\x11nodeAffinityBytes\x18\x04 \x01(\fR\x11nodeAffinityBytes\x12*\n" +
-	"\x10tolerationsBytes\x18\x05 \x03(\fR\x10tolerationsBytes\x1a?\n" +
+	"\x10tolerationsBytes\x18\x05 \x03(\fR\x10tolerationsBytes\x12f\n" +
+	"\tpodLabels\x18\x06 \x03(\v2H.github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.PodLabelsEntryR\tpodLabels\x12*\n" +
+	"\x10podAffinityBytes\x18\a \x03(\fR\x10podAffinityBytes\x122\n" +
+	"\x14podAntiAffinityBytes\x18\b \x03(\fR\x14podAntiAffinityBytes\x12F\n" +
+	"\x1etopologySpreadConstraintsBytes\x18\t \x03(\fR\x1etopologySpreadConstraintsBytes\x1a?\n" +
 	"\x11NodeSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0ePodLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x01\x10\x02J\x04\b\x03\x10\x04R\fnodeAffinityR\vtolerations\"w\n" +
 	"\x0fObjectReference\x12\x1e\n" +
 	"\n" +
@@ -889,23 +1437,40 @@ const file_pkg_estimator_pb_estimator_proto_rawDesc = "" +
 	"apiVersion\x12\x12\n" +
 	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1c\n" +
 	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\"\xc1\x03\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"\x92\x05\n" +
 	"\x13ReplicaRequirements\x12\\\n" +
 	"\tnodeClaim\x18\x01 \x01(\v29.github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaimH\x00R\tnodeClaim\x88\x01\x01\x12\x1c\n" +
 	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
 	"\x11priorityClassName\x18\x04 \x01(\tR\x11priorityClassName\x12\x91\x01\n" +
-	"\x14resourceRequestBytes\x18\x05 \x03(\v2].github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.ResourceRequestBytesEntryR\x14resourceRequestBytes\x1aG\n" +
+	"\x14resourceRequestBytes\x18\x05 \x03(\v2].github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.ResourceRequestBytesEntryR\x14resourceRequestBytes\x12e\n" +
+	"\x0edeviceRequests\x18\x06 \x03(\v2=.github.com.karmada_io.karmada.pkg.estimator.pb.DeviceRequestR\x0edeviceRequests\x12h\n" +
+	"\x0fstorageRequests\x18\a \x03(\v2>.github.com.karmada_io.karmada.pkg.estimator.pb.StorageRequestR\x0fstorageRequests\x1aG\n" +
 	"\x19ResourceRequestBytesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01B\f\n" +
 	"\n" +
-	"_nodeClaimJ\x04\b\x02\x10\x03R\x0fresourceRequest\"\xcd\x01\n" +
+	"_nodeClaimJ\x04\b\x02\x10\x03R\x0fresourceRequest\"m\n" +
+	"\rDeviceRequest\x12(\n" +
+	"\x0fdeviceClassName\x18\x01 \x01(\tR\x0fdeviceClassName\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1c\n" +
+	"\tselectors\x18\x03 \x03(\tR\tselectors\"V\n" +
+	"\x0eStorageRequest\x12*\n" +
+	"\x10storageClassName\x18\x01 \x01(\tR\x10storageClassName\x12\x18\n" +
+	"\astorage\x18\x02 \x01(\x03R\astorage\"\xcd\x01\n" +
 	"\x1cUnschedulableReplicasRequest\x12\x18\n" +
 	"\acluster\x18\x01 \x01(\tR\acluster\x12[\n" +
 	"\bresource\x18\x02 \x01(\v2?.github.com.karmada_io.karmada.pkg.estimator.pb.ObjectReferenceR\bresource\x126\n" +
 	"\x16unschedulableThreshold\x18\x03 \x01(\x03R\x16unschedulableThreshold\"U\n" +
 	"\x1dUnschedulableReplicasResponse\x124\n" +
-	"\x15unschedulableReplicas\x18\x01 \x01(\x05R\x15unschedulableReplicasB0Z.github.com/karmada-io/karmada/pkg/estimator/pbb\x06proto3"
+	"\x15unschedulableReplicas\x18\x01 \x01(\x05R\x15unschedulableReplicas\"\xa7\x01\n" +
+	"!BatchUnschedulableReplicasRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12h\n" +
+	"\brequests\x18\x02 \x03(\v2L.github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequestR\brequests\"\x8b\x01\n" +
+	"\"BatchUnschedulableReplicasResponse\x12e\n" +
+	"\aresults\x18\x01 \x03(\v2K.github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResultR\aresults\"i\n" +
+	"\x1bUnschedulableReplicasResult\x124\n" +
+	"\x15unschedulableReplicas\x18\x01 \x01(\x05R\x15unschedulableReplicas\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05errorB0Z.github.com/karmada-io/karmada/pkg/estimator/pbb\x06proto3"
 
 var (
 	file_pkg_estimator_pb_estimator_proto_rawDescOnce sync.Once
@@ -919,42 +1484,59 @@ func file_pkg_estimator_pb_estimator_proto_rawDescGZIP() []byte {
 	return file_pkg_estimator_pb_estimator_proto_rawDescData
 }
 
-var file_pkg_estimator_pb_estimator_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_pkg_estimator_pb_estimator_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_pkg_estimator_pb_estimator_proto_goTypes = []any{
-	(*Component)(nil),                         // 0: github.com.karmada_io.karmada.pkg.estimator.pb.Component
-	(*ComponentReplicaRequirements)(nil),      // 1: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements
-	(*AssumedWorkload)(nil),                   // 2: github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload
-	(*MaxAvailableComponentSetsRequest)(nil),  // 3: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest
-	(*MaxAvailableComponentSetsResponse)(nil), // 4: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse
-	(*MaxAvailableReplicasRequest)(nil),       // 5: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest
-	(*MaxAvailableReplicasResponse)(nil),      // 6: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse
-	(*NodeClaim)(nil),                         // 7: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim
-	(*ObjectReference)(nil),                   // 8: github.com.karmada_io.karmada.pkg.estimator.pb.ObjectReference
-	(*ReplicaRequirements)(nil),               // 9: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements
-	(*UnschedulableReplicasRequest)(nil),      // 10: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest
-	(*UnschedulableReplicasResponse)(nil),     // 11: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse
-	nil,                                       // 12: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.ResourceRequestBytesEntry
-	nil,                                       // 13: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.NodeSelectorEntry
-	nil,                                       // 14: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.ResourceRequestBytesEntry
+	(*Component)(nil),                          // 0: github.com.karmada_io.karmada.pkg.estimator.pb.Component
+	(*ComponentReplicaRequirements)(nil),       // 1: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements
+	(*AssumedWorkload)(nil),                    // 2: github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload
+	(*MaxAvailableComponentSetsRequest)(nil),   // 3: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest
+	(*MaxAvailableComponentSetsResponse)(nil),  // 4: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse
+	(*MaxAvailableReplicasRequest)(nil),        // 5: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest
+	(*MaxAvailableReplicasResponse)(nil),       // 6: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse
+	(*BatchMaxAvailableReplicasRequest)(nil),   // 7: github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasRequest
+	(*BatchMaxAvailableReplicasResponse)(nil),  // 8: github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasResponse
+	(*MaxAvailableReplicasResult)(nil),         // 9: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResult
+	(*NodeClaim)(nil),                          // 10: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim
+	(*ObjectReference)(nil),                    // 11: github.com.karmada_io.karmada.pkg.estimator.pb.ObjectReference
+	(*ReplicaRequirements)(nil),                // 12: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements
+	(*DeviceRequest)(nil),                      // 13: github.com.karmada_io.karmada.pkg.estimator.pb.DeviceRequest
+	(*StorageRequest)(nil),                     // 14: github.com.karmada_io.karmada.pkg.estimator.pb.StorageRequest
+	(*UnschedulableReplicasRequest)(nil),       // 15: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest
+	(*UnschedulableReplicasResponse)(nil),      // 16: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse
+	(*BatchUnschedulableReplicasRequest)(nil),  // 17: github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasRequest
+	(*BatchUnschedulableReplicasResponse)(nil), // 18: github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasResponse
+	(*UnschedulableReplicasResult)(nil),        // 19: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResult
+	nil,                                        // 20: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.ResourceRequestBytesEntry
+	nil,                                        // 21: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.NodeSelectorEntry
+	nil,                                        // 22: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.PodLabelsEntry
+	nil,                                        // 23: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.ResourceRequestBytesEntry
 }
 var file_pkg_estimator_pb_estimator_proto_depIdxs = []int32{
 	1,  // 0: github.com.karmada_io.karmada.pkg.estimator.pb.Component.replicaRequirements:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements
-	7,  // 1: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.nodeClaim:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim
-	12, // 2: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.resourceRequestBytes:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.ResourceRequestBytesEntry
-	0,  // 3: github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload.components:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.Component
-	0,  // 4: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest.components:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.Component
-	2,  // 5: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest.assumedWorkloads:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload
-	9,  // 6: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest.replicaRequirements:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements
-	2,  // 7: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest.assumedWorkloads:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload
-	13, // 8: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.nodeSelector:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.NodeSelectorEntry
-	7,  // 9: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.nodeClaim:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim
-	14, // 10: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.resourceRequestBytes:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.ResourceRequestBytesEntry
-	8,  // 11: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest.resource:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ObjectReference
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	10, // 1: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.nodeClaim:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim
+	20, // 2: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.resourceRequestBytes:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.ResourceRequestBytesEntry
+	13, // 3: github.com.karmada_io.karmada.pkg.estimator.pb.ComponentReplicaRequirements.deviceRequests:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.DeviceRequest
+	0,  // 4: github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload.components:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.Component
+	0,  // 5: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest.components:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.Component
+	2,  // 6: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest.assumedWorkloads:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload
+	12, // 7: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest.replicaRequirements:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements
+	2,  // 8: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest.assumedWorkloads:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.AssumedWorkload
+	5,  // 9: github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasRequest.requests:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest
+	9,  // 10: github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasResponse.results:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResult
+	21, // 11: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.nodeSelector:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.NodeSelectorEntry
+	22, // 12: github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.podLabels:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim.PodLabelsEntry
+	10, // 13: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.nodeClaim:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.NodeClaim
+	23, // 14: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.resourceRequestBytes:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.ResourceRequestBytesEntry
+	13, // 15: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.deviceRequests:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.DeviceRequest
+	14, // 16: github.com.karmada_io.karmada.pkg.estimator.pb.ReplicaRequirements.storageRequests:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.StorageRequest
+	11, // 17: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest.resource:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.ObjectReference
+	15, // 18: github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasRequest.requests:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest
+	19, // 19: github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasResponse.results:type_name -> github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResult
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_pkg_estimator_pb_estimator_proto_init() }
@@ -963,14 +1545,14 @@ func file_pkg_estimator_pb_estimator_proto_init() {
 		return
 	}
 	file_pkg_estimator_pb_estimator_proto_msgTypes[1].OneofWrappers = []any{}
-	file_pkg_estimator_pb_estimator_proto_msgTypes[9].OneofWrappers = []any{}
+	file_pkg_estimator_pb_estimator_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_estimator_pb_estimator_proto_rawDesc), len(file_pkg_estimator_pb_estimator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -91,6 +91,96 @@ func (n *NodeClaim) UnmarshalTolerations() ([]corev1.Toleration, error) {
 	return nil, nil
 }
 
+// SetPodAffinity marshals the given PodAffinityTerms into PodAffinityBytes field.
+func (n *NodeClaim) SetPodAffinity(terms []corev1.PodAffinityTerm) error {
+	b, err := marshalRepeated(terms)
+	if err != nil {
+		return err
+	}
+	n.PodAffinityBytes = b
+	return nil
+}
+
+// UnmarshalPodAffinity unmarshals the PodAffinityBytes field into a slice of PodAffinityTerms.
+func (n *NodeClaim) UnmarshalPodAffinity() ([]corev1.PodAffinityTerm, error) {
+	if n == nil {
+		return nil, nil
+	}
+	return unmarshalRepeated[corev1.PodAffinityTerm](n.PodAffinityBytes)
+}
+
+// SetPodAntiAffinity marshals the given PodAffinityTerms into PodAntiAffinityBytes field.
+func (n *NodeClaim) SetPodAntiAffinity(terms []corev1.PodAffinityTerm) error {
+	b, err := marshalRepeated(terms)
+	if err != nil {
+		return err
+	}
+	n.PodAntiAffinityBytes = b
+	return nil
+}
+
+// UnmarshalPodAntiAffinity unmarshals the PodAntiAffinityBytes field into a slice of PodAffinityTerms.
+func (n *NodeClaim) UnmarshalPodAntiAffinity() ([]corev1.PodAffinityTerm, error) {
+	if n == nil {
+		return nil, nil
+	}
+	return unmarshalRepeated[corev1.PodAffinityTerm](n.PodAntiAffinityBytes)
+}
+
+// SetTopologySpreadConstraints marshals the given TopologySpreadConstraints into TopologySpreadConstraintsBytes field.
+func (n *NodeClaim) SetTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint) error {
+	b, err := marshalRepeated(constraints)
+	if err != nil {
+		return err
+	}
+	n.TopologySpreadConstraintsBytes = b
+	return nil
+}
+
+// UnmarshalTopologySpreadConstraints unmarshals the TopologySpreadConstraintsBytes field into a slice of
+// TopologySpreadConstraints.
+func (n *NodeClaim) UnmarshalTopologySpreadConstraints() ([]corev1.TopologySpreadConstraint, error) {
+	if n == nil {
+		return nil, nil
+	}
+	return unmarshalRepeated[corev1.TopologySpreadConstraint](n.TopologySpreadConstraintsBytes)
+}
+
+// protoMessage is implemented by the pointers of K8s API types, which are able to be proto-serialized.
+type protoMessage[T any] interface {
+	*T
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+func marshalRepeated[T any, PT protoMessage[T]](items []T) ([][]byte, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	out := make([][]byte, len(items))
+	for i := range items {
+		b, err := PT(&items[i]).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = b
+	}
+	return out, nil
+}
+
+func unmarshalRepeated[T any, PT protoMessage[T]](data [][]byte) ([]T, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	items := make([]T, len(data))
+	for i, b := range data {
+		if err := PT(&items[i]).Unmarshal(b); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
 // SetResourceRequest marshals the given ResourceList into ResourceRequestBytes field.
 func (r *ReplicaRequirements) SetResourceRequest(res corev1.ResourceList) error {
 	if len(res) == 0 {
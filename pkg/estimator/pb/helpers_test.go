@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func marshalNodeSelector(t *testing.T, ns *corev1.NodeSelector) []byte {
@@ -151,6 +152,50 @@ func TestNodeClaim_UnmarshalTolerations(t *testing.T) {
 	}
 }
 
+func TestNodeClaim_PodAffinityRoundTrip(t *testing.T) {
+	affinity := []corev1.PodAffinityTerm{{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "cache"}},
+		TopologyKey:   "kubernetes.io/hostname",
+	}}
+	antiAffinity := []corev1.PodAffinityTerm{{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		Namespaces:    []string{"default"},
+		TopologyKey:   "topology.kubernetes.io/zone",
+	}}
+	constraints := []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+	}}
+
+	claim := &NodeClaim{}
+	require.NoError(t, claim.SetPodAffinity(affinity))
+	require.NoError(t, claim.SetPodAntiAffinity(antiAffinity))
+	require.NoError(t, claim.SetTopologySpreadConstraints(constraints))
+
+	gotAffinity, err := claim.UnmarshalPodAffinity()
+	require.NoError(t, err)
+	assert.Equal(t, affinity, gotAffinity)
+	gotAntiAffinity, err := claim.UnmarshalPodAntiAffinity()
+	require.NoError(t, err)
+	assert.Equal(t, antiAffinity, gotAntiAffinity)
+	gotConstraints, err := claim.UnmarshalTopologySpreadConstraints()
+	require.NoError(t, err)
+	assert.Equal(t, constraints, gotConstraints)
+
+	require.NoError(t, claim.SetPodAffinity(nil))
+	assert.Nil(t, claim.PodAffinityBytes)
+
+	var nilClaim *NodeClaim
+	gotConstraints, err = nilClaim.UnmarshalTopologySpreadConstraints()
+	require.NoError(t, err)
+	assert.Nil(t, gotConstraints)
+
+	_, err = (&NodeClaim{PodAntiAffinityBytes: [][]byte{[]byte("invalid")}}).UnmarshalPodAntiAffinity()
+	assert.Error(t, err)
+}
+
 func marshalQuantity(t *testing.T, q resource.Quantity) []byte {
 	t.Helper()
 	b, err := q.Marshal()
@@ -17,6 +17,8 @@ limitations under the License.
 package estimator
 
 import (
+	"context"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 
@@ -33,12 +35,23 @@ import (
 type SchedulingSimulator struct {
 	// nodes represent the available cluster nodes with their resource capacity
 	nodes []*schedulerframework.NodeInfo
+	// filterPlugins filter the nodes for the replicas of a pod, in addition to the node affinity,
+	// tolerations and resources.
+	filterPlugins []FilterPlugin
+}
+
+// FilterPlugin is a kube-scheduler plugin filtering the nodes for a pod. The state pre-computed by the
+// plugin is updated through its PreFilterExtensions with each replica placed during the simulation.
+type FilterPlugin interface {
+	schedulerframework.PreFilterPlugin
+	schedulerframework.FilterPlugin
 }
 
 // NewSchedulingSimulator creates a new scheduling simulator instance.
-func NewSchedulingSimulator(nodes []*schedulerframework.NodeInfo) *SchedulingSimulator {
+func NewSchedulingSimulator(nodes []*schedulerframework.NodeInfo, filterPlugins ...FilterPlugin) *SchedulingSimulator {
 	return &SchedulingSimulator{
-		nodes: nodes,
+		nodes:         nodes,
+		filterPlugins: filterPlugins,
 	}
 }
 
@@ -129,6 +142,77 @@ func (s *SchedulingSimulator) scheduleComponent(component *pb.Component) (bool,
 	return remaining == 0, nil
 }
 
+// SimulatePodScheduling estimates how many replicas of the pod can be placed onto the nodes, which is capped
+// by the upper bound. The replicas are placed in rounds, each of which places at most one replica onto every
+// node that matches the node affinity and tolerations of the pod, has enough resources and passes all the
+// filter plugins. Since the placed replicas are added to the state of the filter plugins, e.g. a replica
+// with anti-affinity to itself repels the following replicas from its topology domain.
+func (s *SchedulingSimulator) SimulatePodScheduling(ctx context.Context, pod *corev1.Pod, upperBound int32) (int32, error) {
+	state := schedulerframework.NewCycleState()
+	for _, pl := range s.filterPlugins {
+		if status := pl.PreFilter(ctx, state, pod); !status.IsSuccess() {
+			return 0, status.AsError()
+		}
+	}
+	podInfo, err := schedulerframework.NewPodInfo(pod)
+	if err != nil {
+		return 0, err
+	}
+
+	affinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+	requiredPerReplica := util.EmptyResource().AddPodTemplateRequest(&pod.Spec)
+	requiredPerReplica.AllowedPodNumber = 1
+	requests := requiredPerReplica.ResourceList()
+
+	var replicas int32
+	for replicas < upperBound {
+		var placed int32
+		for _, node := range s.nodes {
+			if replicas+placed >= upperBound {
+				break
+			}
+			if !MatchNode(node, affinity, pod.Spec.Tolerations) || node.Allocatable.MaxDivided(requests) == 0 {
+				continue
+			}
+			fits, err := s.runFilterPlugins(ctx, state, pod, node)
+			if err != nil {
+				return 0, err
+			}
+			if !fits {
+				continue
+			}
+
+			node.Allocatable.SubResource(requiredPerReplica)
+			for _, pl := range s.filterPlugins {
+				if status := pl.PreFilterExtensions().AddPod(ctx, state, pod, podInfo, node); !status.IsSuccess() {
+					return 0, status.AsError()
+				}
+			}
+			placed++
+		}
+		if placed == 0 {
+			break
+		}
+		replicas += placed
+	}
+	return replicas, nil
+}
+
+// runFilterPlugins tells whether the pod passes all the filter plugins on the node.
+func (s *SchedulingSimulator) runFilterPlugins(ctx context.Context, state *schedulerframework.CycleState,
+	pod *corev1.Pod, node *schedulerframework.NodeInfo) (bool, error) {
+	for _, pl := range s.filterPlugins {
+		status := pl.Filter(ctx, state, pod, node)
+		if status.IsUnschedulable() {
+			return false, nil
+		}
+		if !status.IsSuccess() {
+			return false, status.AsError()
+		}
+	}
+	return true, nil
+}
+
 // GetAffinityAndTolerations extracts node affinity and tolerations from a NodeClaim.
 func GetAffinityAndTolerations(nodeClaim *pb.NodeClaim) (nodeaffinity.RequiredNodeAffinity, []corev1.Toleration, error) {
 	affinity, err := nodeutil.GetRequiredNodeAffinity(&pb.ReplicaRequirements{NodeClaim: nodeClaim})
@@ -17,16 +17,23 @@ limitations under the License.
 package estimator
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/karmada-io/karmada/pkg/estimator/pb"
 	"github.com/karmada-io/karmada/pkg/util"
+	schedcache "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/cache"
 	schedulerframework "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework/plugins/interpodaffinity"
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework/plugins/podtopologyspread"
 )
 
 func TestMatchNode(t *testing.T) {
@@ -359,6 +366,184 @@ func TestSchedulingSimulator_SimulateSchedulingFF(t *testing.T) {
 	}
 }
 
+func TestSchedulingSimulator_SimulatePodScheduling(t *testing.T) {
+	webLabels := map[string]string{"app": "web"}
+	dbLabels := map[string]string{"app": "db"}
+	antiAffinity := func(topologyKey string) *corev1.PodAntiAffinity {
+		return &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{podAffinityTerm(topologyKey, webLabels)},
+		}
+	}
+	zoneSpread := func(maxSkew int32, minDomains *int32) corev1.TopologySpreadConstraint {
+		return corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: webLabels},
+			MinDomains:        minDomains,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		affinity     *corev1.Affinity
+		spread       []corev1.TopologySpreadConstraint
+		nodeSelector map[string]string
+		// pods are the existing pods on each node.
+		pods       map[string][]*corev1.Pod
+		upperBound int32
+		expected   int32
+	}{
+		{
+			name:     "anti-affinity by hostname",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			expected: 4,
+		},
+		{
+			name:     "anti-affinity by hostname with existing replica",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			pods:     map[string][]*corev1.Pod{"node-1": {newTopologyPod("web-0", webLabels, nil)}},
+			expected: 3,
+		},
+		{
+			name:     "anti-affinity by zone",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelTopologyZone)},
+			expected: 2,
+		},
+		{
+			name:     "anti-affinity of existing pods",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			pods: map[string][]*corev1.Pod{"node-1": {newTopologyPod("db-0", dbLabels, &corev1.Affinity{
+				PodAntiAffinity: antiAffinity(corev1.LabelTopologyZone),
+			})}},
+			// all the nodes in zone-a are excluded.
+			expected: 1,
+		},
+		{
+			name: "affinity to other pods",
+			affinity: &corev1.Affinity{
+				PodAffinity: &corev1.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{podAffinityTerm(corev1.LabelTopologyZone, dbLabels)},
+				},
+				PodAntiAffinity: antiAffinity(corev1.LabelHostname),
+			},
+			pods:     map[string][]*corev1.Pod{"node-4": {newTopologyPod("db-0", dbLabels, nil)}},
+			expected: 1,
+		},
+		{
+			name: "affinity to other pods not found",
+			affinity: &corev1.Affinity{
+				PodAffinity: &corev1.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{podAffinityTerm(corev1.LabelTopologyZone, dbLabels)},
+				},
+			},
+			expected: 0,
+		},
+		{
+			name: "affinity to itself",
+			affinity: &corev1.Affinity{
+				PodAffinity: &corev1.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{podAffinityTerm(corev1.LabelTopologyZone, webLabels)},
+				},
+				PodAntiAffinity: antiAffinity(corev1.LabelHostname),
+			},
+			// the first replica goes to zone-a, and the others follow it.
+			expected: 3,
+		},
+		{
+			name:   "topology spread only",
+			spread: []corev1.TopologySpreadConstraint{zoneSpread(1, nil)},
+			// zone-b is full with 3 replicas, and zone-a gets at most one more replica than zone-b.
+			expected: 7,
+		},
+		{
+			name:     "topology spread with anti-affinity by hostname",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			spread:   []corev1.TopologySpreadConstraint{zoneSpread(1, nil)},
+			// zone-a gets at most one more replica than zone-b.
+			expected: 3,
+		},
+		{
+			name:     "topology spread with existing skew",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			spread:   []corev1.TopologySpreadConstraint{zoneSpread(1, nil)},
+			pods:     map[string][]*corev1.Pod{"node-4": {newTopologyPod("web-0", webLabels, nil)}},
+			expected: 2,
+		},
+		{
+			name:     "topology spread with min domains and anti-affinity by hostname",
+			affinity: &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			spread:   []corev1.TopologySpreadConstraint{zoneSpread(1, new(int32(3)))},
+			// the global minimum is 0 since there are fewer domains than required.
+			expected: 2,
+		},
+		{
+			name:   "topology spread with more min domains than domains",
+			spread: []corev1.TopologySpreadConstraint{zoneSpread(3, new(int32(5)))},
+			// the global minimum is 0 since there are fewer domains than required, so that each of
+			// the 2 zones gets at most maxSkew replicas.
+			expected: 6,
+		},
+		{
+			name:         "node selector",
+			affinity:     &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			nodeSelector: map[string]string{corev1.LabelTopologyZone: "zone-a"},
+			expected:     3,
+		},
+		{
+			name:       "capped by the upper bound",
+			affinity:   &corev1.Affinity{PodAntiAffinity: antiAffinity(corev1.LabelHostname)},
+			upperBound: 1,
+			expected:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := []*corev1.Node{
+				makeTopologyNode("node-1", "zone-a"),
+				makeTopologyNode("node-2", "zone-a"),
+				makeTopologyNode("node-3", "zone-a"),
+				makeTopologyNode("node-4", "zone-b"),
+			}
+			var pods []*corev1.Pod
+			for nodeName, nodePods := range tt.pods {
+				for _, pod := range nodePods {
+					pod.Spec.NodeName = nodeName
+					pods = append(pods, pod)
+				}
+			}
+			snapshot := schedcache.NewSnapshot(pods, nodes)
+			nodeInfos, err := snapshot.NodeInfos().List()
+			if err != nil {
+				t.Fatalf("failed to list node infos: %v", err)
+			}
+			for i, nodeInfo := range nodeInfos {
+				nodeInfos[i] = nodeInfo.Clone()
+				nodeInfos[i].Allocatable.AllowedPodNumber -= int64(len(nodeInfo.Pods))
+			}
+			nsLister := listersv1.NewNamespaceLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+
+			pod := newTopologyPod("", webLabels, tt.affinity)
+			pod.Spec.TopologySpreadConstraints = tt.spread
+			pod.Spec.NodeSelector = tt.nodeSelector
+			upperBound := tt.upperBound
+			if upperBound == 0 {
+				upperBound = math.MaxInt32
+			}
+
+			simulator := NewSchedulingSimulator(nodeInfos, interpodaffinity.New(snapshot, nsLister), podtopologyspread.New(snapshot))
+			result, err := simulator.SimulatePodScheduling(context.TODO(), pod, upperBound)
+			if err != nil {
+				t.Fatalf("SimulatePodScheduling() error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("SimulatePodScheduling() = %d, expected %d", result, tt.expected)
+			}
+		})
+	}
+}
+
 // BenchmarkSimulateScheduling_AssumedWorkloadDeduction benchmarks the deduction loop
 // used in the noderesource estimator plugin: for each assumed workload, call
 // SimulateScheduling with upperBound=1 to consume its node resources.
@@ -451,3 +636,23 @@ func makeNode(name string, labels map[string]string, allocatable corev1.Resource
 		},
 	}
 }
+
+// makeTopologyNode makes a node in the zone, which allows 3 pods.
+func makeTopologyNode(name, zone string) *corev1.Node {
+	return makeNode(name, map[string]string{corev1.LabelHostname: name, corev1.LabelTopologyZone: zone},
+		corev1.ResourceList{corev1.ResourcePods: resource.MustParse("3")})
+}
+
+func newTopologyPod(name string, podLabels map[string]string, affinity *corev1.Affinity) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: podLabels},
+		Spec:       corev1.PodSpec{Affinity: affinity},
+	}
+}
+
+func podAffinityTerm(topologyKey string, matchLabels map[string]string) corev1.PodAffinityTerm {
+	return corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: matchLabels},
+		TopologyKey:   topologyKey,
+	}
+}
@@ -40,6 +40,46 @@ func (es *AccurateSchedulerEstimatorServer) EstimateReplicas(ctx context.Context
 	}
 	trace.Step("Snapshotting estimator cache and node infos done")
 
+	maxAvailableReplicas, err := es.estimateReplicasWithSnapshot(ctx, snapShot, request)
+	if err != nil {
+		return 0, err
+	}
+	trace.Step("Computing estimation done")
+
+	return maxAvailableReplicas, nil
+}
+
+// BatchEstimateReplicas returns max available replicas of each request against one snapshot of the cluster status,
+// so that all the requests of a batch are evaluated on the same view of the nodes.
+// An error is returned only if the snapshot cannot be taken, errors of the individual requests are
+// reported in their results.
+// The objects are the resources the requests belong to, in the order of the requests.
+func (es *AccurateSchedulerEstimatorServer) BatchEstimateReplicas(ctx context.Context, objects []string, requests []*pb.MaxAvailableReplicasRequest) ([]*pb.MaxAvailableReplicasResult, error) {
+	trace := utiltrace.New("Batch estimating", utiltrace.Field{Key: "namespacedNames", Value: objects},
+		utiltrace.Field{Key: "requests", Value: len(requests)})
+	defer trace.LogIfLong(100 * time.Millisecond)
+
+	snapShot := schedcache.NewEmptySnapshot()
+	if err := es.Cache.UpdateSnapshot(snapShot); err != nil {
+		return nil, err
+	}
+	trace.Step("Snapshotting estimator cache and node infos done")
+
+	results := make([]*pb.MaxAvailableReplicasResult, len(requests))
+	for i, request := range requests {
+		maxAvailableReplicas, err := es.estimateReplicasWithSnapshot(ctx, snapShot, request)
+		if err != nil {
+			results[i] = &pb.MaxAvailableReplicasResult{Error: err.Error()}
+			continue
+		}
+		results[i] = &pb.MaxAvailableReplicasResult{MaxReplicas: maxAvailableReplicas}
+	}
+	trace.Step("Computing estimation done")
+
+	return results, nil
+}
+
+func (es *AccurateSchedulerEstimatorServer) estimateReplicasWithSnapshot(ctx context.Context, snapShot *schedcache.Snapshot, request *pb.MaxAvailableReplicasRequest) (int32, error) {
 	if snapShot.NumNodes() == 0 {
 		return 0, nil
 	}
@@ -51,13 +91,7 @@ func (es *AccurateSchedulerEstimatorServer) EstimateReplicas(ctx context.Context
 	if features.FeatureGate.Enabled(features.SchedulingOvercommitProtection) {
 		estCtx.AssumedWorkloads = request.GetAssumedWorkloads()
 	}
-	maxAvailableReplicas, err := es.estimateReplicas(ctx, estCtx)
-	if err != nil {
-		return 0, err
-	}
-	trace.Step("Computing estimation done")
-
-	return maxAvailableReplicas, nil
+	return es.estimateReplicas(ctx, estCtx)
 }
 
 func (es *AccurateSchedulerEstimatorServer) estimateReplicas(ctx context.Context, estCtx framework.ReplicaEstimationContext) (int32, error) {
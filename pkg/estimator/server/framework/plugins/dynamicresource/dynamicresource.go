@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicresource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	resourcev1 "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	resourcelisters "k8s.io/client-go/listers/resource/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/estimator"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+const (
+	// Name is the name of the plugin used in Registry and configurations.
+	Name = "DynamicResourceEstimator"
+
+	// noDeviceConstraint represents the value when there is no device constraint.
+	noDeviceConstraint = math.MaxInt32
+)
+
+// dynamicResourceEstimator estimates how many replicas are allowed by the devices published through
+// Dynamic Resource Allocation for a given pb.ReplicaRequirements.
+// The devices are read from the ResourceSlices of the member cluster, and are selected by the CEL
+// selectors of the DeviceClasses referred by the device requests together with the CEL selectors of
+// the requests. The devices already allocated to ResourceClaims are not available.
+// Devices local to a node can only be used by replicas on that node, while the devices shared among
+// nodes (e.g. network-attached devices) can be used by replicas on any node. So replicas are estimated
+// node by node, with the shared devices making up the shortage of the node-local devices, then the
+// replicas only using the left shared devices are added up.
+// The estimation is optimistic about devices matching multiple device requests, which are counted
+// for each of the device requests.
+type dynamicResourceEstimator struct {
+	enabled     bool
+	sliceLister resourcelisters.ResourceSliceLister
+	classLister resourcelisters.DeviceClassLister
+	claimLister resourcelisters.ResourceClaimLister
+	selectors   *selectorEvaluator
+}
+
+var _ framework.EstimateReplicasPlugin = &dynamicResourceEstimator{}
+
+// New initializes a new plugin and returns it.
+func New(fh framework.Handle) (framework.Plugin, error) {
+	enabled := features.FeatureGate.Enabled(features.DynamicResourceEstimate)
+	if !enabled {
+		// Disabled, won't do anything.
+		return &dynamicResourceEstimator{}, nil
+	}
+	served, err := resourceAPIServed(fh.ClientSet())
+	if err != nil {
+		return nil, err
+	}
+	if !served {
+		// The informers of an API not served by the member cluster would never be synced.
+		klog.Warningf("Disable the plugin %s as the member cluster does not serve the API %s.", Name, resourcev1.SchemeGroupVersion.String())
+		return &dynamicResourceEstimator{}, nil
+	}
+	selectors, err := newSelectorEvaluator()
+	if err != nil {
+		return nil, err
+	}
+	resourceInformers := fh.SharedInformerFactory().Resource().V1()
+	return &dynamicResourceEstimator{
+		enabled:     enabled,
+		sliceLister: resourceInformers.ResourceSlices().Lister(),
+		classLister: resourceInformers.DeviceClasses().Lister(),
+		claimLister: resourceInformers.ResourceClaims().Lister(),
+		selectors:   selectors,
+	}, nil
+}
+
+// resourceAPIServed tells if the member cluster serves the resources of the resource.k8s.io/v1 API read by the plugin.
+func resourceAPIServed(client clientset.Interface) (bool, error) {
+	if client == nil {
+		return false, nil
+	}
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(resourcev1.SchemeGroupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to discover the API %s: %w", resourcev1.SchemeGroupVersion.String(), err)
+	}
+	served := sets.New[string]()
+	for _, resource := range resources.APIResources {
+		served.Insert(resource.Name)
+	}
+	return served.HasAll("resourceslices", "deviceclasses", "resourceclaims"), nil
+}
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (pl *dynamicResourceEstimator) Name() string {
+	return Name
+}
+
+// deviceID identifies a device in the member cluster.
+type deviceID struct {
+	driver string
+	pool   string
+	device string
+}
+
+// poolID identifies a resource pool in the member cluster.
+type poolID struct {
+	driver string
+	pool   string
+}
+
+// Estimate estimates the replicas allowed by the available devices for the device requests.
+func (pl *dynamicResourceEstimator) Estimate(_ context.Context, estCtx framework.ReplicaEstimationContext) (int32, *framework.Result) {
+	if !pl.enabled {
+		klog.V(5).Info("Estimator Plugin", "name", Name, "enabled", pl.enabled)
+		return noDeviceConstraint, framework.NewResult(framework.Noopperation, fmt.Sprintf("%s is disabled", pl.Name()))
+	}
+	requirements := estCtx.ReplicaRequirements
+	if requirements == nil || len(requirements.DeviceRequests) == 0 {
+		return noDeviceConstraint, framework.NewResult(framework.Success, fmt.Sprintf("%s found no device requests", pl.Name()))
+	}
+
+	// The requests of the same device class and selectors are merged, since they are satisfied by the same devices.
+	var selectors [][]resourcev1.DeviceSelector
+	var demands []int64
+	requestIndex := make(map[string]int, len(requirements.DeviceRequests))
+	for _, request := range requirements.DeviceRequests {
+		count := request.Count
+		if count <= 0 {
+			count = 1
+		}
+		key := request.DeviceClassName + "\x00" + strings.Join(request.Selectors, "\x00")
+		if i, ok := requestIndex[key]; ok {
+			demands[i] += count
+			continue
+		}
+		class, err := pl.classLister.Get(request.DeviceClassName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("device class %s not found", request.DeviceClassName))
+			}
+			return 0, framework.AsResult(err)
+		}
+		requestIndex[key] = len(selectors)
+		selectors = append(selectors, requestSelectors(class, request.Selectors))
+		demands = append(demands, count)
+	}
+
+	nodeDevices, sharedDevices, err := pl.countAvailableDevices(estCtx, selectors)
+	if err != nil {
+		return 0, framework.AsResult(err)
+	}
+
+	var replicas int64
+	for _, nodeName := range sets.List(sets.KeySet(nodeDevices)) {
+		replicas += allocateReplicas(demands, nodeDevices[nodeName], sharedDevices)
+	}
+	replicas += allocateReplicas(demands, make([]int64, len(demands)), sharedDevices)
+	if replicas > noDeviceConstraint {
+		replicas = noDeviceConstraint
+	}
+	if replicas == 0 {
+		return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("zero replica is estimated by %s", pl.Name()))
+	}
+	return int32(replicas), framework.NewResult(framework.Success)
+}
+
+// requestSelectors returns the selectors a device must satisfy for the request, which are the selectors of
+// the device class followed by the CEL expressions of the request.
+func requestSelectors(class *resourcev1.DeviceClass, expressions []string) []resourcev1.DeviceSelector {
+	selectors := make([]resourcev1.DeviceSelector, 0, len(class.Spec.Selectors)+len(expressions))
+	selectors = append(selectors, class.Spec.Selectors...)
+	for _, expression := range expressions {
+		selectors = append(selectors, resourcev1.DeviceSelector{CEL: &resourcev1.CELDeviceSelector{Expression: expression}})
+	}
+	return selectors
+}
+
+// countAvailableDevices counts the unallocated devices matching each of the selectors, grouped by the
+// nodes the devices are local to. Devices on nodes not matching the node claim of the replicas are ignored.
+func (pl *dynamicResourceEstimator) countAvailableDevices(estCtx framework.ReplicaEstimationContext,
+	selectors [][]resourcev1.DeviceSelector) (map[string][]int64, []int64, error) {
+	slices, err := pl.sliceLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	allocated, err := pl.listAllocatedDevices()
+	if err != nil {
+		return nil, nil, err
+	}
+	affinity, tolerations, err := estimator.GetAffinityAndTolerations(estCtx.ReplicaRequirements.NodeClaim)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only the slices of the latest generation of a pool are valid, the others are being replaced.
+	generations := make(map[poolID]int64)
+	for _, slice := range slices {
+		id := poolID{driver: slice.Spec.Driver, pool: slice.Spec.Pool.Name}
+		if slice.Spec.Pool.Generation > generations[id] {
+			generations[id] = slice.Spec.Pool.Generation
+		}
+	}
+
+	nodeMatched := make(map[string]bool)
+	matchNode := func(nodeName string) bool {
+		if matched, ok := nodeMatched[nodeName]; ok {
+			return matched
+		}
+		matched := false
+		if estCtx.Snapshot != nil {
+			if nodeInfo, err := estCtx.Snapshot.Get(nodeName); err == nil {
+				matched = estimator.MatchNode(nodeInfo, affinity, tolerations)
+			}
+		}
+		nodeMatched[nodeName] = matched
+		return matched
+	}
+
+	nodeDevices := make(map[string][]int64)
+	sharedDevices := make([]int64, len(selectors))
+	for _, slice := range slices {
+		if slice.Spec.Pool.Generation < generations[poolID{driver: slice.Spec.Driver, pool: slice.Spec.Pool.Name}] {
+			continue
+		}
+		for i := range slice.Spec.Devices {
+			device := &slice.Spec.Devices[i]
+			if allocated.Has(deviceID{driver: slice.Spec.Driver, pool: slice.Spec.Pool.Name, device: device.Name}) {
+				continue
+			}
+
+			counts := sharedDevices
+			if nodeName := deviceNodeName(slice, device); nodeName != "" {
+				if !matchNode(nodeName) {
+					continue
+				}
+				if nodeDevices[nodeName] == nil {
+					nodeDevices[nodeName] = make([]int64, len(selectors))
+				}
+				counts = nodeDevices[nodeName]
+			}
+
+			for j := range selectors {
+				matched, err := pl.selectors.matches(selectors[j], slice.Spec.Driver, device)
+				if err != nil {
+					klog.V(4).InfoS("Failed to match device against device request", "plugin", pl.Name(),
+						"resourceSlice", slice.Name, "device", device.Name, "err", err)
+					continue
+				}
+				if matched {
+					counts[j]++
+				}
+			}
+		}
+	}
+	return nodeDevices, sharedDevices, nil
+}
+
+// listAllocatedDevices lists the devices allocated to ResourceClaims.
+func (pl *dynamicResourceEstimator) listAllocatedDevices() (deviceSet, error) {
+	claims, err := pl.claimLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	allocated := make(deviceSet)
+	for _, claim := range claims {
+		if claim.Status.Allocation == nil {
+			continue
+		}
+		for _, result := range claim.Status.Allocation.Devices.Results {
+			allocated[deviceID{driver: result.Driver, pool: result.Pool, device: result.Device}] = struct{}{}
+		}
+	}
+	return allocated, nil
+}
+
+type deviceSet map[deviceID]struct{}
+
+func (s deviceSet) Has(id deviceID) bool {
+	_, ok := s[id]
+	return ok
+}
+
+// deviceNodeName returns the name of the node the device is local to, or empty if the device is
+// accessible from multiple nodes.
+func deviceNodeName(slice *resourcev1.ResourceSlice, device *resourcev1.Device) string {
+	if slice.Spec.NodeName != nil {
+		return *slice.Spec.NodeName
+	}
+	if device.NodeName != nil {
+		return *device.NodeName
+	}
+	return ""
+}
+
+// allocateReplicas returns how many replicas can be satisfied by the local devices of a node together
+// with the shared devices, given the number of devices of each device class a replica demands.
+// The shared devices used by the replicas are deducted from shared.
+func allocateReplicas(demands, local, shared []int64) int64 {
+	var replicas int64 = math.MaxInt64
+	for i, demand := range demands {
+		replicas = min(replicas, (local[i]+shared[i])/demand)
+	}
+	for i, demand := range demands {
+		if used := replicas*demand - local[i]; used > 0 {
+			shared[i] -= used
+		}
+	}
+	return replicas
+}
@@ -0,0 +1,417 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicresource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/karmada/pkg/estimator/pb"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework"
+	frameworkruntime "github.com/karmada-io/karmada/pkg/estimator/server/framework/runtime"
+	"github.com/karmada-io/karmada/pkg/features"
+	schedcache "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/cache"
+)
+
+const (
+	gpuDriver      = "gpu.example.com"
+	gpuClassName   = "gpu"
+	largeGPUClass  = "large-gpu"
+	fpgaClassName  = "fpga"
+	fpgaDriver     = "fpga.example.com"
+	missingClass   = "missing"
+	gpuNodeName    = "node-1"
+	otherNodeName  = "node-2"
+	taintedNode    = "node-3"
+	gpuMemoryLarge = "80Gi"
+	gpuMemorySmall = "16Gi"
+)
+
+func newDeviceClass(name, expression string) *resourcev1.DeviceClass {
+	return &resourcev1.DeviceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: resourcev1.DeviceClassSpec{
+			Selectors: []resourcev1.DeviceSelector{{CEL: &resourcev1.CELDeviceSelector{Expression: expression}}},
+		},
+	}
+}
+
+func newGPU(name, memory string) resourcev1.Device {
+	return resourcev1.Device{
+		Name: name,
+		Attributes: map[resourcev1.QualifiedName]resourcev1.DeviceAttribute{
+			"driverVersion": {VersionValue: new("1.2.0")},
+		},
+		Capacity: map[resourcev1.QualifiedName]resourcev1.DeviceCapacity{
+			"memory": {Value: resource.MustParse(memory)},
+		},
+	}
+}
+
+func newNodeSlice(name, nodeName string, generation int64, devices ...resourcev1.Device) *resourcev1.ResourceSlice {
+	return &resourcev1.ResourceSlice{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: resourcev1.ResourceSliceSpec{
+			Driver:   gpuDriver,
+			Pool:     resourcev1.ResourcePool{Name: nodeName, Generation: generation, ResourceSliceCount: 1},
+			NodeName: new(nodeName),
+			Devices:  devices,
+		},
+	}
+}
+
+func newClaim(name string, devices ...string) *resourcev1.ResourceClaim {
+	claim := &resourcev1.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: resourcev1.ResourceClaimStatus{
+			Allocation: &resourcev1.AllocationResult{},
+		},
+	}
+	for _, device := range devices {
+		claim.Status.Allocation.Devices.Results = append(claim.Status.Allocation.Devices.Results, resourcev1.DeviceRequestAllocationResult{
+			Request: "gpu",
+			Driver:  gpuDriver,
+			Pool:    gpuNodeName,
+			Device:  device,
+		})
+	}
+	return claim
+}
+
+func newNode(name string, labels map[string]string, taints ...corev1.Taint) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec:       corev1.NodeSpec{Taints: taints},
+	}
+}
+
+func newResourceAPIResourceList() *metav1.APIResourceList {
+	return &metav1.APIResourceList{
+		GroupVersion: resourcev1.SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{{Name: "resourceslices"}, {Name: "deviceclasses"}, {Name: "resourceclaims"}},
+	}
+}
+
+func setup(t *testing.T, enabled bool, objects ...runtime.Object) *dynamicResourceEstimator {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	client := fake.NewClientset(objects...)
+	client.Resources = []*metav1.APIResourceList{newResourceAPIResourceList()}
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	fh, err := frameworkruntime.NewFramework(nil, frameworkruntime.WithClientSet(client), frameworkruntime.WithInformerFactory(informerFactory))
+	require.NoError(t, err)
+
+	// override feature-gates
+	runtimeutil.Must(utilfeature.DefaultMutableFeatureGate.Add(features.DefaultFeatureGates))
+	err = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DynamicResourceEstimate, enabled))
+	require.NoError(t, err, "override feature-gates")
+
+	pl, err := New(fh)
+	require.NoError(t, err)
+
+	informerFactory.Start(ctx.Done())
+	t.Cleanup(func() {
+		// Need to cancel before waiting for the shutdown.
+		cancel()
+		informerFactory.Shutdown()
+	})
+	for rtype, synced := range informerFactory.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "informer of %s not synced", rtype)
+	}
+	return pl.(*dynamicResourceEstimator)
+}
+
+func TestDynamicResourceEstimator_Estimate(t *testing.T) {
+	gpuClass := newDeviceClass(gpuClassName, fmt.Sprintf("device.driver == %q", gpuDriver))
+	largeGPU := newDeviceClass(largeGPUClass,
+		fmt.Sprintf(`device.driver == %q && device.capacity[%q].memory.compareTo(quantity("40Gi")) >= 0 && device.attributes[%q].driverVersion.isGreaterThan(semver("1.0.0"))`,
+			gpuDriver, gpuDriver, gpuDriver))
+	fpgaClass := newDeviceClass(fpgaClassName, fmt.Sprintf("device.driver == %q", fpgaDriver))
+
+	gpuSlice := newNodeSlice("node-1-gpu", gpuNodeName, 2,
+		newGPU("gpu-0", gpuMemoryLarge), newGPU("gpu-1", gpuMemoryLarge), newGPU("gpu-2", gpuMemorySmall), newGPU("gpu-3", gpuMemorySmall))
+	staleSlice := newNodeSlice("node-1-gpu-stale", gpuNodeName, 1,
+		newGPU("gpu-4", gpuMemoryLarge), newGPU("gpu-5", gpuMemoryLarge))
+	otherSlice := newNodeSlice("node-2-gpu", otherNodeName, 1,
+		newGPU("gpu-0", gpuMemoryLarge), newGPU("gpu-1", gpuMemoryLarge))
+	otherSlice.Spec.Pool.Name = otherNodeName
+	taintedSlice := newNodeSlice("node-3-gpu", taintedNode, 1,
+		newGPU("gpu-0", gpuMemoryLarge), newGPU("gpu-1", gpuMemoryLarge))
+	taintedSlice.Spec.Pool.Name = taintedNode
+	sharedSlice := &resourcev1.ResourceSlice{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-fpga"},
+		Spec: resourcev1.ResourceSliceSpec{
+			Driver:   fpgaDriver,
+			Pool:     resourcev1.ResourcePool{Name: "shared", Generation: 1, ResourceSliceCount: 1},
+			AllNodes: new(true),
+			Devices:  []resourcev1.Device{{Name: "fpga-0"}, {Name: "fpga-1"}, {Name: "fpga-2"}},
+		},
+	}
+
+	snapshot := schedcache.NewSnapshot(nil, []*corev1.Node{
+		newNode(gpuNodeName, map[string]string{"zone": "a"}),
+		newNode(otherNodeName, map[string]string{"zone": "b"}),
+		newNode(taintedNode, map[string]string{"zone": "a"}, corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+	})
+
+	objects := []runtime.Object{gpuClass, largeGPU, fpgaClass, gpuSlice, staleSlice, otherSlice, taintedSlice, sharedSlice,
+		newClaim("allocated", "gpu-0")}
+
+	tests := []struct {
+		name         string
+		enabled      bool
+		requirements *pb.ReplicaRequirements
+		wantReplica  int32
+		wantResult   *framework.Result
+	}{
+		{
+			name:         "plugin disabled",
+			enabled:      false,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{{DeviceClassName: gpuClassName, Count: 1}}},
+			wantReplica:  math.MaxInt32,
+			wantResult:   framework.NewResult(framework.Noopperation, "DynamicResourceEstimator is disabled"),
+		},
+		{
+			name:         "no device requests",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{},
+			wantReplica:  math.MaxInt32,
+			wantResult:   framework.NewResult(framework.Success, "DynamicResourceEstimator found no device requests"),
+		},
+		{
+			name:         "device class not found",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{{DeviceClassName: missingClass, Count: 1}}},
+			wantReplica:  0,
+			wantResult:   framework.NewResult(framework.Unschedulable, "device class missing not found"),
+		},
+		{
+			name:         "one device per replica",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{{DeviceClassName: gpuClassName, Count: 1}}},
+			// node-1: 3 unallocated devices of the latest generation, node-2: 2 devices,
+			// node-3 is ignored because of the taint.
+			wantReplica: 5,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "devices of each replica are on the same node",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: gpuClassName, Count: 1},
+				{DeviceClassName: gpuClassName, Count: 1},
+			}},
+			wantReplica: 2,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "selected by capacity and version",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: largeGPUClass, Count: 1},
+			}},
+			// node-1: gpu-1, node-2: gpu-0 and gpu-1.
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "selected by the selectors of the request",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: gpuClassName, Count: 1, Selectors: []string{
+					fmt.Sprintf(`device.capacity[%q].memory.compareTo(quantity("40Gi")) >= 0`, gpuDriver)}},
+			}},
+			// node-1: gpu-1, node-2: gpu-0 and gpu-1.
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "requests of the same device class with different selectors are not merged",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: gpuClassName, Count: 1, Selectors: []string{
+					fmt.Sprintf(`device.capacity[%q].memory.compareTo(quantity("40Gi")) >= 0`, gpuDriver)}},
+				{DeviceClassName: gpuClassName, Count: 1},
+			}},
+			// node-1: gpu-1 is the only unallocated large device, node-2: gpu-0 and gpu-1 are large.
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "nodes not matching node claim are ignored",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				NodeClaim:      &pb.NodeClaim{NodeSelector: map[string]string{"zone": "a"}},
+				DeviceRequests: []*pb.DeviceRequest{{DeviceClassName: gpuClassName, Count: 1}},
+			},
+			// node-2 is not in zone a.
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "shared devices",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: fpgaClassName, Count: 2},
+			}},
+			wantReplica: 1,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "node-local and shared devices",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: gpuClassName, Count: 1},
+				{DeviceClassName: fpgaClassName, Count: 1},
+			}},
+			// each replica uses a node-local gpu and one of the 3 shared fpgas.
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "insufficient devices",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{DeviceRequests: []*pb.DeviceRequest{
+				{DeviceClassName: gpuClassName, Count: 4},
+			}},
+			wantReplica: 0,
+			wantResult:  framework.NewResult(framework.Unschedulable, "zero replica is estimated by DynamicResourceEstimator"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := setup(t, tt.enabled, objects...)
+			replica, result := pl.Estimate(context.TODO(), framework.ReplicaEstimationContext{
+				Snapshot:            snapshot,
+				ReplicaRequirements: tt.requirements,
+			})
+			assert.Equal(t, tt.wantReplica, replica)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func TestNew_ResourceAPINotServed(t *testing.T) {
+	runtimeutil.Must(utilfeature.DefaultMutableFeatureGate.Add(features.DefaultFeatureGates))
+	require.NoError(t, features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DynamicResourceEstimate, true)))
+	t.Cleanup(func() {
+		_ = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DynamicResourceEstimate, false))
+	})
+
+	tests := []struct {
+		name        string
+		resources   []*metav1.APIResourceList
+		wantEnabled bool
+	}{
+		{
+			name: "API not served",
+		},
+		{
+			name: "resources missing from the API",
+			resources: []*metav1.APIResourceList{{
+				GroupVersion: resourcev1.SchemeGroupVersion.String(),
+				APIResources: []metav1.APIResource{{Name: "deviceclasses"}},
+			}},
+		},
+		{
+			name:        "API served",
+			resources:   []*metav1.APIResourceList{newResourceAPIResourceList()},
+			wantEnabled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientset()
+			client.Resources = tt.resources
+			informerFactory := informers.NewSharedInformerFactory(client, 0)
+			fh, err := frameworkruntime.NewFramework(nil, frameworkruntime.WithClientSet(client), frameworkruntime.WithInformerFactory(informerFactory))
+			require.NoError(t, err)
+
+			pl, err := New(fh)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEnabled, pl.(*dynamicResourceEstimator).enabled)
+			if !tt.wantEnabled {
+				// no informer is registered, so that syncing the informers never hangs.
+				assert.Empty(t, informerFactory.WaitForCacheSync(context.TODO().Done()))
+			}
+		})
+	}
+}
+
+func TestSelectorEvaluator_Matches(t *testing.T) {
+	evaluator, err := newSelectorEvaluator()
+	require.NoError(t, err)
+	device := &resourcev1.Device{
+		Name: "gpu-0",
+		Attributes: map[resourcev1.QualifiedName]resourcev1.DeviceAttribute{
+			"model":                       {StringValue: new("a100")},
+			"index":                       {IntValue: new(int64(0))},
+			"mig":                         {BoolValue: new(true)},
+			"driverVersion":               {VersionValue: new("1.2.0")},
+			"topology.example.com/socket": {IntValue: new(int64(1))},
+		},
+		Capacity: map[resourcev1.QualifiedName]resourcev1.DeviceCapacity{
+			"memory": {Value: resource.MustParse("80Gi")},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       bool
+		wantErr    bool
+	}{
+		{name: "driver", expression: `device.driver == "gpu.example.com"`, want: true},
+		{name: "string attribute", expression: `device.attributes["gpu.example.com"].model == "a100"`, want: true},
+		{name: "int attribute", expression: `device.attributes["gpu.example.com"].index > 0`, want: false},
+		{name: "bool attribute", expression: `device.attributes["gpu.example.com"].mig`, want: true},
+		{name: "version attribute", expression: `device.attributes["gpu.example.com"].driverVersion.isLessThan(semver("2.0.0"))`, want: true},
+		{name: "qualified attribute", expression: `device.attributes["topology.example.com"].socket == 1`, want: true},
+		{name: "capacity", expression: `device.capacity["gpu.example.com"].memory.isGreaterThan(quantity("40Gi"))`, want: true},
+		{name: "missing attribute", expression: `device.attributes["gpu.example.com"].missing == 1`, wantErr: true},
+		{name: "invalid expression", expression: `device.driver ==`, wantErr: true},
+		{name: "not bool", expression: `device.driver`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selectors := []resourcev1.DeviceSelector{{CEL: &resourcev1.CELDeviceSelector{Expression: tt.expression}}}
+			got, err := evaluator.matches(selectors, gpuDriver, device)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicresource
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	resourcev1 "k8s.io/api/resource/v1"
+	apiservercel "k8s.io/apiserver/pkg/cel"
+	"k8s.io/apiserver/pkg/cel/library"
+)
+
+const (
+	deviceVariable = "device"
+
+	// selectorCostLimit is the cost limit of evaluating a selector, which is the same as
+	// the limit of CEL selectors in Kubernetes.
+	selectorCostLimit = 1000000
+)
+
+// selectorEvaluator evaluates the CEL selectors of DeviceClasses against devices.
+// The compiled programs are cached by expressions, since the DeviceClasses rarely change.
+type selectorEvaluator struct {
+	env *cel.Env
+
+	lock     sync.RWMutex
+	programs map[string]cel.Program
+}
+
+func newSelectorEvaluator() (*selectorEvaluator, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(deviceVariable, cel.MapType(cel.StringType, cel.DynType)),
+		cel.DefaultUTCTimeZone(true),
+		cel.CrossTypeNumericComparisons(true),
+		cel.OptionalTypes(),
+		ext.Strings(ext.StringsVersion(2)),
+		library.URLs(),
+		library.Regex(),
+		library.Lists(),
+		library.Quantity(),
+		library.SemverLib(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build CEL environment of device selectors: %w", err)
+	}
+	return &selectorEvaluator{env: env, programs: map[string]cel.Program{}}, nil
+}
+
+// program returns the compiled program of the expression.
+func (e *selectorEvaluator) program(expression string) (cel.Program, error) {
+	e.lock.RLock()
+	prg, ok := e.programs[expression]
+	e.lock.RUnlock()
+	if ok {
+		return prg, nil
+	}
+
+	ast, issues := e.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile CEL selector(%s): %w", expression, issues.Err())
+	}
+	prg, err := e.env.Program(ast, cel.CostLimit(selectorCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build program of CEL selector(%s): %w", expression, err)
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.programs[expression] = prg
+	return prg, nil
+}
+
+// matches tells whether the device satisfies all the selectors.
+func (e *selectorEvaluator) matches(selectors []resourcev1.DeviceSelector, driver string, device *resourcev1.Device) (bool, error) {
+	var input map[string]any
+	for _, selector := range selectors {
+		if selector.CEL == nil {
+			continue
+		}
+		prg, err := e.program(selector.CEL.Expression)
+		if err != nil {
+			return false, err
+		}
+		if input == nil {
+			input = map[string]any{deviceVariable: deviceToValue(driver, device)}
+		}
+		val, _, err := prg.Eval(input)
+		if err != nil {
+			return false, fmt.Errorf("failed to evaluate CEL selector(%s): %w", selector.CEL.Expression, err)
+		}
+		matched, ok := val.(types.Bool)
+		if !ok {
+			return false, fmt.Errorf("CEL selector(%s) returns %s instead of bool", selector.CEL.Expression, val.Type().TypeName())
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// deviceToValue converts the device to the value of the 'device' variable, which has the same shape as
+// the one in Kubernetes: the attributes and capacities are grouped by domains, and the names without
+// domain belong to the domain of the driver.
+func deviceToValue(driver string, device *resourcev1.Device) map[string]any {
+	attributes := map[string]map[string]any{}
+	for name, attribute := range device.Attributes {
+		domain, id := parseQualifiedName(name, driver)
+		if attributes[domain] == nil {
+			attributes[domain] = map[string]any{}
+		}
+		switch {
+		case attribute.IntValue != nil:
+			attributes[domain][id] = *attribute.IntValue
+		case attribute.BoolValue != nil:
+			attributes[domain][id] = *attribute.BoolValue
+		case attribute.StringValue != nil:
+			attributes[domain][id] = *attribute.StringValue
+		case attribute.VersionValue != nil:
+			v, err := semver.Parse(*attribute.VersionValue)
+			if err != nil {
+				continue
+			}
+			attributes[domain][id] = apiservercel.Semver{Version: v}
+		}
+	}
+
+	capacity := map[string]map[string]any{}
+	for name, c := range device.Capacity {
+		domain, id := parseQualifiedName(name, driver)
+		if capacity[domain] == nil {
+			capacity[domain] = map[string]any{}
+		}
+		value := c.Value.DeepCopy()
+		capacity[domain][id] = apiservercel.Quantity{Quantity: &value}
+	}
+
+	return map[string]any{
+		"driver":     driver,
+		"attributes": attributes,
+		"capacity":   capacity,
+	}
+}
+
+func parseQualifiedName(name resourcev1.QualifiedName, driver string) (string, string) {
+	if domain, id, ok := strings.Cut(string(name), "/"); ok {
+		return domain, id
+	}
+	return driver, string(name)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtopology
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/estimator"
+	"github.com/karmada-io/karmada/pkg/estimator/pb"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework"
+	"github.com/karmada-io/karmada/pkg/features"
+	"github.com/karmada-io/karmada/pkg/util"
+	schedcache "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/cache"
+	schedulerframework "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework/plugins/interpodaffinity"
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework/plugins/podtopologyspread"
+)
+
+const (
+	// Name is the name of the plugin used in Registry and configurations.
+	Name = "PodTopologyEstimator"
+
+	// noPodTopologyConstraint represents the value when there is no pod topology constraint.
+	noPodTopologyConstraint = math.MaxInt32
+)
+
+// podTopologyEstimator estimates how many replicas are allowed by the required inter-pod affinity,
+// anti-affinity and the hard topology spread constraints of a given pb.ReplicaRequirements.
+// The replicas are placed onto the nodes matching the node claim by the scheduling simulator, which runs the
+// InterPodAffinity and PodTopologySpread plugins of kube-scheduler against the existing pods in the snapshot,
+// so that e.g. a workload with strict anti-affinity to itself gets at most one replica per topology domain.
+// Only the pod number of the nodes is taken into account, the other resources are left to the noderesource plugin.
+type podTopologyEstimator struct {
+	enabled         bool
+	namespaceLister corelisters.NamespaceLister
+}
+
+var _ framework.EstimateReplicasPlugin = &podTopologyEstimator{}
+
+// New initializes a new plugin and returns it.
+func New(fh framework.Handle) (framework.Plugin, error) {
+	enabled := features.FeatureGate.Enabled(features.PodTopologyEstimate)
+	if !enabled {
+		// Disabled, won't do anything.
+		return &podTopologyEstimator{}, nil
+	}
+	return &podTopologyEstimator{
+		enabled:         enabled,
+		namespaceLister: fh.SharedInformerFactory().Core().V1().Namespaces().Lister(),
+	}, nil
+}
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (pl *podTopologyEstimator) Name() string {
+	return Name
+}
+
+// Estimate estimates the replicas allowed by the pod topology constraints.
+func (pl *podTopologyEstimator) Estimate(ctx context.Context, estCtx framework.ReplicaEstimationContext) (int32, *framework.Result) {
+	if !pl.enabled {
+		klog.V(5).Info("Estimator Plugin", "name", Name, "enabled", pl.enabled)
+		return noPodTopologyConstraint, framework.NewResult(framework.Noopperation, fmt.Sprintf("%s is disabled", pl.Name()))
+	}
+	requirements := estCtx.ReplicaRequirements
+	if requirements == nil || !hasPodTopology(requirements.NodeClaim) {
+		return noPodTopologyConstraint, framework.NewResult(framework.Success, fmt.Sprintf("%s found no pod topology constraints", pl.Name()))
+	}
+
+	pod, err := newReplicaPod(requirements)
+	if err != nil {
+		return 0, framework.AsResult(err)
+	}
+	if estCtx.Snapshot == nil {
+		return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("zero replica is estimated by %s", pl.Name()))
+	}
+	nodes, err := getNodesAvailablePods(estCtx.Snapshot)
+	if err != nil {
+		return 0, framework.AsResult(err)
+	}
+	constrained, err := pl.replicasConstrainEachOther(pod)
+	if err != nil {
+		return 0, framework.AsResult(err)
+	}
+
+	simulator := estimator.NewSchedulingSimulator(nodes,
+		interpodaffinity.New(estCtx.Snapshot, pl.namespaceLister),
+		podtopologyspread.New(estCtx.Snapshot),
+	)
+	// If the replicas do not constrain each other, the constraints are checked against the existing pods only,
+	// i.e. either every replica or none of them is allowed, so that a single replica tells the answer.
+	upperBound := int32(noPodTopologyConstraint)
+	if !constrained {
+		upperBound = 1
+	}
+	replicas, err := simulator.SimulatePodScheduling(ctx, pod, upperBound)
+	if err != nil {
+		return 0, framework.AsResult(err)
+	}
+	switch {
+	case replicas == 0:
+		return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("zero replica is estimated by %s", pl.Name()))
+	case !constrained:
+		return noPodTopologyConstraint, framework.NewResult(framework.Success, fmt.Sprintf("%s found no pod topology constraints", pl.Name()))
+	default:
+		return replicas, framework.NewResult(framework.Success)
+	}
+}
+
+// replicasConstrainEachOther tells whether a placed replica could affect where the following replicas are
+// allowed, which is the case when any required affinity or anti-affinity term, or any hard topology spread
+// constraint selects the replica itself.
+func (pl *podTopologyEstimator) replicasConstrainEachOther(pod *corev1.Pod) (bool, error) {
+	podInfo, err := schedulerframework.NewPodInfo(pod)
+	if err != nil {
+		return false, err
+	}
+	nsLabels := interpodaffinity.GetNamespaceLabelsSnapshot(pod.Namespace, pl.namespaceLister)
+	for _, terms := range [][]schedulerframework.AffinityTerm{podInfo.RequiredAffinityTerms, podInfo.RequiredAntiAffinityTerms} {
+		for i := range terms {
+			if terms[i].Matches(pod, nsLabels) {
+				return true, nil
+			}
+		}
+	}
+	for _, c := range pod.Spec.TopologySpreadConstraints {
+		if c.WhenUnsatisfiable != corev1.DoNotSchedule {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(c.LabelSelector)
+		if err != nil {
+			return false, err
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getNodesAvailablePods clones the nodes in the snapshot with the number of pods they still allow as the only
+// allocatable resource.
+func getNodesAvailablePods(snapshot *schedcache.Snapshot) ([]*schedulerframework.NodeInfo, error) {
+	nodeInfos, err := snapshot.NodeInfos().List()
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*schedulerframework.NodeInfo, 0, len(nodeInfos))
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Clone()
+		node.Allocatable = &util.Resource{
+			AllowedPodNumber: util.MaxInt64(nodeInfo.Allocatable.AllowedPodNumber-int64(len(nodeInfo.Pods)), 0),
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// hasPodTopology tells whether the node claim carries any pod topology constraint.
+func hasPodTopology(nodeClaim *pb.NodeClaim) bool {
+	return nodeClaim != nil && (len(nodeClaim.PodAffinityBytes) > 0 || len(nodeClaim.PodAntiAffinityBytes) > 0 ||
+		len(nodeClaim.TopologySpreadConstraintsBytes) > 0)
+}
+
+// newReplicaPod builds a pod representing a replica, which carries the node claim and the pod topology constraints
+// of the replicas.
+func newReplicaPod(requirements *pb.ReplicaRequirements) (*corev1.Pod, error) {
+	nodeClaim := requirements.NodeClaim
+	podAffinity, err := nodeClaim.UnmarshalPodAffinity()
+	if err != nil {
+		return nil, err
+	}
+	podAntiAffinity, err := nodeClaim.UnmarshalPodAntiAffinity()
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := nodeClaim.UnmarshalTopologySpreadConstraints()
+	if err != nil {
+		return nil, err
+	}
+	nodeAffinity, err := nodeClaim.UnmarshalNodeAffinity()
+	if err != nil {
+		return nil, err
+	}
+	tolerations, err := nodeClaim.UnmarshalTolerations()
+	if err != nil {
+		return nil, err
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: requirements.Namespace,
+			Labels:    nodeClaim.PodLabels,
+		},
+		Spec: corev1.PodSpec{
+			NodeSelector:              nodeClaim.NodeSelector,
+			Tolerations:               tolerations,
+			TopologySpreadConstraints: constraints,
+		},
+	}
+	if nodeAffinity != nil || len(podAffinity) > 0 || len(podAntiAffinity) > 0 {
+		pod.Spec.Affinity = &corev1.Affinity{}
+		if nodeAffinity != nil {
+			pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: nodeAffinity}
+		}
+		if len(podAffinity) > 0 {
+			pod.Spec.Affinity.PodAffinity = &corev1.PodAffinity{RequiredDuringSchedulingIgnoredDuringExecution: podAffinity}
+		}
+		if len(podAntiAffinity) > 0 {
+			pod.Spec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: podAntiAffinity}
+		}
+	}
+	return pod, nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podtopology
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/karmada/pkg/estimator/pb"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework"
+	frameworkruntime "github.com/karmada-io/karmada/pkg/estimator/server/framework/runtime"
+	"github.com/karmada-io/karmada/pkg/features"
+	schedcache "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/cache"
+)
+
+func setup(t *testing.T, enabled bool, objects ...runtime.Object) *podTopologyEstimator {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	client := fake.NewClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	fh, err := frameworkruntime.NewFramework(nil, frameworkruntime.WithInformerFactory(informerFactory))
+	require.NoError(t, err)
+
+	// override feature-gates
+	runtimeutil.Must(utilfeature.DefaultMutableFeatureGate.Add(features.DefaultFeatureGates))
+	err = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.PodTopologyEstimate, enabled))
+	require.NoError(t, err, "override feature-gates")
+
+	pl, err := New(fh)
+	require.NoError(t, err)
+
+	informerFactory.Start(ctx.Done())
+	t.Cleanup(func() {
+		// Need to cancel before waiting for the shutdown.
+		cancel()
+		informerFactory.Shutdown()
+	})
+	for rtype, synced := range informerFactory.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "informer of %s not synced", rtype)
+	}
+	return pl.(*podTopologyEstimator)
+}
+
+func newNode(name, zone string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{corev1.LabelHostname: name, corev1.LabelTopologyZone: zone},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("110")},
+		},
+	}
+}
+
+func newNodeClaim(t *testing.T, podLabels map[string]string, antiAffinity []corev1.PodAffinityTerm,
+	constraints []corev1.TopologySpreadConstraint) *pb.NodeClaim {
+	nodeClaim := &pb.NodeClaim{PodLabels: podLabels}
+	require.NoError(t, nodeClaim.SetPodAntiAffinity(antiAffinity))
+	require.NoError(t, nodeClaim.SetTopologySpreadConstraints(constraints))
+	return nodeClaim
+}
+
+func TestPodTopologyEstimator_Estimate(t *testing.T) {
+	webLabels := map[string]string{"app": "web"}
+	hostnameAntiAffinity := []corev1.PodAffinityTerm{{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: webLabels},
+		TopologyKey:   corev1.LabelHostname,
+	}}
+	// The anti-affinity only applies to the namespaces labeled with tier=frontend.
+	frontendAntiAffinity := []corev1.PodAffinityTerm{{
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: webLabels},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}},
+		TopologyKey:       corev1.LabelTopologyZone,
+	}}
+	zoneSpread := []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: webLabels},
+	}}
+
+	objects := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Labels: map[string]string{"tier": "frontend"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "backend"}},
+	}
+	snapshot := schedcache.NewSnapshot(nil, []*corev1.Node{
+		newNode("node-1", "zone-a"),
+		newNode("node-2", "zone-a"),
+		newNode("node-3", "zone-b"),
+	})
+
+	tests := []struct {
+		name         string
+		enabled      bool
+		requirements *pb.ReplicaRequirements
+		wantReplica  int32
+		wantResult   *framework.Result
+	}{
+		{
+			name:    "plugin disabled",
+			enabled: false,
+			requirements: &pb.ReplicaRequirements{
+				NodeClaim: newNodeClaim(t, webLabels, hostnameAntiAffinity, nil),
+			},
+			wantReplica: math.MaxInt32,
+			wantResult:  framework.NewResult(framework.Noopperation, "PodTopologyEstimator is disabled"),
+		},
+		{
+			name:         "no pod topology",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{NodeClaim: &pb.NodeClaim{NodeSelector: map[string]string{"foo": "bar"}}},
+			wantReplica:  math.MaxInt32,
+			wantResult:   framework.NewResult(framework.Success, "PodTopologyEstimator found no pod topology constraints"),
+		},
+		{
+			name:    "anti-affinity by hostname",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				Namespace: "frontend",
+				NodeClaim: newNodeClaim(t, webLabels, hostnameAntiAffinity, nil),
+			},
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "anti-affinity by hostname with topology spread",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				Namespace: "frontend",
+				NodeClaim: newNodeClaim(t, webLabels, hostnameAntiAffinity, zoneSpread),
+			},
+			wantReplica: 3,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "topology spread only",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				Namespace: "frontend",
+				NodeClaim: newNodeClaim(t, webLabels, nil, zoneSpread),
+			},
+			// zone-b is full with 110 replicas, and zone-a gets at most one more replica than zone-b.
+			wantReplica: 221,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "namespace selector matched",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				Namespace: "frontend",
+				NodeClaim: newNodeClaim(t, webLabels, frontendAntiAffinity, nil),
+			},
+			wantReplica: 2,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "namespace selector not matched",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				Namespace: "backend",
+				NodeClaim: newNodeClaim(t, webLabels, frontendAntiAffinity, nil),
+			},
+			wantReplica: math.MaxInt32,
+			wantResult:  framework.NewResult(framework.Success, "PodTopologyEstimator found no pod topology constraints"),
+		},
+		{
+			name:    "no node matches node claim",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				Namespace: "frontend",
+				NodeClaim: func() *pb.NodeClaim {
+					nodeClaim := newNodeClaim(t, webLabels, hostnameAntiAffinity, nil)
+					nodeClaim.NodeSelector = map[string]string{corev1.LabelTopologyZone: "zone-c"}
+					return nodeClaim
+				}(),
+			},
+			wantReplica: 0,
+			wantResult:  framework.NewResult(framework.Unschedulable, "zero replica is estimated by PodTopologyEstimator"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := setup(t, tt.enabled, objects...)
+			replica, result := pl.Estimate(context.TODO(), framework.ReplicaEstimationContext{
+				Snapshot:            snapshot,
+				ReplicaRequirements: tt.requirements,
+			})
+			assert.Equal(t, tt.wantReplica, replica)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
@@ -17,16 +17,22 @@ limitations under the License.
 package plugins
 
 import (
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework/plugins/dynamicresource"
 	"github.com/karmada-io/karmada/pkg/estimator/server/framework/plugins/noderesource"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework/plugins/podtopology"
 	"github.com/karmada-io/karmada/pkg/estimator/server/framework/plugins/resourcequota"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework/plugins/storagecapacity"
 	"github.com/karmada-io/karmada/pkg/estimator/server/framework/runtime"
 )
 
 // NewInTreeRegistry builds the registry with all the in-tree plugins.
 func NewInTreeRegistry() runtime.Registry {
 	registry := runtime.Registry{
-		noderesource.Name:  noderesource.New,
-		resourcequota.Name: resourcequota.New,
+		noderesource.Name:    noderesource.New,
+		resourcequota.Name:   resourcequota.New,
+		dynamicresource.Name: dynamicresource.New,
+		storagecapacity.Name: storagecapacity.New,
+		podtopology.Name:     podtopology.New,
 	}
 	return registry
 }
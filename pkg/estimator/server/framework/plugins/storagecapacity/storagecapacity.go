@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecapacity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/estimator"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+const (
+	// Name is the name of the plugin used in Registry and configurations.
+	Name = "StorageCapacityEstimator"
+
+	// noStorageConstraint represents the value when there is no storage constraint.
+	noStorageConstraint = math.MaxInt32
+
+	isDefaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaIsDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// storageCapacityEstimator estimates how many replicas are allowed by the storage capacity for the
+// persistent volumes required by a given pb.ReplicaRequirements.
+// For each StorageClass the volumes are provisioned from:
+// 1) The nodes matching both the node claim of the replicas and the allowed topologies of the StorageClass
+// are where the volumes can be accessed. If there is no such node, no replica is allowed.
+// 2) If the CSI driver of the StorageClass publishes storage capacity, the replicas allowed by each
+// CSIStorageCapacity accessible from those nodes are added up. Otherwise, the storage capacity is unknown
+// and the StorageClass doesn't constrain the replicas.
+// The estimation is optimistic about the replicas requiring volumes of different StorageClasses,
+// which are assumed to be able to access all of them.
+// The storage requests are only interpreted by karmada-controller-manager with the StorageCapacityEstimate
+// feature gate enabled, so the gate has to be enabled in both components.
+type storageCapacityEstimator struct {
+	enabled         bool
+	classLister     storagelisters.StorageClassLister
+	capacityLister  storagelisters.CSIStorageCapacityLister
+	csiDriverLister storagelisters.CSIDriverLister
+}
+
+var _ framework.EstimateReplicasPlugin = &storageCapacityEstimator{}
+
+// New initializes a new plugin and returns it.
+func New(fh framework.Handle) (framework.Plugin, error) {
+	enabled := features.FeatureGate.Enabled(features.StorageCapacityEstimate)
+	if !enabled {
+		// Disabled, won't do anything.
+		return &storageCapacityEstimator{}, nil
+	}
+	storageInformers := fh.SharedInformerFactory().Storage().V1()
+	return &storageCapacityEstimator{
+		enabled:         enabled,
+		classLister:     storageInformers.StorageClasses().Lister(),
+		capacityLister:  storageInformers.CSIStorageCapacities().Lister(),
+		csiDriverLister: storageInformers.CSIDrivers().Lister(),
+	}, nil
+}
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (pl *storageCapacityEstimator) Name() string {
+	return Name
+}
+
+// storageDemand is the storage each replica demands from a StorageClass.
+type storageDemand struct {
+	// total is the total size of the volumes.
+	total int64
+	// largest is the size of the largest volume.
+	largest int64
+}
+
+// Estimate estimates the replicas allowed by the storage capacity for the storage requests.
+func (pl *storageCapacityEstimator) Estimate(_ context.Context, estCtx framework.ReplicaEstimationContext) (int32, *framework.Result) {
+	if !pl.enabled {
+		klog.V(5).Info("Estimator Plugin", "name", Name, "enabled", pl.enabled)
+		return noStorageConstraint, framework.NewResult(framework.Noopperation, fmt.Sprintf("%s is disabled", pl.Name()))
+	}
+	requirements := estCtx.ReplicaRequirements
+	if requirements == nil || len(requirements.StorageRequests) == 0 {
+		return noStorageConstraint, framework.NewResult(framework.Success, fmt.Sprintf("%s found no storage requests", pl.Name()))
+	}
+
+	classes := make(map[string]*storagev1.StorageClass)
+	demands := make(map[string]*storageDemand)
+	for _, request := range requirements.StorageRequests {
+		class, err := pl.getStorageClass(request.StorageClassName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("storage class %s not found", request.StorageClassName))
+			}
+			return 0, framework.AsResult(err)
+		}
+		if class == nil {
+			// No default StorageClass, the volume would be bound to a pre-provisioned one.
+			continue
+		}
+		if demands[class.Name] == nil {
+			classes[class.Name] = class
+			demands[class.Name] = &storageDemand{}
+		}
+		demands[class.Name].total += request.Storage
+		demands[class.Name].largest = max(demands[class.Name].largest, request.Storage)
+	}
+	if len(demands) == 0 {
+		return noStorageConstraint, framework.NewResult(framework.Success, fmt.Sprintf("%s found no storage constraints", pl.Name()))
+	}
+
+	nodes, err := pl.listMatchedNodes(estCtx)
+	if err != nil {
+		return 0, framework.AsResult(err)
+	}
+
+	var replicas int64 = noStorageConstraint
+	for _, name := range sets.List(sets.KeySet(classes)) {
+		class := classes[name]
+		accessibleNodes := make([]*corev1.Node, 0, len(nodes))
+		for _, node := range nodes {
+			if matchAllowedTopologies(class.AllowedTopologies, node.Labels) {
+				accessibleNodes = append(accessibleNodes, node)
+			}
+		}
+		if len(accessibleNodes) == 0 {
+			return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("no node matches the topology of storage class %s", name))
+		}
+
+		classReplicas, err := pl.estimateByCapacity(class, demands[name], accessibleNodes)
+		if err != nil {
+			return 0, framework.AsResult(err)
+		}
+		replicas = min(replicas, classReplicas)
+	}
+
+	switch replicas {
+	case noStorageConstraint:
+		return noStorageConstraint, framework.NewResult(framework.Success, fmt.Sprintf("%s found no storage constraints", pl.Name()))
+	case 0:
+		return 0, framework.NewResult(framework.Unschedulable, fmt.Sprintf("zero replica is estimated by %s", pl.Name()))
+	default:
+		return int32(replicas), framework.NewResult(framework.Success)
+	}
+}
+
+// getStorageClass gets the StorageClass by name, or the default StorageClass if the name is empty.
+// It returns nil if there is no default StorageClass.
+func (pl *storageCapacityEstimator) getStorageClass(name string) (*storagev1.StorageClass, error) {
+	if name != "" {
+		return pl.classLister.Get(name)
+	}
+	classes, err := pl.classLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var defaultClass *storagev1.StorageClass
+	for _, class := range classes {
+		if !isDefaultStorageClass(class) {
+			continue
+		}
+		// The newest default StorageClass is used if there are multiple ones, the same as Kubernetes.
+		if defaultClass == nil || class.CreationTimestamp.After(defaultClass.CreationTimestamp.Time) {
+			defaultClass = class
+		}
+	}
+	return defaultClass, nil
+}
+
+func isDefaultStorageClass(class *storagev1.StorageClass) bool {
+	return class.Annotations[isDefaultStorageClassAnnotation] == "true" ||
+		class.Annotations[betaIsDefaultStorageClassAnnotation] == "true"
+}
+
+// listMatchedNodes lists the nodes matching the node claim of the replicas.
+func (pl *storageCapacityEstimator) listMatchedNodes(estCtx framework.ReplicaEstimationContext) ([]*corev1.Node, error) {
+	if estCtx.Snapshot == nil {
+		return nil, nil
+	}
+	affinity, tolerations, err := estimator.GetAffinityAndTolerations(estCtx.ReplicaRequirements.NodeClaim)
+	if err != nil {
+		return nil, err
+	}
+	nodeInfos, err := estCtx.Snapshot.NodeInfos().List()
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*corev1.Node, 0, len(nodeInfos))
+	for _, nodeInfo := range nodeInfos {
+		if nodeInfo.Node() != nil && estimator.MatchNode(nodeInfo, affinity, tolerations) {
+			nodes = append(nodes, nodeInfo.Node())
+		}
+	}
+	return nodes, nil
+}
+
+// estimateByCapacity estimates the replicas allowed by the CSIStorageCapacities of the StorageClass, which are
+// accessible from the nodes.
+func (pl *storageCapacityEstimator) estimateByCapacity(class *storagev1.StorageClass, demand *storageDemand, nodes []*corev1.Node) (int64, error) {
+	tracked, err := pl.tracksCapacity(class)
+	if err != nil || !tracked {
+		return noStorageConstraint, err
+	}
+
+	capacities, err := pl.capacityLister.List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	var replicas int64
+	for _, capacity := range capacities {
+		if capacity.StorageClassName != class.Name || capacity.Capacity == nil {
+			continue
+		}
+		if capacity.MaximumVolumeSize != nil && capacity.MaximumVolumeSize.Value() < demand.largest {
+			continue
+		}
+		accessible, err := isAccessible(capacity.NodeTopology, nodes)
+		if err != nil {
+			klog.V(4).InfoS("Failed to parse node topology of CSIStorageCapacity", "plugin", pl.Name(),
+				"namespace", capacity.Namespace, "name", capacity.Name, "err", err)
+			continue
+		}
+		if !accessible {
+			continue
+		}
+		if demand.total > 0 {
+			replicas += capacity.Capacity.Value() / demand.total
+		}
+	}
+	return min(replicas, noStorageConstraint), nil
+}
+
+// tracksCapacity tells whether the CSI driver of the StorageClass publishes storage capacity.
+func (pl *storageCapacityEstimator) tracksCapacity(class *storagev1.StorageClass) (bool, error) {
+	driver, err := pl.csiDriverLister.Get(class.Provisioner)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Not provisioned by a CSI driver.
+			return false, nil
+		}
+		return false, err
+	}
+	return driver.Spec.StorageCapacity != nil && *driver.Spec.StorageCapacity, nil
+}
+
+// isAccessible tells whether any of the nodes is in the node topology. A nil node topology means
+// the storage is not accessible from any node.
+func isAccessible(nodeTopology *metav1.LabelSelector, nodes []*corev1.Node) (bool, error) {
+	if nodeTopology == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(nodeTopology)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(nodes, func(node *corev1.Node) bool {
+		return selector.Matches(labels.Set(node.Labels))
+	}), nil
+}
+
+// matchAllowedTopologies tells whether the node labels match any of the allowed topologies.
+// Empty allowed topologies match all nodes.
+func matchAllowedTopologies(terms []corev1.TopologySelectorTerm, nodeLabels map[string]string) bool {
+	if len(terms) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(terms, func(term corev1.TopologySelectorTerm) bool {
+		for _, expression := range term.MatchLabelExpressions {
+			value, ok := nodeLabels[expression.Key]
+			if !ok || !slices.Contains(expression.Values, value) {
+				return false
+			}
+		}
+		return true
+	})
+}
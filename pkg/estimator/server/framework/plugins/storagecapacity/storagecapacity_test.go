@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagecapacity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/karmada/pkg/estimator/pb"
+	"github.com/karmada-io/karmada/pkg/estimator/server/framework"
+	frameworkruntime "github.com/karmada-io/karmada/pkg/estimator/server/framework/runtime"
+	"github.com/karmada-io/karmada/pkg/features"
+	schedcache "github.com/karmada-io/karmada/pkg/util/lifted/scheduler/cache"
+)
+
+const (
+	csiDriverName    = "csi.example.com"
+	zonalClassName   = "zonal"
+	defaultClassName = "standard"
+	zoneLabel        = "topology.kubernetes.io/zone"
+	gi               = 1024 * 1024 * 1024
+)
+
+func newStorageClass(name string, annotations map[string]string, zones ...string) *storagev1.StorageClass {
+	class := &storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: name, Annotations: annotations},
+		Provisioner: csiDriverName,
+	}
+	if len(zones) > 0 {
+		class.AllowedTopologies = []corev1.TopologySelectorTerm{{
+			MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{{Key: zoneLabel, Values: zones}},
+		}}
+	}
+	return class
+}
+
+func newCapacity(name, className, zone, capacity string, maximumVolumeSize string) *storagev1.CSIStorageCapacity {
+	c := &storagev1.CSIStorageCapacity{
+		ObjectMeta:       metav1.ObjectMeta{Name: name, Namespace: "kube-system"},
+		StorageClassName: className,
+		NodeTopology:     &metav1.LabelSelector{MatchLabels: map[string]string{zoneLabel: zone}},
+		Capacity:         new(resource.MustParse(capacity)),
+	}
+	if maximumVolumeSize != "" {
+		c.MaximumVolumeSize = new(resource.MustParse(maximumVolumeSize))
+	}
+	return c
+}
+
+func newNode(name, zone string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{zoneLabel: zone}}}
+}
+
+func setup(t *testing.T, enabled bool, objects ...runtime.Object) *storageCapacityEstimator {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	client := fake.NewClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	fh, err := frameworkruntime.NewFramework(nil, frameworkruntime.WithInformerFactory(informerFactory))
+	require.NoError(t, err)
+
+	// override feature-gates
+	runtimeutil.Must(utilfeature.DefaultMutableFeatureGate.Add(features.DefaultFeatureGates))
+	err = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.StorageCapacityEstimate, enabled))
+	require.NoError(t, err, "override feature-gates")
+
+	pl, err := New(fh)
+	require.NoError(t, err)
+
+	informerFactory.Start(ctx.Done())
+	t.Cleanup(func() {
+		// Need to cancel before waiting for the shutdown.
+		cancel()
+		informerFactory.Shutdown()
+	})
+	for rtype, synced := range informerFactory.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "informer of %s not synced", rtype)
+	}
+	return pl.(*storageCapacityEstimator)
+}
+
+func TestStorageCapacityEstimator_Estimate(t *testing.T) {
+	csiDriver := &storagev1.CSIDriver{
+		ObjectMeta: metav1.ObjectMeta{Name: csiDriverName},
+		Spec:       storagev1.CSIDriverSpec{StorageCapacity: new(true)},
+	}
+	zonalClass := newStorageClass(zonalClassName, nil, "zone-a", "zone-b")
+	defaultClass := newStorageClass(defaultClassName, map[string]string{isDefaultStorageClassAnnotation: "true"})
+	untrackedClass := newStorageClass("untracked", nil)
+	untrackedClass.Provisioner = "kubernetes.io/no-provisioner"
+	remoteClass := newStorageClass("remote", nil, "zone-c")
+
+	objects := []runtime.Object{csiDriver, zonalClass, defaultClass, untrackedClass, remoteClass,
+		newCapacity("zonal-a", zonalClassName, "zone-a", "100Gi", ""),
+		newCapacity("zonal-b", zonalClassName, "zone-b", "50Gi", "20Gi"),
+		newCapacity("zonal-c", zonalClassName, "zone-c", "1000Gi", ""),
+		newCapacity("standard-a", defaultClassName, "zone-a", "10Gi", ""),
+	}
+	snapshot := schedcache.NewSnapshot(nil, []*corev1.Node{
+		newNode("node-a", "zone-a"),
+		newNode("node-b", "zone-b"),
+	})
+
+	tests := []struct {
+		name         string
+		enabled      bool
+		requirements *pb.ReplicaRequirements
+		wantReplica  int32
+		wantResult   *framework.Result
+	}{
+		{
+			name:         "plugin disabled",
+			enabled:      false,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{StorageClassName: zonalClassName, Storage: 10 * gi}}},
+			wantReplica:  math.MaxInt32,
+			wantResult:   framework.NewResult(framework.Noopperation, "StorageCapacityEstimator is disabled"),
+		},
+		{
+			name:         "no storage requests",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{},
+			wantReplica:  math.MaxInt32,
+			wantResult:   framework.NewResult(framework.Success, "StorageCapacityEstimator found no storage requests"),
+		},
+		{
+			name:         "storage class not found",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{StorageClassName: "missing", Storage: gi}}},
+			wantReplica:  0,
+			wantResult:   framework.NewResult(framework.Unschedulable, "storage class missing not found"),
+		},
+		{
+			name:         "capacity of accessible topologies",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{StorageClassName: zonalClassName, Storage: 10 * gi}}},
+			// zone-a: 100Gi, zone-b: 50Gi, zone-c has no node.
+			wantReplica: 15,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "volumes of the same storage class",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{
+				{StorageClassName: zonalClassName, Storage: 10 * gi},
+				{StorageClassName: zonalClassName, Storage: 15 * gi},
+			}},
+			wantReplica: 6,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "volume larger than maximum volume size",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{
+				{StorageClassName: zonalClassName, Storage: 25 * gi},
+			}},
+			// only zone-a is able to provision the volume.
+			wantReplica: 4,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:    "nodes not matching node claim are ignored",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{
+				NodeClaim:       &pb.NodeClaim{NodeSelector: map[string]string{zoneLabel: "zone-b"}},
+				StorageRequests: []*pb.StorageRequest{{StorageClassName: zonalClassName, Storage: 10 * gi}},
+			},
+			wantReplica: 5,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:         "default storage class",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{Storage: 3 * gi}}},
+			wantReplica:  3,
+			wantResult:   framework.NewResult(framework.Success),
+		},
+		{
+			name:    "minimum of storage classes",
+			enabled: true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{
+				{StorageClassName: zonalClassName, Storage: 10 * gi},
+				{StorageClassName: defaultClassName, Storage: 5 * gi},
+			}},
+			wantReplica: 2,
+			wantResult:  framework.NewResult(framework.Success),
+		},
+		{
+			name:         "capacity not tracked",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{StorageClassName: "untracked", Storage: 10 * gi}}},
+			wantReplica:  math.MaxInt32,
+			wantResult:   framework.NewResult(framework.Success, "StorageCapacityEstimator found no storage constraints"),
+		},
+		{
+			name:         "no node matches allowed topologies",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{StorageClassName: "remote", Storage: gi}}},
+			wantReplica:  0,
+			wantResult:   framework.NewResult(framework.Unschedulable, "no node matches the topology of storage class remote"),
+		},
+		{
+			name:         "insufficient capacity",
+			enabled:      true,
+			requirements: &pb.ReplicaRequirements{StorageRequests: []*pb.StorageRequest{{Storage: 20 * gi}}},
+			wantReplica:  0,
+			wantResult:   framework.NewResult(framework.Unschedulable, "zero replica is estimated by StorageCapacityEstimator"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := setup(t, tt.enabled, objects...)
+			replica, result := pl.Estimate(context.TODO(), framework.ReplicaEstimationContext{
+				Snapshot:            snapshot,
+				ReplicaRequirements: tt.requirements,
+			})
+			assert.Equal(t, tt.wantReplica, replica)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func TestMatchAllowedTopologies(t *testing.T) {
+	terms := []corev1.TopologySelectorTerm{
+		{MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{
+			{Key: zoneLabel, Values: []string{"zone-a"}},
+			{Key: "disk", Values: []string{"ssd"}},
+		}},
+		{MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{
+			{Key: zoneLabel, Values: []string{"zone-b"}},
+		}},
+	}
+
+	assert.True(t, matchAllowedTopologies(nil, map[string]string{zoneLabel: "zone-c"}))
+	assert.True(t, matchAllowedTopologies(terms, map[string]string{zoneLabel: "zone-a", "disk": "ssd"}))
+	assert.False(t, matchAllowedTopologies(terms, map[string]string{zoneLabel: "zone-a"}))
+	assert.True(t, matchAllowedTopologies(terms, map[string]string{zoneLabel: "zone-b"}))
+	assert.False(t, matchAllowedTopologies(terms, map[string]string{zoneLabel: "zone-c"}))
+}
@@ -95,6 +95,7 @@ func NewFramework(r Registry, opts ...Option) (framework.Framework, error) {
 		opt(&options)
 	}
 	f := &frameworkImpl{
+		clientSet:       options.clientSet,
 		informerFactory: options.informerFactory,
 	}
 	estimateReplicasPluginsList := reflect.ValueOf(&f.estimateReplicasPlugins).Elem()
@@ -35,6 +35,10 @@ const (
 	EstimatingTypeGetUnschedulableReplicas = "GetUnschedulableReplicas"
 	// EstimatingTypeMaxAvailableComponentSets - label of estimating type
 	EstimatingTypeMaxAvailableComponentSets = "MaxAvailableComponentSets"
+	// EstimatingTypeBatchMaxAvailableReplicas - label of estimating type
+	EstimatingTypeBatchMaxAvailableReplicas = "BatchMaxAvailableReplicas"
+	// EstimatingTypeBatchGetUnschedulableReplicas - label of estimating type
+	EstimatingTypeBatchGetUnschedulableReplicas = "BatchGetUnschedulableReplicas"
 )
 
 const (
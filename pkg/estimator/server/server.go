@@ -286,8 +286,94 @@ func (es *AccurateSchedulerEstimatorServer) GetUnschedulableReplicas(ctx context
 	if request.Cluster != es.clusterName {
 		return nil, fmt.Errorf("cluster name does not match, got: %s, desire: %s", request.Cluster, es.clusterName)
 	}
+	unschedulables, err := es.getUnschedulableReplicas(request, metrics.EstimatingTypeGetUnschedulableReplicas)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnschedulableReplicasResponse{UnschedulableReplicas: unschedulables}, nil
+}
+
+// BatchMaxAvailableReplicas is the implementation of gRPC interface. It will return the
+// max available replicas of each request, which are all evaluated against one snapshot of the cluster.
+func (es *AccurateSchedulerEstimatorServer) BatchMaxAvailableReplicas(ctx context.Context, request *pb.BatchMaxAvailableReplicasRequest) (response *pb.BatchMaxAvailableReplicasResponse, rerr error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		klog.Warningf("No metadata from context.")
+	}
+	// The client carries the object of each request in the metadata in the order of the requests.
+	objects := md.Get(string(util.ContextKeyObject))
+
+	klog.V(4).Infof("Begin calculating cluster available replicas of %d requests, resources: %q", len(request.Requests), objects)
+	defer func(start time.Time) {
+		metrics.CountRequests(rerr, metrics.EstimatingTypeBatchMaxAvailableReplicas)
+		metrics.UpdateEstimatingAlgorithmLatency(rerr, metrics.EstimatingTypeBatchMaxAvailableReplicas, metrics.EstimatingStepTotal, start)
+		if rerr != nil {
+			klog.Errorf("Failed to calculate cluster available replicas in batch: %v", rerr)
+			return
+		}
+		klog.V(2).Infof("Finish calculating cluster available replicas of %d requests, resources: %q, time elapsed: %s", len(request.Requests), objects, time.Since(start))
+	}(time.Now())
+
+	if request.Cluster != es.clusterName {
+		return nil, fmt.Errorf("cluster name does not match, got: %s, desire: %s", request.Cluster, es.clusterName)
+	}
+	for _, req := range request.Requests {
+		if req.Cluster != es.clusterName {
+			return nil, fmt.Errorf("cluster name does not match, got: %s, desire: %s", req.Cluster, es.clusterName)
+		}
+	}
+	results, err := es.BatchEstimateReplicas(ctx, objects, request.Requests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate replicas: %v", err)
+	}
+	return &pb.BatchMaxAvailableReplicasResponse{Results: results}, nil
+}
+
+// BatchGetUnschedulableReplicas is the implementation of gRPC interface. It will return the
+// unschedulable replicas of each workload in the request.
+func (es *AccurateSchedulerEstimatorServer) BatchGetUnschedulableReplicas(ctx context.Context, request *pb.BatchUnschedulableReplicasRequest) (response *pb.BatchUnschedulableReplicasResponse, rerr error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		klog.Warningf("No metadata from context.")
+	}
+	// The client carries the object of each request in the metadata in the order of the requests.
+	objects := md.Get(string(util.ContextKeyObject))
+
+	klog.V(4).Infof("Begin detecting cluster unschedulable replicas of %d requests, resources: %q", len(request.Requests), objects)
+	defer func(start time.Time) {
+		metrics.CountRequests(rerr, metrics.EstimatingTypeBatchGetUnschedulableReplicas)
+		metrics.UpdateEstimatingAlgorithmLatency(rerr, metrics.EstimatingTypeBatchGetUnschedulableReplicas, metrics.EstimatingStepTotal, start)
+		if rerr != nil {
+			klog.Errorf("Failed to detect cluster unschedulable replicas in batch: %v", rerr)
+			return
+		}
+		klog.V(2).Infof("Finish detecting cluster unschedulable replicas of %d requests, resources: %q, time elapsed: %s", len(request.Requests), objects, time.Since(start))
+	}(time.Now())
+
+	if request.Cluster != es.clusterName {
+		return nil, fmt.Errorf("cluster name does not match, got: %s, desire: %s", request.Cluster, es.clusterName)
+	}
+	for _, req := range request.Requests {
+		if req.Cluster != es.clusterName {
+			return nil, fmt.Errorf("cluster name does not match, got: %s, desire: %s", req.Cluster, es.clusterName)
+		}
+	}
+	results := make([]*pb.UnschedulableReplicasResult, len(request.Requests))
+	for i, req := range request.Requests {
+		unschedulables, err := es.getUnschedulableReplicas(req, metrics.EstimatingTypeBatchGetUnschedulableReplicas)
+		if err != nil {
+			results[i] = &pb.UnschedulableReplicasResult{Error: err.Error()}
+			continue
+		}
+		results[i] = &pb.UnschedulableReplicasResult{UnschedulableReplicas: unschedulables}
+	}
+	return &pb.BatchUnschedulableReplicasResponse{Results: results}, nil
+}
+
+// getUnschedulableReplicas returns the unschedulable replicas of the workload referred by the request.
+func (es *AccurateSchedulerEstimatorServer) getUnschedulableReplicas(request *pb.UnschedulableReplicasRequest, estimatingType string) (int32, error) {
 	if request.Resource == nil {
-		return nil, fmt.Errorf("resource is nil")
+		return 0, fmt.Errorf("resource is nil")
 	}
 
 	// Get the workload.
@@ -300,20 +386,19 @@ func (es *AccurateSchedulerEstimatorServer) GetUnschedulableReplicas(ctx context
 		Namespace: request.Resource.Namespace,
 		Name:      request.Resource.Name,
 	})
-	metrics.UpdateEstimatingAlgorithmLatency(err, metrics.EstimatingTypeGetUnschedulableReplicas, metrics.EstimatingStepGetObjectFromCache, startTime)
+	metrics.UpdateEstimatingAlgorithmLatency(err, estimatingType, metrics.EstimatingStepGetObjectFromCache, startTime)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// List all unschedulable replicas.
 	startTime = time.Now()
 	unschedulables, err := replica.GetUnschedulablePodsOfWorkload(unstructObj, time.Duration(request.UnschedulableThreshold), es.replicaLister)
-	metrics.UpdateEstimatingAlgorithmLatency(err, metrics.EstimatingTypeGetUnschedulableReplicas, metrics.EstimatingStepGetUnschedulablePodsOfWorkload, startTime)
+	metrics.UpdateEstimatingAlgorithmLatency(err, estimatingType, metrics.EstimatingStepGetUnschedulablePodsOfWorkload, startTime)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-
-	return &pb.UnschedulableReplicasResponse{UnschedulableReplicas: unschedulables}, err
+	return unschedulables, nil
 }
 
 // newPodInformer creates a shared index informer that returns only non-terminal pods.
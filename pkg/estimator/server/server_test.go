@@ -258,6 +258,114 @@ func TestAccurateSchedulerEstimatorServer_MaxAvailableReplicas(t *testing.T) {
 	}
 }
 
+func TestAccurateSchedulerEstimatorServer_BatchMaxAvailableReplicas(t *testing.T) {
+	opt := &options.Options{
+		ClusterName: "fake",
+	}
+	// node 1(with label: a = 1) left: 2 cpu, 6 mem, 8 pod, 14 storage
+	// node 2(with label: a = 3; b = 2) left: 3 cpu, 5 mem, 9 pod, 12 storage
+	// node 3(without labels) left: 8 cpu, 16 mem, 11 pod, 16 storage
+	objs := []runtime.Object{
+		testhelper.MakeNodeWithLabels("machine1", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[string]string{"a": "1"}),
+		testhelper.MakeNodeWithLabels("machine2", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage, map[string]string{"a": "3", "b": "2"}),
+		testhelper.NewNode("machine3", 8*testhelper.ResourceUnitCPU, 16*testhelper.ResourceUnitMem, 11*testhelper.ResourceUnitPod, 16*testhelper.ResourceUnitEphemeralStorage),
+		testhelper.NewPodWithRequest("pod1", "machine1", 1*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, testhelper.ResourceUnitZero),
+		testhelper.NewPodWithRequest("pod2", "machine1", 3*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, testhelper.ResourceUnitZero),
+		testhelper.NewPodWithRequest("pod3", "machine1", 2*testhelper.ResourceUnitCPU, 4*testhelper.ResourceUnitMem, 2*testhelper.ResourceUnitEphemeralStorage),
+		testhelper.NewPodWithRequest("pod4", "machine2", 4*testhelper.ResourceUnitCPU, 8*testhelper.ResourceUnitMem, 2*testhelper.ResourceUnitEphemeralStorage),
+		testhelper.NewPodWithRequest("pod5", "machine2", 1*testhelper.ResourceUnitCPU, 3*testhelper.ResourceUnitMem, 2*testhelper.ResourceUnitEphemeralStorage),
+	}
+	newRequest := func(cluster string, nodeClaim *pb.NodeClaim) *pb.MaxAvailableReplicasRequest {
+		return &pb.MaxAvailableReplicasRequest{
+			Cluster: cluster,
+			ReplicaRequirements: (&pb.ReplicaRequirements{NodeClaim: nodeClaim}).
+				MustSetResourceRequest(testhelper.NewResourceList(1*testhelper.ResourceUnitCPU, 2*testhelper.ResourceUnitMem, testhelper.ResourceUnitZero)),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		request     *pb.BatchMaxAvailableReplicasRequest
+		wantResults []*pb.MaxAvailableReplicasResult
+		wantErr     bool
+	}{
+		{
+			name: "requests evaluated against the same snapshot",
+			request: &pb.BatchMaxAvailableReplicasRequest{
+				Cluster: "fake",
+				Requests: []*pb.MaxAvailableReplicasRequest{
+					newRequest("fake", nil),
+					newRequest("fake", &pb.NodeClaim{NodeSelector: map[string]string{"a": "3"}}),
+					newRequest("fake", &pb.NodeClaim{NodeSelector: map[string]string{"a": "4"}}),
+				},
+			},
+			wantResults: []*pb.MaxAvailableReplicasResult{
+				{MaxReplicas: 12},
+				{MaxReplicas: 2},
+				{MaxReplicas: 0},
+			},
+		},
+		{
+			name:        "empty batch",
+			request:     &pb.BatchMaxAvailableReplicasRequest{Cluster: "fake"},
+			wantResults: []*pb.MaxAvailableReplicasResult{},
+		},
+		{
+			name: "cluster name of batch does not match",
+			request: &pb.BatchMaxAvailableReplicasRequest{
+				Cluster:  "other",
+				Requests: []*pb.MaxAvailableReplicasRequest{newRequest("other", nil)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cluster name of request does not match",
+			request: &pb.BatchMaxAvailableReplicasRequest{
+				Cluster:  "fake",
+				Requests: []*pb.MaxAvailableReplicasRequest{newRequest("fake", nil), newRequest("other", nil)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			gvrToListKind := map[schema.GroupVersionResource]string{
+				{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+			}
+			dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind)
+			discoveryClient := &discoveryfake.FakeDiscovery{
+				Fake: &coretesting.Fake{},
+			}
+
+			es, _ := NewEstimatorServer(ctx, fake.NewClientset(objs...), dynamicClient, discoveryClient, opt)
+
+			es.informerFactory.Start(ctx.Done())
+			es.informerFactory.WaitForCacheSync(ctx.Done())
+			waitForEstimatorCacheSync(t, es, objs)
+
+			gotResponse, err := es.BatchMaxAvailableReplicas(ctx, tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BatchMaxAvailableReplicas() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(gotResponse.Results) != len(tt.wantResults) {
+				t.Fatalf("BatchMaxAvailableReplicas() got %d results, want %d", len(gotResponse.Results), len(tt.wantResults))
+			}
+			for i := range tt.wantResults {
+				if gotResponse.Results[i].MaxReplicas != tt.wantResults[i].MaxReplicas || gotResponse.Results[i].Error != tt.wantResults[i].Error {
+					t.Errorf("BatchMaxAvailableReplicas() result[%d] = %v, want %v", i, gotResponse.Results[i], tt.wantResults[i])
+				}
+			}
+		})
+	}
+}
+
 // waitForEstimatorCacheSync waits until es.Cache has been populated with the
 // expected number of nodes and assigned pods. The estimator cache is filled
 // asynchronously by informer event handlers, so informerFactory.WaitForCacheSync
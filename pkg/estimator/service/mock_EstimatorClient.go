@@ -55,6 +55,172 @@ func (_m *MockEstimatorClient) EXPECT() *MockEstimatorClient_Expecter {
 	return &MockEstimatorClient_Expecter{mock: &_m.Mock}
 }
 
+// BatchGetUnschedulableReplicas provides a mock function for the type MockEstimatorClient
+func (_mock *MockEstimatorClient) BatchGetUnschedulableReplicas(ctx context.Context, in *pb.BatchUnschedulableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchUnschedulableReplicasResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetUnschedulableReplicas")
+	}
+
+	var r0 *pb.BatchUnschedulableReplicasResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.BatchUnschedulableReplicasRequest, ...grpc.CallOption) (*pb.BatchUnschedulableReplicasResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.BatchUnschedulableReplicasRequest, ...grpc.CallOption) *pb.BatchUnschedulableReplicasResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.BatchUnschedulableReplicasResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *pb.BatchUnschedulableReplicasRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEstimatorClient_BatchGetUnschedulableReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchGetUnschedulableReplicas'
+type MockEstimatorClient_BatchGetUnschedulableReplicas_Call struct {
+	*mock.Call
+}
+
+// BatchGetUnschedulableReplicas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *pb.BatchUnschedulableReplicasRequest
+//   - opts ...grpc.CallOption
+func (_e *MockEstimatorClient_Expecter) BatchGetUnschedulableReplicas(ctx interface{}, in interface{}, opts ...interface{}) *MockEstimatorClient_BatchGetUnschedulableReplicas_Call {
+	return &MockEstimatorClient_BatchGetUnschedulableReplicas_Call{Call: _e.mock.On("BatchGetUnschedulableReplicas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockEstimatorClient_BatchGetUnschedulableReplicas_Call) Run(run func(ctx context.Context, in *pb.BatchUnschedulableReplicasRequest, opts ...grpc.CallOption)) *MockEstimatorClient_BatchGetUnschedulableReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *pb.BatchUnschedulableReplicasRequest
+		if args[1] != nil {
+			arg1 = args[1].(*pb.BatchUnschedulableReplicasRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEstimatorClient_BatchGetUnschedulableReplicas_Call) Return(batchUnschedulableReplicasResponse *pb.BatchUnschedulableReplicasResponse, err error) *MockEstimatorClient_BatchGetUnschedulableReplicas_Call {
+	_c.Call.Return(batchUnschedulableReplicasResponse, err)
+	return _c
+}
+
+func (_c *MockEstimatorClient_BatchGetUnschedulableReplicas_Call) RunAndReturn(run func(ctx context.Context, in *pb.BatchUnschedulableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchUnschedulableReplicasResponse, error)) *MockEstimatorClient_BatchGetUnschedulableReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchMaxAvailableReplicas provides a mock function for the type MockEstimatorClient
+func (_mock *MockEstimatorClient) BatchMaxAvailableReplicas(ctx context.Context, in *pb.BatchMaxAvailableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchMaxAvailableReplicasResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchMaxAvailableReplicas")
+	}
+
+	var r0 *pb.BatchMaxAvailableReplicasResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.BatchMaxAvailableReplicasRequest, ...grpc.CallOption) (*pb.BatchMaxAvailableReplicasResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.BatchMaxAvailableReplicasRequest, ...grpc.CallOption) *pb.BatchMaxAvailableReplicasResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.BatchMaxAvailableReplicasResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *pb.BatchMaxAvailableReplicasRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEstimatorClient_BatchMaxAvailableReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchMaxAvailableReplicas'
+type MockEstimatorClient_BatchMaxAvailableReplicas_Call struct {
+	*mock.Call
+}
+
+// BatchMaxAvailableReplicas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *pb.BatchMaxAvailableReplicasRequest
+//   - opts ...grpc.CallOption
+func (_e *MockEstimatorClient_Expecter) BatchMaxAvailableReplicas(ctx interface{}, in interface{}, opts ...interface{}) *MockEstimatorClient_BatchMaxAvailableReplicas_Call {
+	return &MockEstimatorClient_BatchMaxAvailableReplicas_Call{Call: _e.mock.On("BatchMaxAvailableReplicas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockEstimatorClient_BatchMaxAvailableReplicas_Call) Run(run func(ctx context.Context, in *pb.BatchMaxAvailableReplicasRequest, opts ...grpc.CallOption)) *MockEstimatorClient_BatchMaxAvailableReplicas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *pb.BatchMaxAvailableReplicasRequest
+		if args[1] != nil {
+			arg1 = args[1].(*pb.BatchMaxAvailableReplicasRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEstimatorClient_BatchMaxAvailableReplicas_Call) Return(batchMaxAvailableReplicasResponse *pb.BatchMaxAvailableReplicasResponse, err error) *MockEstimatorClient_BatchMaxAvailableReplicas_Call {
+	_c.Call.Return(batchMaxAvailableReplicasResponse, err)
+	return _c
+}
+
+func (_c *MockEstimatorClient_BatchMaxAvailableReplicas_Call) RunAndReturn(run func(ctx context.Context, in *pb.BatchMaxAvailableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchMaxAvailableReplicasResponse, error)) *MockEstimatorClient_BatchMaxAvailableReplicas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUnschedulableReplicas provides a mock function for the type MockEstimatorClient
 func (_mock *MockEstimatorClient) GetUnschedulableReplicas(ctx context.Context, in *pb.UnschedulableReplicasRequest, opts ...grpc.CallOption) (*pb.UnschedulableReplicasResponse, error) {
 	var tmpRet mock.Arguments
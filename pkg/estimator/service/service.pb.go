@@ -40,29 +40,39 @@ var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x123github.com.karmada_io.karmada.pkg.estimator.service\x1a pkg/estimator/pb/estimator.proto2\xc2\x04\n" +
+	"\rservice.proto\x123github.com.karmada_io.karmada.pkg.estimator.service\x1a pkg/estimator/pb/estimator.proto2\xd2\a\n" +
 	"\tEstimator\x12\xb3\x01\n" +
 	"\x14MaxAvailableReplicas\x12K.github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest\x1aL.github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse\"\x00\x12\xc2\x01\n" +
 	"\x19MaxAvailableComponentSets\x12P.github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest\x1aQ.github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse\"\x00\x12\xb9\x01\n" +
-	"\x18GetUnschedulableReplicas\x12L.github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest\x1aM.github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse\"\x00B5Z3github.com/karmada-io/karmada/pkg/estimator/service"
+	"\x18GetUnschedulableReplicas\x12L.github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest\x1aM.github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse\"\x00\x12\xc2\x01\n" +
+	"\x19BatchMaxAvailableReplicas\x12P.github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasRequest\x1aQ.github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasResponse\"\x00\x12\xc8\x01\n" +
+	"\x1dBatchGetUnschedulableReplicas\x12Q.github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasRequest\x1aR.github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasResponse\"\x00B5Z3github.com/karmada-io/karmada/pkg/estimator/service"
 
 var file_service_proto_goTypes = []any{
-	(*pb.MaxAvailableReplicasRequest)(nil),       // 0: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest
-	(*pb.MaxAvailableComponentSetsRequest)(nil),  // 1: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest
-	(*pb.UnschedulableReplicasRequest)(nil),      // 2: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest
-	(*pb.MaxAvailableReplicasResponse)(nil),      // 3: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse
-	(*pb.MaxAvailableComponentSetsResponse)(nil), // 4: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse
-	(*pb.UnschedulableReplicasResponse)(nil),     // 5: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse
+	(*pb.MaxAvailableReplicasRequest)(nil),        // 0: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest
+	(*pb.MaxAvailableComponentSetsRequest)(nil),   // 1: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest
+	(*pb.UnschedulableReplicasRequest)(nil),       // 2: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest
+	(*pb.BatchMaxAvailableReplicasRequest)(nil),   // 3: github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasRequest
+	(*pb.BatchUnschedulableReplicasRequest)(nil),  // 4: github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasRequest
+	(*pb.MaxAvailableReplicasResponse)(nil),       // 5: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse
+	(*pb.MaxAvailableComponentSetsResponse)(nil),  // 6: github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse
+	(*pb.UnschedulableReplicasResponse)(nil),      // 7: github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse
+	(*pb.BatchMaxAvailableReplicasResponse)(nil),  // 8: github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasResponse
+	(*pb.BatchUnschedulableReplicasResponse)(nil), // 9: github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasResponse
 }
 var file_service_proto_depIdxs = []int32{
 	0, // 0: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.MaxAvailableReplicas:input_type -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasRequest
 	1, // 1: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.MaxAvailableComponentSets:input_type -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsRequest
 	2, // 2: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.GetUnschedulableReplicas:input_type -> github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasRequest
-	3, // 3: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.MaxAvailableReplicas:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse
-	4, // 4: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.MaxAvailableComponentSets:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse
-	5, // 5: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.GetUnschedulableReplicas:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	3, // 3: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.BatchMaxAvailableReplicas:input_type -> github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasRequest
+	4, // 4: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.BatchGetUnschedulableReplicas:input_type -> github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasRequest
+	5, // 5: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.MaxAvailableReplicas:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableReplicasResponse
+	6, // 6: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.MaxAvailableComponentSets:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.MaxAvailableComponentSetsResponse
+	7, // 7: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.GetUnschedulableReplicas:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.UnschedulableReplicasResponse
+	8, // 8: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.BatchMaxAvailableReplicas:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.BatchMaxAvailableReplicasResponse
+	9, // 9: github.com.karmada_io.karmada.pkg.estimator.service.Estimator.BatchGetUnschedulableReplicas:output_type -> github.com.karmada_io.karmada.pkg.estimator.pb.BatchUnschedulableReplicasResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -35,9 +35,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Estimator_MaxAvailableReplicas_FullMethodName      = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/MaxAvailableReplicas"
-	Estimator_MaxAvailableComponentSets_FullMethodName = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/MaxAvailableComponentSets"
-	Estimator_GetUnschedulableReplicas_FullMethodName  = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/GetUnschedulableReplicas"
+	Estimator_MaxAvailableReplicas_FullMethodName          = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/MaxAvailableReplicas"
+	Estimator_MaxAvailableComponentSets_FullMethodName     = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/MaxAvailableComponentSets"
+	Estimator_GetUnschedulableReplicas_FullMethodName      = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/GetUnschedulableReplicas"
+	Estimator_BatchMaxAvailableReplicas_FullMethodName     = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/BatchMaxAvailableReplicas"
+	Estimator_BatchGetUnschedulableReplicas_FullMethodName = "/github.com.karmada_io.karmada.pkg.estimator.service.Estimator/BatchGetUnschedulableReplicas"
 )
 
 // EstimatorClient is the client API for Estimator service.
@@ -47,6 +49,8 @@ type EstimatorClient interface {
 	MaxAvailableReplicas(ctx context.Context, in *pb.MaxAvailableReplicasRequest, opts ...grpc.CallOption) (*pb.MaxAvailableReplicasResponse, error)
 	MaxAvailableComponentSets(ctx context.Context, in *pb.MaxAvailableComponentSetsRequest, opts ...grpc.CallOption) (*pb.MaxAvailableComponentSetsResponse, error)
 	GetUnschedulableReplicas(ctx context.Context, in *pb.UnschedulableReplicasRequest, opts ...grpc.CallOption) (*pb.UnschedulableReplicasResponse, error)
+	BatchMaxAvailableReplicas(ctx context.Context, in *pb.BatchMaxAvailableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchMaxAvailableReplicasResponse, error)
+	BatchGetUnschedulableReplicas(ctx context.Context, in *pb.BatchUnschedulableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchUnschedulableReplicasResponse, error)
 }
 
 type estimatorClient struct {
@@ -87,6 +91,26 @@ func (c *estimatorClient) GetUnschedulableReplicas(ctx context.Context, in *pb.U
 	return out, nil
 }
 
+func (c *estimatorClient) BatchMaxAvailableReplicas(ctx context.Context, in *pb.BatchMaxAvailableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchMaxAvailableReplicasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pb.BatchMaxAvailableReplicasResponse)
+	err := c.cc.Invoke(ctx, Estimator_BatchMaxAvailableReplicas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *estimatorClient) BatchGetUnschedulableReplicas(ctx context.Context, in *pb.BatchUnschedulableReplicasRequest, opts ...grpc.CallOption) (*pb.BatchUnschedulableReplicasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(pb.BatchUnschedulableReplicasResponse)
+	err := c.cc.Invoke(ctx, Estimator_BatchGetUnschedulableReplicas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EstimatorServer is the server API for Estimator service.
 // All implementations must embed UnimplementedEstimatorServer
 // for forward compatibility.
@@ -94,6 +118,8 @@ type EstimatorServer interface {
 	MaxAvailableReplicas(context.Context, *pb.MaxAvailableReplicasRequest) (*pb.MaxAvailableReplicasResponse, error)
 	MaxAvailableComponentSets(context.Context, *pb.MaxAvailableComponentSetsRequest) (*pb.MaxAvailableComponentSetsResponse, error)
 	GetUnschedulableReplicas(context.Context, *pb.UnschedulableReplicasRequest) (*pb.UnschedulableReplicasResponse, error)
+	BatchMaxAvailableReplicas(context.Context, *pb.BatchMaxAvailableReplicasRequest) (*pb.BatchMaxAvailableReplicasResponse, error)
+	BatchGetUnschedulableReplicas(context.Context, *pb.BatchUnschedulableReplicasRequest) (*pb.BatchUnschedulableReplicasResponse, error)
 	mustEmbedUnimplementedEstimatorServer()
 }
 
@@ -113,6 +139,12 @@ func (UnimplementedEstimatorServer) MaxAvailableComponentSets(context.Context, *
 func (UnimplementedEstimatorServer) GetUnschedulableReplicas(context.Context, *pb.UnschedulableReplicasRequest) (*pb.UnschedulableReplicasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUnschedulableReplicas not implemented")
 }
+func (UnimplementedEstimatorServer) BatchMaxAvailableReplicas(context.Context, *pb.BatchMaxAvailableReplicasRequest) (*pb.BatchMaxAvailableReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMaxAvailableReplicas not implemented")
+}
+func (UnimplementedEstimatorServer) BatchGetUnschedulableReplicas(context.Context, *pb.BatchUnschedulableReplicasRequest) (*pb.BatchUnschedulableReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUnschedulableReplicas not implemented")
+}
 func (UnimplementedEstimatorServer) mustEmbedUnimplementedEstimatorServer() {}
 func (UnimplementedEstimatorServer) testEmbeddedByValue()                   {}
 
@@ -188,6 +220,42 @@ func _Estimator_GetUnschedulableReplicas_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Estimator_BatchMaxAvailableReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.BatchMaxAvailableReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstimatorServer).BatchMaxAvailableReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Estimator_BatchMaxAvailableReplicas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstimatorServer).BatchMaxAvailableReplicas(ctx, req.(*pb.BatchMaxAvailableReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Estimator_BatchGetUnschedulableReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.BatchUnschedulableReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstimatorServer).BatchGetUnschedulableReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Estimator_BatchGetUnschedulableReplicas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstimatorServer).BatchGetUnschedulableReplicas(ctx, req.(*pb.BatchUnschedulableReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Estimator_ServiceDesc is the grpc.ServiceDesc for Estimator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +275,14 @@ var Estimator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUnschedulableReplicas",
 			Handler:    _Estimator_GetUnschedulableReplicas_Handler,
 		},
+		{
+			MethodName: "BatchMaxAvailableReplicas",
+			Handler:    _Estimator_BatchMaxAvailableReplicas_Handler,
+		},
+		{
+			MethodName: "BatchGetUnschedulableReplicas",
+			Handler:    _Estimator_BatchGetUnschedulableReplicas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -46,6 +46,8 @@ const (
 	EventReasonInterpretHealthSucceed = "InterpretHealthSucceed"
 	// EventReasonInterpretHealthFailed indicates that interpret health failed.
 	EventReasonInterpretHealthFailed = "InterpretHealthFailed"
+	// EventReasonInterpretRolloutStatusFailed indicates that interpret rollout status failed.
+	EventReasonInterpretRolloutStatusFailed = "InterpretRolloutStatusFailed"
 )
 
 // Define events for work objects and their associated resources.
@@ -161,3 +163,11 @@ const (
 	// EventReasonAPIIncompatible indicates that the MultiClusterService may not function properly as some member clusters do not support EndpointSlice.
 	EventReasonAPIIncompatible = "APIIncompatible"
 )
+
+// Define events for MultiClusterIngress objects.
+const (
+	// EventReasonSyncIngressFailed indicates that sync ingress to entry clusters failed.
+	EventReasonSyncIngressFailed = "SyncIngressFailed"
+	// EventReasonSyncIngressSucceed indicates that sync ingress to entry clusters succeed.
+	EventReasonSyncIngressSucceed = "SyncIngressSucceed"
+)
@@ -56,6 +56,27 @@ const (
 	// ResourceQuotaEstimate indicates if enable resource quota check in estimator
 	ResourceQuotaEstimate featuregate.Feature = "ResourceQuotaEstimate"
 
+	// DynamicResourceEstimate indicates if enable the estimation of devices allocated through
+	// Dynamic Resource Allocation in estimator.
+	//
+	// alpha: v1.19
+	DynamicResourceEstimate featuregate.Feature = "DynamicResourceEstimate"
+
+	// StorageCapacityEstimate indicates if enable the estimation of the persistent volumes required by
+	// each replica, e.g. the volumeClaimTemplates of StatefulSets, against the storage capacity in estimator.
+	// It must be enabled in both karmada-controller-manager, which interprets the storage requests of the
+	// replicas, and karmada-scheduler-estimator, which estimates them against the storage capacity. With
+	// only one of them enabled, the storage capacity is silently not taken into account.
+	//
+	// alpha: v1.19
+	StorageCapacityEstimate featuregate.Feature = "StorageCapacityEstimate"
+
+	// PodTopologyEstimate indicates if enable the estimation of the inter-pod affinity, anti-affinity and
+	// topology spread constraints of each replica against the existing pods in estimator.
+	//
+	// alpha: v1.19
+	PodTopologyEstimate featuregate.Feature = "PodTopologyEstimate"
+
 	// StatefulFailoverInjection controls whether Karmada collects state information
 	// from the source cluster during a failover event for stateful applications and
 	// injects this information into the application configuration when it is moved
@@ -153,6 +174,16 @@ const (
 	// owner: @XiShanYongYe-Chang, @RainbowMango, @mszacillo
 	// alpha: v1.18
 	SchedulingOvercommitProtection featuregate.Feature = "SchedulingOvercommitProtection"
+
+	// DependencyOrderedApply controls whether the execution controller applies the manifests of Works
+	// in the order of their dependencies.
+	// When enabled, a Work is not applied to the member cluster until the Works of its prerequisites,
+	// i.e. its Namespace, its CustomResourceDefinition and the dependencies distributed along with it,
+	// have been applied, and the CustomResourceDefinition has been established. The blocked Work is
+	// reported by its Applied condition with the reason WaitingForDependencies.
+	//
+	// alpha: v1.19
+	DependencyOrderedApply featuregate.Feature = "DependencyOrderedApply"
 )
 
 var (
@@ -172,6 +203,9 @@ var (
 		PolicyPreemption:                  {Default: false, PreRelease: featuregate.Alpha},
 		MultiClusterService:               {Default: false, PreRelease: featuregate.Alpha},
 		ResourceQuotaEstimate:             {Default: false, PreRelease: featuregate.Alpha},
+		DynamicResourceEstimate:           {Default: false, PreRelease: featuregate.Alpha},
+		StorageCapacityEstimate:           {Default: false, PreRelease: featuregate.Alpha},
+		PodTopologyEstimate:               {Default: false, PreRelease: featuregate.Alpha},
 		StatefulFailoverInjection:         {Default: false, PreRelease: featuregate.Alpha},
 		PriorityBasedScheduling:           {Default: true, PreRelease: featuregate.Beta},
 		FederatedQuotaEnforcement:         {Default: false, PreRelease: featuregate.Alpha},
@@ -182,6 +216,7 @@ var (
 		ControllerPriorityQueue:           {Default: true, PreRelease: featuregate.Beta},
 		WorkloadAffinity:                  {Default: false, PreRelease: featuregate.Alpha},
 		SchedulingOvercommitProtection:    {Default: false, PreRelease: featuregate.Alpha},
+		DependencyOrderedApply:            {Default: false, PreRelease: featuregate.Alpha},
 	}
 )
 
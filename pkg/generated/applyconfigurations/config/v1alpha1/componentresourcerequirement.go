@@ -63,6 +63,19 @@ type ComponentResourceRequirementApplyConfiguration struct {
 	// - components: the resource requirements for each component.
 	// The returned value will be set into a ResourceBinding or ClusterResourceBinding.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to extract the components
+	// of the resource. It is an alternative to LuaScript, exactly one of them
+	// should be specified.
+	//
+	// The expression should return a list of components, for example:
+	//
+	// ```
+	// celExpression: '[{"name": "jobmanager", "replicas": desiredObj.spec.jobManager.replicas}]'
+	// ```
+	//
+	// The variable desiredObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // ComponentResourceRequirementApplyConfiguration constructs a declarative configuration of the ComponentResourceRequirement type for use with
@@ -78,3 +91,11 @@ func (b *ComponentResourceRequirementApplyConfiguration) WithLuaScript(value str
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *ComponentResourceRequirementApplyConfiguration) WithCELExpression(value string) *ComponentResourceRequirementApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
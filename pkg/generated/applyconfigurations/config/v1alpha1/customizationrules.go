@@ -69,12 +69,21 @@ type CustomizationRulesApplyConfiguration struct {
 	// HealthInterpretation describes the health assessment rules by which Karmada
 	// can assess the health state of the resource type.
 	HealthInterpretation *HealthInterpretationApplyConfiguration `json:"healthInterpretation,omitempty"`
+	// RolloutStatusInterpretation describes the rules by which Karmada can assess
+	// whether the resource has finished rolling out its latest generation.
+	// Karmada provides built-in rules for Deployment, StatefulSet, DaemonSet and Job.
+	// If RolloutStatusInterpretation is set, the built-in rules will be ignored.
+	RolloutStatusInterpretation *RolloutStatusInterpretationApplyConfiguration `json:"rolloutStatusInterpretation,omitempty"`
 	// DependencyInterpretation describes the rules for Karmada to analyze the
 	// dependent resources.
 	// Karmada provides built-in rules for several standard Kubernetes types, see:
 	// https://karmada.io/docs/userguide/globalview/customizing-resource-interpreter/#interpretdependency
 	// If DependencyInterpretation is set, the built-in rules will be ignored.
 	DependencyInterpretation *DependencyInterpretationApplyConfiguration `json:"dependencyInterpretation,omitempty"`
+	// LuaScriptLimits describes the resource limits of running the Lua scripts
+	// of the rules. It doesn't apply to CEL expressions.
+	// If not set, the scripts are only bound by a timeout of one second.
+	LuaScriptLimits *LuaScriptLimitsApplyConfiguration `json:"luaScriptLimits,omitempty"`
 }
 
 // CustomizationRulesApplyConfiguration constructs a declarative configuration of the CustomizationRules type for use with
@@ -139,6 +148,14 @@ func (b *CustomizationRulesApplyConfiguration) WithHealthInterpretation(value *H
 	return b
 }
 
+// WithRolloutStatusInterpretation sets the RolloutStatusInterpretation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutStatusInterpretation field is set to the value of the last call.
+func (b *CustomizationRulesApplyConfiguration) WithRolloutStatusInterpretation(value *RolloutStatusInterpretationApplyConfiguration) *CustomizationRulesApplyConfiguration {
+	b.RolloutStatusInterpretation = value
+	return b
+}
+
 // WithDependencyInterpretation sets the DependencyInterpretation field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DependencyInterpretation field is set to the value of the last call.
@@ -146,3 +163,11 @@ func (b *CustomizationRulesApplyConfiguration) WithDependencyInterpretation(valu
 	b.DependencyInterpretation = value
 	return b
 }
+
+// WithLuaScriptLimits sets the LuaScriptLimits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LuaScriptLimits field is set to the value of the last call.
+func (b *CustomizationRulesApplyConfiguration) WithLuaScriptLimits(value *LuaScriptLimitsApplyConfiguration) *CustomizationRulesApplyConfiguration {
+	b.LuaScriptLimits = value
+	return b
+}
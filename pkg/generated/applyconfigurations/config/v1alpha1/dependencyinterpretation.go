@@ -54,6 +54,19 @@ type DependencyInterpretationApplyConfiguration struct {
 	//
 	// The returned value should be expressed by a slice of DependentObjectReference.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to interpret the
+	// dependencies of a specific resource. It is an alternative to LuaScript,
+	// exactly one of them should be specified.
+	//
+	// The expression should return a list of dependent object references, for example:
+	//
+	// ```
+	// celExpression: '[{"apiVersion": "v1", "kind": "ConfigMap", "namespace": desiredObj.metadata.namespace, "name": desiredObj.spec.configName}]'
+	// ```
+	//
+	// The variable desiredObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // DependencyInterpretationApplyConfiguration constructs a declarative configuration of the DependencyInterpretation type for use with
@@ -69,3 +82,11 @@ func (b *DependencyInterpretationApplyConfiguration) WithLuaScript(value string)
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *DependencyInterpretationApplyConfiguration) WithCELExpression(value string) *DependencyInterpretationApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
@@ -45,6 +45,19 @@ type HealthInterpretationApplyConfiguration struct {
 	//
 	// The returned boolean value indicates the health status.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to assess the health
+	// state of a specific resource. It is an alternative to LuaScript, exactly
+	// one of them should be specified.
+	//
+	// The expression should return a boolean value, for example:
+	//
+	// ```
+	// celExpression: 'observedObj.status.readyReplicas == observedObj.spec.replicas'
+	// ```
+	//
+	// The variable observedObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // HealthInterpretationApplyConfiguration constructs a declarative configuration of the HealthInterpretation type for use with
@@ -60,3 +73,11 @@ func (b *HealthInterpretationApplyConfiguration) WithLuaScript(value string) *He
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *HealthInterpretationApplyConfiguration) WithCELExpression(value string) *HealthInterpretationApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
@@ -49,6 +49,20 @@ type LocalValueRetentionApplyConfiguration struct {
 	// The returned object should be a retained configuration which will be
 	// applied to member cluster eventually.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to retain runtime values
+	// to the desired specification. It is an alternative to LuaScript, exactly
+	// one of them should be specified.
+	//
+	// The expression should return the fields to be retained, which will be
+	// merged into the desired object as a JSON merge patch, for example:
+	//
+	// ```
+	// celExpression: '{"spec": {"fieldFoo": observedObj.spec.fieldFoo}}'
+	// ```
+	//
+	// The variables desiredObj and observedObj are supplied by the system,
+	// which have the same meaning as the parameters of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // LocalValueRetentionApplyConfiguration constructs a declarative configuration of the LocalValueRetention type for use with
@@ -64,3 +78,11 @@ func (b *LocalValueRetentionApplyConfiguration) WithLuaScript(value string) *Loc
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *LocalValueRetentionApplyConfiguration) WithCELExpression(value string) *LocalValueRetentionApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// LuaScriptLimitsApplyConfiguration represents a declarative configuration of the LuaScriptLimits type for use
+// with apply.
+//
+// LuaScriptLimits describes the resource limits of running Lua scripts.
+// A script exceeding any of the limits is aborted and the interpretation fails.
+// The heap memory allocated by a script, e.g. for strings and tables, is not
+// limited, since the Lua VM doesn't account for it. Only the data stack and the
+// call stack of the Lua VM are bounded, which limits the memory used by local
+// variables, arguments and nested calls of a script.
+type LuaScriptLimitsApplyConfiguration struct {
+	// TimeoutMilliseconds is the maximum wall-clock time of running a script.
+	// Defaults to 1000.
+	TimeoutMilliseconds *int32 `json:"timeoutMilliseconds,omitempty"`
+	// MaxInstructions is the maximum number of Lua VM instructions executed by
+	// a script. It bounds the CPU time of a script regardless of the load of the
+	// component. It doesn't bound the memory of a script, since a single instruction,
+	// e.g. a string concatenation, allocates in proportion to the size of its operands.
+	// If not set, the number of instructions is not limited.
+	MaxInstructions *int64 `json:"maxInstructions,omitempty"`
+}
+
+// LuaScriptLimitsApplyConfiguration constructs a declarative configuration of the LuaScriptLimits type for use with
+// apply.
+func LuaScriptLimits() *LuaScriptLimitsApplyConfiguration {
+	return &LuaScriptLimitsApplyConfiguration{}
+}
+
+// WithTimeoutMilliseconds sets the TimeoutMilliseconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutMilliseconds field is set to the value of the last call.
+func (b *LuaScriptLimitsApplyConfiguration) WithTimeoutMilliseconds(value int32) *LuaScriptLimitsApplyConfiguration {
+	b.TimeoutMilliseconds = &value
+	return b
+}
+
+// WithMaxInstructions sets the MaxInstructions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxInstructions field is set to the value of the last call.
+func (b *LuaScriptLimitsApplyConfiguration) WithMaxInstructions(value int64) *LuaScriptLimitsApplyConfiguration {
+	b.MaxInstructions = &value
+	return b
+}
@@ -55,6 +55,20 @@ type ReplicaResourceRequirementApplyConfiguration struct {
 	// ResourceBindingSpec.ReplicaRequirements.
 	// The returned values will be set into a ResourceBinding or ClusterResourceBinding.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to discover the resource's
+	// replica as well as resource requirements. It is an alternative to LuaScript,
+	// exactly one of them should be specified.
+	//
+	// The expression should return either the replica as an integer, or a map
+	// with the 'replica' and 'requires' keys, for example:
+	//
+	// ```
+	// celExpression: 'desiredObj.spec.replicas'
+	// ```
+	//
+	// The variable desiredObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // ReplicaResourceRequirementApplyConfiguration constructs a declarative configuration of the ReplicaResourceRequirement type for use with
@@ -70,3 +84,11 @@ func (b *ReplicaResourceRequirementApplyConfiguration) WithLuaScript(value strin
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *ReplicaResourceRequirementApplyConfiguration) WithCELExpression(value string) *ReplicaResourceRequirementApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
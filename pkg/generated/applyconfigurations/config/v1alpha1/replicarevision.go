@@ -45,6 +45,20 @@ type ReplicaRevisionApplyConfiguration struct {
 	// The returned object should be a revised configuration which will be
 	// applied to member cluster eventually.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to revise replicas in
+	// the desired specification. It is an alternative to LuaScript, exactly one
+	// of them should be specified.
+	//
+	// The expression should return the revised fields, which will be merged into
+	// the desired object as a JSON merge patch, for example:
+	//
+	// ```
+	// celExpression: '{"spec": {"replicas": desiredReplica}}'
+	// ```
+	//
+	// The variables desiredObj and desiredReplica are supplied by the system,
+	// which have the same meaning as the parameters of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // ReplicaRevisionApplyConfiguration constructs a declarative configuration of the ReplicaRevision type for use with
@@ -60,3 +74,11 @@ func (b *ReplicaRevisionApplyConfiguration) WithLuaScript(value string) *Replica
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *ReplicaRevisionApplyConfiguration) WithCELExpression(value string) *ReplicaRevisionApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
@@ -66,6 +66,25 @@ type ResourceInterpreterWebhookApplyConfiguration struct {
 	// include any versions known to the Karmada, calls to the webhook will fail
 	// and be subject to the failure policy.
 	InterpreterContextVersions []string `json:"interpreterContextVersions,omitempty"`
+	// MaxBatchSize is the maximum number of objects Karmada puts into one request to the webhook.
+	// When it is greater than 1, concurrent InterpretStatus and InterpretHealth requests for the
+	// same webhook are collected for a short period and sent together in the `requests` field of
+	// `ResourceInterpreterContext`, and the webhook must reply with the `responses` field.
+	// Other operations are always sent one object per request.
+	// Default to nil, which means batch requests are not sent to the webhook.
+	MaxBatchSize *int32 `json:"maxBatchSize,omitempty"`
+	// BatchWindowMilliseconds specifies how long Karmada collects the requests for a batch
+	// before sending it to the webhook, a batch is sent earlier once it reaches MaxBatchSize.
+	// It is only used when MaxBatchSize is greater than 1.
+	// Default to 10 milliseconds.
+	BatchWindowMilliseconds *int32 `json:"batchWindowMilliseconds,omitempty"`
+	// CacheTTLSeconds specifies how long Karmada reuses the results returned by the webhook.
+	// Results are cached by the UID and resourceVersion of the object and the operation, so
+	// a cached result is only reused for the same revision of an object.
+	// Only the results of InterpretStatus, InterpretHealth and InterpretRolloutStatus are cached,
+	// and the cache is dropped once the webhook configuration changes.
+	// Default to nil, which means results are not cached.
+	CacheTTLSeconds *int32 `json:"cacheTTLSeconds,omitempty"`
 }
 
 // ResourceInterpreterWebhookApplyConfiguration constructs a declarative configuration of the ResourceInterpreterWebhook type for use with
@@ -120,3 +139,27 @@ func (b *ResourceInterpreterWebhookApplyConfiguration) WithInterpreterContextVer
 	}
 	return b
 }
+
+// WithMaxBatchSize sets the MaxBatchSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxBatchSize field is set to the value of the last call.
+func (b *ResourceInterpreterWebhookApplyConfiguration) WithMaxBatchSize(value int32) *ResourceInterpreterWebhookApplyConfiguration {
+	b.MaxBatchSize = &value
+	return b
+}
+
+// WithBatchWindowMilliseconds sets the BatchWindowMilliseconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BatchWindowMilliseconds field is set to the value of the last call.
+func (b *ResourceInterpreterWebhookApplyConfiguration) WithBatchWindowMilliseconds(value int32) *ResourceInterpreterWebhookApplyConfiguration {
+	b.BatchWindowMilliseconds = &value
+	return b
+}
+
+// WithCacheTTLSeconds sets the CacheTTLSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CacheTTLSeconds field is set to the value of the last call.
+func (b *ResourceInterpreterWebhookApplyConfiguration) WithCacheTTLSeconds(value int32) *ResourceInterpreterWebhookApplyConfiguration {
+	b.CacheTTLSeconds = &value
+	return b
+}
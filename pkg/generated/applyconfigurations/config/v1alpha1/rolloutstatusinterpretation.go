@@ -0,0 +1,92 @@
+/*
+Copyright The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RolloutStatusInterpretationApplyConfiguration represents a declarative configuration of the RolloutStatusInterpretation type for use
+// with apply.
+//
+// RolloutStatusInterpretation holds the rules for interpreting the rollout progress of a specific resource.
+type RolloutStatusInterpretationApplyConfiguration struct {
+	// LuaScript holds the Lua script that is used to interpret the rollout progress of
+	// a specific resource.
+	// The script should implement a function as follows:
+	//
+	// ```
+	// luaScript: >
+	// function InterpretRolloutStatus(observedObj)
+	// local rolloutStatus = {}
+	// rolloutStatus.generation = observedObj.metadata.generation
+	// rolloutStatus.observedGeneration = observedObj.status.observedGeneration
+	// rolloutStatus.updatedReplicas = observedObj.status.updatedReplicas
+	// if observedObj.status.observedGeneration == observedObj.metadata.generation and
+	// observedObj.status.updatedReplicas == observedObj.spec.replicas then
+	// rolloutStatus.state = "Done"
+	// else
+	// rolloutStatus.state = "Progressing"
+	// end
+	// return rolloutStatus
+	// end
+	// ```
+	//
+	// The content of the LuaScript needs to be a whole function including both
+	// declaration and implementation.
+	//
+	// The parameters will be supplied by the system:
+	// - observedObj: the object represents the configuration that is observed
+	// from a specific member cluster.
+	//
+	// The returned value should be expressed by a RolloutStatus, of which the state
+	// is one of "Done", "Progressing" and "Failed".
+	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to interpret the rollout
+	// progress of a specific resource. It is an alternative to LuaScript, exactly
+	// one of them should be specified.
+	//
+	// The expression should return a RolloutStatus, for example:
+	//
+	// ```
+	// celExpression: '{"state": observedObj.status.updatedReplicas == observedObj.spec.replicas ? "Done" : "Progressing"}'
+	// ```
+	//
+	// The variable observedObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
+}
+
+// RolloutStatusInterpretationApplyConfiguration constructs a declarative configuration of the RolloutStatusInterpretation type for use with
+// apply.
+func RolloutStatusInterpretation() *RolloutStatusInterpretationApplyConfiguration {
+	return &RolloutStatusInterpretationApplyConfiguration{}
+}
+
+// WithLuaScript sets the LuaScript field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LuaScript field is set to the value of the last call.
+func (b *RolloutStatusInterpretationApplyConfiguration) WithLuaScript(value string) *RolloutStatusInterpretationApplyConfiguration {
+	b.LuaScript = &value
+	return b
+}
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *RolloutStatusInterpretationApplyConfiguration) WithCELExpression(value string) *RolloutStatusInterpretationApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
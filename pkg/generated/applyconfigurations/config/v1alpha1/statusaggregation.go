@@ -46,6 +46,20 @@ type StatusAggregationApplyConfiguration struct {
 	//
 	// The returned object should be a whole object with status aggregated.
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to aggregate decentralized
+	// statuses to the desired specification. It is an alternative to LuaScript,
+	// exactly one of them should be specified.
+	//
+	// The expression should return the aggregated fields, which will be merged
+	// into the desired object as a JSON merge patch, for example:
+	//
+	// ```
+	// celExpression: '{"status": {"readyReplicas": statusItems.map(item, item.status.readyReplicas).sum()}}'
+	// ```
+	//
+	// The variables desiredObj and statusItems are supplied by the system,
+	// which have the same meaning as the parameters of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // StatusAggregationApplyConfiguration constructs a declarative configuration of the StatusAggregation type for use with
@@ -61,3 +75,11 @@ func (b *StatusAggregationApplyConfiguration) WithLuaScript(value string) *Statu
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *StatusAggregationApplyConfiguration) WithCELExpression(value string) *StatusAggregationApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
@@ -45,6 +45,19 @@ type StatusReflectionApplyConfiguration struct {
 	// The returned status could be the whole status or part of it and will
 	// be set into both Work and ResourceBinding(ClusterResourceBinding).
 	LuaScript *string `json:"luaScript,omitempty"`
+	// CELExpression holds the CEL expression that is used to get the status from
+	// the observed specification. It is an alternative to LuaScript, exactly one
+	// of them should be specified.
+	//
+	// The expression should return the status as a map, for example:
+	//
+	// ```
+	// celExpression: '{"readyReplicas": observedObj.status.readyReplicas}'
+	// ```
+	//
+	// The variable observedObj is supplied by the system, which has the same
+	// meaning as the parameter of the Lua script.
+	CELExpression *string `json:"celExpression,omitempty"`
 }
 
 // StatusReflectionApplyConfiguration constructs a declarative configuration of the StatusReflection type for use with
@@ -60,3 +73,11 @@ func (b *StatusReflectionApplyConfiguration) WithLuaScript(value string) *Status
 	b.LuaScript = &value
 	return b
 }
+
+// WithCELExpression sets the CELExpression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CELExpression field is set to the value of the last call.
+func (b *StatusReflectionApplyConfiguration) WithCELExpression(value string) *StatusReflectionApplyConfiguration {
+	b.CELExpression = &value
+	return b
+}
@@ -506,10 +506,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ComponentResourceRequirement
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.CustomizationRules
   map:
     fields:
@@ -522,6 +524,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: healthInterpretation
       type:
         namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.HealthInterpretation
+    - name: luaScriptLimits
+      type:
+        namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.LuaScriptLimits
     - name: replicaResource
       type:
         namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ReplicaResourceRequirement
@@ -531,6 +536,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: retention
       type:
         namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.LocalValueRetention
+    - name: rolloutStatusInterpretation
+      type:
+        namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.RolloutStatusInterpretation
     - name: statusAggregation
       type:
         namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.StatusAggregation
@@ -551,38 +559,57 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.DependencyInterpretation
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.HealthInterpretation
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.LocalValueRetention
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
+- name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.LuaScriptLimits
+  map:
+    fields:
+    - name: maxInstructions
+      type:
+        scalar: numeric
+    - name: timeoutMilliseconds
+      type:
+        scalar: numeric
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ReplicaResourceRequirement
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ReplicaRevision
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ResourceInterpreterCustomization
   map:
     fields:
@@ -614,6 +641,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ResourceInterpreterWebhook
   map:
     fields:
+    - name: batchWindowMilliseconds
+      type:
+        scalar: numeric
+    - name: cacheTTLSeconds
+      type:
+        scalar: numeric
     - name: clientConfig
       type:
         namedType: io.k8s.api.admissionregistration.v1.WebhookClientConfig
@@ -624,6 +657,9 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: maxBatchSize
+      type:
+        scalar: numeric
     - name: name
       type:
         scalar: string
@@ -656,6 +692,15 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.ResourceInterpreterWebhook
           elementRelationship: atomic
+- name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.RolloutStatusInterpretation
+  map:
+    fields:
+    - name: celExpression
+      type:
+        scalar: string
+    - name: luaScript
+      type:
+        scalar: string
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.RuleWithOperations
   map:
     fields:
@@ -686,17 +731,21 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.StatusAggregation
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.config.v1alpha1.StatusReflection
   map:
     fields:
+    - name: celExpression
+      type:
+        scalar: string
     - name: luaScript
       type:
         scalar: string
-      default: ""
 - name: com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.ClusterSelector
   map:
     fields:
@@ -821,12 +870,26 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: trafficPolicy
+      type:
+        namedType: com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.TrafficPolicy
     - name: types
       type:
         list:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.ProviderWeight
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: weight
+      type:
+        scalar: numeric
+      default: 0
 - name: com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.ServiceLocation
   map:
     fields:
@@ -840,6 +903,18 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.TrafficPolicy
+  map:
+    fields:
+    - name: localityPreference
+      type:
+        scalar: string
+    - name: providerWeights
+      type:
+        list:
+          elementType:
+            namedType: com.github.karmada-io.karmada.pkg.apis.networking.v1alpha1.ProviderWeight
+          elementRelationship: atomic
 - name: com.github.karmada-io.karmada.pkg.apis.policy.v1alpha1.ApplicationFailoverBehavior
   map:
     fields:
@@ -1807,6 +1882,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.ResourceIdentifier
       default: {}
+    - name: rolloutStatus
+      type:
+        namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.RolloutStatus
     - name: status
       type:
         namedType: __untyped_atomic_
@@ -1917,6 +1995,31 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.RolloutStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: generation
+      type:
+        scalar: numeric
+    - name: message
+      type:
+        scalar: string
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: state
+      type:
+        scalar: string
+      default: ""
+    - name: updatedReplicas
+      type:
+        scalar: numeric
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha1.TargetCluster
   map:
     fields:
@@ -2001,6 +2104,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: health
       type:
         scalar: string
+    - name: rolloutStatus
+      type:
+        namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.RolloutStatus
     - name: status
       type:
         namedType: __untyped_atomic_
@@ -2058,6 +2164,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.ComponentReplicaRequirements
   map:
     fields:
+    - name: deviceRequests
+      type:
+        list:
+          elementType:
+            namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.DeviceRequest
+          elementRelationship: atomic
     - name: nodeClaim
       type:
         namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.NodeClaim
@@ -2069,6 +2181,22 @@ var schemaYAML = typed.YAMLObject(`types:
         map:
           elementType:
             namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+- name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.DeviceRequest
+  map:
+    fields:
+    - name: count
+      type:
+        scalar: numeric
+    - name: deviceClassName
+      type:
+        scalar: string
+      default: ""
+    - name: selectors
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.GracefulEvictionTask
   map:
     fields:
@@ -2119,17 +2247,40 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: hardNodeAffinity
       type:
         namedType: io.k8s.api.core.v1.NodeSelector
+    - name: hardPodAffinity
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.PodAffinityTerm
+          elementRelationship: atomic
+    - name: hardPodAntiAffinity
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.PodAffinityTerm
+          elementRelationship: atomic
     - name: nodeSelector
       type:
         map:
           elementType:
             scalar: string
+    - name: podLabels
+      type:
+        map:
+          elementType:
+            scalar: string
     - name: tolerations
       type:
         list:
           elementType:
             namedType: io.k8s.api.core.v1.Toleration
           elementRelationship: atomic
+    - name: topologySpreadConstraints
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.TopologySpreadConstraint
+          elementRelationship: atomic
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.ObjectReference
   map:
     fields:
@@ -2157,6 +2308,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.ReplicaRequirements
   map:
     fields:
+    - name: deviceRequests
+      type:
+        list:
+          elementType:
+            namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.DeviceRequest
+          elementRelationship: atomic
     - name: namespace
       type:
         scalar: string
@@ -2171,6 +2328,12 @@ var schemaYAML = typed.YAMLObject(`types:
         map:
           elementType:
             namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: storageRequests
+      type:
+        list:
+          elementType:
+            namedType: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.StorageRequest
+          elementRelationship: atomic
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.ResourceBinding
   map:
     fields:
@@ -2283,12 +2446,46 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: schedulerObservingAffinityName
       type:
         scalar: string
+- name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.RolloutStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: generation
+      type:
+        scalar: numeric
+    - name: message
+      type:
+        scalar: string
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: state
+      type:
+        scalar: string
+      default: ""
+    - name: updatedReplicas
+      type:
+        scalar: numeric
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.SchedulePriority
   map:
     fields:
     - name: priority
       type:
         scalar: numeric
+- name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.StorageRequest
+  map:
+    fields:
+    - name: storage
+      type:
+        namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: storageClassName
+      type:
+        scalar: string
 - name: com.github.karmada-io.karmada.pkg.apis.work.v1alpha2.Suspension
   map:
     fields:
@@ -2750,6 +2947,37 @@ var schemaYAML = typed.YAMLObject(`types:
             namedType: io.k8s.api.core.v1.NodeSelectorRequirement
           elementRelationship: atomic
     elementRelationship: atomic
+- name: io.k8s.api.core.v1.PodAffinityTerm
+  map:
+    fields:
+    - name: labelSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: matchLabelKeys
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: mismatchLabelKeys
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: namespaceSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: namespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: topologyKey
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.api.core.v1.PortStatus
   map:
     fields:
@@ -2814,6 +3042,39 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: value
       type:
         scalar: string
+- name: io.k8s.api.core.v1.TopologySpreadConstraint
+  map:
+    fields:
+    - name: labelSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: matchLabelKeys
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: maxSkew
+      type:
+        scalar: numeric
+      default: 0
+    - name: minDomains
+      type:
+        scalar: numeric
+    - name: nodeAffinityPolicy
+      type:
+        scalar: string
+    - name: nodeTaintsPolicy
+      type:
+        scalar: string
+    - name: topologyKey
+      type:
+        scalar: string
+      default: ""
+    - name: whenUnsatisfiable
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.api.core.v1.TypedLocalObjectReference
   map:
     fields:
@@ -59,6 +59,12 @@ type MultiClusterServiceSpecApplyConfiguration struct {
 	// ConsumerClusters specifies the clusters where the service will be exposed, for clients.
 	// If leave it empty, the service will be exposed to all clusters.
 	ConsumerClusters []ClusterSelectorApplyConfiguration `json:"consumerClusters,omitempty"`
+	// TrafficPolicy describes how the traffic from the consumer clusters is
+	// routed among the provider clusters.
+	// Only valid in case of Types contains CrossCluster.
+	// If not set, the EndpointSlices of all provider clusters will be
+	// dispatched to every consumer cluster unchanged.
+	TrafficPolicy *TrafficPolicyApplyConfiguration `json:"trafficPolicy,omitempty"`
 }
 
 // MultiClusterServiceSpecApplyConfiguration constructs a declarative configuration of the MultiClusterServiceSpec type for use with
@@ -143,3 +149,11 @@ func (b *MultiClusterServiceSpecApplyConfiguration) WithConsumerClusters(values
 	}
 	return b
 }
+
+// WithTrafficPolicy sets the TrafficPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrafficPolicy field is set to the value of the last call.
+func (b *MultiClusterServiceSpecApplyConfiguration) WithTrafficPolicy(value *TrafficPolicyApplyConfiguration) *MultiClusterServiceSpecApplyConfiguration {
+	b.TrafficPolicy = value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ProviderWeightApplyConfiguration represents a declarative configuration of the ProviderWeight type for use
+// with apply.
+//
+// ProviderWeight describes the weight of a provider cluster.
+type ProviderWeightApplyConfiguration struct {
+	// Name is the name of the provider cluster.
+	Name *string `json:"name,omitempty"`
+	// Weight is the weight of the provider cluster relative to the others.
+	// A weight of 0 means that no endpoint of the provider cluster is dispatched.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// ProviderWeightApplyConfiguration constructs a declarative configuration of the ProviderWeight type for use with
+// apply.
+func ProviderWeight() *ProviderWeightApplyConfiguration {
+	return &ProviderWeightApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ProviderWeightApplyConfiguration) WithName(value string) *ProviderWeightApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *ProviderWeightApplyConfiguration) WithWeight(value int32) *ProviderWeightApplyConfiguration {
+	b.Weight = &value
+	return b
+}
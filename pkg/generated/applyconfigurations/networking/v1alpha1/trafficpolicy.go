@@ -0,0 +1,75 @@
+/*
+Copyright The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+)
+
+// TrafficPolicyApplyConfiguration represents a declarative configuration of the TrafficPolicy type for use
+// with apply.
+//
+// TrafficPolicy describes how the traffic is routed among the provider clusters.
+type TrafficPolicyApplyConfiguration struct {
+	// LocalityPreference makes the consumer clusters prefer the provider clusters
+	// located in the same region or zone as themselves.
+	// The endpoints of the provider clusters out of the locality will be dispatched
+	// to a consumer cluster only if none of the provider clusters in its locality
+	// has ready endpoints.
+	// A cluster without the region or zone set is not considered local to any
+	// other cluster.
+	// If not set, all provider clusters are treated equally.
+	LocalityPreference *networkingv1alpha1.LocalityPreference `json:"localityPreference,omitempty"`
+	// ProviderWeights specifies the weights of the provider clusters.
+	// The weights are relative: the ready endpoints of the provider clusters are
+	// dispatched in numbers in proportion to the weights, so that each provider
+	// cluster receives a share of the traffic in proportion to its weight. The
+	// provider cluster with the fewest ready endpoints relative to its weight
+	// dispatches all of them, and a provider cluster with a non-zero weight
+	// dispatches at least one endpoint.
+	// The provider clusters not listed here have a weight of 100.
+	ProviderWeights []ProviderWeightApplyConfiguration `json:"providerWeights,omitempty"`
+}
+
+// TrafficPolicyApplyConfiguration constructs a declarative configuration of the TrafficPolicy type for use with
+// apply.
+func TrafficPolicy() *TrafficPolicyApplyConfiguration {
+	return &TrafficPolicyApplyConfiguration{}
+}
+
+// WithLocalityPreference sets the LocalityPreference field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LocalityPreference field is set to the value of the last call.
+func (b *TrafficPolicyApplyConfiguration) WithLocalityPreference(value networkingv1alpha1.LocalityPreference) *TrafficPolicyApplyConfiguration {
+	b.LocalityPreference = &value
+	return b
+}
+
+// WithProviderWeights adds the given value to the ProviderWeights field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ProviderWeights field.
+func (b *TrafficPolicyApplyConfiguration) WithProviderWeights(values ...*ProviderWeightApplyConfiguration) *TrafficPolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithProviderWeights")
+		}
+		b.ProviderWeights = append(b.ProviderWeights, *values[i])
+	}
+	return b
+}
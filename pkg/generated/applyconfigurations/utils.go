@@ -118,6 +118,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsconfigv1alpha1.HealthInterpretationApplyConfiguration{}
 	case configv1alpha1.SchemeGroupVersion.WithKind("LocalValueRetention"):
 		return &applyconfigurationsconfigv1alpha1.LocalValueRetentionApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("LuaScriptLimits"):
+		return &applyconfigurationsconfigv1alpha1.LuaScriptLimitsApplyConfiguration{}
 	case configv1alpha1.SchemeGroupVersion.WithKind("ReplicaResourceRequirement"):
 		return &applyconfigurationsconfigv1alpha1.ReplicaResourceRequirementApplyConfiguration{}
 	case configv1alpha1.SchemeGroupVersion.WithKind("ReplicaRevision"):
@@ -130,6 +132,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsconfigv1alpha1.ResourceInterpreterWebhookApplyConfiguration{}
 	case configv1alpha1.SchemeGroupVersion.WithKind("ResourceInterpreterWebhookConfiguration"):
 		return &applyconfigurationsconfigv1alpha1.ResourceInterpreterWebhookConfigurationApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("RolloutStatusInterpretation"):
+		return &applyconfigurationsconfigv1alpha1.RolloutStatusInterpretationApplyConfiguration{}
 	case configv1alpha1.SchemeGroupVersion.WithKind("Rule"):
 		return &applyconfigurationsconfigv1alpha1.RuleApplyConfiguration{}
 	case configv1alpha1.SchemeGroupVersion.WithKind("RuleWithOperations"):
@@ -154,8 +158,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsnetworkingv1alpha1.MultiClusterServiceApplyConfiguration{}
 	case networkingv1alpha1.SchemeGroupVersion.WithKind("MultiClusterServiceSpec"):
 		return &applyconfigurationsnetworkingv1alpha1.MultiClusterServiceSpecApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("ProviderWeight"):
+		return &applyconfigurationsnetworkingv1alpha1.ProviderWeightApplyConfiguration{}
 	case networkingv1alpha1.SchemeGroupVersion.WithKind("ServiceLocation"):
 		return &applyconfigurationsnetworkingv1alpha1.ServiceLocationApplyConfiguration{}
+	case networkingv1alpha1.SchemeGroupVersion.WithKind("TrafficPolicy"):
+		return &applyconfigurationsnetworkingv1alpha1.TrafficPolicyApplyConfiguration{}
 
 		// Group=policy.karmada.io, Version=v1alpha1
 	case policyv1alpha1.SchemeGroupVersion.WithKind("ApplicationFailoverBehavior"):
@@ -298,6 +306,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsworkv1alpha1.ResourceBindingStatusApplyConfiguration{}
 	case workv1alpha1.SchemeGroupVersion.WithKind("ResourceIdentifier"):
 		return &applyconfigurationsworkv1alpha1.ResourceIdentifierApplyConfiguration{}
+	case workv1alpha1.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &applyconfigurationsworkv1alpha1.RolloutStatusApplyConfiguration{}
 	case workv1alpha1.SchemeGroupVersion.WithKind("TargetCluster"):
 		return &applyconfigurationsworkv1alpha1.TargetClusterApplyConfiguration{}
 	case workv1alpha1.SchemeGroupVersion.WithKind("Work"):
@@ -320,6 +330,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workv1alpha2.ComponentApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ComponentReplicaRequirements"):
 		return &workv1alpha2.ComponentReplicaRequirementsApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("DeviceRequest"):
+		return &workv1alpha2.DeviceRequestApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("GracefulEvictionTask"):
 		return &workv1alpha2.GracefulEvictionTaskApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("NodeClaim"):
@@ -334,8 +346,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workv1alpha2.ResourceBindingSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ResourceBindingStatus"):
 		return &workv1alpha2.ResourceBindingStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("RolloutStatus"):
+		return &workv1alpha2.RolloutStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("SchedulePriority"):
 		return &workv1alpha2.SchedulePriorityApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("StorageRequest"):
+		return &workv1alpha2.StorageRequestApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("Suspension"):
 		return &workv1alpha2.SuspensionApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("TargetCluster"):
@@ -35,6 +35,9 @@ type ManifestStatusApplyConfiguration struct {
 	// Health represents the healthy state of the current resource.
 	// There maybe different rules for different resources to achieve health status.
 	Health *workv1alpha1.ResourceHealth `json:"health,omitempty"`
+	// RolloutStatus represents the rollout progress of the current resource.
+	// It is only set for those resources that the rollout status can be interpreted.
+	RolloutStatus *RolloutStatusApplyConfiguration `json:"rolloutStatus,omitempty"`
 }
 
 // ManifestStatusApplyConfiguration constructs a declarative configuration of the ManifestStatus type for use with
@@ -66,3 +69,11 @@ func (b *ManifestStatusApplyConfiguration) WithHealth(value workv1alpha1.Resourc
 	b.Health = &value
 	return b
 }
+
+// WithRolloutStatus sets the RolloutStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutStatus field is set to the value of the last call.
+func (b *ManifestStatusApplyConfiguration) WithRolloutStatus(value *RolloutStatusApplyConfiguration) *ManifestStatusApplyConfiguration {
+	b.RolloutStatus = value
+	return b
+}
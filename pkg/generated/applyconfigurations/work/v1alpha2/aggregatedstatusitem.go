@@ -41,6 +41,9 @@ type AggregatedStatusItemApplyConfiguration struct {
 	// Health represents the healthy state of the current resource.
 	// There maybe different rules for different resources to achieve health status.
 	Health *workv1alpha2.ResourceHealth `json:"health,omitempty"`
+	// RolloutStatus represents the rollout progress of the current resource.
+	// It is only set for those resources that the rollout status can be interpreted.
+	RolloutStatus *RolloutStatusApplyConfiguration `json:"rolloutStatus,omitempty"`
 }
 
 // AggregatedStatusItemApplyConfiguration constructs a declarative configuration of the AggregatedStatusItem type for use with
@@ -88,3 +91,11 @@ func (b *AggregatedStatusItemApplyConfiguration) WithHealth(value workv1alpha2.R
 	b.Health = &value
 	return b
 }
+
+// WithRolloutStatus sets the RolloutStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutStatus field is set to the value of the last call.
+func (b *AggregatedStatusItemApplyConfiguration) WithRolloutStatus(value *RolloutStatusApplyConfiguration) *AggregatedStatusItemApplyConfiguration {
+	b.RolloutStatus = value
+	return b
+}
@@ -33,6 +33,9 @@ type ComponentReplicaRequirementsApplyConfiguration struct {
 	ResourceRequest *v1.ResourceList `json:"resourceRequest,omitempty"`
 	// PriorityClassName represents the resources priorityClassName
 	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// DeviceRequests represents the devices required by each replica, which are
+	// allocated through Dynamic Resource Allocation in member clusters.
+	DeviceRequests []DeviceRequestApplyConfiguration `json:"deviceRequests,omitempty"`
 }
 
 // ComponentReplicaRequirementsApplyConfiguration constructs a declarative configuration of the ComponentReplicaRequirements type for use with
@@ -64,3 +67,16 @@ func (b *ComponentReplicaRequirementsApplyConfiguration) WithPriorityClassName(v
 	b.PriorityClassName = &value
 	return b
 }
+
+// WithDeviceRequests adds the given value to the DeviceRequests field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DeviceRequests field.
+func (b *ComponentReplicaRequirementsApplyConfiguration) WithDeviceRequests(values ...*DeviceRequestApplyConfiguration) *ComponentReplicaRequirementsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDeviceRequests")
+		}
+		b.DeviceRequests = append(b.DeviceRequests, *values[i])
+	}
+	return b
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
@@ -37,6 +38,21 @@ type NodeClaimApplyConfiguration struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	// If specified, the pod's tolerations.
 	Tolerations []v1.TolerationApplyConfiguration `json:"tolerations,omitempty"`
+	// PodLabels is the labels of the pod, which are used to evaluate HardPodAffinity, HardPodAntiAffinity
+	// and TopologySpreadConstraints against the replicas themselves.
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+	// HardPodAffinity represents the inter-pod affinity terms of the pod.
+	// Note that only PodSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	// is included here because it has a hard limit on pod scheduling.
+	HardPodAffinity []corev1.PodAffinityTerm `json:"hardPodAffinity,omitempty"`
+	// HardPodAntiAffinity represents the inter-pod anti-affinity terms of the pod.
+	// Note that only PodSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	// is included here because it has a hard limit on pod scheduling.
+	HardPodAntiAffinity []corev1.PodAffinityTerm `json:"hardPodAntiAffinity,omitempty"`
+	// TopologySpreadConstraints represents the topology spread constraints of the pod.
+	// Note that only the constraints with WhenUnsatisfiable DoNotSchedule are included here
+	// because they have a hard limit on pod scheduling.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // NodeClaimApplyConfiguration constructs a declarative configuration of the NodeClaim type for use with
@@ -79,3 +95,47 @@ func (b *NodeClaimApplyConfiguration) WithTolerations(values ...*v1.TolerationAp
 	}
 	return b
 }
+
+// WithPodLabels puts the entries into the PodLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the PodLabels field,
+// overwriting an existing map entries in PodLabels field with the same key.
+func (b *NodeClaimApplyConfiguration) WithPodLabels(entries map[string]string) *NodeClaimApplyConfiguration {
+	if b.PodLabels == nil && len(entries) > 0 {
+		b.PodLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.PodLabels[k] = v
+	}
+	return b
+}
+
+// WithHardPodAffinity adds the given value to the HardPodAffinity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HardPodAffinity field.
+func (b *NodeClaimApplyConfiguration) WithHardPodAffinity(values ...corev1.PodAffinityTerm) *NodeClaimApplyConfiguration {
+	for i := range values {
+		b.HardPodAffinity = append(b.HardPodAffinity, values[i])
+	}
+	return b
+}
+
+// WithHardPodAntiAffinity adds the given value to the HardPodAntiAffinity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HardPodAntiAffinity field.
+func (b *NodeClaimApplyConfiguration) WithHardPodAntiAffinity(values ...corev1.PodAffinityTerm) *NodeClaimApplyConfiguration {
+	for i := range values {
+		b.HardPodAntiAffinity = append(b.HardPodAntiAffinity, values[i])
+	}
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *NodeClaimApplyConfiguration) WithTopologySpreadConstraints(values ...corev1.TopologySpreadConstraint) *NodeClaimApplyConfiguration {
+	for i := range values {
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
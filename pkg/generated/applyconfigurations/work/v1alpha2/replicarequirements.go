@@ -35,6 +35,12 @@ type ReplicaRequirementsApplyConfiguration struct {
 	Namespace *string `json:"namespace,omitempty"`
 	// PriorityClassName represents the resources priorityClassName
 	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// DeviceRequests represents the devices required by each replica, which are
+	// allocated through Dynamic Resource Allocation in member clusters.
+	DeviceRequests []DeviceRequestApplyConfiguration `json:"deviceRequests,omitempty"`
+	// StorageRequests represents the persistent volumes required by each replica,
+	// e.g. the volumes claimed by the volumeClaimTemplates of a StatefulSet.
+	StorageRequests []StorageRequestApplyConfiguration `json:"storageRequests,omitempty"`
 }
 
 // ReplicaRequirementsApplyConfiguration constructs a declarative configuration of the ReplicaRequirements type for use with
@@ -74,3 +80,29 @@ func (b *ReplicaRequirementsApplyConfiguration) WithPriorityClassName(value stri
 	b.PriorityClassName = &value
 	return b
 }
+
+// WithDeviceRequests adds the given value to the DeviceRequests field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DeviceRequests field.
+func (b *ReplicaRequirementsApplyConfiguration) WithDeviceRequests(values ...*DeviceRequestApplyConfiguration) *ReplicaRequirementsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDeviceRequests")
+		}
+		b.DeviceRequests = append(b.DeviceRequests, *values[i])
+	}
+	return b
+}
+
+// WithStorageRequests adds the given value to the StorageRequests field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StorageRequests field.
+func (b *ReplicaRequirementsApplyConfiguration) WithStorageRequests(values ...*StorageRequestApplyConfiguration) *ReplicaRequirementsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithStorageRequests")
+		}
+		b.StorageRequests = append(b.StorageRequests, *values[i])
+	}
+	return b
+}
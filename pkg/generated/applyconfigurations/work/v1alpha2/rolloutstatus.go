@@ -0,0 +1,106 @@
+/*
+Copyright The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// RolloutStatusApplyConfiguration represents a declarative configuration of the RolloutStatus type for use
+// with apply.
+//
+// RolloutStatus represents the rollout progress of a resource in a member cluster.
+type RolloutStatusApplyConfiguration struct {
+	// State represents whether the resource has finished rolling out its latest generation.
+	State *workv1alpha2.RolloutState `json:"state,omitempty"`
+	// Generation is the generation of the resource in the member cluster.
+	Generation *int64 `json:"generation,omitempty"`
+	// ObservedGeneration is the generation observed by the controller of the resource in the member cluster.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// UpdatedReplicas is the number of replicas that have been updated to the latest generation.
+	UpdatedReplicas *int32 `json:"updatedReplicas,omitempty"`
+	// ReadyReplicas is the number of replicas that are ready.
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas is the number of replicas that are available.
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+	// Message is a human-readable message indicating details about the rollout, e.g. the reason of a failed rollout.
+	Message *string `json:"message,omitempty"`
+}
+
+// RolloutStatusApplyConfiguration constructs a declarative configuration of the RolloutStatus type for use with
+// apply.
+func RolloutStatus() *RolloutStatusApplyConfiguration {
+	return &RolloutStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithState(value workv1alpha2.RolloutState) *RolloutStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithGeneration(value int64) *RolloutStatusApplyConfiguration {
+	b.Generation = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithObservedGeneration(value int64) *RolloutStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithUpdatedReplicas sets the UpdatedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedReplicas field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithUpdatedReplicas(value int32) *RolloutStatusApplyConfiguration {
+	b.UpdatedReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithReadyReplicas(value int32) *RolloutStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithAvailableReplicas sets the AvailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailableReplicas field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithAvailableReplicas(value int32) *RolloutStatusApplyConfiguration {
+	b.AvailableReplicas = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *RolloutStatusApplyConfiguration) WithMessage(value string) *RolloutStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
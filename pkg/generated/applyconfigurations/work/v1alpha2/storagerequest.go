@@ -0,0 +1,57 @@
+/*
+Copyright The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// StorageRequestApplyConfiguration represents a declarative configuration of the StorageRequest type for use
+// with apply.
+//
+// StorageRequest represents a persistent volume required by each replica.
+type StorageRequestApplyConfiguration struct {
+	// StorageClassName is the name of the StorageClass the volume is provisioned from.
+	// If not set, the volume is provisioned from the default StorageClass of member clusters.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Storage is the size of the volume.
+	Storage *resource.Quantity `json:"storage,omitempty"`
+}
+
+// StorageRequestApplyConfiguration constructs a declarative configuration of the StorageRequest type for use with
+// apply.
+func StorageRequest() *StorageRequestApplyConfiguration {
+	return &StorageRequestApplyConfiguration{}
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *StorageRequestApplyConfiguration) WithStorageClassName(value string) *StorageRequestApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *StorageRequestApplyConfiguration) WithStorage(value resource.Quantity) *StorageRequestApplyConfiguration {
+	b.Storage = &value
+	return b
+}
@@ -88,6 +88,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		configv1alpha1.DependentObjectReference{}.OpenAPIModelName():                    schema_pkg_apis_config_v1alpha1_DependentObjectReference(ref),
 		configv1alpha1.HealthInterpretation{}.OpenAPIModelName():                        schema_pkg_apis_config_v1alpha1_HealthInterpretation(ref),
 		configv1alpha1.LocalValueRetention{}.OpenAPIModelName():                         schema_pkg_apis_config_v1alpha1_LocalValueRetention(ref),
+		configv1alpha1.LuaScriptLimits{}.OpenAPIModelName():                             schema_pkg_apis_config_v1alpha1_LuaScriptLimits(ref),
 		configv1alpha1.ReplicaResourceRequirement{}.OpenAPIModelName():                  schema_pkg_apis_config_v1alpha1_ReplicaResourceRequirement(ref),
 		configv1alpha1.ReplicaRevision{}.OpenAPIModelName():                             schema_pkg_apis_config_v1alpha1_ReplicaRevision(ref),
 		configv1alpha1.RequestStatus{}.OpenAPIModelName():                               schema_pkg_apis_config_v1alpha1_RequestStatus(ref),
@@ -100,6 +101,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		configv1alpha1.ResourceInterpreterWebhook{}.OpenAPIModelName():                  schema_pkg_apis_config_v1alpha1_ResourceInterpreterWebhook(ref),
 		configv1alpha1.ResourceInterpreterWebhookConfiguration{}.OpenAPIModelName():     schema_pkg_apis_config_v1alpha1_ResourceInterpreterWebhookConfiguration(ref),
 		configv1alpha1.ResourceInterpreterWebhookConfigurationList{}.OpenAPIModelName(): schema_pkg_apis_config_v1alpha1_ResourceInterpreterWebhookConfigurationList(ref),
+		configv1alpha1.RolloutStatusInterpretation{}.OpenAPIModelName():                 schema_pkg_apis_config_v1alpha1_RolloutStatusInterpretation(ref),
 		configv1alpha1.Rule{}.OpenAPIModelName():                                        schema_pkg_apis_config_v1alpha1_Rule(ref),
 		configv1alpha1.RuleWithOperations{}.OpenAPIModelName():                          schema_pkg_apis_config_v1alpha1_RuleWithOperations(ref),
 		configv1alpha1.StatusAggregation{}.OpenAPIModelName():                           schema_pkg_apis_config_v1alpha1_StatusAggregation(ref),
@@ -113,7 +115,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		networkingv1alpha1.MultiClusterService{}.OpenAPIModelName():                     schema_pkg_apis_networking_v1alpha1_MultiClusterService(ref),
 		networkingv1alpha1.MultiClusterServiceList{}.OpenAPIModelName():                 schema_pkg_apis_networking_v1alpha1_MultiClusterServiceList(ref),
 		networkingv1alpha1.MultiClusterServiceSpec{}.OpenAPIModelName():                 schema_pkg_apis_networking_v1alpha1_MultiClusterServiceSpec(ref),
+		networkingv1alpha1.ProviderWeight{}.OpenAPIModelName():                          schema_pkg_apis_networking_v1alpha1_ProviderWeight(ref),
 		networkingv1alpha1.ServiceLocation{}.OpenAPIModelName():                         schema_pkg_apis_networking_v1alpha1_ServiceLocation(ref),
+		networkingv1alpha1.TrafficPolicy{}.OpenAPIModelName():                           schema_pkg_apis_networking_v1alpha1_TrafficPolicy(ref),
 		policyv1alpha1.ApplicationFailoverBehavior{}.OpenAPIModelName():                 schema_pkg_apis_policy_v1alpha1_ApplicationFailoverBehavior(ref),
 		policyv1alpha1.ClusterAffinity{}.OpenAPIModelName():                             schema_pkg_apis_policy_v1alpha1_ClusterAffinity(ref),
 		policyv1alpha1.ClusterAffinityTerm{}.OpenAPIModelName():                         schema_pkg_apis_policy_v1alpha1_ClusterAffinityTerm(ref),
@@ -193,6 +197,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		workv1alpha1.ResourceBindingSpec{}.OpenAPIModelName():                           schema_pkg_apis_work_v1alpha1_ResourceBindingSpec(ref),
 		workv1alpha1.ResourceBindingStatus{}.OpenAPIModelName():                         schema_pkg_apis_work_v1alpha1_ResourceBindingStatus(ref),
 		workv1alpha1.ResourceIdentifier{}.OpenAPIModelName():                            schema_pkg_apis_work_v1alpha1_ResourceIdentifier(ref),
+		workv1alpha1.RolloutStatus{}.OpenAPIModelName():                                 schema_pkg_apis_work_v1alpha1_RolloutStatus(ref),
 		workv1alpha1.TargetCluster{}.OpenAPIModelName():                                 schema_pkg_apis_work_v1alpha1_TargetCluster(ref),
 		workv1alpha1.Work{}.OpenAPIModelName():                                          schema_pkg_apis_work_v1alpha1_Work(ref),
 		workv1alpha1.WorkList{}.OpenAPIModelName():                                      schema_pkg_apis_work_v1alpha1_WorkList(ref),
@@ -205,6 +210,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha2.ClusterResourceBindingList{}.OpenAPIModelName():                        schema_pkg_apis_work_v1alpha2_ClusterResourceBindingList(ref),
 		v1alpha2.Component{}.OpenAPIModelName():                                         schema_pkg_apis_work_v1alpha2_Component(ref),
 		v1alpha2.ComponentReplicaRequirements{}.OpenAPIModelName():                      schema_pkg_apis_work_v1alpha2_ComponentReplicaRequirements(ref),
+		v1alpha2.DeviceRequest{}.OpenAPIModelName():                                     schema_pkg_apis_work_v1alpha2_DeviceRequest(ref),
 		v1alpha2.GracefulEvictionTask{}.OpenAPIModelName():                              schema_pkg_apis_work_v1alpha2_GracefulEvictionTask(ref),
 		v1alpha2.NodeClaim{}.OpenAPIModelName():                                         schema_pkg_apis_work_v1alpha2_NodeClaim(ref),
 		v1alpha2.ObjectReference{}.OpenAPIModelName():                                   schema_pkg_apis_work_v1alpha2_ObjectReference(ref),
@@ -213,7 +219,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1alpha2.ResourceBindingList{}.OpenAPIModelName():                               schema_pkg_apis_work_v1alpha2_ResourceBindingList(ref),
 		v1alpha2.ResourceBindingSpec{}.OpenAPIModelName():                               schema_pkg_apis_work_v1alpha2_ResourceBindingSpec(ref),
 		v1alpha2.ResourceBindingStatus{}.OpenAPIModelName():                             schema_pkg_apis_work_v1alpha2_ResourceBindingStatus(ref),
+		v1alpha2.RolloutStatus{}.OpenAPIModelName():                                     schema_pkg_apis_work_v1alpha2_RolloutStatus(ref),
 		v1alpha2.SchedulePriority{}.OpenAPIModelName():                                  schema_pkg_apis_work_v1alpha2_SchedulePriority(ref),
+		v1alpha2.StorageRequest{}.OpenAPIModelName():                                    schema_pkg_apis_work_v1alpha2_StorageRequest(ref),
 		v1alpha2.Suspension{}.OpenAPIModelName():                                        schema_pkg_apis_work_v1alpha2_Suspension(ref),
 		v1alpha2.TargetCluster{}.OpenAPIModelName():                                     schema_pkg_apis_work_v1alpha2_TargetCluster(ref),
 		v1alpha2.TargetComponent{}.OpenAPIModelName():                                   schema_pkg_apis_work_v1alpha2_TargetComponent(ref),
@@ -2102,13 +2110,18 @@ func schema_pkg_apis_config_v1alpha1_ComponentResourceRequirement(ref common.Ref
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to extract the desired replica count and resource requirements for each component of the resource.\n\nThe script should implement a function as follows:\n\n```\n  luaScript: >\n      function GetComponents(desiredObj)\n          local components = {}\n\n          local jobManagerComponent = {\n              name = \"jobmanager\",\n              replicas = desiredObj.spec.jobManager.replicas\n          }\n          table.insert(components, jobManagerComponent)\n\n          local taskManagerComponent = {\n              name = \"taskmanager\",\n              replicas = desiredObj.spec.taskManager.replicas\n          }\n          table.insert(components, taskManagerComponent)\n\n          return components\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - desiredObj: the object represents the configuration to be applied\n      to the member cluster.\n\nThe function expects one return value:\n  - components: the resource requirements for each component.\nThe returned value will be set into a ResourceBinding or ClusterResourceBinding.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to extract the components of the resource. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return a list of components, for example:\n\n```\n  celExpression: '[{\"name\": \"jobmanager\", \"replicas\": desiredObj.spec.jobManager.replicas}]'\n```\n\nThe variable desiredObj is supplied by the system, which has the same meaning as the parameter of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -2163,17 +2176,29 @@ func schema_pkg_apis_config_v1alpha1_CustomizationRules(ref common.ReferenceCall
 							Ref:         ref(configv1alpha1.HealthInterpretation{}.OpenAPIModelName()),
 						},
 					},
+					"rolloutStatusInterpretation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStatusInterpretation describes the rules by which Karmada can assess whether the resource has finished rolling out its latest generation. Karmada provides built-in rules for Deployment, StatefulSet, DaemonSet and Job. If RolloutStatusInterpretation is set, the built-in rules will be ignored.",
+							Ref:         ref(configv1alpha1.RolloutStatusInterpretation{}.OpenAPIModelName()),
+						},
+					},
 					"dependencyInterpretation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DependencyInterpretation describes the rules for Karmada to analyze the dependent resources. Karmada provides built-in rules for several standard Kubernetes types, see: https://karmada.io/docs/userguide/globalview/customizing-resource-interpreter/#interpretdependency If DependencyInterpretation is set, the built-in rules will be ignored.",
 							Ref:         ref(configv1alpha1.DependencyInterpretation{}.OpenAPIModelName()),
 						},
 					},
+					"luaScriptLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LuaScriptLimits describes the resource limits of running the Lua scripts of the rules. It doesn't apply to CEL expressions. If not set, the scripts are only bound by a timeout of one second.",
+							Ref:         ref(configv1alpha1.LuaScriptLimits{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			configv1alpha1.ComponentResourceRequirement{}.OpenAPIModelName(), configv1alpha1.DependencyInterpretation{}.OpenAPIModelName(), configv1alpha1.HealthInterpretation{}.OpenAPIModelName(), configv1alpha1.LocalValueRetention{}.OpenAPIModelName(), configv1alpha1.ReplicaResourceRequirement{}.OpenAPIModelName(), configv1alpha1.ReplicaRevision{}.OpenAPIModelName(), configv1alpha1.StatusAggregation{}.OpenAPIModelName(), configv1alpha1.StatusReflection{}.OpenAPIModelName()},
+			configv1alpha1.ComponentResourceRequirement{}.OpenAPIModelName(), configv1alpha1.DependencyInterpretation{}.OpenAPIModelName(), configv1alpha1.HealthInterpretation{}.OpenAPIModelName(), configv1alpha1.LocalValueRetention{}.OpenAPIModelName(), configv1alpha1.LuaScriptLimits{}.OpenAPIModelName(), configv1alpha1.ReplicaResourceRequirement{}.OpenAPIModelName(), configv1alpha1.ReplicaRevision{}.OpenAPIModelName(), configv1alpha1.RolloutStatusInterpretation{}.OpenAPIModelName(), configv1alpha1.StatusAggregation{}.OpenAPIModelName(), configv1alpha1.StatusReflection{}.OpenAPIModelName()},
 	}
 }
 
@@ -2217,13 +2242,18 @@ func schema_pkg_apis_config_v1alpha1_DependencyInterpretation(ref common.Referen
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to interpret the dependencies of a specific resource. The script should implement a function as follows:\n\n```\n  luaScript: >\n      function GetDependencies(desiredObj)\n          dependencies = {}\n          serviceAccountName = desiredObj.spec.template.spec.serviceAccountName\n          if serviceAccountName ~= nil and serviceAccountName ~= \"default\" then\n              dependency = {}\n              dependency.apiVersion = \"v1\"\n              dependency.kind = \"ServiceAccount\"\n              dependency.name = serviceAccountName\n              dependency.namespace = desiredObj.metadata.namespace\n              dependencies[1] = dependency\n          end\n          return dependencies\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - desiredObj: the object represents the configuration to be applied\n      to the member cluster.\n\nThe returned value should be expressed by a slice of DependentObjectReference.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to interpret the dependencies of a specific resource. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return a list of dependent object references, for example:\n\n```\n  celExpression: '[{\"apiVersion\": \"v1\", \"kind\": \"ConfigMap\", \"namespace\": desiredObj.metadata.namespace, \"name\": desiredObj.spec.configName}]'\n```\n\nThe variable desiredObj is supplied by the system, which has the same meaning as the parameter of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -2291,13 +2321,18 @@ func schema_pkg_apis_config_v1alpha1_HealthInterpretation(ref common.ReferenceCa
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to assess the health state of a specific resource. The script should implement a function as follows:\n\n```\n  luaScript: >\n      function InterpretHealth(observedObj)\n          if observedObj.status.readyReplicas == observedObj.spec.replicas then\n              return true\n          end\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - observedObj: the object represents the configuration that is observed\n      from a specific member cluster.\n\nThe returned boolean value indicates the health status.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to assess the health state of a specific resource. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return a boolean value, for example:\n\n```\n  celExpression: 'observedObj.status.readyReplicas == observedObj.spec.replicas'\n```\n\nThe variable observedObj is supplied by the system, which has the same meaning as the parameter of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -2313,13 +2348,45 @@ func schema_pkg_apis_config_v1alpha1_LocalValueRetention(ref common.ReferenceCal
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to retain runtime values to the desired specification.\n\nThe script should implement a function as follows:\n\n```\n  luaScript: >\n      function Retain(desiredObj, observedObj)\n          desiredObj.spec.fieldFoo = observedObj.spec.fieldFoo\n          return desiredObj\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - desiredObj: the object represents the configuration to be applied\n      to the member cluster.\n  - observedObj: the object represents the configuration that is observed\n      from a specific member cluster.\n\nThe returned object should be a retained configuration which will be applied to member cluster eventually.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to retain runtime values to the desired specification. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return the fields to be retained, which will be merged into the desired object as a JSON merge patch, for example:\n\n```\n  celExpression: '{\"spec\": {\"fieldFoo\": observedObj.spec.fieldFoo}}'\n```\n\nThe variables desiredObj and observedObj are supplied by the system, which have the same meaning as the parameters of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_config_v1alpha1_LuaScriptLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LuaScriptLimits describes the resource limits of running Lua scripts. A script exceeding any of the limits is aborted and the interpretation fails. The heap memory allocated by a script, e.g. for strings and tables, is not limited, since the Lua VM doesn't account for it. Only the data stack and the call stack of the Lua VM are bounded, which limits the memory used by local variables, arguments and nested calls of a script.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeoutMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutMilliseconds is the maximum wall-clock time of running a script. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxInstructions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInstructions is the maximum number of Lua VM instructions executed by a script. It bounds the CPU time of a script regardless of the load of the component. It doesn't bound the memory of a script, since a single instruction, e.g. a string concatenation, allocates in proportion to the size of its operands. If not set, the number of instructions is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -2335,13 +2402,18 @@ func schema_pkg_apis_config_v1alpha1_ReplicaResourceRequirement(ref common.Refer
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to discover the resource's replica as well as resource requirements\n\nThe script should implement a function as follows:\n\n```\n  luaScript: >\n      function GetReplicas(desiredObj)\n          replica = desiredObj.spec.replicas\n          requirement = {}\n          requirement.nodeClaim = {}\n          requirement.nodeClaim.nodeSelector = desiredObj.spec.template.spec.nodeSelector\n          requirement.nodeClaim.tolerations = desiredObj.spec.template.spec.tolerations\n          requirement.resourceRequest = desiredObj.spec.template.spec.containers[1].resources.limits\n          return replica, requirement\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - desiredObj: the object represents the configuration to be applied\n      to the member cluster.\n\nThe function expects two return values:\n  - replica: the declared replica number\n  - requirement: the resource required by each replica expressed with a\n      ResourceBindingSpec.ReplicaRequirements.\nThe returned values will be set into a ResourceBinding or ClusterResourceBinding.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to discover the resource's replica as well as resource requirements. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return either the replica as an integer, or a map with the 'replica' and 'requires' keys, for example:\n\n```\n  celExpression: 'desiredObj.spec.replicas'\n```\n\nThe variable desiredObj is supplied by the system, which has the same meaning as the parameter of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -2357,13 +2429,18 @@ func schema_pkg_apis_config_v1alpha1_ReplicaRevision(ref common.ReferenceCallbac
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to revise replicas in the desired specification. The script should implement a function as follows:\n\n```\n  luaScript: >\n      function ReviseReplica(desiredObj, desiredReplica)\n          desiredObj.spec.replicas = desiredReplica\n          return desiredObj\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - desiredObj: the object represents the configuration to be applied\n      to the member cluster.\n  - desiredReplica: the replica number should be applied with.\n\nThe returned object should be a revised configuration which will be applied to member cluster eventually.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to revise replicas in the desired specification. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return the revised fields, which will be merged into the desired object as a JSON merge patch, for example:\n\n```\n  celExpression: '{\"spec\": {\"replicas\": desiredReplica}}'\n```\n\nThe variables desiredObj and desiredReplica are supplied by the system, which have the same meaning as the parameters of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -2429,6 +2506,32 @@ func schema_pkg_apis_config_v1alpha1_ResourceInterpreterContext(ref common.Refer
 							Ref:         ref(configv1alpha1.ResourceInterpreterResponse{}.OpenAPIModelName()),
 						},
 					},
+					"requests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requests describes multiple interpreter requests sent in one call. It is only used for webhooks which set MaxBatchSize, in which case Request is not set. All requests in a batch have the same operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(configv1alpha1.ResourceInterpreterRequest{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"responses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Responses describes the responses for Requests. Each response is correlated to the request by UID, and must be present for every request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(configv1alpha1.ResourceInterpreterResponse{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -2739,12 +2842,18 @@ func schema_pkg_apis_config_v1alpha1_ResourceInterpreterResponse(ref common.Refe
 							Format:      "",
 						},
 					},
+					"rolloutStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStatus represents the referencing object's rollout progress. Required if InterpreterOperation is InterpreterOperationInterpretRolloutStatus.",
+							Ref:         ref(v1alpha2.RolloutStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"uid", "successful"},
 			},
 		},
 		Dependencies: []string{
-			configv1alpha1.DependentObjectReference{}.OpenAPIModelName(), configv1alpha1.RequestStatus{}.OpenAPIModelName(), v1alpha2.Component{}.OpenAPIModelName(), v1alpha2.ReplicaRequirements{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			configv1alpha1.DependentObjectReference{}.OpenAPIModelName(), configv1alpha1.RequestStatus{}.OpenAPIModelName(), v1alpha2.Component{}.OpenAPIModelName(), v1alpha2.ReplicaRequirements{}.OpenAPIModelName(), v1alpha2.RolloutStatus{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -2804,6 +2913,27 @@ func schema_pkg_apis_config_v1alpha1_ResourceInterpreterWebhook(ref common.Refer
 							},
 						},
 					},
+					"maxBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBatchSize is the maximum number of objects Karmada puts into one request to the webhook. When it is greater than 1, concurrent InterpretStatus and InterpretHealth requests for the same webhook are collected for a short period and sent together in the `requests` field of `ResourceInterpreterContext`, and the webhook must reply with the `responses` field. Other operations are always sent one object per request. Default to nil, which means batch requests are not sent to the webhook.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchWindowMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchWindowMilliseconds specifies how long Karmada collects the requests for a batch before sending it to the webhook, a batch is sent earlier once it reaches MaxBatchSize. It is only used when MaxBatchSize is greater than 1. Default to 10 milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cacheTTLSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTTLSeconds specifies how long Karmada reuses the results returned by the webhook. Results are cached by the UID and resourceVersion of the object and the operation, so a cached result is only reused for the same revision of an object. Only the results of InterpretStatus, InterpretHealth and InterpretRolloutStatus are cached, and the cache is dropped once the webhook configuration changes. Default to nil, which means results are not cached.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"name", "clientConfig", "interpreterContextVersions"},
 			},
@@ -2911,6 +3041,33 @@ func schema_pkg_apis_config_v1alpha1_ResourceInterpreterWebhookConfigurationList
 	}
 }
 
+func schema_pkg_apis_config_v1alpha1_RolloutStatusInterpretation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatusInterpretation holds the rules for interpreting the rollout progress of a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"luaScript": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LuaScript holds the Lua script that is used to interpret the rollout progress of a specific resource. The script should implement a function as follows:\n\n```\n  luaScript: >\n      function InterpretRolloutStatus(observedObj)\n          local rolloutStatus = {}\n          rolloutStatus.generation = observedObj.metadata.generation\n          rolloutStatus.observedGeneration = observedObj.status.observedGeneration\n          rolloutStatus.updatedReplicas = observedObj.status.updatedReplicas\n          if observedObj.status.observedGeneration == observedObj.metadata.generation and\n              observedObj.status.updatedReplicas == observedObj.spec.replicas then\n              rolloutStatus.state = \"Done\"\n          else\n              rolloutStatus.state = \"Progressing\"\n          end\n          return rolloutStatus\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - observedObj: the object represents the configuration that is observed\n      from a specific member cluster.\n\nThe returned value should be expressed by a RolloutStatus, of which the state is one of \"Done\", \"Progressing\" and \"Failed\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to interpret the rollout progress of a specific resource. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return a RolloutStatus, for example:\n\n```\n  celExpression: '{\"state\": observedObj.status.updatedReplicas == observedObj.spec.replicas ? \"Done\" : \"Progressing\"}'\n```\n\nThe variable observedObj is supplied by the system, which has the same meaning as the parameter of the Lua script.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_config_v1alpha1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3047,13 +3204,18 @@ func schema_pkg_apis_config_v1alpha1_StatusAggregation(ref common.ReferenceCallb
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to aggregate decentralized statuses to the desired specification. The script should implement a function as follows:\n\n```\n  luaScript: >\n      function AggregateStatus(desiredObj, statusItems)\n          for i = 1, #statusItems do\n              desiredObj.status.readyReplicas = desiredObj.status.readyReplicas + items[i].readyReplicas\n          end\n          return desiredObj\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - desiredObj: the object represents a resource template.\n  - statusItems: the slice of status expressed with AggregatedStatusItem.\n\nThe returned object should be a whole object with status aggregated.",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to aggregate decentralized statuses to the desired specification. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return the aggregated fields, which will be merged into the desired object as a JSON merge patch, for example:\n\n```\n  celExpression: '{\"status\": {\"readyReplicas\": statusItems.map(item, item.status.readyReplicas).sum()}}'\n```\n\nThe variables desiredObj and statusItems are supplied by the system, which have the same meaning as the parameters of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -3069,13 +3231,18 @@ func schema_pkg_apis_config_v1alpha1_StatusReflection(ref common.ReferenceCallba
 					"luaScript": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LuaScript holds the Lua script that is used to get the status from the observed specification. The script should implement a function as follows:\n\n```\n  luaScript: >\n      function ReflectStatus(observedObj)\n          status = {}\n          status.readyReplicas = observedObj.status.observedObj\n          return status\n      end\n```\n\nThe content of the LuaScript needs to be a whole function including both declaration and implementation.\n\nThe parameters will be supplied by the system:\n  - observedObj: the object represents the configuration that is observed\n      from a specific member cluster.\n\nThe returned status could be the whole status or part of it and will be set into both Work and ResourceBinding(ClusterResourceBinding).",
-							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"celExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CELExpression holds the CEL expression that is used to get the status from the observed specification. It is an alternative to LuaScript, exactly one of them should be specified.\n\nThe expression should return the status as a map, for example:\n\n```\n  celExpression: '{\"readyReplicas\": observedObj.status.readyReplicas}'\n```\n\nThe variable observedObj is supplied by the system, which has the same meaning as the parameter of the Lua script.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"luaScript"},
 			},
 		},
 	}
@@ -3500,12 +3667,48 @@ func schema_pkg_apis_networking_v1alpha1_MultiClusterServiceSpec(ref common.Refe
 							},
 						},
 					},
+					"trafficPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrafficPolicy describes how the traffic from the consumer clusters is routed among the provider clusters. Only valid in case of Types contains CrossCluster. If not set, the EndpointSlices of all provider clusters will be dispatched to every consumer cluster unchanged.",
+							Ref:         ref(networkingv1alpha1.TrafficPolicy{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"types"},
 			},
 		},
 		Dependencies: []string{
-			networkingv1alpha1.ClusterSelector{}.OpenAPIModelName(), networkingv1alpha1.ExposurePort{}.OpenAPIModelName(), networkingv1alpha1.ExposureRange{}.OpenAPIModelName()},
+			networkingv1alpha1.ClusterSelector{}.OpenAPIModelName(), networkingv1alpha1.ExposurePort{}.OpenAPIModelName(), networkingv1alpha1.ExposureRange{}.OpenAPIModelName(), networkingv1alpha1.TrafficPolicy{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_networking_v1alpha1_ProviderWeight(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderWeight describes the weight of a provider cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the provider cluster.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the weight of the provider cluster relative to the others. A weight of 0 means that no endpoint of the provider cluster is dispatched.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "weight"},
+			},
+		},
 	}
 }
 
@@ -3545,6 +3748,41 @@ func schema_pkg_apis_networking_v1alpha1_ServiceLocation(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_networking_v1alpha1_TrafficPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TrafficPolicy describes how the traffic is routed among the provider clusters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"localityPreference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalityPreference makes the consumer clusters prefer the provider clusters located in the same region or zone as themselves. The endpoints of the provider clusters out of the locality will be dispatched to a consumer cluster only if none of the provider clusters in its locality has ready endpoints. A cluster without the region or zone set is not considered local to any other cluster. If not set, all provider clusters are treated equally.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerWeights": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderWeights specifies the weights of the provider clusters. The weights are relative: the ready endpoints of the provider clusters are dispatched in numbers in proportion to the weights, so that each provider cluster receives a share of the traffic in proportion to its weight. The provider cluster with the fewest ready endpoints relative to its weight dispatches all of them, and a provider cluster with a non-zero weight dispatches at least one endpoint. The provider clusters not listed here have a weight of 100.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(networkingv1alpha1.ProviderWeight{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			networkingv1alpha1.ProviderWeight{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_policy_v1alpha1_ApplicationFailoverBehavior(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6604,12 +6842,18 @@ func schema_pkg_apis_work_v1alpha1_ManifestStatus(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"rolloutStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStatus represents the rollout progress of the current resource. It is only set for those resources that the rollout status can be interpreted.",
+							Ref:         ref(workv1alpha1.RolloutStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"identifier"},
 			},
 		},
 		Dependencies: []string{
-			workv1alpha1.ResourceIdentifier{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
+			workv1alpha1.ResourceIdentifier{}.OpenAPIModelName(), workv1alpha1.RolloutStatus{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -6932,6 +7176,70 @@ func schema_pkg_apis_work_v1alpha1_ResourceIdentifier(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_work_v1alpha1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus represents the rollout progress of a resource in a member cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State represents whether the resource has finished rolling out its latest generation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the resource in the member cluster.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation observed by the controller of the resource in the member cluster.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of replicas that have been updated to the latest generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyReplicas is the number of replicas that are ready.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailableReplicas is the number of replicas that are available.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message indicating details about the rollout, e.g. the reason of a failed rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"state"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_work_v1alpha1_TargetCluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7207,12 +7515,18 @@ func schema_pkg_apis_work_v1alpha2_AggregatedStatusItem(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"rolloutStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStatus represents the rollout progress of the current resource. It is only set for those resources that the rollout status can be interpreted.",
+							Ref:         ref(v1alpha2.RolloutStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"clusterName"},
 			},
 		},
 		Dependencies: []string{
-			runtime.RawExtension{}.OpenAPIModelName()},
+			v1alpha2.RolloutStatus{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName()},
 	}
 }
 
@@ -7431,11 +7745,67 @@ func schema_pkg_apis_work_v1alpha2_ComponentReplicaRequirements(ref common.Refer
 							Format:      "",
 						},
 					},
+					"deviceRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceRequests represents the devices required by each replica, which are allocated through Dynamic Resource Allocation in member clusters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(v1alpha2.DeviceRequest{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1alpha2.NodeClaim{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName()},
+			v1alpha2.DeviceRequest{}.OpenAPIModelName(), v1alpha2.NodeClaim{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_work_v1alpha2_DeviceRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceRequest represents a request for devices of a DeviceClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deviceClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceClassName references the DeviceClass in member clusters which the devices are selected from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of devices required. If not set, one device is required.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"selectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selectors are the CEL expressions of the request which the devices must satisfy, in addition to the selectors of the DeviceClass.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"deviceClassName"},
+			},
+		},
 	}
 }
 
@@ -7590,11 +7960,65 @@ func schema_pkg_apis_work_v1alpha2_NodeClaim(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"podLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodLabels is the labels of the pod, which are used to evaluate HardPodAffinity, HardPodAntiAffinity and TopologySpreadConstraints against the replicas themselves.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"hardPodAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HardPodAffinity represents the inter-pod affinity terms of the pod. Note that only PodSpec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution is included here because it has a hard limit on pod scheduling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(corev1.PodAffinityTerm{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"hardPodAntiAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HardPodAntiAffinity represents the inter-pod anti-affinity terms of the pod. Note that only PodSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution is included here because it has a hard limit on pod scheduling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(corev1.PodAffinityTerm{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints represents the topology spread constraints of the pod. Note that only the constraints with WhenUnsatisfiable DoNotSchedule are included here because they have a hard limit on pod scheduling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(corev1.TopologySpreadConstraint{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			corev1.NodeSelector{}.OpenAPIModelName(), corev1.Toleration{}.OpenAPIModelName()},
+			corev1.NodeSelector{}.OpenAPIModelName(), corev1.PodAffinityTerm{}.OpenAPIModelName(), corev1.Toleration{}.OpenAPIModelName(), corev1.TopologySpreadConstraint{}.OpenAPIModelName()},
 	}
 }
 
@@ -7698,11 +8122,37 @@ func schema_pkg_apis_work_v1alpha2_ReplicaRequirements(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"deviceRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceRequests represents the devices required by each replica, which are allocated through Dynamic Resource Allocation in member clusters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(v1alpha2.DeviceRequest{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"storageRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageRequests represents the persistent volumes required by each replica, e.g. the volumes claimed by the volumeClaimTemplates of a StatefulSet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(v1alpha2.StorageRequest{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1alpha2.NodeClaim{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName()},
+			v1alpha2.DeviceRequest{}.OpenAPIModelName(), v1alpha2.NodeClaim{}.OpenAPIModelName(), v1alpha2.StorageRequest{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName()},
 	}
 }
 
@@ -8018,6 +8468,70 @@ func schema_pkg_apis_work_v1alpha2_ResourceBindingStatus(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_work_v1alpha2_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus represents the rollout progress of a resource in a member cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State represents whether the resource has finished rolling out its latest generation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the resource in the member cluster.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation observed by the controller of the resource in the member cluster.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of replicas that have been updated to the latest generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyReplicas is the number of replicas that are ready.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailableReplicas is the number of replicas that are available.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message indicating details about the rollout, e.g. the reason of a failed rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"state"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_work_v1alpha2_SchedulePriority(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8038,6 +8552,35 @@ func schema_pkg_apis_work_v1alpha2_SchedulePriority(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_work_v1alpha2_StorageRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageRequest represents a persistent volume required by each replica.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass the volume is provisioned from. If not set, the volume is provisioned from the default StorageClass of member clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage is the size of the volume.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"storage"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_work_v1alpha2_Suspension(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
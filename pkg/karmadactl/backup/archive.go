@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// resourcesDir is the directory in the backup archive holding the objects, one YAML file per object:
+//
+//	resources/<resource>.<group>/namespaces/<namespace>/<name>.yaml
+//	resources/<resource>.<group>/cluster/<name>.yaml
+const resourcesDir = "resources"
+
+// archivePath returns the path of the object in the backup archive.
+func archivePath(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	resource := gvr.Resource
+	if gvr.Group != "" {
+		resource = resource + "." + gvr.Group
+	}
+	if obj.GetNamespace() != "" {
+		return path.Join(resourcesDir, resource, "namespaces", obj.GetNamespace(), obj.GetName()+".yaml")
+	}
+	return path.Join(resourcesDir, resource, "cluster", obj.GetName()+".yaml")
+}
+
+// writeArchive writes the objects to a gzip compressed tarball.
+func writeArchive(w io.Writer, items []*backupItem) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	now := time.Now()
+	for _, item := range items {
+		data, err := yaml.Marshal(item.obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s(%s): %v", item.gvr.Resource, objectKey(item.obj), err)
+		}
+		header := &tar.Header{
+			Name:    archivePath(item.gvr, item.obj),
+			Mode:    0600,
+			Size:    int64(len(data)),
+			ModTime: now,
+		}
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err = tarWriter.Write(data); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// readArchive reads the objects from a gzip compressed tarball written by writeArchive.
+func readArchive(r io.Reader) ([]*unstructured.Unstructured, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup archive: %v", err)
+	}
+	defer gzipReader.Close()
+
+	var objs []*unstructured.Unstructured
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backup archive: %v", err)
+		}
+		if header.Typeflag != tar.TypeReg || !strings.HasPrefix(header.Name, resourcesDir+"/") ||
+			path.Ext(header.Name) != ".yaml" {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from backup archive: %v", header.Name, err)
+		}
+		if data, err = yaml.YAMLToJSON(data); err != nil {
+			return nil, fmt.Errorf("failed to decode %s from backup archive: %v", header.Name, err)
+		}
+		obj := &unstructured.Unstructured{}
+		if err = obj.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("failed to decode %s from backup archive: %v", header.Name, err)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native/prune"
+	"github.com/karmada-io/karmada/pkg/util/restmapper"
+)
+
+var (
+	backupLong = templates.LongDesc(`
+		Back up the API objects of the Karmada control plane to a gzip compressed tarball.
+
+		All the resources served by the Karmada API groups are backed up, including the clusters,
+		propagation and override policies, resource interpreter customizations and the bindings,
+		except the Works which are generated from the bindings. The secrets referenced by the clusters
+		and the resource templates referenced by the bindings are backed up along with them, so are the
+		namespaces of the namespaced objects. Other Kubernetes resource types can be backed up in
+		addition with '--include-resources'.
+
+		The status and the fields generated by the API server are removed from the objects, and the
+		tarball can be restored into a fresh Karmada control plane with the 'restore' command.`)
+
+	backupExample = templates.Examples(`
+		# Back up the Karmada control plane
+		%[1]s backup karmada-backup.tar.gz
+
+		# Back up the Karmada control plane along with all the configmaps and secrets
+		%[1]s backup karmada-backup.tar.gz --include-resources=configmaps,secrets
+
+		# Back up the namespaced objects in the namespace default and foo only
+		%[1]s backup karmada-backup.tar.gz --include-namespaces=default,foo`)
+)
+
+var (
+	clusterGroupKind                = clusterv1alpha1.SchemeGroupVersion.WithKind(clusterv1alpha1.ResourceKindCluster).GroupKind()
+	resourceBindingGroupKind        = workv1alpha2.SchemeGroupVersion.WithKind(workv1alpha2.ResourceKindResourceBinding).GroupKind()
+	clusterResourceBindingGroupKind = workv1alpha2.SchemeGroupVersion.WithKind(workv1alpha2.ResourceKindClusterResourceBinding).GroupKind()
+
+	secretGVR    = corev1.SchemeGroupVersion.WithResource("secrets")
+	namespaceGVR = corev1.SchemeGroupVersion.WithResource("namespaces")
+)
+
+// excludedResources are the resources in the Karmada API groups not backed up, since they are generated by
+// the controllers.
+var excludedResources = []schema.GroupResource{
+	workv1alpha1.SchemeGroupVersion.WithResource("works").GroupResource(),
+}
+
+// NewCmdBackup creates the `backup` command.
+func NewCmdBackup(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandBackupOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "backup FILE",
+		Short:                 "Back up the API objects of the Karmada control plane to a tarball",
+		Long:                  backupLong,
+		Example:               fmt.Sprintf(backupExample, parentCommand),
+		Args:                  cobra.ExactArgs(1),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			return o.Run(context.TODO())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterRegistration,
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVar(&o.IncludeResources, "include-resources", nil, "Kubernetes resource types to back up in addition to the Karmada resources, e.g. configmaps,secrets.")
+	flags.StringSliceVar(&o.IncludeNamespaces, "include-namespaces", nil, "Namespaces to back up the namespaced objects from. All namespaces are backed up if not specified.")
+	flags.StringSliceVar(&o.ExcludeNamespaces, "exclude-namespaces", nil, "Namespaces not to back up the namespaced objects from.")
+	options.AddKubeConfigFlags(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// CommandBackupOptions contains the input to the backup command.
+type CommandBackupOptions struct {
+	genericiooptions.IOStreams
+
+	// IncludeResources are the Kubernetes resource types backed up in addition to the Karmada resources.
+	IncludeResources []string
+	// IncludeNamespaces are the namespaces to back up the namespaced objects from, all namespaces if empty.
+	IncludeNamespaces []string
+	// ExcludeNamespaces are the namespaces not to back up the namespaced objects from.
+	ExcludeNamespaces []string
+
+	file            string
+	dynamicClient   dynamic.Interface
+	discoveryClient discovery.DiscoveryInterface
+	mapper          meta.RESTMapper
+}
+
+// backupResource is a resource type to back up.
+type backupResource struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// backupItem is an object to back up.
+type backupItem struct {
+	gvr schema.GroupVersionResource
+	obj *unstructured.Unstructured
+}
+
+// Complete completes all the required options.
+func (o *CommandBackupOptions) Complete(f util.Factory, args []string) error {
+	o.file = args[0]
+
+	var err error
+	if o.dynamicClient, err = f.DynamicClient(); err != nil {
+		return err
+	}
+	if o.discoveryClient, err = f.ToDiscoveryClient(); err != nil {
+		return err
+	}
+	o.mapper, err = f.ToRESTMapper()
+	return err
+}
+
+// Run backs up the Karmada control plane to the file.
+func (o *CommandBackupOptions) Run(ctx context.Context) error {
+	lists, err := o.discoveryClient.ServerPreferredResources()
+	if err = o.checkDiscoveryError(err); err != nil {
+		return fmt.Errorf("failed to discover the resources of Karmada control plane: %v", err)
+	}
+	resources := karmadaResources(lists)
+	for _, resource := range o.IncludeResources {
+		r, err := o.resourceFor(resource)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, r)
+	}
+
+	items, err := o.collect(ctx, resources)
+	if err != nil {
+		return err
+	}
+
+	// The archive contains the Secrets of the control plane, so it's readable by the owner only.
+	file, err := os.OpenFile(o.file, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if err = writeArchive(file, items); err != nil {
+		_ = file.Close()
+		_ = os.Remove(o.file)
+		return fmt.Errorf("failed to write backup archive: %v", err)
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "Backed up %d objects to %s\n", len(items), o.file)
+	return nil
+}
+
+// checkDiscoveryError returns the error of the resource discovery. The discovery failures of groups other than
+// the karmada.io groups, e.g. the groups of unavailable aggregated API servers, are reported as warnings.
+func (o *CommandBackupOptions) checkDiscoveryError(err error) error {
+	if err == nil {
+		return nil
+	}
+	failed, ok := err.(*discovery.ErrGroupDiscoveryFailed)
+	if !ok {
+		return err
+	}
+	for gv := range failed.Groups {
+		if isKarmadaGroup(gv.Group) {
+			return err
+		}
+	}
+	fmt.Fprintf(o.ErrOut, "Warning: %v, skipped\n", err)
+	return nil
+}
+
+// isKarmadaGroup tells whether the API group is served by Karmada.
+func isKarmadaGroup(group string) bool {
+	return strings.HasSuffix(group, ".karmada.io")
+}
+
+// karmadaResources returns the resources served by the Karmada API groups which can be listed and created.
+func karmadaResources(lists []*metav1.APIResourceList) []backupResource {
+	var resources []backupResource
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil || !isKarmadaGroup(gv.Group) {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") || !slices.Contains(r.Verbs, "list") || !slices.Contains(r.Verbs, "create") {
+				continue
+			}
+			gvr := gv.WithResource(r.Name)
+			if slices.Contains(excludedResources, gvr.GroupResource()) {
+				continue
+			}
+			resources = append(resources, backupResource{gvr: gvr, namespaced: r.Namespaced})
+		}
+	}
+	return resources
+}
+
+// resourceFor resolves the resource type specified by --include-resources.
+func (o *CommandBackupOptions) resourceFor(resource string) (backupResource, error) {
+	gvr, err := o.mapper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err != nil {
+		return backupResource{}, fmt.Errorf("failed to find resource type %q: %v", resource, err)
+	}
+	gvk, err := o.mapper.KindFor(gvr)
+	if err != nil {
+		return backupResource{}, err
+	}
+	mapping, err := o.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return backupResource{}, err
+	}
+	return backupResource{gvr: gvr, namespaced: mapping.Scope.Name() == meta.RESTScopeNameNamespace}, nil
+}
+
+// collect lists the objects of the resources, along with the secrets referenced by the clusters, the resource
+// templates referenced by the bindings and the namespaces of the namespaced objects. The returned objects are
+// cleaned up and ready to be written to the backup archive.
+func (o *CommandBackupOptions) collect(ctx context.Context, resources []backupResource) ([]*backupItem, error) {
+	var items []*backupItem
+	seen := make(map[string]bool)
+	add := func(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) {
+		key := gvr.GroupResource().String() + "/" + objectKey(obj)
+		if seen[key] || shouldSkip(obj) {
+			return
+		}
+		seen[key] = true
+		items = append(items, &backupItem{gvr: gvr, obj: obj})
+	}
+
+	for _, r := range resources {
+		list, err := o.dynamicClient.Resource(r.gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %v", r.gvr.GroupResource(), err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if r.namespaced && !o.namespaceIncluded(obj.GetNamespace()) {
+				continue
+			}
+			add(r.gvr, obj)
+		}
+	}
+
+	// The dependencies are appended to the items, they are iterated by index since the slice grows.
+	for i := 0; i < len(items); i++ {
+		for _, ref := range o.dependencies(items[i].obj) {
+			obj, err := o.get(ctx, ref)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					fmt.Fprintf(o.ErrOut, "Warning: %s %s referenced by %s %s not found, skipped\n",
+						ref.gvk.Kind, ref.key(), items[i].obj.GetKind(), objectKey(items[i].obj))
+					continue
+				}
+				return nil, err
+			}
+			add(ref.gvr, obj)
+		}
+	}
+
+	for _, item := range items {
+		if err := cleanObject(item.obj); err != nil {
+			return nil, fmt.Errorf("failed to clean up %s(%s): %v", item.gvr.GroupResource(), objectKey(item.obj), err)
+		}
+	}
+	return items, nil
+}
+
+// objectReference refers to an object the backed up object depends on.
+type objectReference struct {
+	gvk       schema.GroupVersionKind
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+func (r objectReference) key() string {
+	if r.namespace == "" {
+		return r.name
+	}
+	return r.namespace + "/" + r.name
+}
+
+// dependencies returns the objects the object depends on, which are backed up along with it.
+func (o *CommandBackupOptions) dependencies(obj *unstructured.Unstructured) []objectReference {
+	var refs []objectReference
+	if obj.GetNamespace() != "" {
+		refs = append(refs, objectReference{gvk: corev1.SchemeGroupVersion.WithKind("Namespace"), gvr: namespaceGVR, name: obj.GetNamespace()})
+	}
+
+	switch obj.GroupVersionKind().GroupKind() {
+	case clusterGroupKind:
+		for _, field := range []string{"secretRef", "impersonatorSecretRef"} {
+			namespace, _, _ := unstructured.NestedString(obj.Object, "spec", field, "namespace")
+			name, _, _ := unstructured.NestedString(obj.Object, "spec", field, "name")
+			if name != "" {
+				refs = append(refs, objectReference{gvk: corev1.SchemeGroupVersion.WithKind("Secret"), gvr: secretGVR, namespace: namespace, name: name})
+			}
+		}
+	case resourceBindingGroupKind, clusterResourceBindingGroupKind:
+		apiVersion, _, _ := unstructured.NestedString(obj.Object, "spec", "resource", "apiVersion")
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "resource", "kind")
+		namespace, _, _ := unstructured.NestedString(obj.Object, "spec", "resource", "namespace")
+		name, _, _ := unstructured.NestedString(obj.Object, "spec", "resource", "name")
+		gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+		gvr, err := restmapper.GetGroupVersionResource(o.mapper, gvk)
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "Warning: resource type of %s %s referenced by %s %s not found, skipped\n",
+				kind, name, obj.GetKind(), objectKey(obj))
+			break
+		}
+		refs = append(refs, objectReference{gvk: gvk, gvr: gvr, namespace: namespace, name: name})
+	}
+	return refs
+}
+
+func (o *CommandBackupOptions) get(ctx context.Context, ref objectReference) (*unstructured.Unstructured, error) {
+	if ref.namespace == "" {
+		return o.dynamicClient.Resource(ref.gvr).Get(ctx, ref.name, metav1.GetOptions{})
+	}
+	return o.dynamicClient.Resource(ref.gvr).Namespace(ref.namespace).Get(ctx, ref.name, metav1.GetOptions{})
+}
+
+func (o *CommandBackupOptions) namespaceIncluded(namespace string) bool {
+	if slices.Contains(o.ExcludeNamespaces, namespace) {
+		return false
+	}
+	return len(o.IncludeNamespaces) == 0 || slices.Contains(o.IncludeNamespaces, namespace)
+}
+
+// shouldSkip tells if the object should not be backed up, since it's being deleted or generated by the API server.
+func shouldSkip(obj *unstructured.Unstructured) bool {
+	if obj.GetDeletionTimestamp() != nil {
+		return true
+	}
+	if obj.GetKind() == "Secret" {
+		secretType, _, _ := unstructured.NestedString(obj.Object, "type")
+		return secretType == string(corev1.SecretTypeServiceAccountToken)
+	}
+	return false
+}
+
+// cleanObject removes the status and the fields generated by the API server from the object.
+func cleanObject(obj *unstructured.Unstructured) error {
+	if err := prune.RemoveIrrelevantFields(obj); err != nil {
+		return err
+	}
+	switch obj.GroupVersionKind().GroupKind() {
+	case resourceBindingGroupKind, clusterResourceBindingGroupKind:
+		// The resource template gets a new uid and resourceVersion once restored, and they will be
+		// refreshed when the resource template is adopted by the binding again.
+		unstructured.RemoveNestedField(obj.Object, "spec", "resource", "uid")
+		unstructured.RemoveNestedField(obj.Object, "spec", "resource", "resourceVersion")
+	}
+	return nil
+}
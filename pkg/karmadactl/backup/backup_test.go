@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+var (
+	clusterGVR           = schema.GroupVersionResource{Group: "cluster.karmada.io", Version: "v1alpha1", Resource: "clusters"}
+	propagationPolicyGVR = schema.GroupVersionResource{Group: "policy.karmada.io", Version: "v1alpha1", Resource: "propagationpolicies"}
+	resourceBindingGVR   = schema.GroupVersionResource{Group: "work.karmada.io", Version: "v1alpha2", Resource: "resourcebindings"}
+	deploymentGVR        = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+)
+
+func newObject(apiVersion, kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func newTestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "cluster.karmada.io", Version: "v1alpha1", Kind: "Cluster"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "policy.karmada.io", Version: "v1alpha1", Kind: "PropagationPolicy"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "work.karmada.io", Version: "v1alpha2", Kind: "ResourceBinding"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+func TestKarmadaResources(t *testing.T) {
+	lists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{Name: "deployments", Namespaced: true, Verbs: []string{"list", "create"}}},
+		},
+		{
+			GroupVersion: "cluster.karmada.io/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{Name: "clusters", Verbs: []string{"list", "create"}},
+				{Name: "clusters/status", Verbs: []string{"get", "update"}},
+				{Name: "clusters/proxy", Verbs: []string{"create", "get"}},
+			},
+		},
+		{
+			GroupVersion: "work.karmada.io/v1alpha1",
+			APIResources: []metav1.APIResource{{Name: "works", Namespaced: true, Verbs: []string{"list", "create"}}},
+		},
+		{
+			GroupVersion: "work.karmada.io/v1alpha2",
+			APIResources: []metav1.APIResource{{Name: "resourcebindings", Namespaced: true, Verbs: []string{"list", "create"}}},
+		},
+		{
+			GroupVersion: "search.karmada.io/v1alpha1",
+			APIResources: []metav1.APIResource{{Name: "proxying", Verbs: []string{"get"}}},
+		},
+	}
+
+	assert.Equal(t, []backupResource{
+		{gvr: clusterGVR},
+		{gvr: resourceBindingGVR, namespaced: true},
+	}, karmadaResources(lists))
+}
+
+func TestCommandBackupOptions_checkDiscoveryError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantErr     bool
+		wantWarning bool
+	}{
+		{name: "no error"},
+		{name: "discovery failed", err: errors.New("connection refused"), wantErr: true},
+		{
+			name: "non-karmada group failed",
+			err: &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{
+				{Group: "metrics.k8s.io", Version: "v1beta1"}: errors.New("service unavailable"),
+			}},
+			wantWarning: true,
+		},
+		{
+			name: "karmada group failed",
+			err: &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{
+				{Group: "metrics.k8s.io", Version: "v1beta1"}:      errors.New("service unavailable"),
+				{Group: "cluster.karmada.io", Version: "v1alpha1"}: errors.New("service unavailable"),
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errOut := &bytes.Buffer{}
+			o := &CommandBackupOptions{}
+			o.ErrOut = errOut
+			err := o.checkDiscoveryError(tt.err)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantWarning, errOut.Len() > 0)
+		})
+	}
+}
+
+func TestCommandBackupOptions_collect(t *testing.T) {
+	objects := []runtime.Object{
+		newObject("cluster.karmada.io/v1alpha1", "Cluster", "", "member1", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"secretRef":             map[string]interface{}{"namespace": "karmada-cluster", "name": "member1"},
+				"impersonatorSecretRef": map[string]interface{}{"namespace": "karmada-cluster", "name": "member1-impersonator"},
+			},
+			"status": map[string]interface{}{"kubernetesVersion": "v1.31.0"},
+		}),
+		newObject("v1", "Secret", "karmada-cluster", "member1", nil),
+		newObject("v1", "Namespace", "", "karmada-cluster", nil),
+		newObject("v1", "Namespace", "", "default", nil),
+		newObject("policy.karmada.io/v1alpha1", "PropagationPolicy", "default", "nginx-pp", nil),
+		newObject("policy.karmada.io/v1alpha1", "PropagationPolicy", "foo", "foo-pp", nil),
+		newObject("work.karmada.io/v1alpha2", "ResourceBinding", "default", "nginx-deployment", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"resource": map[string]interface{}{
+					"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "nginx",
+					"uid": "a1b2", "resourceVersion": "100",
+				},
+				"clusters": []interface{}{map[string]interface{}{"name": "member1", "replicas": int64(2)}},
+			},
+		}),
+		newObject("apps/v1", "Deployment", "default", "nginx", map[string]interface{}{
+			"spec":   map[string]interface{}{"replicas": int64(2)},
+			"status": map[string]interface{}{"replicas": int64(2)},
+		}),
+	}
+	for _, obj := range objects {
+		obj.(*unstructured.Unstructured).SetUID("uid")
+		obj.(*unstructured.Unstructured).SetResourceVersion("1")
+	}
+
+	errOut := &bytes.Buffer{}
+	o := &CommandBackupOptions{
+		ExcludeNamespaces: []string{"foo"},
+		dynamicClient:     fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), objects...),
+		mapper:            newTestMapper(),
+	}
+	o.ErrOut = errOut
+	items, err := o.collect(context.TODO(), []backupResource{
+		{gvr: clusterGVR},
+		{gvr: propagationPolicyGVR, namespaced: true},
+		{gvr: resourceBindingGVR, namespaced: true},
+	})
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(items))
+	for _, item := range items {
+		paths = append(paths, archivePath(item.gvr, item.obj))
+		assert.Empty(t, item.obj.GetUID())
+		assert.Empty(t, item.obj.GetResourceVersion())
+		assert.NotContains(t, item.obj.Object, "status")
+	}
+	assert.Equal(t, []string{
+		"resources/clusters.cluster.karmada.io/cluster/member1.yaml",
+		"resources/propagationpolicies.policy.karmada.io/namespaces/default/nginx-pp.yaml",
+		"resources/resourcebindings.work.karmada.io/namespaces/default/nginx-deployment.yaml",
+		"resources/secrets/namespaces/karmada-cluster/member1.yaml",
+		"resources/namespaces/cluster/default.yaml",
+		"resources/deployments.apps/namespaces/default/nginx.yaml",
+		"resources/namespaces/cluster/karmada-cluster.yaml",
+	}, paths)
+	assert.Equal(t, "Warning: Secret karmada-cluster/member1-impersonator referenced by Cluster member1 not found, skipped\n", errOut.String())
+
+	binding := items[2].obj
+	assert.Equal(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "namespace": "default", "name": "nginx"},
+		binding.Object["spec"].(map[string]interface{})["resource"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "member1", "replicas": int64(2)}},
+		binding.Object["spec"].(map[string]interface{})["clusters"])
+}
+
+func TestArchive(t *testing.T) {
+	items := []*backupItem{
+		{gvr: clusterGVR, obj: newObject("cluster.karmada.io/v1alpha1", "Cluster", "", "member1", nil)},
+		{gvr: deploymentGVR, obj: newObject("apps/v1", "Deployment", "default", "nginx", map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": int64(2)},
+		})},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeArchive(buf, items))
+	objs, err := readArchive(buf)
+	require.NoError(t, err)
+	require.Len(t, objs, 2)
+	assert.Equal(t, items[0].obj, objs[0])
+	assert.Equal(t, items[1].obj, objs[1])
+
+	_, err = readArchive(bytes.NewBufferString("not a tarball"))
+	assert.Error(t, err)
+}
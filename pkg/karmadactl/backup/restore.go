@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/restmapper"
+)
+
+var (
+	restoreLong = templates.LongDesc(`
+		Restore the API objects backed up by the 'backup' command into a Karmada control plane.
+
+		The objects are restored in dependency order: namespaces, custom resource definitions,
+		secrets, clusters, resource interpreter customizations, propagation and override policies,
+		other Karmada resources, bindings, and finally the resource templates. As the bindings are
+		restored with their scheduling results before the resource templates, the resource templates
+		are adopted by the existing bindings instead of being scheduled again, so the workloads in
+		member clusters stay where they are.
+
+		The objects already existing in the control plane are left untouched, so the restore can be
+		resumed by running the command again after fixing the errors.`)
+
+	restoreExample = templates.Examples(`
+		# Restore the Karmada control plane from the backup
+		%[1]s restore karmada-backup.tar.gz
+
+		# Show the objects to restore without restoring them
+		%[1]s restore karmada-backup.tar.gz --dry-run
+
+		# Restore the secrets referenced by the clusters into the namespace karmada-cluster-secrets
+		%[1]s restore karmada-backup.tar.gz --cluster-secret-namespace=karmada-cluster-secrets`)
+)
+
+// restoreOrder is the order of kinds to restore, the kinds depended on by others come first. The kinds not
+// listed are restored after them, with the other Karmada resources first, then the bindings and the
+// resource templates at last.
+var restoreOrder = []schema.GroupKind{
+	{Kind: "Namespace"},
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"},
+	{Kind: "Secret"},
+	clusterGroupKind,
+	configv1alpha1.SchemeGroupVersion.WithKind(configv1alpha1.ResourceKindResourceInterpreterCustomization).GroupKind(),
+	configv1alpha1.SchemeGroupVersion.WithKind(configv1alpha1.ResourceKindResourceInterpreterWebhookConfiguration).GroupKind(),
+	policyv1alpha1.SchemeGroupVersion.WithKind(policyv1alpha1.ResourceKindClusterPropagationPolicy).GroupKind(),
+	policyv1alpha1.SchemeGroupVersion.WithKind(policyv1alpha1.ResourceKindPropagationPolicy).GroupKind(),
+	policyv1alpha1.SchemeGroupVersion.WithKind(policyv1alpha1.ResourceKindClusterOverridePolicy).GroupKind(),
+	policyv1alpha1.SchemeGroupVersion.WithKind(policyv1alpha1.ResourceKindOverridePolicy).GroupKind(),
+}
+
+// restoreAction is the action taken for an object.
+type restoreAction string
+
+const (
+	// restoreActionRestore means the object is going to be restored.
+	restoreActionRestore restoreAction = "Restore"
+	// restoreActionRestored means the object has been restored.
+	restoreActionRestored restoreAction = "Restored"
+	// restoreActionExists means the object already exists in the control plane and is left untouched.
+	restoreActionExists restoreAction = "Exists"
+	// restoreActionFailed means the object failed to be restored.
+	restoreActionFailed restoreAction = "Failed"
+)
+
+// restoreItem is an object to restore.
+type restoreItem struct {
+	obj    *unstructured.Unstructured
+	action restoreAction
+	reason string
+}
+
+// NewCmdRestore creates the `restore` command.
+func NewCmdRestore(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandRestoreOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "restore FILE",
+		Short:                 "Restore the API objects of the Karmada control plane from a tarball",
+		Long:                  restoreLong,
+		Example:               fmt.Sprintf(restoreExample, parentCommand),
+		Args:                  cobra.ExactArgs(1),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(context.TODO())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterRegistration,
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&o.ClusterSecretNamespace, "cluster-secret-namespace", "", "The namespace to restore the secrets referenced by the clusters into. The secrets are restored into their original namespace if not specified.")
+	flags.BoolVar(&o.DryRun, "dry-run", false, "Only print the objects to restore, without restoring them.")
+	options.AddKubeConfigFlags(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// CommandRestoreOptions contains the input to the restore command.
+type CommandRestoreOptions struct {
+	genericiooptions.IOStreams
+
+	// ClusterSecretNamespace is the namespace to restore the secrets referenced by the clusters into.
+	ClusterSecretNamespace string
+	// DryRun tells if only print the objects to restore.
+	DryRun bool
+
+	file          string
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+}
+
+// Complete completes all the required options.
+func (o *CommandRestoreOptions) Complete(f util.Factory, args []string) error {
+	o.file = args[0]
+
+	var err error
+	if o.dynamicClient, err = f.DynamicClient(); err != nil {
+		return err
+	}
+	o.mapper, err = f.ToRESTMapper()
+	return err
+}
+
+// Validate checks the options.
+func (o *CommandRestoreOptions) Validate() error {
+	if o.ClusterSecretNamespace != "" {
+		if errs := validation.IsDNS1123Label(o.ClusterSecretNamespace); len(errs) > 0 {
+			return fmt.Errorf("invalid --cluster-secret-namespace %q: %s", o.ClusterSecretNamespace, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// Run restores the Karmada control plane from the file.
+func (o *CommandRestoreOptions) Run(ctx context.Context) error {
+	file, err := os.Open(o.file)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	objs, err := readArchive(file)
+	if err != nil {
+		return err
+	}
+	if len(objs) == 0 {
+		fmt.Fprintf(o.Out, "No objects found in %s\n", o.file)
+		return nil
+	}
+	return o.restore(ctx, remapClusterSecrets(objs, o.ClusterSecretNamespace), o.Out)
+}
+
+// restore creates the objects in dependency order and prints the result of each object.
+func (o *CommandRestoreOptions) restore(ctx context.Context, objs []*unstructured.Unstructured, out io.Writer) error {
+	sortObjects(objs)
+
+	items := make([]*restoreItem, 0, len(objs))
+	failed := 0
+	for _, obj := range objs {
+		item := &restoreItem{obj: obj}
+		item.action, item.reason = o.restoreObject(ctx, obj)
+		if item.action == restoreActionFailed {
+			failed++
+		}
+		items = append(items, item)
+	}
+
+	printRestoreReport(out, items)
+	if failed > 0 {
+		return fmt.Errorf("%d object(s) failed to be restored, rerun the command to resume the restore after fixing the errors", failed)
+	}
+	return nil
+}
+
+func (o *CommandRestoreOptions) restoreObject(ctx context.Context, obj *unstructured.Unstructured) (restoreAction, string) {
+	gvr, err := restmapper.GetGroupVersionResource(o.mapper, obj.GroupVersionKind())
+	if err != nil {
+		return restoreActionFailed, "resource type not installed in Karmada control plane"
+	}
+	var client dynamic.ResourceInterface = o.dynamicClient.Resource(gvr)
+	if obj.GetNamespace() != "" {
+		client = o.dynamicClient.Resource(gvr).Namespace(obj.GetNamespace())
+	}
+
+	if o.DryRun {
+		_, err = client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		switch {
+		case err == nil:
+			return restoreActionExists, ""
+		case apierrors.IsNotFound(err):
+			return restoreActionRestore, ""
+		default:
+			return restoreActionFailed, err.Error()
+		}
+	}
+
+	_, err = client.Create(ctx, obj, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		return restoreActionRestored, ""
+	case apierrors.IsAlreadyExists(err):
+		return restoreActionExists, ""
+	default:
+		return restoreActionFailed, err.Error()
+	}
+}
+
+// remapClusterSecrets moves the secrets referenced by the clusters into the namespace, and points the
+// references of the clusters to the moved secrets. The namespace is created if it's not in the backup.
+func remapClusterSecrets(objs []*unstructured.Unstructured, namespace string) []*unstructured.Unstructured {
+	if namespace == "" {
+		return objs
+	}
+
+	secrets := sets.New[string]()
+	for _, obj := range objs {
+		if obj.GroupVersionKind().GroupKind() != clusterGroupKind {
+			continue
+		}
+		for _, field := range []string{"secretRef", "impersonatorSecretRef"} {
+			ref, found, _ := unstructured.NestedStringMap(obj.Object, "spec", field)
+			if !found || ref["name"] == "" {
+				continue
+			}
+			secrets.Insert(ref["namespace"] + "/" + ref["name"])
+			_ = unstructured.SetNestedField(obj.Object, namespace, "spec", field, "namespace")
+		}
+	}
+
+	namespaceExists := false
+	for _, obj := range objs {
+		switch obj.GroupVersionKind().GroupKind() {
+		case corev1.SchemeGroupVersion.WithKind("Secret").GroupKind():
+			if secrets.Has(objectKey(obj)) {
+				obj.SetNamespace(namespace)
+			}
+		case corev1.SchemeGroupVersion.WithKind("Namespace").GroupKind():
+			namespaceExists = namespaceExists || obj.GetName() == namespace
+		}
+	}
+	if !namespaceExists && secrets.Len() > 0 {
+		ns := &unstructured.Unstructured{}
+		ns.SetAPIVersion("v1")
+		ns.SetKind("Namespace")
+		ns.SetName(namespace)
+		objs = append(objs, ns)
+	}
+	return objs
+}
+
+// sortObjects sorts the objects in the order to restore.
+func sortObjects(objs []*unstructured.Unstructured) {
+	order := func(gk schema.GroupKind) int {
+		if i := slices.Index(restoreOrder, gk); i >= 0 {
+			return i
+		}
+		switch {
+		case gk == resourceBindingGroupKind || gk == clusterResourceBindingGroupKind:
+			return len(restoreOrder) + 1
+		case strings.HasSuffix(gk.Group, ".karmada.io"):
+			return len(restoreOrder)
+		}
+		return len(restoreOrder) + 2
+	}
+	sort.SliceStable(objs, func(i, j int) bool {
+		a, b := objs[i], objs[j]
+		gkA, gkB := a.GroupVersionKind().GroupKind(), b.GroupVersionKind().GroupKind()
+		if order(gkA) != order(gkB) {
+			return order(gkA) < order(gkB)
+		}
+		if gkA != gkB {
+			return gkA.String() < gkB.String()
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
+func printRestoreReport(out io.Writer, items []*restoreItem) {
+	w := printers.GetNewTabWriter(out)
+	fmt.Fprintln(w, "NAMESPACE\tKIND\tNAME\tACTION\tREASON")
+	counts := make(map[restoreAction]int)
+	for _, item := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.obj.GetNamespace(), item.obj.GetKind(), item.obj.GetName(), item.action, item.reason)
+		counts[item.action]++
+	}
+	_ = w.Flush()
+
+	fmt.Fprintf(out, "\n%d to restore, %d restored, %d already exist, %d failed\n",
+		counts[restoreActionRestore], counts[restoreActionRestored], counts[restoreActionExists], counts[restoreActionFailed])
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	coretesting "k8s.io/client-go/testing"
+)
+
+func newBackupObjects() []*unstructured.Unstructured {
+	return []*unstructured.Unstructured{
+		newObject("apps/v1", "Deployment", "default", "nginx", nil),
+		newObject("work.karmada.io/v1alpha2", "ResourceBinding", "default", "nginx-deployment", nil),
+		newObject("policy.karmada.io/v1alpha1", "PropagationPolicy", "default", "nginx-pp", nil),
+		newObject("v1", "Secret", "karmada-cluster", "member1", nil),
+		newObject("cluster.karmada.io/v1alpha1", "Cluster", "", "member1", map[string]interface{}{
+			"spec": map[string]interface{}{"secretRef": map[string]interface{}{"namespace": "karmada-cluster", "name": "member1"}},
+		}),
+		newObject("v1", "Namespace", "", "default", nil),
+		newObject("v1", "Namespace", "", "karmada-cluster", nil),
+		newObject("example.io/v1", "Foo", "default", "foo", nil),
+	}
+}
+
+func restoreResult(out string) []string {
+	var lines []string
+	for _, line := range bytes.Split([]byte(out), []byte("\n")) {
+		if len(line) > 0 {
+			lines = append(lines, string(bytes.Join(bytes.Fields(line), []byte(" "))))
+		}
+	}
+	return lines
+}
+
+func TestCommandRestoreOptions_restore(t *testing.T) {
+	defaultNamespace := newObject("v1", "Namespace", "", "default", nil)
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), defaultNamespace)
+	o := &CommandRestoreOptions{dynamicClient: dynamicClient, mapper: newTestMapper()}
+
+	// dry run makes no changes
+	o.DryRun = true
+	out := &bytes.Buffer{}
+	err := o.restore(context.TODO(), newBackupObjects(), out)
+	assert.EqualError(t, err, "1 object(s) failed to be restored, rerun the command to resume the restore after fixing the errors")
+	assert.Equal(t, []string{
+		"NAMESPACE KIND NAME ACTION REASON",
+		"Namespace default Exists",
+		"Namespace karmada-cluster Restore",
+		"karmada-cluster Secret member1 Restore",
+		"Cluster member1 Restore",
+		"default PropagationPolicy nginx-pp Restore",
+		"default ResourceBinding nginx-deployment Restore",
+		"default Deployment nginx Restore",
+		"default Foo foo Failed resource type not installed in Karmada control plane",
+		"6 to restore, 0 restored, 1 already exist, 1 failed",
+	}, restoreResult(out.String()))
+	_, err = dynamicClient.Resource(clusterGVR).Get(context.TODO(), "member1", metav1.GetOptions{})
+	assert.Error(t, err, "object should not be created in dry-run mode")
+
+	// the restore of the deployment fails
+	o.DryRun = false
+	dynamicClient.PrependReactor("create", "deployments", func(coretesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("unexpected error")
+	})
+	objs := newBackupObjects()[:7]
+	out = &bytes.Buffer{}
+	err = o.restore(context.TODO(), objs, out)
+	assert.EqualError(t, err, "1 object(s) failed to be restored, rerun the command to resume the restore after fixing the errors")
+	assert.Contains(t, out.String(), "0 to restore, 5 restored, 1 already exist, 1 failed")
+
+	// resume the restore
+	dynamicClient.ReactionChain = dynamicClient.ReactionChain[1:]
+	out = &bytes.Buffer{}
+	require.NoError(t, o.restore(context.TODO(), newBackupObjects()[:7], out))
+	assert.Contains(t, out.String(), "0 to restore, 1 restored, 6 already exist, 0 failed")
+	_, err = dynamicClient.Resource(deploymentGVR).Namespace("default").Get(context.TODO(), "nginx", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestRemapClusterSecrets(t *testing.T) {
+	objs := remapClusterSecrets(newBackupObjects(), "karmada-cluster-secrets")
+	require.Len(t, objs, 9)
+
+	assert.Equal(t, "karmada-cluster-secrets", objs[3].GetNamespace())
+	secretNamespace, _, _ := unstructured.NestedString(objs[4].Object, "spec", "secretRef", "namespace")
+	assert.Equal(t, "karmada-cluster-secrets", secretNamespace)
+	assert.Equal(t, "Namespace", objs[8].GetKind())
+	assert.Equal(t, "karmada-cluster-secrets", objs[8].GetName())
+
+	objs = remapClusterSecrets(newBackupObjects(), "")
+	assert.Equal(t, newBackupObjects(), objs)
+}
+
+func TestCommandRestoreOptions_Validate(t *testing.T) {
+	assert.NoError(t, (&CommandRestoreOptions{}).Validate())
+	assert.NoError(t, (&CommandRestoreOptions{ClusterSecretNamespace: "karmada-cluster"}).Validate())
+	assert.Error(t, (&CommandRestoreOptions{ClusterSecretNamespace: "Karmada_Cluster"}).Validate())
+}
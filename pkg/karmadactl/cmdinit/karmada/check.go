@@ -18,6 +18,7 @@ package karmada
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,18 +32,30 @@ import (
 // WaitAPIServiceReady wait the api service condition true
 func WaitAPIServiceReady(c aggregator.Interface, name string, timeout time.Duration) error {
 	if err := wait.PollUntilContextTimeout(context.TODO(), time.Second, timeout, true, func(ctx context.Context) (done bool, err error) {
-		apiService, e := c.ApiregistrationV1().APIServices().Get(ctx, name, metav1.GetOptions{})
-		if e != nil {
+		if e := CheckAPIServiceReady(ctx, c, name); e != nil {
+			klog.Infof("Waiting for APIService(%s) condition(%s), will try", name, apiregistrationv1.Available)
 			return false, nil
 		}
-		if apiregistrationv1helper.IsAPIServiceConditionTrue(apiService, apiregistrationv1.Available) {
-			return true, nil
-		}
-
-		klog.Infof("Waiting for APIService(%s) condition(%s), will try", name, apiregistrationv1.Available)
-		return false, nil
+		return true, nil
 	}); err != nil {
 		return err
 	}
 	return nil
 }
+
+// CheckAPIServiceReady checks whether the api service condition is true
+func CheckAPIServiceReady(ctx context.Context, c aggregator.Interface, name string) error {
+	apiService, err := c.ApiregistrationV1().APIServices().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if apiregistrationv1helper.IsAPIServiceConditionTrue(apiService, apiregistrationv1.Available) {
+		return nil
+	}
+
+	condition := apiregistrationv1helper.GetAPIServiceConditionByType(apiService, apiregistrationv1.Available)
+	if condition == nil {
+		return fmt.Errorf("APIService(%s) condition(%s) not reported", name, apiregistrationv1.Available)
+	}
+	return fmt.Errorf("APIService(%s) condition(%s) is %s: %s", name, apiregistrationv1.Available, condition.Status, condition.Message)
+}
@@ -96,6 +96,69 @@ func TestWaitAPIServiceReady(t *testing.T) {
 	}
 }
 
+func TestCheckAPIServiceReady(t *testing.T) {
+	aaAPIServiceName := "karmada-search"
+	tests := []struct {
+		name    string
+		prep    func(aggregator.Interface) error
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "CheckAPIServiceReady_AAAPIServiceDoesNotExist_NotFound",
+			prep:    func(aggregator.Interface) error { return nil },
+			wantErr: true,
+			errMsg:  "not found",
+		},
+		{
+			name: "CheckAPIServiceReady_AAAPIServiceConditionNotReported_NotReady",
+			prep: func(client aggregator.Interface) error {
+				_, err := createAAAPIService(client, aaAPIServiceName)
+				return err
+			},
+			wantErr: true,
+			errMsg:  "condition(Available) not reported",
+		},
+		{
+			name: "CheckAPIServiceReady_AAAPIServiceIsNotAvailable_NotReady",
+			prep: func(client aggregator.Interface) error {
+				service, err := createAAAPIService(client, aaAPIServiceName)
+				if err != nil {
+					return err
+				}
+				return updateAAAPIServiceCondition(service, client, apiregistrationv1.Available, apiregistrationv1.ConditionFalse)
+			},
+			wantErr: true,
+			errMsg:  "condition(Available) is False",
+		},
+		{
+			name: "CheckAPIServiceReady_AAAPIServiceIsReady_Ready",
+			prep: func(client aggregator.Interface) error {
+				return createAndMarkAAAPIServiceAvailable(client, aaAPIServiceName)
+			},
+			wantErr: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fakeAggregator.NewSimpleClientset()
+			if err := test.prep(client); err != nil {
+				t.Fatalf("failed to prep before checking API service, got: %v", err)
+			}
+			err := CheckAPIServiceReady(context.TODO(), client, aaAPIServiceName)
+			if err == nil && test.wantErr {
+				t.Fatal("expected an error, but got none")
+			}
+			if err != nil && !test.wantErr {
+				t.Errorf("unexpected error, got: %v", err)
+			}
+			if err != nil && test.wantErr && !strings.Contains(err.Error(), test.errMsg) {
+				t.Errorf("expected error message %s to be in %s", test.errMsg, err.Error())
+			}
+		})
+	}
+}
+
 // createAndMarkAAAPIServiceAvailable creates the specified AA APIService and then
 // updates its condition status to "Available" by setting the condition status to "ConditionTrue".
 // This function simplifies the combined process of creation and availability marking.
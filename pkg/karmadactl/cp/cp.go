@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubectlcp "k8s.io/kubectl/pkg/cmd/cp"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+var (
+	cpLong = templates.LongDesc(`
+		Copy files and directories to and from containers in a member cluster.
+
+		The files are transferred through the cluster proxy of Karmada, so the kubeconfig of the member
+		cluster is not required.`)
+
+	cpExample = templates.Examples(`
+		# !!!Important Note!!!
+		# Requires that the 'tar' binary is present in your container
+		# image.  If 'tar' is not present, '%[1]s cp' will fail.
+
+		# Copy /tmp/foo_dir local directory to /tmp/bar_dir in a remote pod in the default namespace in cluster(member1)
+		%[1]s cp /tmp/foo_dir <some-pod>:/tmp/bar_dir -C=member1
+
+		# Copy /tmp/foo local file to /tmp/bar in a remote pod in a specific container in cluster(member1)
+		%[1]s cp /tmp/foo <some-pod>:/tmp/bar -c <specific-container> -C=member1
+
+		# Copy /tmp/foo local file to /tmp/bar in a remote pod in namespace <some-namespace> in cluster(member1)
+		%[1]s cp /tmp/foo <some-namespace>/<some-pod>:/tmp/bar -C=member1
+
+		# Copy /tmp/foo from a remote pod to /tmp/bar locally in cluster(member1)
+		%[1]s cp <some-namespace>/<some-pod>:/tmp/foo /tmp/bar -C=member1`)
+)
+
+// NewCmdCp new cp command.
+func NewCmdCp(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandCpOptions{
+		KubectlCpOptions: kubectlcp.NewCopyOptions(streams),
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "cp <file-spec-src> <file-spec-dest> (-C CLUSTER)",
+		Short:                 "Copy files and directories to and from containers in a cluster",
+		Long:                  cpLong,
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Example:               fmt.Sprintf(cpExample, parentCommand),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(f, cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	cmdutil.AddContainerVarFlags(cmd, &o.KubectlCpOptions.Container, o.KubectlCpOptions.Container)
+	flags.BoolVar(&o.KubectlCpOptions.NoPreserve, "no-preserve", false, "The copied file/directory's ownership and permissions will not be preserved in the container")
+	flags.IntVar(&o.KubectlCpOptions.MaxTries, "retries", 0, "Set number of retries to complete a copy operation from a container. Specify 0 to disable or any negative value for infinite retrying. The default is 0 (no retry).")
+	flags.StringVarP(&o.Cluster, "cluster", "C", "", "Specify a member cluster")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForClusterFlag(cmd)
+	return cmd
+}
+
+// CommandCpOptions contains the input to the cp command.
+type CommandCpOptions struct {
+	// flags specific to cp
+	KubectlCpOptions *kubectlcp.CopyOptions
+	Cluster          string
+}
+
+// Complete completes all the required options for cp cmd.
+func (o *CommandCpOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	if o.Cluster == "" {
+		return errors.New("must specify a cluster")
+	}
+
+	memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
+	if err != nil {
+		return err
+	}
+	if err := o.KubectlCpOptions.Complete(memberFactory, cmd, args); err != nil {
+		return err
+	}
+	// The exec command suggested for copying symlinks is not runnable without the cluster flags,
+	// so leave it out of the warning.
+	o.KubectlCpOptions.ExecParentCmdName = ""
+	return nil
+}
+
+// Validate makes sure provided values for cp options are valid.
+func (o *CommandCpOptions) Validate() error {
+	return o.KubectlCpOptions.Validate()
+}
+
+// Run copies the files through the cluster proxy.
+func (o *CommandCpOptions) Run() error {
+	return o.KubectlCpOptions.Run()
+}
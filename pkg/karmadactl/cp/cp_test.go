@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/rest/fake"
+	kubectlcp "k8s.io/kubectl/pkg/cmd/cp"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+const memberProxyHost = "https://karmada-apiserver:5443/apis/cluster.karmada.io/v1alpha1/clusters/member1/proxy"
+
+type testFactory struct {
+	util.Factory
+	memberFactory cmdutil.Factory
+}
+
+func (t *testFactory) FactoryForMemberCluster(string) (cmdutil.Factory, error) {
+	if t.memberFactory == nil {
+		return nil, errors.New("failed to create factory for member cluster")
+	}
+	return t.memberFactory, nil
+}
+
+func newMemberFactory() cmdutil.Factory {
+	f := cmdtesting.NewTestFactory().WithNamespace("test")
+	f.ClientConfigVal = &rest.Config{Host: memberProxyHost}
+	f.Client = &fake.RESTClient{}
+	return f
+}
+
+func TestCompleteCpOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster string
+		f       util.Factory
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "CompleteCpOptions_WithoutCluster_ClusterMustBeSpecified",
+			f:       &testFactory{},
+			wantErr: true,
+			errMsg:  "must specify a cluster",
+		},
+		{
+			name:    "CompleteCpOptions_ReturnMemberClusterFactory_FailedToReturnMemberClusterFactory",
+			cluster: "member1",
+			f:       &testFactory{},
+			wantErr: true,
+			errMsg:  "failed to create factory for member cluster",
+		},
+		{
+			name:    "CompleteCpOptions_WithMemberCluster_CopyThroughClusterProxy",
+			cluster: "member1",
+			f:       &testFactory{memberFactory: newMemberFactory()},
+			wantErr: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := &CommandCpOptions{
+				KubectlCpOptions: kubectlcp.NewCopyOptions(genericiooptions.NewTestIOStreamsDiscard()),
+				Cluster:          test.cluster,
+			}
+			parent := &cobra.Command{Use: "karmadactl"}
+			cmd := &cobra.Command{Use: "cp"}
+			parent.AddCommand(cmd)
+
+			err := o.Complete(test.f, cmd, []string{"/tmp/foo", "nginx:/tmp/bar"})
+			if err == nil && test.wantErr {
+				t.Fatal("expected an error, but got none")
+			}
+			if err != nil && !test.wantErr {
+				t.Fatalf("unexpected error, got: %v", err)
+			}
+			if err != nil && test.wantErr && !strings.Contains(err.Error(), test.errMsg) {
+				t.Errorf("expected error message %s to be in %s", test.errMsg, err.Error())
+			}
+			if test.wantErr {
+				return
+			}
+			if o.KubectlCpOptions.ClientConfig.Host != memberProxyHost {
+				t.Errorf("expected host %s, but got %s", memberProxyHost, o.KubectlCpOptions.ClientConfig.Host)
+			}
+			if o.KubectlCpOptions.Namespace != "test" {
+				t.Errorf("expected namespace test, but got %s", o.KubectlCpOptions.Namespace)
+			}
+			if o.KubectlCpOptions.ExecParentCmdName != "" {
+				t.Errorf("expected no exec command in the symlink warning, but got %s", o.KubectlCpOptions.ExecParentCmdName)
+			}
+			if err := o.Validate(); err != nil {
+				t.Errorf("unexpected error, got: %v", err)
+			}
+		})
+	}
+}
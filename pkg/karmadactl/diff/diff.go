@@ -0,0 +1,418 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/dynamic"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native/prune"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+// unscheduledReplicas marks the target clusters which are not scheduled by the binding.
+const unscheduledReplicas = -1
+
+var (
+	diffLong = templates.LongDesc(`
+		Diff the manifests desired in member clusters against the live objects.
+
+		For each member cluster the resource template is propagated to, the desired manifest is rendered
+		the same way as Karmada does: the replicas are revised with the scheduling result, the
+		OverridePolicies and ClusterOverridePolicies are applied, and the fields of the live object are
+		retained by the resource interpreter. The live object is fetched through the cluster proxy of
+		Karmada control plane. Resource interpreter webhooks are not taken into account.
+
+		The resource template is read from the file, or from Karmada control plane if it is referred by
+		type and name. The member clusters are the scheduling result of the resource template unless
+		they are specified by --cluster.
+
+		Exit status: 0 No differences were found. 1 Differences were found or an error occurred.`)
+
+	diffExample = templates.Examples(`
+		# Diff the deployment(default/nginx) in Karmada control plane against member clusters
+		%[1]s diff deployment nginx -n default
+
+		# Diff the resources in the file against member clusters
+		%[1]s diff -f nginx.yaml
+
+		# Diff the deployment(default/nginx) against member1 and member2 only
+		%[1]s diff deployment nginx -n default -C member1,member2
+
+		# Compare the rendered manifests directly instead of sending them to member clusters in dry-run mode
+		%[1]s diff -f nginx.yaml --server-side-dry-run=false`)
+)
+
+// NewCmdDiff creates the `diff` command.
+func NewCmdDiff(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandDiffOptions{
+		IOStreams:        streams,
+		ServerSideDryRun: true,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "diff (-f FILENAME | TYPE NAME) [-C CLUSTER]",
+		Short:                 "Diff the manifests desired in member clusters against the live objects",
+		Long:                  diffLong,
+		Example:               fmt.Sprintf(diffExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(f, cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+
+	flags := cmd.Flags()
+	cmdutil.AddFilenameOptionFlags(cmd, &o.FilenameOptions, "containing the resource templates to diff")
+	flags.StringSliceVarP(&o.Clusters, "cluster", "C", nil, "The member clusters to diff against, defaults to the clusters the resource template is scheduled to.")
+	flags.BoolVar(&o.ServerSideDryRun, "server-side-dry-run", o.ServerSideDryRun, "If true, the desired manifests are sent to member clusters as dry-run requests, so that the defaulting and admission of member clusters take effect before comparing.")
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForClusterFlag(cmd)
+	return cmd
+}
+
+// CommandDiffOptions contains the input to the diff command.
+type CommandDiffOptions struct {
+	genericiooptions.IOStreams
+	resource.FilenameOptions
+
+	// Clusters are the member clusters to diff against.
+	Clusters []string
+	// ServerSideDryRun tells whether to send the desired manifests to member clusters in dry-run mode.
+	ServerSideDryRun bool
+
+	args               []string
+	namespace          string
+	enforceNamespace   bool
+	builder            *resource.Builder
+	controlPlaneClient client.Client
+	// memberDynamicClient returns the dynamic client of the member cluster, which accesses the member cluster
+	// through the cluster proxy.
+	memberDynamicClient func(cluster string) (dynamic.Interface, error)
+}
+
+// Complete completes all the required options.
+func (o *CommandDiffOptions) Complete(f util.Factory, _ *cobra.Command, args []string) error {
+	var err error
+	o.args = args
+	o.namespace, o.enforceNamespace, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	o.builder = f.NewBuilder()
+
+	restConfig, err := f.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	o.controlPlaneClient, err = gclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	memberClients := make(map[string]dynamic.Interface)
+	o.memberDynamicClient = func(cluster string) (dynamic.Interface, error) {
+		if c, ok := memberClients[cluster]; ok {
+			return c, nil
+		}
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+		c, err := memberFactory.DynamicClient()
+		if err != nil {
+			return nil, err
+		}
+		memberClients[cluster] = c
+		return c, nil
+	}
+	return nil
+}
+
+// Validate checks the options.
+func (o *CommandDiffOptions) Validate() error {
+	if cmdutil.IsFilenameSliceEmpty(o.Filenames, o.Kustomize) && len(o.args) == 0 {
+		return fmt.Errorf("must specify the resource templates by -f FILENAME or TYPE NAME")
+	}
+	if !cmdutil.IsFilenameSliceEmpty(o.Filenames, o.Kustomize) && len(o.args) > 0 {
+		return fmt.Errorf("can not specify both -f FILENAME and TYPE NAME")
+	}
+	for _, cluster := range o.Clusters {
+		if cluster == "" {
+			return fmt.Errorf("cluster name should not be empty")
+		}
+	}
+	return nil
+}
+
+// Run diffs the resource templates against member clusters.
+func (o *CommandDiffOptions) Run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	r := o.builder.
+		Unstructured().
+		NamespaceParam(o.namespace).DefaultNamespace().
+		FilenameParam(o.enforceNamespace, &o.FilenameOptions).
+		ResourceTypeOrNameArgs(false, o.args...).
+		RequireObject(true).
+		Flatten().
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	render, err := newRenderer(ctx, o.controlPlaneClient)
+	if err != nil {
+		return err
+	}
+
+	drifted := false
+	var errs []error
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		template, ok := info.Object.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("unexpected object type %T", info.Object)
+		}
+		if info.Namespaced() && template.GetNamespace() == "" {
+			template.SetNamespace(info.Namespace)
+		}
+
+		templateDrifted, diffErr := o.diffTemplate(ctx, render, template, info.Mapping)
+		drifted = drifted || templateDrifted
+		if diffErr != nil {
+			errs = append(errs, diffErr)
+		}
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+	if drifted {
+		// As the differences are printed above, don't print anything else.
+		return cmdutil.ErrExit
+	}
+	return nil
+}
+
+// diffTemplate diffs the resource template against its target clusters, and tells whether any difference is found.
+func (o *CommandDiffOptions) diffTemplate(ctx context.Context, render *renderer, template *unstructured.Unstructured, mapping *meta.RESTMapping) (bool, error) {
+	bindingSpec, err := render.getBindingSpec(ctx, template)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the binding of %s: %v", objectName(template), err)
+	}
+
+	targets, err := o.targetClusters(template, bindingSpec)
+	if err != nil {
+		return false, err
+	}
+
+	drifted := false
+	var errs []error
+	for _, target := range targets {
+		clusterDrifted, err := o.diffCluster(ctx, render, template, mapping, bindingSpec, target)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to diff %s against cluster(%s): %v", objectName(template), target.Name, err))
+			continue
+		}
+		drifted = drifted || clusterDrifted
+	}
+	return drifted, utilerrors.NewAggregate(errs)
+}
+
+// targetClusters returns the clusters to diff against. The replicas of the clusters not scheduled
+// by the binding are left as it is in the resource template.
+func (o *CommandDiffOptions) targetClusters(template *unstructured.Unstructured, bindingSpec *workv1alpha2.ResourceBindingSpec) ([]workv1alpha2.TargetCluster, error) {
+	if len(o.Clusters) == 0 {
+		if bindingSpec == nil {
+			return nil, fmt.Errorf("%s is not propagated to any cluster, please specify the clusters by --cluster", objectName(template))
+		}
+		if len(bindingSpec.Clusters) == 0 {
+			fmt.Fprintf(o.ErrOut, "%s has not been scheduled to any cluster yet\n", objectName(template))
+		}
+		return bindingSpec.Clusters, nil
+	}
+
+	targets := make([]workv1alpha2.TargetCluster, 0, len(o.Clusters))
+	for _, cluster := range o.Clusters {
+		target := workv1alpha2.TargetCluster{Name: cluster, Replicas: unscheduledReplicas}
+		if bindingSpec != nil {
+			for _, scheduled := range bindingSpec.Clusters {
+				if scheduled.Name == cluster {
+					target = scheduled
+					break
+				}
+			}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// diffCluster prints the difference between the manifest desired in the cluster and the live object,
+// and tells whether there is any difference.
+func (o *CommandDiffOptions) diffCluster(ctx context.Context, render *renderer, template *unstructured.Unstructured, mapping *meta.RESTMapping,
+	bindingSpec *workv1alpha2.ResourceBindingSpec, target workv1alpha2.TargetCluster) (bool, error) {
+	if target.Replicas == unscheduledReplicas {
+		// The cluster is not scheduled, so the replicas are not revised.
+		bindingSpec = nil
+	}
+	desired, err := render.render(template, bindingSpec, target)
+	if err != nil {
+		return false, err
+	}
+
+	dynamicClient, err := o.memberDynamicClient(target.Name)
+	if err != nil {
+		return false, err
+	}
+	resourceClient := dynamicClient.Resource(mapping.Resource).Namespace(desired.GetNamespace())
+	live, err := resourceClient.Get(ctx, desired.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		live = nil
+	}
+
+	if live != nil {
+		// Pass the same ResourceVersion as the live object for the dry-run update, otherwise it will fail.
+		desired.SetResourceVersion(live.GetResourceVersion())
+		if desired, err = render.retain(desired, live); err != nil {
+			return false, err
+		}
+	}
+	if o.ServerSideDryRun {
+		if live != nil {
+			desired, err = resourceClient.Update(ctx, desired, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+		} else {
+			desired, err = resourceClient.Create(ctx, desired, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		}
+		if err != nil {
+			return false, fmt.Errorf("dry-run request failed: %v", err)
+		}
+	}
+
+	name := diffName(target.Name, desired)
+	return printDiff(o.Out, "live/"+name, "desired/"+name, live, desired)
+}
+
+// printDiff prints the unified diff of the two objects, and tells whether they differ. A nil object is
+// regarded as absent.
+func printDiff(w io.Writer, fromName, toName string, from, to *unstructured.Unstructured) (bool, error) {
+	fromYAML, err := toComparableYAML(from)
+	if err != nil {
+		return false, err
+	}
+	toYAML, err := toComparableYAML(to)
+	if err != nil {
+		return false, err
+	}
+	if fromYAML == toYAML {
+		return false, nil
+	}
+
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromYAML),
+		B:        difflib.SplitLines(toYAML),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	if err != nil {
+		return false, err
+	}
+	fmt.Fprint(w, text)
+	return true, nil
+}
+
+// toComparableYAML serializes the object to YAML without the fields populated by member clusters.
+func toComparableYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	obj = obj.DeepCopy()
+	if err := prune.RemoveIrrelevantFields(obj, prune.RemoveJobTTLSeconds); err != nil {
+		return "", err
+	}
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// diffName returns the name of the object in the diff, e.g. member1/apps.v1.Deployment.default.nginx.
+func diffName(cluster string, obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	parts := []string{gvk.Group, gvk.Version, gvk.Kind, obj.GetNamespace(), obj.GetName()}
+	if gvk.Group == "" {
+		parts = parts[1:]
+	}
+	if obj.GetNamespace() == "" {
+		parts = append(parts[:len(parts)-2], obj.GetName())
+	}
+	return cluster + "/" + strings.Join(parts, ".")
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s(%s)", obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s(%s/%s)", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
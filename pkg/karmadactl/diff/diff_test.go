@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+var deploymentMapping = &meta.RESTMapping{
+	Resource: schemaGVR("apps", "v1", "deployments"),
+	Scope:    meta.RESTScopeNamespace,
+}
+
+func newDeployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "nginx", "namespace": "default"},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "nginx", "image": image}},
+				},
+			},
+		},
+	}}
+}
+
+// newLiveDeployment returns a deployment populated by a member cluster.
+func newLiveDeployment(replicas int64, image string) *unstructured.Unstructured {
+	obj := newDeployment(replicas, image)
+	obj.SetResourceVersion("100")
+	obj.SetUID("uid")
+	obj.SetGeneration(2)
+	obj.SetLabels(map[string]string{workv1alpha2.WorkPermanentIDLabel: "nginx-work"})
+	_ = unstructured.SetNestedField(obj.Object, replicas, "status", "replicas")
+	return obj
+}
+
+func newTestOptions(t *testing.T, out *bytes.Buffer, live map[string][]runtime.Object) (*CommandDiffOptions, *renderer) {
+	t.Helper()
+	controlPlaneObjects := []runtime.Object{
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member2"}},
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Replicas: 4,
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}, {Name: "member2", Replicas: 1}},
+			},
+		},
+		&policyv1alpha1.OverridePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "image", Namespace: "default"},
+			Spec: policyv1alpha1.OverrideSpec{
+				OverrideRules: []policyv1alpha1.RuleWithCluster{{
+					TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+					Overriders: policyv1alpha1.Overriders{
+						Plaintext: []policyv1alpha1.PlaintextOverrider{{
+							Path:     "/spec/template/spec/containers/0/image",
+							Operator: policyv1alpha1.OverriderOpReplace,
+							Value:    apiextensionsv1.JSON{Raw: []byte(`"nginx:1.1"`)},
+						}},
+					},
+				}},
+			},
+		},
+	}
+	controlPlaneClient := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithRuntimeObjects(controlPlaneObjects...).Build()
+	render, err := newRenderer(context.TODO(), controlPlaneClient)
+	require.NoError(t, err)
+
+	memberClients := make(map[string]dynamic.Interface)
+	for cluster, objects := range live {
+		memberClients[cluster] = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	}
+	o := &CommandDiffOptions{
+		IOStreams:          genericiooptions.IOStreams{Out: out, ErrOut: out},
+		controlPlaneClient: controlPlaneClient,
+		memberDynamicClient: func(cluster string) (dynamic.Interface, error) {
+			c, ok := memberClients[cluster]
+			if !ok {
+				return nil, fmt.Errorf("cluster %s not found", cluster)
+			}
+			return c, nil
+		},
+	}
+	return o, render
+}
+
+func TestCommandDiffOptions_diffTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		clusters    []string
+		live        map[string][]runtime.Object
+		wantDrifted bool
+		wantErr     bool
+		wantOut     []string
+		notWantOut  []string
+	}{
+		{
+			name: "no drift",
+			live: map[string][]runtime.Object{
+				"member1": {newLiveDeployment(3, "nginx:1.1")},
+				"member2": {newLiveDeployment(1, "nginx:1.0")},
+			},
+			wantDrifted: false,
+		},
+		{
+			name: "drift in one cluster",
+			live: map[string][]runtime.Object{
+				"member1": {newLiveDeployment(3, "nginx:1.1")},
+				"member2": {newLiveDeployment(2, "nginx:0.9")},
+			},
+			wantDrifted: true,
+			wantOut: []string{
+				"--- live/member2/apps.v1.Deployment.default.nginx",
+				"+++ desired/member2/apps.v1.Deployment.default.nginx",
+				"-  replicas: 2",
+				"+  replicas: 1",
+				"-      - image: nginx:0.9",
+				"+      - image: nginx:1.0",
+			},
+			notWantOut: []string{"member1"},
+		},
+		{
+			name: "object missing in the cluster",
+			live: map[string][]runtime.Object{
+				"member1": {},
+				"member2": {newLiveDeployment(1, "nginx:1.0")},
+			},
+			wantDrifted: true,
+			wantOut:     []string{"+++ desired/member1/apps.v1.Deployment.default.nginx", "+  replicas: 3", "+      - image: nginx:1.1"},
+		},
+		{
+			name:     "specified cluster not scheduled",
+			clusters: []string{"member3"},
+			live: map[string][]runtime.Object{
+				"member3": {newLiveDeployment(4, "nginx:1.0")},
+			},
+			wantDrifted: false,
+			wantErr:     true,
+		},
+		{
+			name:     "specified cluster",
+			clusters: []string{"member2"},
+			live: map[string][]runtime.Object{
+				"member2": {newLiveDeployment(1, "nginx:1.0")},
+			},
+			wantDrifted: false,
+		},
+		{
+			name:     "member cluster unreachable",
+			clusters: []string{"member1", "member2"},
+			live: map[string][]runtime.Object{
+				"member2": {newLiveDeployment(2, "nginx:1.0")},
+			},
+			wantDrifted: true,
+			wantErr:     true,
+			wantOut:     []string{"-  replicas: 2", "+  replicas: 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			o, render := newTestOptions(t, out, tt.live)
+			o.Clusters = tt.clusters
+
+			drifted, err := o.diffTemplate(context.TODO(), render, newDeployment(4, "nginx:1.0"), deploymentMapping)
+			assert.Equal(t, tt.wantErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, tt.wantDrifted, drifted, out.String())
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+			for _, notWant := range tt.notWantOut {
+				assert.NotContains(t, out.String(), notWant)
+			}
+		})
+	}
+}
+
+func TestCommandDiffOptions_targetClusters(t *testing.T) {
+	bindingSpec := &workv1alpha2.ResourceBindingSpec{
+		Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}},
+	}
+
+	o := &CommandDiffOptions{IOStreams: genericiooptions.NewTestIOStreamsDiscard()}
+	targets, err := o.targetClusters(newDeployment(3, "nginx"), bindingSpec)
+	require.NoError(t, err)
+	assert.Equal(t, bindingSpec.Clusters, targets)
+
+	_, err = o.targetClusters(newDeployment(3, "nginx"), nil)
+	assert.ErrorContains(t, err, "please specify the clusters by --cluster")
+
+	o.Clusters = []string{"member1", "member2"}
+	targets, err = o.targetClusters(newDeployment(3, "nginx"), bindingSpec)
+	require.NoError(t, err)
+	assert.Equal(t, []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}, {Name: "member2", Replicas: unscheduledReplicas}}, targets)
+}
+
+func TestDiffName(t *testing.T) {
+	namespace := &unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind("Namespace")
+	namespace.SetName("foo")
+
+	assert.Equal(t, "member1/apps.v1.Deployment.default.nginx", diffName("member1", newDeployment(1, "nginx")))
+	assert.Equal(t, "member1/v1.Namespace.foo", diffName("member1", namespace))
+}
+
+func TestCommandDiffOptions_Validate(t *testing.T) {
+	o := &CommandDiffOptions{}
+	assert.Error(t, o.Validate())
+
+	o.args = []string{"deployment", "nginx"}
+	assert.NoError(t, o.Validate())
+
+	o.Filenames = []string{"nginx.yaml"}
+	assert.Error(t, o.Validate())
+
+	o.args = nil
+	o.Clusters = []string{""}
+	assert.Error(t, o.Validate())
+}
+
+func schemaGVR(group, version, resource string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: group, Version: version, Resource: resource}
+}
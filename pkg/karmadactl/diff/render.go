@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/native/prune"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/thirdparty"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/util/overridemanager"
+)
+
+// renderer renders the manifest of a resource template desired in a member cluster, following the same steps
+// as karmada-controller-manager does when it propagates the resource template:
+//  1. revise the replicas with the scheduling result,
+//  2. apply the OverridePolicies and ClusterOverridePolicies,
+//  3. retain the fields of the live object in the member cluster.
+//
+// The resource interpreter customizations in Karmada control plane, the third-party and the built-in default
+// interpreters are taken into account, while the interpreter webhooks are not.
+type renderer struct {
+	client          client.Client
+	overrideManager overridemanager.OverrideManager
+	customized      *declarative.ConfigurableInterpreter
+	thirdparty      *thirdparty.ConfigurableInterpreter
+	native          *native.DefaultInterpreter
+}
+
+// newRenderer builds a renderer with the client of Karmada control plane.
+func newRenderer(ctx context.Context, c client.Client) (*renderer, error) {
+	customizationList := &configv1alpha1.ResourceInterpreterCustomizationList{}
+	if err := c.List(ctx, customizationList); err != nil {
+		return nil, fmt.Errorf("failed to list resource interpreter customizations: %v", err)
+	}
+	customizations := make([]*configv1alpha1.ResourceInterpreterCustomization, 0, len(customizationList.Items))
+	for i := range customizationList.Items {
+		customizations = append(customizations, &customizationList.Items[i])
+	}
+	customized := declarative.NewConfigurableInterpreter(nil)
+	customized.LoadConfig(customizations)
+
+	return &renderer{
+		client: c,
+		// The events of applying overrides are meaningless here, so they are dropped.
+		overrideManager: overridemanager.New(c, &record.FakeRecorder{}),
+		customized:      customized,
+		thirdparty:      thirdparty.NewConfigurableInterpreter(),
+		native:          native.NewDefaultInterpreter(),
+	}, nil
+}
+
+// getBindingSpec returns the spec of the binding of the resource template, or nil if the binding is not found.
+func (r *renderer) getBindingSpec(ctx context.Context, template *unstructured.Unstructured) (*workv1alpha2.ResourceBindingSpec, error) {
+	bindingName := names.GenerateBindingName(template.GetKind(), template.GetName())
+	if template.GetNamespace() == "" {
+		binding := &workv1alpha2.ClusterResourceBinding{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: bindingName}, binding); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &binding.Spec, nil
+	}
+
+	binding := &workv1alpha2.ResourceBinding{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: template.GetNamespace(), Name: bindingName}, binding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &binding.Spec, nil
+}
+
+// render returns the manifest desired in the cluster. The replicas are revised only if the target cluster
+// carries the replicas assigned by the scheduler.
+func (r *renderer) render(template *unstructured.Unstructured, bindingSpec *workv1alpha2.ResourceBindingSpec, target workv1alpha2.TargetCluster) (*unstructured.Unstructured, error) {
+	desired := template.DeepCopy()
+	if err := prune.RemoveIrrelevantFields(desired, prune.RemoveJobTTLSeconds); err != nil {
+		return nil, err
+	}
+
+	if bindingSpec != nil && bindingSpec.IsWorkload() {
+		revised, err := r.reviseReplica(desired, int64(target.Replicas))
+		if err != nil {
+			return nil, fmt.Errorf("failed to revise replicas for cluster(%s): %v", target.Name, err)
+		}
+		desired = revised
+	}
+
+	// Overrides have the highest priority, so they are applied last.
+	if _, _, err := r.overrideManager.ApplyOverridePolicies(desired, target.Name); err != nil {
+		return nil, fmt.Errorf("failed to apply overrides for cluster(%s): %v", target.Name, err)
+	}
+	return desired, nil
+}
+
+// retain retains the fields of the observed object in the member cluster, which are typically set by
+// the controllers in the member cluster and shouldn't be overwritten.
+func (r *renderer) retain(desired, observed *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	desired.SetFinalizers(observed.GetFinalizers())
+	desired.SetOwnerReferences(observed.GetOwnerReferences())
+	util.RetainAnnotations(desired, observed)
+	util.RetainLabels(desired, observed)
+
+	if retained, enabled, err := r.customized.Retain(desired, observed); enabled {
+		return retained, err
+	}
+	if retained, enabled, err := r.thirdparty.Retain(desired, observed); enabled {
+		return retained, err
+	}
+	if r.native.HookEnabled(desired.GroupVersionKind(), configv1alpha1.InterpreterOperationRetain) {
+		return r.native.Retain(desired, observed)
+	}
+	return desired, nil
+}
+
+func (r *renderer) reviseReplica(object *unstructured.Unstructured, replicas int64) (*unstructured.Unstructured, error) {
+	if revised, enabled, err := r.customized.ReviseReplica(object, replicas); enabled {
+		return revised, err
+	}
+	if revised, enabled, err := r.thirdparty.ReviseReplica(object, replicas); enabled {
+		return revised, err
+	}
+	if r.native.HookEnabled(object.GroupVersionKind(), configv1alpha1.InterpreterOperationReviseReplica) {
+		return r.native.ReviseReplica(object, replicas)
+	}
+	return object, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// dialTimeout is the timeout to connect to karmada-apiserver to get its serving certificate.
+const dialTimeout = 5 * time.Second
+
+// checkCertificates checks the expiry of the serving certificate of karmada-apiserver and the client
+// certificate in the kubeconfig. The CA bundles of the APIServices and the webhooks are checked along with them.
+func (o *CommandDoctorOptions) checkCertificates(_ context.Context) []Finding {
+	if o.restConfig == nil {
+		return nil
+	}
+
+	var findings []Finding
+	if certs, err := servingCertificates(o.restConfig.Host); err != nil {
+		findings = append(findings, Finding{Check: "Certificate", Target: names.KarmadaAPIServerComponentName, Severity: SeverityWarning,
+			Message: fmt.Sprintf("failed to get the serving certificate: %v", err)})
+	} else if len(certs) > 0 {
+		findings = append(findings, o.certificateFinding("Certificate", names.KarmadaAPIServerComponentName, certs))
+	}
+
+	clientCert := o.restConfig.CertData
+	if len(clientCert) == 0 && o.restConfig.CertFile != "" {
+		data, err := os.ReadFile(o.restConfig.CertFile)
+		if err != nil {
+			return append(findings, Finding{Check: "Certificate", Target: "kubeconfig", Severity: SeverityWarning,
+				Message: fmt.Sprintf("failed to read the client certificate: %v", err)})
+		}
+		clientCert = data
+	}
+	if len(clientCert) > 0 {
+		findings = append(findings, o.checkCertificate("Certificate", "kubeconfig", clientCert))
+	}
+	return findings
+}
+
+// servingCertificates returns the certificates served by the host, it returns nothing if the host is not
+// served over TLS.
+func servingCertificates(host string) ([]*x509.Certificate, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" {
+		return nil, nil
+	}
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	// Only the certificates are inspected, nothing is sent over the connection.
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", address, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates, nil
+}
+
+// checkCertificate checks the expiry of the PEM encoded certificates.
+func (o *CommandDoctorOptions) checkCertificate(check, target string, data []byte) Finding {
+	certs, err := certutil.ParseCertsPEM(data)
+	if err != nil {
+		return Finding{Check: check, Target: target, Severity: SeverityWarning, Message: fmt.Sprintf("failed to parse certificates: %v", err)}
+	}
+	return o.certificateFinding(check, target, certs)
+}
+
+// certificateFinding reports the certificate expiring first.
+func (o *CommandDoctorOptions) certificateFinding(check, target string, certs []*x509.Certificate) Finding {
+	first := certs[0]
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(first.NotAfter) {
+			first = cert
+		}
+	}
+
+	finding := Finding{Check: check, Target: target, Severity: SeverityOK}
+	remaining := first.NotAfter.Sub(o.now())
+	expiry := first.NotAfter.UTC().Format(time.RFC3339)
+	switch {
+	case remaining <= 0:
+		finding.Severity = SeverityError
+		finding.Message = fmt.Sprintf("certificate %q expired at %s", first.Subject.CommonName, expiry)
+	case remaining < o.CertExpirationThreshold:
+		finding.Severity = SeverityWarning
+		finding.Message = fmt.Sprintf("certificate %q expires in %d day(s) at %s", first.Subject.CommonName, int(remaining.Hours()/24), expiry)
+	default:
+		finding.Message = fmt.Sprintf("certificate %q expires at %s", first.Subject.CommonName, expiry)
+	}
+	if finding.Severity != SeverityOK {
+		finding.Suggestion = "Renew the certificate and the certificates signed by the same CA before they expire."
+	}
+	return finding
+}
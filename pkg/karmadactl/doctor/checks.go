@@ -0,0 +1,470 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	initkarmada "github.com/karmada-io/karmada/pkg/karmadactl/cmdinit/karmada"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// proxyVersionURL is the URL to get the version of a member cluster through the cluster proxy.
+const proxyVersionURL = "/apis/cluster.karmada.io/v1alpha1/clusters/%s/proxy/version"
+
+// componentLeases are the leader election leases of the components in Karmada control plane, the optional
+// components may not be installed.
+var componentLeases = []struct {
+	name     string
+	optional bool
+}{
+	{name: names.KarmadaControllerManagerComponentName},
+	{name: names.KarmadaSchedulerComponentName},
+	{name: names.KarmadaDeschedulerComponentName, optional: true},
+}
+
+// apiServices are the APIServices registered by the components in Karmada control plane, the optional
+// APIServices are registered by the addons which may not be installed.
+var apiServices = []struct {
+	name      string
+	component string
+	optional  bool
+}{
+	{name: "v1alpha1.cluster.karmada.io", component: names.KarmadaAggregatedAPIServerComponentName},
+	{name: "v1alpha1.search.karmada.io", component: names.KarmadaSearchComponentName, optional: true},
+	{name: "v1beta1.metrics.k8s.io", component: names.KarmadaMetricsAdapterComponentName, optional: true},
+	{name: "v1beta1.custom.metrics.k8s.io", component: names.KarmadaMetricsAdapterComponentName, optional: true},
+	{name: "v1beta2.custom.metrics.k8s.io", component: names.KarmadaMetricsAdapterComponentName, optional: true},
+}
+
+// checkAPIServer checks the reachability of karmada-apiserver.
+func (o *CommandDoctorOptions) checkAPIServer(_ context.Context) []Finding {
+	finding := Finding{Check: "APIServer", Target: names.KarmadaAPIServerComponentName}
+	version, err := o.kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		finding.Severity, finding.Message = SeverityError, fmt.Sprintf("not reachable: %v", err)
+		finding.Suggestion = "Check the kubeconfig of Karmada control plane and the pods of karmada-apiserver."
+		return []Finding{finding}
+	}
+	finding.Severity, finding.Message = SeverityOK, fmt.Sprintf("reachable, version %s", version.GitVersion)
+	return []Finding{finding}
+}
+
+// checkComponents checks the leader election leases of the components in Karmada control plane, and the
+// workloads of the components in the host cluster.
+func (o *CommandDoctorOptions) checkComponents(ctx context.Context) []Finding {
+	var findings []Finding
+	for _, component := range componentLeases {
+		finding := Finding{Check: "Component", Target: component.name}
+		lease, err := o.kubeClient.CoordinationV1().Leases(names.NamespaceKarmadaSystem).Get(ctx, component.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err) && component.optional:
+			continue
+		case apierrors.IsNotFound(err):
+			finding.Severity, finding.Message = SeverityError, "leader election lease not found"
+			finding.Suggestion = fmt.Sprintf("Check whether %s is installed and running.", component.name)
+		case err != nil:
+			finding.Severity, finding.Message = SeverityError, fmt.Sprintf("failed to get leader election lease: %v", err)
+		default:
+			finding.Severity, finding.Message = o.leaseFreshness(lease)
+			if finding.Severity != SeverityOK {
+				finding.Suggestion = fmt.Sprintf("Check the pods and logs of %s.", component.name)
+			}
+		}
+		findings = append(findings, finding)
+	}
+	return append(findings, o.checkHostWorkloads(ctx)...)
+}
+
+// checkHostWorkloads checks the workloads of the components in the host cluster.
+func (o *CommandDoctorOptions) checkHostWorkloads(ctx context.Context) []Finding {
+	if o.hostClient == nil {
+		return []Finding{{Check: "Workload", Target: o.HostNamespace, Severity: SeveritySkipped, Message: "--host-kubeconfig not specified"}}
+	}
+
+	deployments, err := o.hostClient.AppsV1().Deployments(o.HostNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []Finding{{Check: "Workload", Target: o.HostNamespace, Severity: SeverityError, Message: fmt.Sprintf("failed to list Deployments: %v", err)}}
+	}
+	statefulSets, err := o.hostClient.AppsV1().StatefulSets(o.HostNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []Finding{{Check: "Workload", Target: o.HostNamespace, Severity: SeverityError, Message: fmt.Sprintf("failed to list StatefulSets: %v", err)}}
+	}
+
+	var findings []Finding
+	workloadFinding := func(kind, name string, desired, ready int32) Finding {
+		finding := Finding{Check: "Workload", Target: fmt.Sprintf("%s/%s", kind, name), Severity: SeverityOK,
+			Message: fmt.Sprintf("%d/%d replicas ready", ready, desired)}
+		if ready < desired {
+			finding.Severity = SeverityError
+			finding.Suggestion = fmt.Sprintf("Run 'kubectl -n %s describe %s %s' against the host cluster to find out why the replicas are not ready.",
+				o.HostNamespace, strings.ToLower(kind), name)
+		}
+		return finding
+	}
+	for _, deployment := range deployments.Items {
+		findings = append(findings, workloadFinding("Deployment", deployment.Name, ptr.Deref(deployment.Spec.Replicas, 1), deployment.Status.ReadyReplicas))
+	}
+	for _, statefulSet := range statefulSets.Items {
+		findings = append(findings, workloadFinding("StatefulSet", statefulSet.Name, ptr.Deref(statefulSet.Spec.Replicas, 1), statefulSet.Status.ReadyReplicas))
+	}
+	if len(findings) == 0 {
+		return []Finding{{Check: "Workload", Target: o.HostNamespace, Severity: SeverityError, Message: "no workloads found",
+			Suggestion: "Check whether --host-namespace is the namespace Karmada control plane is installed in."}}
+	}
+	return findings
+}
+
+// checkAPIServices checks the availability and the CA bundles of the APIServices.
+func (o *CommandDoctorOptions) checkAPIServices(ctx context.Context) []Finding {
+	var findings []Finding
+	for _, service := range apiServices {
+		finding := Finding{Check: "APIService", Target: service.name, Severity: SeverityOK, Message: "available"}
+		err := initkarmada.CheckAPIServiceReady(ctx, o.aggregatorClient, service.name)
+		switch {
+		case apierrors.IsNotFound(err) && service.optional:
+			continue
+		case err != nil:
+			finding.Severity, finding.Message = SeverityError, err.Error()
+			finding.Suggestion = fmt.Sprintf("Check the pods and logs of %s, and the network from karmada-apiserver to it.", service.component)
+		}
+		findings = append(findings, finding)
+
+		apiService, err := o.aggregatorClient.ApiregistrationV1().APIServices().Get(ctx, service.name, metav1.GetOptions{})
+		if err == nil && len(apiService.Spec.CABundle) > 0 {
+			findings = append(findings, o.checkCertificate("APIService CA", service.name, apiService.Spec.CABundle))
+		}
+	}
+	return findings
+}
+
+// checkWebhooks checks the reachability of karmada-webhook with a dry-run request if the webhooks are configured,
+// and the CA bundles of the webhooks.
+func (o *CommandDoctorOptions) checkWebhooks(ctx context.Context) []Finding {
+	var caFindings []Finding
+	configured, listed := 0, true
+	mutatingWebhooks, err := o.kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		listed = false
+		caFindings = append(caFindings, Finding{Check: "Webhook CA", Severity: SeverityError, Message: fmt.Sprintf("failed to list MutatingWebhookConfigurations: %v", err)})
+	} else {
+		for _, config := range mutatingWebhooks.Items {
+			for _, webhook := range config.Webhooks {
+				configured++
+				if len(webhook.ClientConfig.CABundle) > 0 {
+					caFindings = append(caFindings, o.checkCertificate("Webhook CA", config.Name+"/"+webhook.Name, webhook.ClientConfig.CABundle))
+				}
+			}
+		}
+	}
+	validatingWebhooks, err := o.kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		listed = false
+		caFindings = append(caFindings, Finding{Check: "Webhook CA", Severity: SeverityError, Message: fmt.Sprintf("failed to list ValidatingWebhookConfigurations: %v", err)})
+	} else {
+		for _, config := range validatingWebhooks.Items {
+			for _, webhook := range config.Webhooks {
+				configured++
+				if len(webhook.ClientConfig.CABundle) > 0 {
+					caFindings = append(caFindings, o.checkCertificate("Webhook CA", config.Name+"/"+webhook.Name, webhook.ClientConfig.CABundle))
+				}
+			}
+		}
+	}
+
+	finding := Finding{Check: "Webhook", Target: names.KarmadaWebhookComponentName, Severity: SeverityOK, Message: "reachable"}
+	if listed && configured == 0 {
+		// The dry-run request always succeeds without the webhooks, which tells nothing about the reachability.
+		finding.Severity, finding.Message = SeverityWarning, "not configured"
+		finding.Suggestion = "Check the installation of karmada-webhook, the MutatingWebhookConfigurations and ValidatingWebhookConfigurations are expected in karmada-apiserver."
+		return []Finding{finding}
+	}
+
+	policy := &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "karmadactl-doctor"},
+		Spec: policyv1alpha1.PropagationSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{{APIVersion: "v1", Kind: "ConfigMap", Name: "karmadactl-doctor"}},
+		},
+	}
+	_, err = o.karmadaClient.PolicyV1alpha1().PropagationPolicies(metav1.NamespaceDefault).Create(ctx, policy,
+		metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	switch {
+	case err == nil, apierrors.IsAlreadyExists(err), apierrors.IsInvalid(err):
+	case strings.Contains(err.Error(), "failed calling webhook"):
+		finding.Severity, finding.Message = SeverityError, err.Error()
+		finding.Suggestion = "Check the pods and logs of karmada-webhook, and the network from karmada-apiserver to it."
+	default:
+		finding.Severity, finding.Message = SeverityWarning, fmt.Sprintf("unable to verify: %v", err)
+	}
+	return append([]Finding{finding}, caFindings...)
+}
+
+// checkClusters checks the readiness of the clusters, the connectivity through the cluster proxy for the
+// clusters in Push mode, and the freshness of the leases renewed by karmada-agent for the clusters in Pull mode.
+func (o *CommandDoctorOptions) checkClusters(ctx context.Context) []Finding {
+	clusters, err := o.karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []Finding{{Check: "Cluster", Severity: SeverityError, Message: fmt.Sprintf("failed to list clusters: %v", err)}}
+	}
+	if len(clusters.Items) == 0 {
+		return []Finding{{Check: "Cluster", Severity: SeverityWarning, Message: "no clusters joined",
+			Suggestion: "Join clusters with the 'join' command or register clusters with the 'register' command."}}
+	}
+
+	findings := make([]Finding, 0, len(clusters.Items))
+	for _, cluster := range clusters.Items {
+		finding := Finding{Check: "Cluster", Target: cluster.Name, Severity: SeverityOK}
+		ready := meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+
+		if cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+			lease, err := o.kubeClient.CoordinationV1().Leases(util.NamespaceClusterLease).Get(ctx, cluster.Name, metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err):
+				finding.Severity, finding.Message = SeverityError, "Pull mode, lease of karmada-agent not found"
+			case err != nil:
+				finding.Severity, finding.Message = SeverityError, fmt.Sprintf("Pull mode, failed to get lease of karmada-agent: %v", err)
+			default:
+				var message string
+				finding.Severity, message = o.leaseFreshness(lease)
+				finding.Message = "Pull mode, karmada-agent " + message
+			}
+			if finding.Severity != SeverityOK {
+				finding.Suggestion = "Check the pods and logs of karmada-agent in the cluster, and the network from the cluster to karmada-apiserver."
+			}
+		} else {
+			finding.Message = "Push mode, reachable through the cluster proxy"
+			if err := o.proxyGet(ctx, cluster.Name); err != nil {
+				finding.Severity, finding.Message = SeverityError, fmt.Sprintf("Push mode, not reachable through the cluster proxy: %v", err)
+				finding.Suggestion = "Check the network from karmada-aggregated-apiserver to the cluster, and the credentials in the secret referenced by the cluster."
+			}
+		}
+
+		if finding.Severity == SeverityOK && (ready == nil || ready.Status != metav1.ConditionTrue) {
+			finding.Severity = SeverityError
+			if ready != nil {
+				finding.Message = fmt.Sprintf("%s, but not ready: %s", finding.Message, ready.Message)
+			} else {
+				finding.Message += ", but the readiness is not reported"
+			}
+			finding.Suggestion = "Check the logs of the cluster status controller in karmada-controller-manager, or karmada-agent for the clusters in Pull mode."
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+// checkEstimators checks whether the services of the scheduler estimators in the host cluster have ready
+// endpoints, and dials the gRPC services by the addresses karmada-scheduler connects to.
+func (o *CommandDoctorOptions) checkEstimators(ctx context.Context) []Finding {
+	if o.hostClient == nil {
+		return []Finding{{Check: "Estimator", Target: o.HostNamespace, Severity: SeveritySkipped, Message: "--host-kubeconfig not specified"}}
+	}
+
+	services, err := o.hostClient.CoreV1().Services(o.HostNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []Finding{{Check: "Estimator", Target: o.HostNamespace, Severity: SeverityError, Message: fmt.Sprintf("failed to list Services: %v", err)}}
+	}
+
+	prefix := names.KarmadaSchedulerEstimatorComponentName + "-"
+	var findings []Finding
+	for _, service := range services.Items {
+		cluster, found := strings.CutPrefix(service.Name, prefix)
+		if !found {
+			continue
+		}
+
+		finding := Finding{Check: "Estimator", Target: cluster, Severity: SeverityOK}
+		if _, err = o.karmadaClient.ClusterV1alpha1().Clusters().Get(ctx, cluster, metav1.GetOptions{}); apierrors.IsNotFound(err) {
+			finding.Severity, finding.Message = SeverityWarning, fmt.Sprintf("Service %s found, but the cluster does not exist", service.Name)
+			finding.Suggestion = fmt.Sprintf("Remove the scheduler estimator of the cluster with 'kubectl -n %s delete deployment,service %s' against the host cluster.",
+				o.HostNamespace, service.Name)
+			findings = append(findings, finding)
+			continue
+		}
+
+		slices, err := o.hostClient.DiscoveryV1().EndpointSlices(o.HostNamespace).List(ctx, metav1.ListOptions{
+			LabelSelector: discoveryv1.LabelServiceName + "=" + service.Name,
+		})
+		if err != nil {
+			finding.Severity, finding.Message = SeverityError, fmt.Sprintf("failed to list EndpointSlices of Service %s: %v", service.Name, err)
+			findings = append(findings, finding)
+			continue
+		}
+		ready := 0
+		for _, slice := range slices.Items {
+			for _, endpoint := range slice.Endpoints {
+				if ptr.Deref(endpoint.Conditions.Ready, true) {
+					ready++
+				}
+			}
+		}
+		if ready == 0 {
+			finding.Severity = SeverityError
+			finding.Message = fmt.Sprintf("no ready endpoints of Service %s, karmada-scheduler cannot reach the estimator over gRPC", service.Name)
+			finding.Suggestion = fmt.Sprintf("Check the pods and logs of Deployment %s in the host cluster.", names.GenerateEstimatorDeploymentName(cluster))
+			findings = append(findings, finding)
+			continue
+		}
+		if len(service.Spec.Ports) == 0 {
+			finding.Severity, finding.Message = SeverityError, fmt.Sprintf("%d ready endpoint(s), but Service %s has no ports", ready, service.Name)
+			findings = append(findings, finding)
+			continue
+		}
+
+		port := strconv.Itoa(int(service.Spec.Ports[0].Port))
+		var addresses []string
+		for _, host := range []string{
+			fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, service.Namespace),
+			fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace),
+		} {
+			if o.lookupHost(ctx, host) == nil {
+				addresses = append(addresses, net.JoinHostPort(host, port))
+			}
+		}
+		if len(addresses) == 0 {
+			finding.Severity = SeveritySkipped
+			finding.Message = fmt.Sprintf("%d ready endpoint(s), dialing the gRPC service skipped since the DNS name of Service %s does not resolve", ready, service.Name)
+			finding.Suggestion = "Run karmadactl inside the host cluster to dial the gRPC service of the estimator."
+			findings = append(findings, finding)
+			continue
+		}
+		finding.Message = fmt.Sprintf("%d ready endpoint(s), gRPC service reachable", ready)
+		if err := o.dialEstimator(addresses); err != nil {
+			finding.Severity = SeverityError
+			finding.Message = fmt.Sprintf("%d ready endpoint(s), but failed to dial the gRPC service within %s: %v", ready, estimatorDialTimeout, err)
+			finding.Suggestion = "Check the network to the estimator and the certificates specified by '--estimator-ca-file', '--estimator-cert-file' and " +
+				"'--estimator-key-file'."
+		}
+		findings = append(findings, finding)
+	}
+	if len(findings) == 0 {
+		return []Finding{{Check: "Estimator", Target: o.HostNamespace, Severity: SeveritySkipped, Message: "no scheduler estimators installed"}}
+	}
+	return findings
+}
+
+// checkCRDs checks the version skew between the CRDs of Karmada and the API versions known by karmadactl.
+func (o *CommandDoctorOptions) checkCRDs(ctx context.Context) []Finding {
+	crds, err := o.crdClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []Finding{{Check: "CRD", Severity: SeverityError, Message: fmt.Sprintf("failed to list CRDs: %v", err)}}
+	}
+
+	known := knownKarmadaVersions(gclient.NewSchema())
+	var findings []Finding
+	checked := 0
+	for _, crd := range crds.Items {
+		if !strings.HasSuffix(crd.Spec.Group, ".karmada.io") {
+			continue
+		}
+		checked++
+		if finding, ok := crdVersionSkew(&crd, known[schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}]); !ok {
+			findings = append(findings, finding)
+		}
+	}
+
+	if checked == 0 {
+		return []Finding{{Check: "CRD", Target: "*.karmada.io", Severity: SeverityError, Message: "no CRDs of Karmada found",
+			Suggestion: "Install the CRDs of Karmada, which are installed by the 'init' command."}}
+	}
+	if len(findings) == 0 {
+		return []Finding{{Check: "CRD", Target: "*.karmada.io", Severity: SeverityOK,
+			Message: fmt.Sprintf("%d CRDs match the API versions known by karmadactl", checked)}}
+	}
+	return findings
+}
+
+// knownKarmadaVersions returns the versions of the Karmada kinds registered in the scheme.
+func knownKarmadaVersions(scheme *runtime.Scheme) map[schema.GroupKind]sets.Set[string] {
+	known := make(map[schema.GroupKind]sets.Set[string])
+	for gvk := range scheme.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, ".karmada.io") || gvk.Version == runtime.APIVersionInternal {
+			continue
+		}
+		if known[gvk.GroupKind()] == nil {
+			known[gvk.GroupKind()] = sets.New[string]()
+		}
+		known[gvk.GroupKind()].Insert(gvk.Version)
+	}
+	return known
+}
+
+// crdVersionSkew compares the versions served by the CRD with the versions known by karmadactl, it returns
+// false along with the finding if they don't match.
+func crdVersionSkew(crd *apiextensionsv1.CustomResourceDefinition, known sets.Set[string]) (Finding, bool) {
+	finding := Finding{Check: "CRD", Target: crd.Name}
+	served := sets.New[string]()
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			served.Insert(version.Name)
+		}
+	}
+
+	if known.Len() == 0 {
+		finding.Severity, finding.Message = SeverityWarning, "not known by karmadactl"
+		finding.Suggestion = "karmadactl may be older than Karmada control plane, upgrade karmadactl to the version of Karmada control plane."
+		return finding, false
+	}
+	if missing := known.Difference(served); missing.Len() > 0 {
+		finding.Severity, finding.Message = SeverityError, fmt.Sprintf("version(s) %s known by karmadactl not served", strings.Join(sets.List(missing), ","))
+		finding.Suggestion = "The CRDs may be older than karmadactl, upgrade the CRDs of Karmada or use karmadactl of the version of Karmada control plane."
+		return finding, false
+	}
+	if unknown := served.Difference(known); unknown.Len() > 0 {
+		finding.Severity, finding.Message = SeverityWarning, fmt.Sprintf("served version(s) %s not known by karmadactl", strings.Join(sets.List(unknown), ","))
+		finding.Suggestion = "karmadactl may be older than Karmada control plane, upgrade karmadactl to the version of Karmada control plane."
+		return finding, false
+	}
+	return finding, true
+}
+
+// leaseFreshness tells if the lease is renewed within its duration.
+func (o *CommandDoctorOptions) leaseFreshness(lease *coordinationv1.Lease) (Severity, string) {
+	if lease.Spec.RenewTime == nil {
+		return SeverityError, "lease never renewed"
+	}
+	renewed := o.now().Sub(lease.Spec.RenewTime.Time).Round(time.Second)
+	duration := time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second
+	if renewed > duration {
+		return SeverityError, fmt.Sprintf("lease not renewed for %s, longer than the lease duration %s", renewed, duration)
+	}
+	holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if holder == "" {
+		return SeverityOK, fmt.Sprintf("lease renewed %s ago", renewed)
+	}
+	return SeverityOK, fmt.Sprintf("lease held by %s, renewed %s ago", holder, renewed)
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	aggregator "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util/apiclient"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/grpcconnection"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// outputJSON prints the findings in JSON.
+	outputJSON = "json"
+
+	// defaultCertExpirationThreshold is the default remaining validity below which a certificate is reported.
+	defaultCertExpirationThreshold = 30 * 24 * time.Hour
+
+	// estimatorDialTimeout is the timeout of dialing the gRPC service of a scheduler estimator.
+	estimatorDialTimeout = 5 * time.Second
+)
+
+var (
+	doctorLong = templates.LongDesc(`
+		Check the health of a Karmada installation from end to end, and print the findings along with
+		the suggestions to fix them.
+
+		The following are checked:
+		  - The reachability of karmada-apiserver, and the leader election leases of the components
+		    in Karmada control plane.
+		  - The availability of the APIServices registered by karmada-aggregated-apiserver, karmada-search
+		    and karmada-metrics-adapter.
+		  - The reachability of karmada-webhook, by a dry-run request to karmada-apiserver, if the webhooks
+		    are configured.
+		  - The expiry of the certificates of karmada-apiserver, the kubeconfig, the APIServices and the
+		    webhooks.
+		  - The connectivity of the clusters in Push mode through the cluster proxy, and the freshness of
+		    the leases renewed by karmada-agent for the clusters in Pull mode.
+		  - The version skew between the CRDs of Karmada and karmadactl.
+
+		The workloads of the components and the scheduler estimators are checked in addition if the
+		kubeconfig of the cluster hosting Karmada control plane is specified with '--host-kubeconfig'.
+		The gRPC services of the scheduler estimators are dialed by the addresses karmada-scheduler
+		connects to, so they are reachable only if karmadactl runs inside the host cluster. The dial is
+		skipped if the DNS names of the services do not resolve.`)
+
+	doctorExample = templates.Examples(`
+		# Check the health of the Karmada installation
+		%[1]s doctor
+
+		# Check the health of the Karmada installation, including the workloads in the host cluster
+		%[1]s doctor --host-kubeconfig=/root/.kube/config --host-namespace=karmada-system
+
+		# Print the findings in JSON
+		%[1]s doctor -o json`)
+)
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityOK means the check passed.
+	SeverityOK Severity = "OK"
+	// SeverityWarning means the check found something which may need attention.
+	SeverityWarning Severity = "Warning"
+	// SeverityError means the check failed.
+	SeverityError Severity = "Error"
+	// SeveritySkipped means the check was not run.
+	SeveritySkipped Severity = "Skipped"
+)
+
+// Finding is the result of a check.
+type Finding struct {
+	// Check is the name of the check, e.g. APIService.
+	Check string `json:"check"`
+	// Target is the object checked, e.g. v1alpha1.cluster.karmada.io.
+	Target string `json:"target"`
+	// Severity is the severity of the finding.
+	Severity Severity `json:"severity"`
+	// Message describes the finding.
+	Message string `json:"message"`
+	// Suggestion describes how to fix the problem found.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Report is the findings of all the checks.
+type Report struct {
+	Findings []Finding `json:"findings"`
+	OK       int       `json:"ok"`
+	Warnings int       `json:"warnings"`
+	Errors   int       `json:"errors"`
+	Skipped  int       `json:"skipped"`
+}
+
+// NewCmdDoctor creates the `doctor` command.
+func NewCmdDoctor(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandDoctorOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "doctor",
+		Short:                 "Check the health of a Karmada installation from end to end",
+		Long:                  doctorLong,
+		Example:               fmt.Sprintf(doctorExample, parentCommand),
+		Args:                  cobra.NoArgs,
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := o.Complete(f); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(context.TODO())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: json")
+	flags.StringVar(&o.HostKubeConfig, "host-kubeconfig", "", "Path to the kubeconfig of the cluster hosting Karmada control plane. The workloads of the components and the scheduler estimators are checked if specified.")
+	flags.StringVar(&o.HostContext, "host-context", "", "The name of the kubeconfig context of the cluster hosting Karmada control plane to use.")
+	flags.StringVar(&o.HostNamespace, "host-namespace", names.NamespaceKarmadaSystem, "The namespace Karmada control plane is installed in the host cluster.")
+	flags.DurationVar(&o.CertExpirationThreshold, "cert-expiration-threshold", defaultCertExpirationThreshold, "The certificates expiring within the duration are reported.")
+	flags.StringVar(&o.EstimatorCAFile, "estimator-ca-file", "", "SSL Certificate Authority file used to verify the certificates of the scheduler estimators. The certificates are not verified if not specified.")
+	flags.StringVar(&o.EstimatorCertFile, "estimator-cert-file", "", "SSL certification file used to dial the scheduler estimators.")
+	flags.StringVar(&o.EstimatorKeyFile, "estimator-key-file", "", "SSL key file used to dial the scheduler estimators.")
+	options.AddKubeConfigFlags(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// CommandDoctorOptions contains the input to the doctor command.
+type CommandDoctorOptions struct {
+	genericiooptions.IOStreams
+
+	// OutputFormat is the format of the output, one of: json.
+	OutputFormat string
+	// HostKubeConfig is the path to the kubeconfig of the cluster hosting Karmada control plane.
+	HostKubeConfig string
+	// HostContext is the name of the kubeconfig context of the cluster hosting Karmada control plane.
+	HostContext string
+	// HostNamespace is the namespace Karmada control plane is installed in the host cluster.
+	HostNamespace string
+	// CertExpirationThreshold is the remaining validity below which a certificate is reported.
+	CertExpirationThreshold time.Duration
+	// EstimatorCAFile is the SSL Certificate Authority file used to verify the certificates of the scheduler estimators.
+	EstimatorCAFile string
+	// EstimatorCertFile is the SSL certification file used to dial the scheduler estimators.
+	EstimatorCertFile string
+	// EstimatorKeyFile is the SSL key file used to dial the scheduler estimators.
+	EstimatorKeyFile string
+
+	restConfig       *rest.Config
+	kubeClient       kubernetes.Interface
+	karmadaClient    karmadaclientset.Interface
+	aggregatorClient aggregator.Interface
+	crdClient        apiextensionsclientset.Interface
+	// hostClient is the client of the host cluster, it's nil if the host kubeconfig is not specified.
+	hostClient kubernetes.Interface
+	// proxyGet sends a request to the member cluster through the cluster proxy.
+	proxyGet func(ctx context.Context, cluster string) error
+	// dialEstimator dials the gRPC service of a scheduler estimator by the addresses, one at a time.
+	dialEstimator func(addresses []string) error
+	// lookupHost resolves the host name, the in-cluster DNS names don't resolve outside the host cluster.
+	lookupHost func(ctx context.Context, host string) error
+	now        func() time.Time
+}
+
+// Complete completes all the required options.
+func (o *CommandDoctorOptions) Complete(f util.Factory) error {
+	var err error
+	if o.restConfig, err = f.ToRESTConfig(); err != nil {
+		return err
+	}
+	if o.kubeClient, err = f.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.karmadaClient, err = f.KarmadaClientSet(); err != nil {
+		return err
+	}
+	if o.aggregatorClient, err = apiclient.NewAPIRegistrationClient(o.restConfig); err != nil {
+		return err
+	}
+	if o.crdClient, err = apiclient.NewCRDsClient(o.restConfig); err != nil {
+		return err
+	}
+
+	if o.HostKubeConfig != "" {
+		hostConfig, err := apiclient.RestConfig(o.HostContext, o.HostKubeConfig)
+		if err != nil {
+			return fmt.Errorf("failed to load the kubeconfig of the host cluster: %w", err)
+		}
+		if o.hostClient, err = apiclient.NewClientSet(hostConfig); err != nil {
+			return err
+		}
+	}
+
+	o.proxyGet = func(ctx context.Context, cluster string) error {
+		_, err := o.karmadaClient.ClusterV1alpha1().RESTClient().Get().
+			AbsPath(fmt.Sprintf(proxyVersionURL, cluster)).DoRaw(ctx)
+		return err
+	}
+	o.dialEstimator = func(addresses []string) error {
+		config := &grpcconnection.ClientConfig{
+			InsecureSkipServerVerify: o.EstimatorCAFile == "",
+			ServerAuthCAFile:         o.EstimatorCAFile,
+			CertFile:                 o.EstimatorCertFile,
+			KeyFile:                  o.EstimatorKeyFile,
+		}
+		cc, err := config.DialWithTimeOut(addresses, estimatorDialTimeout)
+		if err != nil {
+			return err
+		}
+		return cc.Close()
+	}
+	o.lookupHost = func(ctx context.Context, host string) error {
+		_, err := net.DefaultResolver.LookupHost(ctx, host)
+		return err
+	}
+	o.now = time.Now
+	return nil
+}
+
+// Validate checks the options.
+func (o *CommandDoctorOptions) Validate() error {
+	if o.OutputFormat != "" && o.OutputFormat != outputJSON {
+		return fmt.Errorf("invalid output format %q, only json is supported", o.OutputFormat)
+	}
+	if o.HostContext != "" && o.HostKubeConfig == "" {
+		return fmt.Errorf("--host-context can only be used along with --host-kubeconfig")
+	}
+	if o.CertExpirationThreshold < 0 {
+		return fmt.Errorf("--cert-expiration-threshold must not be negative")
+	}
+	if (o.EstimatorCertFile == "") != (o.EstimatorKeyFile == "") {
+		return fmt.Errorf("--estimator-cert-file and --estimator-key-file must be specified together")
+	}
+	return nil
+}
+
+// Run runs all the checks and prints the findings.
+func (o *CommandDoctorOptions) Run(ctx context.Context) error {
+	report := o.runChecks(ctx)
+
+	if o.OutputFormat == outputJSON {
+		data, err := json.MarshalIndent(report, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.Out, string(data))
+	} else {
+		printReport(o.Out, report)
+	}
+
+	if report.Errors > 0 {
+		return fmt.Errorf("%d check(s) failed", report.Errors)
+	}
+	return nil
+}
+
+// runChecks runs all the checks in order and summarizes the findings.
+func (o *CommandDoctorOptions) runChecks(ctx context.Context) *Report {
+	checks := []func(context.Context) []Finding{
+		o.checkAPIServer,
+		o.checkComponents,
+		o.checkAPIServices,
+		o.checkWebhooks,
+		o.checkCertificates,
+		o.checkClusters,
+		o.checkEstimators,
+		o.checkCRDs,
+	}
+
+	report := &Report{}
+	for _, check := range checks {
+		report.Findings = append(report.Findings, check(ctx)...)
+	}
+	for _, finding := range report.Findings {
+		switch finding.Severity {
+		case SeverityOK:
+			report.OK++
+		case SeverityWarning:
+			report.Warnings++
+		case SeverityError:
+			report.Errors++
+		case SeveritySkipped:
+			report.Skipped++
+		}
+	}
+	return report
+}
+
+func printReport(out io.Writer, report *Report) {
+	w := printers.GetNewTabWriter(out)
+	fmt.Fprintln(w, "CHECK\tTARGET\tSTATUS\tMESSAGE")
+	for _, finding := range report.Findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", finding.Check, finding.Target, finding.Severity, finding.Message)
+	}
+	_ = w.Flush()
+
+	printedHeader := false
+	for _, finding := range report.Findings {
+		if finding.Suggestion == "" {
+			continue
+		}
+		if !printedHeader {
+			fmt.Fprintln(out, "\nSuggestions:")
+			printedHeader = true
+		}
+		fmt.Fprintf(out, "  - [%s %s] %s\n", finding.Check, finding.Target, finding.Suggestion)
+	}
+
+	fmt.Fprintf(out, "\n%d ok, %d warning(s), %d error(s), %d skipped\n", report.OK, report.Warnings, report.Errors, report.Skipped)
+}
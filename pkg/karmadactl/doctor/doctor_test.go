@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	fakeapiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fakekubeclient "k8s.io/client-go/kubernetes/fake"
+	certutil "k8s.io/client-go/util/cert"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	fakeaggregator "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/fake"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func newCertPEM(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "karmada"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: der})
+}
+
+func newLease(namespace, name string, renewed time.Time) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(name + "-0"),
+			LeaseDurationSeconds: ptr.To[int32](40),
+			RenewTime:            &metav1.MicroTime{Time: renewed},
+		},
+	}
+}
+
+func newCluster(name string, mode clusterv1alpha1.ClusterSyncMode, ready metav1.ConditionStatus) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       clusterv1alpha1.ClusterSpec{SyncMode: mode},
+		Status: clusterv1alpha1.ClusterStatus{
+			Conditions: []metav1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: ready, Message: "cluster is not reachable"}},
+		},
+	}
+}
+
+func TestCommandDoctorOptions_runChecks(t *testing.T) {
+	now := time.Now()
+	kubeClient := fakekubeclient.NewClientset(
+		newLease("karmada-system", "karmada-controller-manager", now.Add(-10*time.Second)),
+		newLease("karmada-system", "karmada-scheduler", now.Add(-time.Minute)),
+		newLease("karmada-cluster", "member3", now.Add(-5*time.Second)),
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "mutating-config"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{{
+				Name:         "propagationpolicy.karmada.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: newCertPEM(t, now.Add(10*24*time.Hour))},
+			}},
+		},
+	)
+	karmadaClient := fakekarmadaclient.NewClientset(
+		newCluster("member1", clusterv1alpha1.Push, metav1.ConditionTrue),
+		newCluster("member2", clusterv1alpha1.Push, metav1.ConditionFalse),
+		newCluster("member3", clusterv1alpha1.Pull, metav1.ConditionTrue),
+		newCluster("member4", clusterv1alpha1.Pull, metav1.ConditionFalse),
+		newCluster("member6", clusterv1alpha1.Push, metav1.ConditionTrue),
+	)
+	aggregatorClient := fakeaggregator.NewSimpleClientset(
+		&apiregistrationv1.APIService{
+			ObjectMeta: metav1.ObjectMeta{Name: "v1alpha1.cluster.karmada.io"},
+			Spec:       apiregistrationv1.APIServiceSpec{CABundle: newCertPEM(t, now.Add(-time.Hour))},
+			Status: apiregistrationv1.APIServiceStatus{
+				Conditions: []apiregistrationv1.APIServiceCondition{{Type: apiregistrationv1.Available, Status: apiregistrationv1.ConditionTrue}},
+			},
+		},
+		&apiregistrationv1.APIService{
+			ObjectMeta: metav1.ObjectMeta{Name: "v1alpha1.search.karmada.io"},
+			Status: apiregistrationv1.APIServiceStatus{
+				Conditions: []apiregistrationv1.APIServiceCondition{{Type: apiregistrationv1.Available, Status: apiregistrationv1.ConditionFalse,
+					Message: "service/karmada-search in \"karmada-system\" is not present"}},
+			},
+		},
+	)
+	crdClient := fakeapiextensionsclient.NewClientset(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "propagationpolicies.policy.karmada.io"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group:    "policy.karmada.io",
+				Names:    apiextensionsv1.CustomResourceDefinitionNames{Kind: "PropagationPolicy"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1alpha1", Served: true, Storage: true}},
+			},
+		},
+	)
+	hostClient := fakekubeclient.NewClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-webhook"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+		},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member1"},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 10352}}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member2"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member3"},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 10352}}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member5"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member6"},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 10352}}}},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member1-abcde",
+				Labels: map[string]string{discoveryv1.LabelServiceName: "karmada-scheduler-estimator-member1"}},
+			Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.1"}}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member3-abcde",
+				Labels: map[string]string{discoveryv1.LabelServiceName: "karmada-scheduler-estimator-member3"}},
+			Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.3"}}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "karmada-system", Name: "karmada-scheduler-estimator-member6-abcde",
+				Labels: map[string]string{discoveryv1.LabelServiceName: "karmada-scheduler-estimator-member6"}},
+			Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.6"}}},
+		},
+	)
+
+	o := &CommandDoctorOptions{
+		HostNamespace:           "karmada-system",
+		CertExpirationThreshold: defaultCertExpirationThreshold,
+		kubeClient:              kubeClient,
+		karmadaClient:           karmadaClient,
+		aggregatorClient:        aggregatorClient,
+		crdClient:               crdClient,
+		hostClient:              hostClient,
+		proxyGet: func(_ context.Context, cluster string) error {
+			if cluster == "member2" {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+		dialEstimator: func(addresses []string) error {
+			if addresses[0] == "karmada-scheduler-estimator-member3.karmada-system.svc.cluster.local:10352" {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+		lookupHost: func(_ context.Context, host string) error {
+			if strings.HasPrefix(host, "karmada-scheduler-estimator-member6.") {
+				return errors.New("no such host")
+			}
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+	report := o.runChecks(context.TODO())
+
+	type result struct {
+		check, target string
+		severity      Severity
+	}
+	var got []result
+	for _, finding := range report.Findings {
+		got = append(got, result{finding.Check, finding.Target, finding.Severity})
+	}
+	assert.Equal(t, []result{
+		{"APIServer", "karmada-apiserver", SeverityOK},
+		{"Component", "karmada-controller-manager", SeverityOK},
+		{"Component", "karmada-scheduler", SeverityError},
+		{"Workload", "Deployment/karmada-scheduler", SeverityOK},
+		{"Workload", "Deployment/karmada-webhook", SeverityError},
+		{"APIService", "v1alpha1.cluster.karmada.io", SeverityOK},
+		{"APIService CA", "v1alpha1.cluster.karmada.io", SeverityError},
+		{"APIService", "v1alpha1.search.karmada.io", SeverityError},
+		{"Webhook", "karmada-webhook", SeverityOK},
+		{"Webhook CA", "mutating-config/propagationpolicy.karmada.io", SeverityWarning},
+		{"Cluster", "member1", SeverityOK},
+		{"Cluster", "member2", SeverityError},
+		{"Cluster", "member3", SeverityOK},
+		{"Cluster", "member4", SeverityError},
+		{"Cluster", "member6", SeverityOK},
+		{"Estimator", "member1", SeverityOK},
+		{"Estimator", "member2", SeverityError},
+		{"Estimator", "member3", SeverityError},
+		{"Estimator", "member5", SeverityWarning},
+		{"Estimator", "member6", SeveritySkipped},
+		{"CRD", "*.karmada.io", SeverityOK},
+	}, got)
+	assert.Equal(t, 10, report.OK)
+	assert.Equal(t, 2, report.Warnings)
+	assert.Equal(t, 8, report.Errors)
+	assert.Equal(t, 1, report.Skipped)
+
+	findings := make(map[string]Finding)
+	for _, finding := range report.Findings {
+		findings[finding.Check+"/"+finding.Target] = finding
+	}
+	assert.Equal(t, "lease not renewed for 1m0s, longer than the lease duration 40s", findings["Component/karmada-scheduler"].Message)
+	assert.Equal(t, "Push mode, not reachable through the cluster proxy: connection refused", findings["Cluster/member2"].Message)
+	assert.Equal(t, "Pull mode, karmada-agent lease held by member3-0, renewed 5s ago", findings["Cluster/member3"].Message)
+	assert.Equal(t, "Pull mode, lease of karmada-agent not found", findings["Cluster/member4"].Message)
+	assert.Contains(t, findings["APIService/v1alpha1.search.karmada.io"].Message, "is not present")
+	assert.Contains(t, findings["Webhook CA/mutating-config/propagationpolicy.karmada.io"].Message, "expires in 9 day(s)")
+	assert.Equal(t, "1 ready endpoint(s), gRPC service reachable", findings["Estimator/member1"].Message)
+	assert.Contains(t, findings["Estimator/member3"].Message, "failed to dial the gRPC service within 5s: connection refused")
+	assert.Contains(t, findings["Estimator/member6"].Message, "DNS name of Service karmada-scheduler-estimator-member6 does not resolve")
+
+	out := &bytes.Buffer{}
+	printReport(out, report)
+	assert.Contains(t, out.String(), "  - [Cluster member2] Check the network from karmada-aggregated-apiserver to the cluster")
+	assert.Contains(t, out.String(), "10 ok, 2 warning(s), 8 error(s), 1 skipped")
+}
+
+func TestCommandDoctorOptions_checkWebhooks(t *testing.T) {
+	o := &CommandDoctorOptions{
+		kubeClient:    fakekubeclient.NewClientset(),
+		karmadaClient: fakekarmadaclient.NewClientset(),
+	}
+	findings := o.checkWebhooks(context.TODO())
+	require.Len(t, findings, 1)
+	assert.Equal(t, SeverityWarning, findings[0].Severity)
+	assert.Equal(t, "not configured", findings[0].Message)
+
+	o.kubeClient = fakekubeclient.NewClientset(&admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "validating-config"},
+		Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "propagationpolicy.karmada.io"}},
+	})
+	findings = o.checkWebhooks(context.TODO())
+	require.Len(t, findings, 1)
+	assert.Equal(t, SeverityOK, findings[0].Severity)
+	assert.Equal(t, "reachable", findings[0].Message)
+}
+
+func TestCommandDoctorOptions_checkHostWorkloads(t *testing.T) {
+	o := &CommandDoctorOptions{HostNamespace: "karmada-system"}
+	assert.Equal(t, []Finding{{Check: "Workload", Target: "karmada-system", Severity: SeveritySkipped, Message: "--host-kubeconfig not specified"}},
+		o.checkHostWorkloads(context.TODO()))
+
+	o.hostClient = fakekubeclient.NewClientset()
+	findings := o.checkHostWorkloads(context.TODO())
+	require.Len(t, findings, 1)
+	assert.Equal(t, SeverityError, findings[0].Severity)
+	assert.Equal(t, "no workloads found", findings[0].Message)
+}
+
+func TestCRDVersionSkew(t *testing.T) {
+	newCRD := func(versions ...string) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "resourcebindings.work.karmada.io"}}
+		for _, version := range versions {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: version, Served: true})
+		}
+		return crd
+	}
+	tests := []struct {
+		name     string
+		crd      *apiextensionsv1.CustomResourceDefinition
+		known    sets.Set[string]
+		wantOK   bool
+		severity Severity
+		message  string
+	}{
+		{name: "versions match", crd: newCRD("v1alpha1", "v1alpha2"), known: sets.New("v1alpha1", "v1alpha2"), wantOK: true},
+		{name: "CRD older than karmadactl", crd: newCRD("v1alpha1"), known: sets.New("v1alpha1", "v1alpha2"),
+			severity: SeverityError, message: "version(s) v1alpha2 known by karmadactl not served"},
+		{name: "karmadactl older than CRD", crd: newCRD("v1alpha1", "v1alpha2", "v1beta1"), known: sets.New("v1alpha1", "v1alpha2"),
+			severity: SeverityWarning, message: "served version(s) v1beta1 not known by karmadactl"},
+		{name: "kind unknown to karmadactl", crd: newCRD("v1alpha1"), known: nil,
+			severity: SeverityWarning, message: "not known by karmadactl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finding, ok := crdVersionSkew(tt.crd, tt.known)
+			assert.Equal(t, tt.wantOK, ok)
+			if !ok {
+				assert.Equal(t, tt.severity, finding.Severity)
+				assert.Equal(t, tt.message, finding.Message)
+			}
+		})
+	}
+}
+
+func TestCommandDoctorOptions_Validate(t *testing.T) {
+	assert.NoError(t, (&CommandDoctorOptions{OutputFormat: "json"}).Validate())
+	assert.Error(t, (&CommandDoctorOptions{OutputFormat: "yaml"}).Validate())
+	assert.Error(t, (&CommandDoctorOptions{HostContext: "kind-karmada-host"}).Validate())
+	assert.Error(t, (&CommandDoctorOptions{CertExpirationThreshold: -time.Hour}).Validate())
+	assert.NoError(t, (&CommandDoctorOptions{EstimatorCertFile: "karmada.crt", EstimatorKeyFile: "karmada.key"}).Validate())
+	assert.Error(t, (&CommandDoctorOptions{EstimatorCertFile: "karmada.crt"}).Validate())
+}
@@ -0,0 +1,512 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/karmadactl/cordon"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/spreadconstraint"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework/plugins/tainttoleration"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+// pollInterval is the interval of checking whether the evicted bindings have been moved.
+const pollInterval = 2 * time.Second
+
+var (
+	drainLong = templates.LongDesc(`
+		Drain a cluster in preparation for maintenance or decommissioning.
+
+		The cluster is cordoned first so that no new resources are scheduled to it, then the bindings
+		scheduled to it are gracefully evicted, with at most --concurrency of them in progress at a time.
+		The resources are kept in the cluster until they become healthy in the clusters they are rescheduled to.
+
+		The bindings which can't be moved elsewhere are listed and left untouched. They are checked against the
+		cluster affinity, the taints of the clusters and the spread constraints in the way karmada-scheduler
+		filters the clusters, but not against the resources available in the clusters, so a binding may still
+		fail to be moved when no other cluster has enough resources. Use 'uncordon' to mark the cluster as
+		schedulable again.`)
+
+	drainExample = templates.Examples(`
+		# Drain cluster "foo"
+		%[1]s drain foo
+
+		# Drain the resources in namespace "bar" from cluster "foo", evicting at most 10 bindings at a time
+		%[1]s drain foo --namespace=bar --concurrency=10
+
+		# Drain the bindings labeled with "app=nginx" from cluster "foo", and give up after 10 minutes
+		%[1]s drain foo -l app=nginx --timeout=10m`)
+)
+
+// NewCmdDrain defines the `drain` command that evacuates the resources from a cluster.
+func NewCmdDrain(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandDrainOption{IOStreams: streams, pollInterval: pollInterval}
+
+	cmd := &cobra.Command{
+		Use:                   "drain CLUSTER",
+		Short:                 "Drain cluster in preparation for maintenance",
+		Long:                  drainLong,
+		Example:               fmt.Sprintf(drainExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.SpecifiedResourceTypeAndNameCompletionFunc(f, []string{"cluster"}),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterManagement,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	flags.StringVarP(&o.Namespace, "namespace", "n", "", "If present, only the ResourceBindings in the namespace are drained, and ClusterResourceBindings are skipped.")
+	flags.StringVarP(&o.LabelSelector, "selector", "l", "", "Selector (label query) to filter the bindings to drain.")
+	flags.IntVar(&o.Concurrency, "concurrency", 5, "The maximum number of bindings being evicted at the same time.")
+	flags.DurationVar(&o.Timeout, "timeout", 0, "The length of time to wait before giving up, zero means infinite.")
+	flags.BoolVar(&o.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// CommandDrainOption holds all command options for drain.
+type CommandDrainOption struct {
+	// ClusterName is the name of the cluster to drain.
+	ClusterName string
+	// Namespace limits the drain to the ResourceBindings in the namespace.
+	Namespace string
+	// LabelSelector limits the drain to the bindings matching the selector.
+	LabelSelector string
+	// Concurrency is the maximum number of bindings being evicted at the same time.
+	Concurrency int
+	// Timeout is the length of time to wait before giving up, zero means infinite.
+	Timeout time.Duration
+	// DryRun tells if run the command in dry-run mode, without making any server requests.
+	DryRun bool
+
+	selector     labels.Selector
+	client       client.Client
+	pollInterval time.Duration
+	// lock serializes the progress output of the concurrent evictions.
+	lock sync.Mutex
+	genericiooptions.IOStreams
+}
+
+// Complete ensures that options are valid and marshals them if necessary.
+func (o *CommandDrainOption) Complete(f util.Factory, args []string) error {
+	if len(args) == 0 {
+		return errors.New("cluster name is required")
+	}
+	if len(args) > 1 {
+		return errors.New("more than one cluster name is not supported")
+	}
+	o.ClusterName = args[0]
+
+	var err error
+	if o.selector, err = labels.Parse(o.LabelSelector); err != nil {
+		return fmt.Errorf("invalid label selector %q: %w", o.LabelSelector, err)
+	}
+
+	restConfig, err := f.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	o.client, err = gclient.NewForConfig(restConfig)
+	return err
+}
+
+// Validate checks the options.
+func (o *CommandDrainOption) Validate() error {
+	if o.Concurrency <= 0 {
+		return fmt.Errorf("concurrency must be greater than 0: %d", o.Concurrency)
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative: %v", o.Timeout)
+	}
+	return nil
+}
+
+// Run cordons the cluster and evicts the bindings from it.
+func (o *CommandDrainOption) Run(f util.Factory) error {
+	if err := cordon.RunCordonOrUncordon(cordon.DesiredCordon, f, cordon.CommandCordonOption{
+		ClusterName: o.ClusterName,
+		DryRun:      o.DryRun,
+	}); err != nil {
+		return err
+	}
+	return o.drain(context.TODO())
+}
+
+func (o *CommandDrainOption) drain(ctx context.Context) error {
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	bindings, err := o.listBindings(ctx)
+	if err != nil {
+		return err
+	}
+	clusterList := &clusterv1alpha1.ClusterList{}
+	if err = o.client.List(ctx, clusterList); err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var movable, pinned []client.Object
+	pinnedReasons := make(map[client.Object]string)
+	for _, binding := range bindings {
+		if ok, reason := canMove(bindingSpec(binding), bindingStatus(binding), clusterList.Items, o.ClusterName); ok {
+			movable = append(movable, binding)
+		} else {
+			pinned = append(pinned, binding)
+			pinnedReasons[binding] = reason
+		}
+	}
+
+	var failed []string
+	if o.DryRun {
+		for _, binding := range movable {
+			o.printf(o.Out, "%s evicted (dry run)\n", bindingName(binding))
+		}
+	} else {
+		failed = o.evictAll(ctx, movable)
+	}
+
+	if len(pinned) > 0 {
+		o.printf(o.Out, "The following bindings can't be moved from cluster %s:\n", o.ClusterName)
+		for _, binding := range pinned {
+			o.printf(o.Out, "  %s: %s\n", bindingName(binding), pinnedReasons[binding])
+		}
+	}
+	if len(failed) > 0 || len(pinned) > 0 {
+		return fmt.Errorf("cluster %s is not fully drained, %d binding(s) failed to be evicted and %d binding(s) can't be moved",
+			o.ClusterName, len(failed), len(pinned))
+	}
+	if o.DryRun {
+		o.printf(o.Out, "cluster %s drained (dry run)\n", o.ClusterName)
+	} else {
+		o.printf(o.Out, "cluster %s drained\n", o.ClusterName)
+	}
+	return nil
+}
+
+// listBindings lists the bindings scheduled to the cluster and matching the namespace and the selector.
+func (o *CommandDrainOption) listBindings(ctx context.Context) ([]client.Object, error) {
+	var bindings []client.Object
+	rbList := &workv1alpha2.ResourceBindingList{}
+	if err := o.client.List(ctx, rbList, client.InNamespace(o.Namespace), client.MatchingLabelsSelector{Selector: o.selector}); err != nil {
+		return nil, fmt.Errorf("failed to list ResourceBindings: %w", err)
+	}
+	for i := range rbList.Items {
+		if rbList.Items[i].Spec.TargetContains(o.ClusterName) {
+			bindings = append(bindings, &rbList.Items[i])
+		}
+	}
+	if o.Namespace != "" {
+		return bindings, nil
+	}
+
+	crbList := &workv1alpha2.ClusterResourceBindingList{}
+	if err := o.client.List(ctx, crbList, client.MatchingLabelsSelector{Selector: o.selector}); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterResourceBindings: %w", err)
+	}
+	for i := range crbList.Items {
+		if crbList.Items[i].Spec.TargetContains(o.ClusterName) {
+			bindings = append(bindings, &crbList.Items[i])
+		}
+	}
+	return bindings, nil
+}
+
+// evictAll evicts the bindings with at most Concurrency of them in progress, and returns the names of the
+// bindings failed to be moved.
+func (o *CommandDrainOption) evictAll(ctx context.Context, bindings []client.Object) []string {
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		failed []string
+	)
+	tokens := make(chan struct{}, o.Concurrency)
+	for _, binding := range bindings {
+		select {
+		case tokens <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			lock.Lock()
+			failed = append(failed, bindingName(binding))
+			lock.Unlock()
+			o.printf(o.ErrOut, "failed to evict %s: %v\n", bindingName(binding), ctx.Err())
+			continue
+		}
+
+		wg.Go(func() {
+			defer func() { <-tokens }()
+			if err := o.evict(ctx, binding); err != nil {
+				lock.Lock()
+				failed = append(failed, bindingName(binding))
+				lock.Unlock()
+				o.printf(o.ErrOut, "failed to evict %s: %v\n", bindingName(binding), err)
+			}
+		})
+	}
+	wg.Wait()
+	return failed
+}
+
+// evict gracefully evicts the cluster from the binding, and waits until the binding has been moved to other clusters.
+func (o *CommandDrainOption) evict(ctx context.Context, binding client.Object) error {
+	key := client.ObjectKeyFromObject(binding)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := o.client.Get(ctx, key, binding); err != nil {
+			return err
+		}
+		spec := bindingSpec(binding)
+		if !spec.TargetContains(o.ClusterName) {
+			return nil
+		}
+		spec.GracefulEvictCluster(o.ClusterName, workv1alpha2.NewTaskOptions(
+			workv1alpha2.WithPurgeMode(policyv1alpha1.PurgeModeGracefully),
+			workv1alpha2.WithProducer(workv1alpha2.EvictionProducerKarmadactl),
+			workv1alpha2.WithReason(workv1alpha2.EvictionReasonClusterDrained),
+			workv1alpha2.WithMessage(fmt.Sprintf("cluster %s is being drained", o.ClusterName))))
+		return o.client.Update(ctx, binding)
+	})
+	if err != nil {
+		return err
+	}
+	o.printf(o.Out, "%s evicted\n", bindingName(binding))
+
+	err = wait.PollUntilContextCancel(ctx, o.pollInterval, true, func(ctx context.Context) (bool, error) {
+		if err := o.client.Get(ctx, key, binding); err != nil {
+			return false, err
+		}
+		return moved(binding, o.ClusterName)
+	})
+	if err != nil {
+		if wait.Interrupted(err) {
+			return fmt.Errorf("timed out waiting for the binding to be moved")
+		}
+		return err
+	}
+	o.printf(o.Out, "%s moved to %s\n", bindingName(binding), formatClusters(bindingSpec(binding).Clusters))
+	return nil
+}
+
+// moved tells whether the binding has been rescheduled to other clusters where the resource is healthy.
+func moved(binding client.Object, cluster string) (bool, error) {
+	spec, status := bindingSpec(binding), bindingStatus(binding)
+	if spec.TargetContains(cluster) || status.SchedulerObservedGeneration < binding.GetGeneration() {
+		return false, nil
+	}
+	if len(spec.Clusters) == 0 {
+		if condition := meta.FindStatusCondition(status.Conditions, workv1alpha2.Scheduled); condition != nil && condition.Status == metav1.ConditionFalse {
+			return false, fmt.Errorf("no cluster to move to: %s", condition.Message)
+		}
+		return false, nil
+	}
+	// The eviction task is removed by karmada-controller-manager once the resource is healthy in the new clusters,
+	// or the grace period elapses.
+	if spec.ClusterInGracefulEvictionTasks(cluster) {
+		return false, nil
+	}
+
+	health := make(map[string]workv1alpha2.ResourceHealth, len(status.AggregatedStatus))
+	for _, item := range status.AggregatedStatus {
+		if item.Applied {
+			health[item.ClusterName] = item.Health
+		}
+	}
+	for _, target := range spec.Clusters {
+		// The resources without health interpretation are reported as Unknown, they are taken as healthy once applied.
+		if h, ok := health[target.Name]; !ok || h == workv1alpha2.ResourceUnhealthy {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// filterPlugins are the filter plugins of karmada-scheduler which tell whether a cluster fits the placement of a binding,
+// regardless of the resources available in the cluster.
+var filterPlugins = []framework.FilterPlugin{
+	&tainttoleration.TaintToleration{},
+	&clusteraffinity.ClusterAffinity{},
+	&spreadconstraint.SpreadConstraint{},
+}
+
+// canMove tells whether the binding can be rescheduled to the clusters other than the drained one, i.e. the clusters
+// passing the filters of karmada-scheduler satisfy the minimum groups of the spread constraints. The cluster affinity
+// terms are tried in order from the observed one, as karmada-scheduler does. The resources available in the clusters
+// are not checked. It returns the reason why the binding can't be moved.
+func canMove(spec *workv1alpha2.ResourceBindingSpec, status *workv1alpha2.ResourceBindingStatus,
+	clusters []clusterv1alpha1.Cluster, drained string) (bool, string) {
+	if spec.Placement == nil {
+		return true, ""
+	}
+
+	statuses := []*workv1alpha2.ResourceBindingStatus{status}
+	if affinities := spec.Placement.ClusterAffinities; len(affinities) > 0 {
+		statuses = nil
+		index := slices.IndexFunc(affinities, func(term policyv1alpha1.ClusterAffinityTerm) bool {
+			return term.AffinityName == status.SchedulerObservedAffinityName
+		})
+		for _, term := range affinities[max(index, 0):] {
+			termStatus := status.DeepCopy()
+			termStatus.SchedulerObservedAffinityName = term.AffinityName
+			statuses = append(statuses, termStatus)
+		}
+	}
+
+	var reason string
+	for _, termStatus := range statuses {
+		var feasible []*clusterv1alpha1.Cluster
+		reasons := sets.New[string]()
+		for i := range clusters {
+			if clusters[i].Name == drained {
+				continue
+			}
+			if result := filterCluster(spec, termStatus, &clusters[i]); !result.IsSuccess() {
+				reasons.Insert(result.Reasons()...)
+				continue
+			}
+			feasible = append(feasible, &clusters[i])
+		}
+		if len(feasible) == 0 {
+			reason = fmt.Sprintf("no other cluster fits the placement: %s", strings.Join(sets.List(reasons), ", "))
+			continue
+		}
+		if reason = unsatisfiedSpreadConstraint(spec.Placement.SpreadConstraints, feasible); reason == "" {
+			return true, ""
+		}
+	}
+	return false, reason
+}
+
+// filterCluster runs the filter plugins against the cluster.
+func filterCluster(spec *workv1alpha2.ResourceBindingSpec, status *workv1alpha2.ResourceBindingStatus,
+	cluster *clusterv1alpha1.Cluster) *framework.Result {
+	for _, plugin := range filterPlugins {
+		if result := plugin.Filter(context.TODO(), spec, status, cluster); !result.IsSuccess() {
+			return result
+		}
+	}
+	return framework.NewResult(framework.Success)
+}
+
+// unsatisfiedSpreadConstraint returns the reason if the clusters fall short of the minimum groups of any spread
+// constraint, or an empty string.
+func unsatisfiedSpreadConstraint(constraints []policyv1alpha1.SpreadConstraint, clusters []*clusterv1alpha1.Cluster) string {
+	for _, constraint := range constraints {
+		if constraint.SpreadByField == "" {
+			continue
+		}
+		groups := sets.New[string]()
+		for _, cluster := range clusters {
+			switch constraint.SpreadByField {
+			case policyv1alpha1.SpreadByFieldCluster:
+				groups.Insert(cluster.Name)
+			case policyv1alpha1.SpreadByFieldRegion:
+				groups.Insert(cluster.Spec.Region)
+			case policyv1alpha1.SpreadByFieldZone:
+				groups.Insert(cluster.Spec.Zones...)
+			case policyv1alpha1.SpreadByFieldProvider:
+				groups.Insert(cluster.Spec.Provider)
+			}
+		}
+		if groups.Len() < constraint.MinGroups {
+			return fmt.Sprintf("only %d %s(s) of the other clusters fit the placement, fewer than the minimum groups %d of the spread constraint",
+				groups.Len(), strings.ToLower(string(constraint.SpreadByField)), constraint.MinGroups)
+		}
+	}
+	return ""
+}
+
+func (o *CommandDrainOption) printf(w io.Writer, format string, a ...any) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	fmt.Fprintf(w, format, a...)
+}
+
+func bindingSpec(binding client.Object) *workv1alpha2.ResourceBindingSpec {
+	switch b := binding.(type) {
+	case *workv1alpha2.ResourceBinding:
+		return &b.Spec
+	case *workv1alpha2.ClusterResourceBinding:
+		return &b.Spec
+	}
+	return nil
+}
+
+func bindingStatus(binding client.Object) *workv1alpha2.ResourceBindingStatus {
+	switch b := binding.(type) {
+	case *workv1alpha2.ResourceBinding:
+		return &b.Status
+	case *workv1alpha2.ClusterResourceBinding:
+		return &b.Status
+	}
+	return nil
+}
+
+func bindingName(binding client.Object) string {
+	if binding.GetNamespace() == "" {
+		return fmt.Sprintf("ClusterResourceBinding %s", binding.GetName())
+	}
+	return fmt.Sprintf("ResourceBinding %s/%s", binding.GetNamespace(), binding.GetName())
+}
+
+func formatClusters(clusters []workv1alpha2.TargetCluster) string {
+	formatted := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		formatted = append(formatted, fmt.Sprintf("%s(%d)", cluster.Name, cluster.Replicas))
+	}
+	return strings.Join(formatted, ",")
+}
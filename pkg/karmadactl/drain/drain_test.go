@@ -0,0 +1,340 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func newCluster(name string, labels map[string]string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func newResourceBinding(namespace, name string, placement *policyv1alpha1.Placement, clusters ...string) *workv1alpha2.ResourceBinding {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: map[string]string{"app": name}},
+		Spec:       workv1alpha2.ResourceBindingSpec{Placement: placement},
+	}
+	for _, cluster := range clusters {
+		binding.Spec.Clusters = append(binding.Spec.Clusters, workv1alpha2.TargetCluster{Name: cluster, Replicas: 2})
+	}
+	return binding
+}
+
+// simulateRescheduling mimics karmada-scheduler and karmada-controller-manager, which move the evicted binding
+// to member2 and remove the eviction task once the resource becomes healthy there.
+func simulateRescheduling(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	spec, status := bindingSpec(obj), bindingStatus(obj)
+	spec.Clusters = []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 2}}
+	spec.GracefulEvictionTasks = nil
+	status.SchedulerObservedGeneration = obj.GetGeneration()
+	status.AggregatedStatus = []workv1alpha2.AggregatedStatusItem{{ClusterName: "member2", Applied: true, Health: workv1alpha2.ResourceHealthy}}
+	return c.Update(ctx, obj, opts...)
+}
+
+func newTestOption(t *testing.T, out *bytes.Buffer, update func(context.Context, client.WithWatch, client.Object, ...client.UpdateOption) error) *CommandDrainOption {
+	t.Helper()
+	objects := []runtime.Object{
+		newCluster("member1", map[string]string{"region": "a"}),
+		newCluster("member2", map[string]string{"region": "b"}),
+		newResourceBinding("default", "nginx", nil, "member1"),
+		newResourceBinding("default", "pinned", &policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+		}, "member1"),
+		newResourceBinding("default", "elsewhere", nil, "member2"),
+		newResourceBinding("foo", "redis", &policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "b"}}},
+		}, "member1"),
+		&workv1alpha2.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "crd"},
+			Spec:       workv1alpha2.ResourceBindingSpec{Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}}},
+		},
+	}
+	builder := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithRuntimeObjects(objects...)
+	if update != nil {
+		builder = builder.WithInterceptorFuncs(interceptor.Funcs{Update: update})
+	}
+	return &CommandDrainOption{
+		ClusterName:  "member1",
+		Concurrency:  2,
+		selector:     labels.Everything(),
+		client:       builder.Build(),
+		pollInterval: 10 * time.Millisecond,
+		IOStreams:    genericiooptions.IOStreams{Out: out, ErrOut: out},
+	}
+}
+
+func TestCommandDrainOption_drain(t *testing.T) {
+	t.Run("drain all bindings", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		o := newTestOption(t, out, simulateRescheduling)
+		err := o.drain(context.TODO())
+		assert.EqualError(t, err, "cluster member1 is not fully drained, 0 binding(s) failed to be evicted and 1 binding(s) can't be moved")
+
+		for _, name := range []string{"ResourceBinding default/nginx", "ResourceBinding foo/redis", "ClusterResourceBinding crd"} {
+			assert.Contains(t, out.String(), name+" evicted\n")
+			assert.Contains(t, out.String(), name+" moved to member2(2)\n")
+		}
+		assert.Contains(t, out.String(), "The following bindings can't be moved from cluster member1:\n"+
+			"  ResourceBinding default/pinned: no other cluster fits the placement: cluster(s) did not match the placement cluster affinity constraint\n")
+		assert.NotContains(t, out.String(), "elsewhere")
+
+		pinned := &workv1alpha2.ResourceBinding{}
+		require.NoError(t, o.client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pinned"}, pinned))
+		assert.True(t, pinned.Spec.TargetContains("member1"))
+	})
+
+	t.Run("drain bindings in namespace with selector", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		o := newTestOption(t, out, simulateRescheduling)
+		o.Namespace = "default"
+		o.selector = labels.SelectorFromSet(labels.Set{"app": "nginx"})
+		require.NoError(t, o.drain(context.TODO()))
+		assert.Equal(t, "ResourceBinding default/nginx evicted\nResourceBinding default/nginx moved to member2(2)\ncluster member1 drained\n", out.String())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		o := newTestOption(t, out, nil)
+		o.Namespace = "foo"
+		o.DryRun = true
+		require.NoError(t, o.drain(context.TODO()))
+		assert.Equal(t, "ResourceBinding foo/redis evicted (dry run)\ncluster member1 drained (dry run)\n", out.String())
+
+		redis := &workv1alpha2.ResourceBinding{}
+		require.NoError(t, o.client.Get(context.TODO(), client.ObjectKey{Namespace: "foo", Name: "redis"}, redis))
+		assert.True(t, redis.Spec.TargetContains("member1"))
+		assert.Empty(t, redis.Spec.GracefulEvictionTasks)
+	})
+
+	t.Run("timed out waiting for the binding to be moved", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		o := newTestOption(t, out, nil)
+		o.Namespace = "foo"
+		o.Timeout = 50 * time.Millisecond
+		assert.EqualError(t, o.drain(context.TODO()), "cluster member1 is not fully drained, 1 binding(s) failed to be evicted and 0 binding(s) can't be moved")
+		assert.Contains(t, out.String(), "failed to evict ResourceBinding foo/redis: timed out waiting for the binding to be moved\n")
+
+		redis := &workv1alpha2.ResourceBinding{}
+		require.NoError(t, o.client.Get(context.TODO(), client.ObjectKey{Namespace: "foo", Name: "redis"}, redis))
+		assert.False(t, redis.Spec.TargetContains("member1"))
+		require.Len(t, redis.Spec.GracefulEvictionTasks, 1)
+		assert.Equal(t, workv1alpha2.EvictionReasonClusterDrained, redis.Spec.GracefulEvictionTasks[0].Reason)
+		assert.Equal(t, workv1alpha2.EvictionProducerKarmadactl, redis.Spec.GracefulEvictionTasks[0].Producer)
+		assert.Equal(t, policyv1alpha1.PurgeModeGracefully, redis.Spec.GracefulEvictionTasks[0].PurgeMode)
+	})
+}
+
+func TestMoved(t *testing.T) {
+	newBinding := func(mutate func(*workv1alpha2.ResourceBinding)) *workv1alpha2.ResourceBinding {
+		binding := newResourceBinding("default", "nginx", nil, "member2")
+		binding.Generation = 2
+		binding.Status.SchedulerObservedGeneration = 2
+		binding.Status.AggregatedStatus = []workv1alpha2.AggregatedStatusItem{{ClusterName: "member2", Applied: true, Health: workv1alpha2.ResourceHealthy}}
+		mutate(binding)
+		return binding
+	}
+
+	tests := []struct {
+		name        string
+		binding     *workv1alpha2.ResourceBinding
+		expected    bool
+		expectedErr string
+	}{
+		{
+			name:     "moved",
+			binding:  newBinding(func(*workv1alpha2.ResourceBinding) {}),
+			expected: true,
+		},
+		{
+			name: "not rescheduled yet",
+			binding: newBinding(func(b *workv1alpha2.ResourceBinding) {
+				b.Status.SchedulerObservedGeneration = 1
+			}),
+		},
+		{
+			name: "waiting for eviction task to finish",
+			binding: newBinding(func(b *workv1alpha2.ResourceBinding) {
+				b.Spec.GracefulEvictionTasks = []workv1alpha2.GracefulEvictionTask{{FromCluster: "member1"}}
+			}),
+		},
+		{
+			name: "unhealthy in new cluster",
+			binding: newBinding(func(b *workv1alpha2.ResourceBinding) {
+				b.Status.AggregatedStatus[0].Health = workv1alpha2.ResourceUnhealthy
+			}),
+		},
+		{
+			name: "health unknown in new cluster",
+			binding: newBinding(func(b *workv1alpha2.ResourceBinding) {
+				b.Status.AggregatedStatus[0].Health = workv1alpha2.ResourceUnknown
+			}),
+			expected: true,
+		},
+		{
+			name: "no cluster to move to",
+			binding: newBinding(func(b *workv1alpha2.ResourceBinding) {
+				b.Spec.Clusters = nil
+				b.Status.Conditions = []metav1.Condition{{Type: workv1alpha2.Scheduled, Status: metav1.ConditionFalse, Message: "0/2 clusters are available"}}
+			}),
+			expectedErr: "no cluster to move to: 0/2 clusters are available",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := moved(tt.binding, "member1")
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCanMove(t *testing.T) {
+	noScheduleTaint := corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule}
+	clusters := []clusterv1alpha1.Cluster{
+		*newCluster("member1", map[string]string{"region": "a"}),
+		*newCluster("member2", map[string]string{"region": "b"}),
+		*newCluster("member3", map[string]string{"region": "c"}),
+	}
+	clusters[0].Spec.Region, clusters[1].Spec.Region, clusters[2].Spec.Region = "a", "b", "b"
+	clusters[2].Spec.Taints = []corev1.Taint{noScheduleTaint}
+
+	tests := []struct {
+		name           string
+		placement      *policyv1alpha1.Placement
+		observedTerm   string
+		expected       bool
+		expectedReason string
+	}{
+		{
+			name:     "no placement",
+			expected: true,
+		},
+		{
+			name:      "no cluster affinity",
+			placement: &policyv1alpha1.Placement{},
+			expected:  true,
+		},
+		{
+			name:           "pinned by cluster names",
+			placement:      &policyv1alpha1.Placement{ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}},
+			expectedReason: "no other cluster fits the placement: cluster(s) did not match the placement cluster affinity constraint, cluster(s) had untolerated taint {maintenance:NoSchedule}",
+		},
+		{
+			name: "pinned by label selector",
+			placement: &policyv1alpha1.Placement{ClusterAffinity: &policyv1alpha1.ClusterAffinity{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "a"}},
+			}},
+			expectedReason: "no other cluster fits the placement: cluster(s) did not match the placement cluster affinity constraint, cluster(s) had untolerated taint {maintenance:NoSchedule}",
+		},
+		{
+			name:           "other cluster has untolerated taint",
+			placement:      &policyv1alpha1.Placement{ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1", "member3"}}},
+			expectedReason: "no other cluster fits the placement: cluster(s) did not match the placement cluster affinity constraint, cluster(s) had untolerated taint {maintenance:NoSchedule}",
+		},
+		{
+			name: "other cluster has tolerated taint",
+			placement: &policyv1alpha1.Placement{
+				ClusterAffinity:    &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1", "member3"}},
+				ClusterTolerations: []corev1.Toleration{{Key: "maintenance", Operator: corev1.TolerationOpExists}},
+			},
+			expected: true,
+		},
+		{
+			name: "spread constraint satisfied by other clusters",
+			placement: &policyv1alpha1.Placement{SpreadConstraints: []policyv1alpha1.SpreadConstraint{
+				{SpreadByField: policyv1alpha1.SpreadByFieldCluster, MinGroups: 1, MaxGroups: 2},
+			}},
+			expected: true,
+		},
+		{
+			name: "too few clusters for spread constraint",
+			placement: &policyv1alpha1.Placement{SpreadConstraints: []policyv1alpha1.SpreadConstraint{
+				{SpreadByField: policyv1alpha1.SpreadByFieldCluster, MinGroups: 2, MaxGroups: 2},
+			}},
+			expectedReason: "only 1 cluster(s) of the other clusters fit the placement, fewer than the minimum groups 2 of the spread constraint",
+		},
+		{
+			name: "too few regions for spread constraint",
+			placement: &policyv1alpha1.Placement{
+				ClusterTolerations: []corev1.Toleration{{Key: "maintenance", Operator: corev1.TolerationOpExists}},
+				SpreadConstraints: []policyv1alpha1.SpreadConstraint{
+					{SpreadByField: policyv1alpha1.SpreadByFieldRegion, MinGroups: 2, MaxGroups: 2},
+				},
+			},
+			expectedReason: "only 1 region(s) of the other clusters fit the placement, fewer than the minimum groups 2 of the spread constraint",
+		},
+		{
+			name: "other cluster matches one of the cluster affinities",
+			placement: &policyv1alpha1.Placement{ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{
+				{AffinityName: "primary", ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}},
+				{AffinityName: "backup", ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}},
+			}},
+			expected: true,
+		},
+		{
+			name: "cluster affinities before the observed one are not tried",
+			placement: &policyv1alpha1.Placement{ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{
+				{AffinityName: "primary", ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}},
+				{AffinityName: "backup", ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}},
+			}},
+			observedTerm:   "backup",
+			expectedReason: "no other cluster fits the placement: cluster(s) did not match the placement cluster affinity constraint, cluster(s) had untolerated taint {maintenance:NoSchedule}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &workv1alpha2.ResourceBindingSpec{Placement: tt.placement, Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}}}
+			status := &workv1alpha2.ResourceBindingStatus{SchedulerObservedAffinityName: tt.observedTerm}
+			ok, reason := canMove(spec, status, clusters, "member1")
+			assert.Equal(t, tt.expected, ok)
+			assert.Equal(t, tt.expectedReason, reason)
+		})
+	}
+}
+
+func TestCommandDrainOption_Validate(t *testing.T) {
+	assert.NoError(t, (&CommandDrainOption{Concurrency: 1}).Validate())
+	assert.Error(t, (&CommandDrainOption{Concurrency: 0}).Validate())
+	assert.Error(t, (&CommandDrainOption{Concurrency: 1, Timeout: -time.Second}).Validate())
+}
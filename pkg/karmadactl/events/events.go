@@ -0,0 +1,541 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// controlPlane is the name shown in the CLUSTER column for the events in Karmada control plane.
+const controlPlane = "karmada"
+
+var (
+	eventsLong = templates.LongDesc(`
+		Display the events of a resource template across Karmada control plane and member clusters.
+
+		The events of the resource template, its ResourceBinding or ClusterResourceBinding and Works
+		are gathered from Karmada control plane, and the events of the propagated resource and the
+		objects owned by it, such as the ReplicaSets and Pods of a Deployment, are gathered from
+		each target cluster through the cluster proxy. The events are merged into one timeline, with
+		the cluster each event comes from.`)
+
+	eventsExample = templates.Examples(`
+		# List the events of the deployment(default/nginx) across Karmada control plane and member clusters
+		%[1]s events deployment/nginx -n default
+
+		# List the events of the deployment(default/nginx), then watch for new events
+		%[1]s events deployment nginx -n default --watch`)
+)
+
+// NewCmdEvents creates the `events` command.
+func NewCmdEvents(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandEventsOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "events (TYPE NAME | TYPE/NAME) [--watch]",
+		Short:                 "Display the events of a resource template across Karmada control plane and member clusters",
+		Long:                  eventsLong,
+		Example:               fmt.Sprintf(eventsExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(context.TODO())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&o.Watch, "watch", "w", false, "After listing the events, watch for new events.")
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// CommandEventsOptions contains the input to the events command.
+type CommandEventsOptions struct {
+	genericiooptions.IOStreams
+
+	// Watch tells if watch for new events after listing the events.
+	Watch bool
+
+	args          []string
+	namespace     string
+	builder       *resource.Builder
+	kubeClient    kubernetes.Interface
+	karmadaClient karmadaclientset.Interface
+	// memberClient returns the client of the member cluster, which accesses the member cluster through the
+	// cluster proxy.
+	memberClient func(cluster string) (kubernetes.Interface, error)
+}
+
+// Complete completes all the required options.
+func (o *CommandEventsOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.args = args
+	o.namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	o.builder = f.NewBuilder()
+
+	if o.kubeClient, err = f.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.karmadaClient, err = f.KarmadaClientSet(); err != nil {
+		return err
+	}
+	o.memberClient = func(cluster string) (kubernetes.Interface, error) {
+		memberFactory, err := f.FactoryForMemberCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+		return memberFactory.KubernetesClientSet()
+	}
+	return nil
+}
+
+// Validate checks the options.
+func (o *CommandEventsOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	return nil
+}
+
+// Run prints the events of the resource template.
+func (o *CommandEventsOptions) Run(ctx context.Context) error {
+	infos, err := o.builder.
+		Unstructured().
+		NamespaceParam(o.namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(true, o.args...).
+		SingleResourceType().
+		Latest().
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("expected exactly one resource, but got %d", len(infos))
+	}
+
+	sources, err := o.eventSources(ctx, infos[0].Object.(*unstructured.Unstructured))
+	if err != nil {
+		return err
+	}
+	events, sources := o.listEvents(ctx, sources)
+
+	w := printers.GetNewTabWriter(o.Out)
+	printHeaders(w)
+	for _, e := range events {
+		printEvent(w, e)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if !o.Watch {
+		return nil
+	}
+	return o.watchEvents(ctx, sources, w)
+}
+
+// involvedObjects identifies the objects of a kind in a namespace whose events are gathered.
+type involvedObjects struct {
+	kind      string
+	namespace string
+	names     sets.Set[string]
+}
+
+// fieldSelector returns the field selector of the events of the objects. The name is selected only if there is
+// exactly one object, the events of the others are filtered by matches.
+func (i involvedObjects) fieldSelector() string {
+	set := fields.Set{"involvedObject.kind": i.kind}
+	if i.namespace != "" {
+		set["involvedObject.namespace"] = i.namespace
+	}
+	if i.names.Len() == 1 {
+		set["involvedObject.name"] = sets.List(i.names)[0]
+	}
+	return fields.SelectorFromSet(set).String()
+}
+
+func (i involvedObjects) matches(ref corev1.ObjectReference) bool {
+	return ref.Kind == i.kind && ref.Namespace == i.namespace && i.names.Has(ref.Name)
+}
+
+// eventSource is where the events of the objects are gathered from.
+type eventSource struct {
+	cluster string
+	client  kubernetes.Interface
+	objects involvedObjects
+	// resourceVersion is the resource version of the event list, the watch starts from it.
+	resourceVersion string
+}
+
+// listOptions returns the options to list or watch the events of the objects, which are scoped to the namespace
+// of the objects by the client.
+func (s *eventSource) listOptions() metav1.ListOptions {
+	return metav1.ListOptions{FieldSelector: s.objects.fieldSelector(), ResourceVersion: s.resourceVersion}
+}
+
+// eventSourceBuilder builds the event sources of the objects in a cluster, one for each kind and namespace.
+type eventSourceBuilder struct {
+	cluster string
+	client  kubernetes.Interface
+	sources []*eventSource
+}
+
+func (b *eventSourceBuilder) add(kind, namespace, name string) {
+	for _, source := range b.sources {
+		if source.objects.kind == kind && source.objects.namespace == namespace {
+			source.objects.names.Insert(name)
+			return
+		}
+	}
+	b.sources = append(b.sources, &eventSource{
+		cluster: b.cluster,
+		client:  b.client,
+		objects: involvedObjects{kind: kind, namespace: namespace, names: sets.New(name)},
+	})
+}
+
+// clusterEvent is an event along with the cluster it comes from.
+type clusterEvent struct {
+	cluster string
+	event   corev1.Event
+}
+
+// eventSources returns the sources to gather the events of the resource template from: the resource
+// template, the binding and the Works in Karmada control plane, and the propagated resource in each
+// target cluster.
+func (o *CommandEventsOptions) eventSources(ctx context.Context, template *unstructured.Unstructured) ([]*eventSource, error) {
+	controlPlaneSources := &eventSourceBuilder{cluster: controlPlane, client: o.kubeClient}
+	controlPlaneSources.add(template.GetKind(), template.GetNamespace(), template.GetName())
+
+	var bindingSpec *workv1alpha2.ResourceBindingSpec
+	var idLabel, bindingID string
+	bindingName := names.GenerateBindingName(template.GetKind(), template.GetName())
+	if template.GetNamespace() != "" {
+		rb, err := o.karmadaClient.WorkV1alpha2().ResourceBindings(template.GetNamespace()).Get(ctx, bindingName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get ResourceBinding %s: %w", bindingName, err)
+		}
+		if err == nil {
+			controlPlaneSources.add(workv1alpha2.ResourceKindResourceBinding, rb.Namespace, rb.Name)
+			bindingSpec, idLabel, bindingID = &rb.Spec, workv1alpha2.ResourceBindingPermanentIDLabel, rb.Labels[workv1alpha2.ResourceBindingPermanentIDLabel]
+		}
+	} else {
+		crb, err := o.karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(ctx, bindingName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get ClusterResourceBinding %s: %w", bindingName, err)
+		}
+		if err == nil {
+			controlPlaneSources.add(workv1alpha2.ResourceKindClusterResourceBinding, "", crb.Name)
+			bindingSpec, idLabel, bindingID = &crb.Spec, workv1alpha2.ClusterResourceBindingPermanentIDLabel, crb.Labels[workv1alpha2.ClusterResourceBindingPermanentIDLabel]
+		}
+	}
+
+	if bindingID != "" {
+		works, err := o.karmadaClient.WorkV1alpha1().Works(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(labels.Set{idLabel: bindingID}).String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Works of %s: %w", bindingName, err)
+		}
+		for _, work := range works.Items {
+			controlPlaneSources.add(workv1alpha1.ResourceKindWork, work.Namespace, work.Name)
+		}
+	}
+
+	sources := controlPlaneSources.sources
+	if bindingSpec == nil {
+		return sources, nil
+	}
+
+	for _, target := range bindingSpec.Clusters {
+		client, err := o.memberClient(target.Name)
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "Warning: failed to access cluster(%s): %v\n", target.Name, err)
+			continue
+		}
+		memberSources := &eventSourceBuilder{cluster: target.Name, client: client}
+		memberSources.add(template.GetKind(), template.GetNamespace(), template.GetName())
+		if template.GetNamespace() != "" {
+			if err = addOwnedObjects(ctx, client, template, memberSources); err != nil {
+				fmt.Fprintf(o.ErrOut, "Warning: failed to list the objects owned by %s(%s/%s) in cluster(%s): %v\n",
+					template.GetKind(), template.GetNamespace(), template.GetName(), target.Name, err)
+			}
+		}
+		sources = append(sources, memberSources.sources...)
+	}
+	return sources, nil
+}
+
+// addOwnedObjects adds the ReplicaSets, Jobs and Pods owned by the propagated resource, directly or through
+// the owned ReplicaSets and Jobs, according to their ownerReferences. The objects are listed with the selector
+// of the workload if it has one.
+func addOwnedObjects(ctx context.Context, client kubernetes.Interface, template *unstructured.Unstructured, builder *eventSourceBuilder) error {
+	listOptions, err := workloadListOptions(template)
+	if err != nil {
+		return err
+	}
+	namespace := template.GetNamespace()
+	owners := map[string]sets.Set[string]{}
+	addOwner := func(kind, name string) {
+		if owners[kind] == nil {
+			owners[kind] = sets.New[string]()
+		}
+		owners[kind].Insert(name)
+	}
+	addOwner(template.GetKind(), template.GetName())
+
+	replicaSets, err := client.AppsV1().ReplicaSets(namespace).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, rs := range replicaSets.Items {
+		if ownedBy(rs.OwnerReferences, owners) {
+			builder.add("ReplicaSet", namespace, rs.Name)
+			addOwner("ReplicaSet", rs.Name)
+		}
+	}
+	jobs, err := client.BatchV1().Jobs(namespace).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs.Items {
+		if ownedBy(job.OwnerReferences, owners) {
+			builder.add("Job", namespace, job.Name)
+			addOwner("Job", job.Name)
+		}
+	}
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		if ownedBy(pod.OwnerReferences, owners) {
+			builder.add("Pod", namespace, pod.Name)
+		}
+	}
+	return nil
+}
+
+// workloadListOptions returns the options to list the objects owned by the workload, which select the objects
+// with the selector of the workload if it has one in '.spec.selector'.
+func workloadListOptions(template *unstructured.Unstructured) (metav1.ListOptions, error) {
+	selectorMap, found, err := unstructured.NestedMap(template.Object, "spec", "selector")
+	if err != nil || !found {
+		// Some workloads, such as CronJob, have no selector.
+		return metav1.ListOptions{}, nil
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+		return metav1.ListOptions{}, fmt.Errorf("failed to parse the selector: %w", err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return metav1.ListOptions{}, fmt.Errorf("failed to parse the selector: %w", err)
+	}
+	return metav1.ListOptions{LabelSelector: selector.String()}, nil
+}
+
+// ownedBy tells if any of the ownerReferences refers to one of the owners, which are the names by kind.
+func ownedBy(refs []metav1.OwnerReference, owners map[string]sets.Set[string]) bool {
+	for _, ref := range refs {
+		if owners[ref.Kind].Has(ref.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// listEvents lists the events from the sources and merges them into one timeline. The sources failed to list
+// events from are excluded from the returned sources.
+func (o *CommandEventsOptions) listEvents(ctx context.Context, sources []*eventSource) ([]clusterEvent, []*eventSource) {
+	var events []clusterEvent
+	available := make([]*eventSource, 0, len(sources))
+	for _, source := range sources {
+		list, err := source.client.CoreV1().Events(source.objects.namespace).List(ctx, source.listOptions())
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "Warning: failed to list events in cluster(%s): %v\n", source.cluster, err)
+			continue
+		}
+		source.resourceVersion = list.ResourceVersion
+		available = append(available, source)
+		for i := range list.Items {
+			if source.objects.matches(list.Items[i].InvolvedObject) {
+				events = append(events, clusterEvent{cluster: source.cluster, event: list.Items[i]})
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i].event).Before(eventTime(events[j].event))
+	})
+	return events, available
+}
+
+// flushWriter is a writer buffering the output, such as a tab writer.
+type flushWriter interface {
+	io.Writer
+	Flush() error
+}
+
+// watchEvents watches for new events from the sources and prints them as they come, until all the watches end.
+func (o *CommandEventsOptions) watchEvents(ctx context.Context, sources []*eventSource, w flushWriter) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan clusterEvent)
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		watcher, err := source.client.CoreV1().Events(source.objects.namespace).Watch(ctx, source.listOptions())
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "Warning: failed to watch events in cluster(%s): %v\n", source.cluster, err)
+			continue
+		}
+		wg.Add(1)
+		go func(source *eventSource) {
+			defer wg.Done()
+			defer watcher.Stop()
+			for e := range watcher.ResultChan() {
+				// events are deleted after they expire, don't print that
+				if e.Type == watch.Deleted {
+					continue
+				}
+				event, ok := e.Object.(*corev1.Event)
+				if !ok || !source.objects.matches(event.InvolvedObject) {
+					continue
+				}
+				select {
+				case events <- clusterEvent{cluster: source.cluster, event: *event}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(source)
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	for e := range events {
+		printEvent(w, e)
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printHeaders(w io.Writer) {
+	fmt.Fprintln(w, "LAST SEEN\tCLUSTER\tTYPE\tREASON\tOBJECT\tMESSAGE")
+}
+
+func printEvent(w io.Writer, e clusterEvent) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s/%s\t%s\n",
+		interval(e.event),
+		e.cluster,
+		printers.EscapeTerminal(e.event.Type),
+		printers.EscapeTerminal(e.event.Reason),
+		printers.EscapeTerminal(e.event.InvolvedObject.Kind),
+		printers.EscapeTerminal(e.event.InvolvedObject.Name),
+		printers.EscapeTerminal(strings.TrimSpace(e.event.Message)),
+	)
+}
+
+// interval returns the time since the event was last seen, along with the count and the time since it was
+// first seen if it's repeated.
+func interval(e corev1.Event) string {
+	first := since(e.EventTime.Time)
+	if e.EventTime.IsZero() {
+		first = since(e.FirstTimestamp.Time)
+	}
+	switch {
+	case e.Series != nil:
+		return fmt.Sprintf("%s (x%d over %s)", since(e.Series.LastObservedTime.Time), e.Series.Count, first)
+	case e.Count > 1:
+		return fmt.Sprintf("%s (x%d over %s)", since(e.LastTimestamp.Time), e.Count, first)
+	default:
+		return first
+	}
+}
+
+func since(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(t))
+}
+
+// eventTime returns the time the event was last seen, which is used to sort the events.
+func eventTime(e corev1.Event) time.Time {
+	if e.Series != nil {
+		return e.Series.LastObservedTime.Time
+	}
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	return e.EventTime.Time
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclient "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func newEvent(namespace, kind, name, reason string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: namespace, Name: name + "." + reason},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: namespace, Name: name},
+		Type:           corev1.EventTypeNormal,
+		Reason:         reason,
+		Message:        reason + " " + name,
+		FirstTimestamp: metav1.NewTime(lastSeen),
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+// newOwnedObject sets the metadata of the object in namespace default, which is owned by the owner.
+func newOwnedObject[T metav1.Object](obj T, name string, labels map[string]string, ownerKind, ownerName string) T {
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	obj.SetOwnerReferences([]metav1.OwnerReference{{Kind: ownerKind, Name: ownerName}})
+	return obj
+}
+
+func TestCommandEventsOptions_listEvents(t *testing.T) {
+	now := time.Now()
+	karmadaClient := fakekarmadaclient.NewClientset(
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "nginx-deployment",
+				Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "rb-1"},
+			},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}, {Name: "member3"}},
+			},
+		},
+		&workv1alpha1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "karmada-es-member1",
+				Name:      "nginx-687f7fb96f",
+				Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "rb-1"},
+			},
+		},
+	)
+	controlPlaneClient := fakekubeclient.NewClientset(
+		newEvent("default", "Deployment", "nginx", "ApplyPolicySucceed", now.Add(-5*time.Minute)),
+		newEvent("default", "ResourceBinding", "nginx-deployment", "ScheduleBindingSucceed", now.Add(-4*time.Minute)),
+		newEvent("karmada-es-member1", "Work", "nginx-687f7fb96f", "WorkDispatching", now.Add(-3*time.Minute)),
+		newEvent("default", "Deployment", "other", "ApplyPolicySucceed", now.Add(-3*time.Minute)),
+	)
+	nginxLabels := map[string]string{"app": "nginx"}
+	memberClients := map[string]kubernetes.Interface{
+		"member1": fakekubeclient.NewClientset(
+			newOwnedObject(&appsv1.ReplicaSet{}, "nginx-6799fc88d8", nginxLabels, "Deployment", "nginx"),
+			newOwnedObject(&corev1.Pod{}, "nginx-6799fc88d8-wsx8p", nginxLabels, "ReplicaSet", "nginx-6799fc88d8"),
+			// named after the deployment, but not owned by it.
+			newOwnedObject(&corev1.Pod{}, "nginx-other", nginxLabels, "ReplicaSet", "nginx-other"),
+			newEvent("default", "Deployment", "nginx", "ScalingReplicaSet", now.Add(-2*time.Minute)),
+			newEvent("default", "Pod", "nginx-6799fc88d8-wsx8p", "Started", now.Add(-time.Minute)),
+			newEvent("default", "Pod", "nginx-other", "Started", now.Add(-time.Minute)),
+			newEvent("default", "Pod", "other-6799fc88d8-wsx8p", "Started", now.Add(-time.Minute)),
+		),
+		"member2": fakekubeclient.NewClientset(
+			newOwnedObject(&appsv1.ReplicaSet{}, "nginx-6799fc88d8", nginxLabels, "Deployment", "nginx"),
+			newEvent("default", "ReplicaSet", "nginx-6799fc88d8", "SuccessfulCreate", now.Add(-90*time.Second)),
+		),
+	}
+	var fieldSelectors []string
+	controlPlaneClient.PrependReactor("list", "events", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		listAction := action.(clienttesting.ListActionImpl)
+		fieldSelectors = append(fieldSelectors, listAction.GetNamespace()+" "+listAction.GetListRestrictions().Fields.String())
+		return false, nil, nil
+	})
+
+	errOut := &bytes.Buffer{}
+	o := &CommandEventsOptions{
+		kubeClient:    controlPlaneClient,
+		karmadaClient: karmadaClient,
+		memberClient: func(cluster string) (kubernetes.Interface, error) {
+			if client, ok := memberClients[cluster]; ok {
+				return client, nil
+			}
+			return nil, errors.New("cluster not ready")
+		},
+	}
+	o.ErrOut = errOut
+
+	template := &unstructured.Unstructured{}
+	template.SetAPIVersion("apps/v1")
+	template.SetKind("Deployment")
+	template.SetNamespace("default")
+	template.SetName("nginx")
+	require.NoError(t, unstructured.SetNestedStringMap(template.Object, nginxLabels, "spec", "selector", "matchLabels"))
+	sources, err := o.eventSources(context.TODO(), template)
+	require.NoError(t, err)
+	// one source for each kind and namespace of the objects.
+	require.Len(t, sources, 8)
+	assert.Equal(t, "Warning: failed to access cluster(member3): cluster not ready\n", errOut.String())
+
+	events, sources := o.listEvents(context.TODO(), sources)
+	assert.Len(t, sources, 8)
+	assert.Equal(t, []string{
+		"default involvedObject.kind=Deployment,involvedObject.name=nginx,involvedObject.namespace=default",
+		"default involvedObject.kind=ResourceBinding,involvedObject.name=nginx-deployment,involvedObject.namespace=default",
+		"karmada-es-member1 involvedObject.kind=Work,involvedObject.name=nginx-687f7fb96f,involvedObject.namespace=karmada-es-member1",
+	}, fieldSelectors)
+	var got []string
+	for _, e := range events {
+		got = append(got, e.cluster+" "+e.event.InvolvedObject.Kind+"/"+e.event.InvolvedObject.Name+" "+e.event.Reason)
+	}
+	assert.Equal(t, []string{
+		"karmada Deployment/nginx ApplyPolicySucceed",
+		"karmada ResourceBinding/nginx-deployment ScheduleBindingSucceed",
+		"karmada Work/nginx-687f7fb96f WorkDispatching",
+		"member1 Deployment/nginx ScalingReplicaSet",
+		"member2 ReplicaSet/nginx-6799fc88d8 SuccessfulCreate",
+		"member1 Pod/nginx-6799fc88d8-wsx8p Started",
+	}, got)
+
+	out := &bytes.Buffer{}
+	printHeaders(out)
+	printEvent(out, events[0])
+	assert.Equal(t, "LAST SEEN\tCLUSTER\tTYPE\tREASON\tOBJECT\tMESSAGE\n"+
+		"5m\tkarmada\tNormal\tApplyPolicySucceed\tDeployment/nginx\tApplyPolicySucceed nginx\n", out.String())
+}
+
+func TestInterval(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		event corev1.Event
+		want  string
+	}{
+		{
+			name:  "unknown",
+			event: corev1.Event{},
+			want:  "<unknown>",
+		},
+		{
+			name:  "seen once",
+			event: corev1.Event{FirstTimestamp: metav1.NewTime(now.Add(-time.Minute)), LastTimestamp: metav1.NewTime(now.Add(-time.Minute)), Count: 1},
+			want:  "60s",
+		},
+		{
+			name:  "repeated",
+			event: corev1.Event{FirstTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)), LastTimestamp: metav1.NewTime(now.Add(-time.Minute)), Count: 3},
+			want:  "60s (x3 over 10m)",
+		},
+		{
+			name: "series",
+			event: corev1.Event{
+				EventTime: metav1.NewMicroTime(now.Add(-10 * time.Minute)),
+				Series:    &corev1.EventSeries{Count: 5, LastObservedTime: metav1.NewMicroTime(now.Add(-2 * time.Minute))},
+			},
+			want: "2m (x5 over 10m)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, interval(tt.event))
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"k8s.io/cli-runtime/pkg/resource"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/celvm"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/luavm"
 )
 
@@ -71,11 +73,12 @@ func (o *Options) runCheck() error {
 			fmt.Fprintf(w, "    %s:\t", r.Name())
 
 			script := r.GetScript(customization)
-			if script == "" {
+			expression := r.GetCELExpression(customization)
+			if script == "" && expression == "" {
 				fmt.Fprintln(w, "UNSET")
 				continue
 			}
-			checkErr := checkScript(script)
+			checkErr := checkRule(configv1alpha1.InterpreterOperation(r.Name()), script, expression)
 			if checkErr != nil {
 				failed = true
 				fmt.Fprintf(w, "%s: %s\t\n", "ERROR", strings.TrimSpace(checkErr.Error()))
@@ -96,6 +99,16 @@ func (o *Options) runCheck() error {
 	return nil
 }
 
+func checkRule(operation configv1alpha1.InterpreterOperation, script, expression string) error {
+	if script != "" && expression != "" {
+		return fmt.Errorf("can not set both luaScript and celExpression")
+	}
+	if expression != "" {
+		return celvm.Compile(operation, expression)
+	}
+	return checkScript(script)
+}
+
 func checkScript(script string) error {
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
 	defer cancel()
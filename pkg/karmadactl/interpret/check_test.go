@@ -64,14 +64,38 @@ target.kind no set
 SOURCE: customization-check
 TARGET: apps/v1 Deployment   
 RULERS:
-    Retain:                  PASS
-    InterpretReplica:        ERROR: <string> line:1(column:10) near 'format':   parse error   
-    InterpretComponent:      UNSET
-    ReviseReplica:           UNSET
-    InterpretStatus:         UNSET
-    AggregateStatus:         UNSET
-    InterpretHealth:         UNSET
-    InterpretDependency:     UNSET
+    Retain:                   PASS
+    InterpretReplica:         ERROR: <string> line:1(column:10) near 'format':   parse error   
+    InterpretComponent:       UNSET
+    ReviseReplica:            UNSET
+    InterpretStatus:          UNSET
+    AggregateStatus:          UNSET
+    InterpretHealth:          UNSET
+    InterpretRolloutStatus:   UNSET
+    InterpretDependency:      UNSET
+`,
+		},
+		{
+			name: "customization with CEL expressions has error",
+			options: &Options{
+				Rules:           interpreter.AllResourceInterpreterCustomizationRules,
+				FilenameOptions: resource.FilenameOptions{Filenames: []string{"./testdata/customization_cel.yml"}},
+				Check:           true,
+			},
+			wantErr: true,
+			want: `-----------------------------------
+SOURCE: customization-cel
+TARGET: apps/v1 Deployment   
+RULERS:
+    Retain:                   UNSET
+    InterpretReplica:         PASS
+    InterpretComponent:       UNSET
+    ReviseReplica:            PASS
+    InterpretStatus:          UNSET
+    AggregateStatus:          UNSET
+    InterpretHealth:          ERROR: InterpreterOperation(InterpretHealth) CEL expression error: expect the returned type is one of [bool] but got string   
+    InterpretRolloutStatus:   UNSET
+    InterpretDependency:      UNSET
 `,
 		},
 		{
@@ -86,14 +110,15 @@ RULERS:
 SOURCE: customization
 TARGET: apps/v1 Deployment   
 RULERS:
-    Retain:                  PASS
-    InterpretReplica:        PASS
-    InterpretComponent:      PASS
-    ReviseReplica:           PASS
-    InterpretStatus:         PASS
-    AggregateStatus:         PASS
-    InterpretHealth:         PASS
-    InterpretDependency:     PASS
+    Retain:                   PASS
+    InterpretReplica:         PASS
+    InterpretComponent:       PASS
+    ReviseReplica:            PASS
+    InterpretStatus:          PASS
+    AggregateStatus:          PASS
+    InterpretHealth:          PASS
+    InterpretRolloutStatus:   UNSET
+    InterpretDependency:      PASS
 `,
 		},
 	}
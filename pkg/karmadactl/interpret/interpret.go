@@ -86,6 +86,9 @@ var (
 
 		# Edit customization
 		%[1]s interpret -f customization.yml --edit
+
+		# Run the test cases in directory testdata against the customizations
+		%[1]s interpret test -f customization.yml testdata
 	`)
 )
 
@@ -136,6 +139,7 @@ func NewCmdInterpret(f util.Factory, parentCommand string, streams genericioopti
 	flags.BoolVarP(&o.FilenameOptions.Recursive, "recursive", "R", false, "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory.")
 
 	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	cmd.AddCommand(NewCmdInterpretTest(f, parentCommand, streams))
 	return cmd
 }
 
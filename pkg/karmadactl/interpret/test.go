@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpret
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/default/thirdparty"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+)
+
+var (
+	testLong = templates.LongDesc(`
+		Run golden-file test cases against interpreter customizations.
+
+		Each test case holds the input objects, the operation and the expected output. Multiple test cases
+		can be put in one file separated by '---', for example:
+
+		    name: "healthy deployment"
+		    operation: InterpretHealth
+		    observedObj:
+		      apiVersion: apps/v1
+		      kind: Deployment
+		      ...
+		    output:
+		      healthy: true
+
+		The keys of output are the names of results returned by the operation, which are shown by
+		executing the operation with 'interpret --operation'. Fields of objects in output with the
+		value '{{EXCLUDE}}' are ignored when comparing.
+
+		The command exits with a non-zero code if any test case fails.`)
+
+	testExample = templates.Examples(`
+		# Run the test cases in directory testdata against the customizations in file
+		%[1]s interpret test -f customization.yml testdata
+
+		# Run the test cases against the thirdparty customizations bundled in Karmada
+		%[1]s interpret test --thirdparty testdata/rollout-test.yaml`)
+)
+
+// NewCmdInterpretTest returns the `interpret test` command.
+func NewCmdInterpretTest(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &TestOptions{
+		IOStreams: streams,
+		Rules:     interpreter.AllResourceInterpreterCustomizationRules,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "test (-f FILENAME | --thirdparty) PATH...",
+		Short:                 "Run golden-file test cases against interpreter customizations",
+		Long:                  testLong,
+		Example:               fmt.Sprintf(testExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Run: func(_ *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, args))
+			cmdutil.CheckErr(o.Validate())
+			cmdutil.CheckErr(o.Run())
+		},
+	}
+
+	flags := cmd.Flags()
+	cmdutil.AddJsonFilenameFlag(flags, &o.FilenameOptions.Filenames, "Filename, directory, or URL to files containing the customizations. Files need to be in either YAML or JSON format.")
+	flags.BoolVarP(&o.FilenameOptions.Recursive, "recursive", "R", false, "Process the directory used in -f, --filename recursively.")
+	flags.BoolVar(&o.ThirdParty, "thirdparty", false, "Run the test cases against the thirdparty customizations bundled in Karmada.")
+	return cmd
+}
+
+// TestOptions holds the options of the `interpret test` command.
+type TestOptions struct {
+	resource.FilenameOptions
+
+	// ThirdParty indicates running test cases against the bundled thirdparty customizations.
+	ThirdParty bool
+	// TestPaths are the files or directories containing the test cases.
+	TestPaths []string
+
+	CustomizationResult *resource.Result
+	Rules               interpreter.Rules
+
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *TestOptions) Complete(f util.Factory, args []string) error {
+	o.TestPaths = args
+	if len(o.FilenameOptions.Filenames) == 0 {
+		return nil
+	}
+
+	scheme := gclient.NewSchema()
+	o.CustomizationResult = f.NewBuilder().
+		WithScheme(scheme, scheme.PrioritizedVersionsAllGroups()...).
+		FilenameParam(false, &o.FilenameOptions).
+		RequireObject(true).
+		Local().
+		Do()
+	return o.CustomizationResult.Err()
+}
+
+// Validate checks the options.
+func (o *TestOptions) Validate() error {
+	if len(o.TestPaths) == 0 {
+		return fmt.Errorf("at least one file or directory of test cases is required")
+	}
+	if o.ThirdParty == (len(o.FilenameOptions.Filenames) > 0) {
+		return fmt.Errorf("exactly one of --filename and --thirdparty must be specified")
+	}
+	return nil
+}
+
+// Run runs all test cases and prints the result.
+func (o *TestOptions) Run() error {
+	customizations, err := o.getCustomizations()
+	if err != nil {
+		return err
+	}
+	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
+	configurableInterpreter.LoadConfig(customizations)
+
+	var testCases []interpreter.TestCase
+	for _, path := range o.TestPaths {
+		cases, err := interpreter.LoadTestCases(path)
+		if err != nil {
+			return fmt.Errorf("failed to load test cases: %v", err)
+		}
+		testCases = append(testCases, cases...)
+	}
+	if len(testCases) == 0 {
+		return fmt.Errorf("no test cases found in %s", strings.Join(o.TestPaths, ", "))
+	}
+
+	failed := 0
+	for _, testCase := range testCases {
+		result := o.Rules.RunTestCase(configurableInterpreter, testCase)
+		if !result.Passed() {
+			failed++
+		}
+		printTestCaseResult(o.Out, testCase, result)
+	}
+
+	fmt.Fprintf(o.Out, "\n%d passed, %d failed\n", len(testCases)-failed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d test cases failed", failed, len(testCases))
+	}
+	return nil
+}
+
+func (o *TestOptions) getCustomizations() ([]*configv1alpha1.ResourceInterpreterCustomization, error) {
+	if o.ThirdParty {
+		customizations, err := thirdparty.LoadCustomizations()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load thirdparty customizations: %v", err)
+		}
+		return customizations, nil
+	}
+
+	infos, err := o.CustomizationResult.Infos()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get customization object: %v", err)
+	}
+	customizations := make([]*configv1alpha1.ResourceInterpreterCustomization, len(infos))
+	for i, info := range infos {
+		c, err := asResourceInterpreterCustomization(info.Object)
+		if err != nil {
+			return nil, err
+		}
+		customizations[i] = c
+	}
+	return customizations, nil
+}
+
+func printTestCaseResult(w io.Writer, testCase interpreter.TestCase, result *interpreter.TestCaseResult) {
+	status := "PASS"
+	if !result.Passed() {
+		status = "FAIL"
+	}
+	fmt.Fprintf(w, "%s [%s] %s (%s)\n", status, testCase.Operation, testCase.Name, testCase.Filepath)
+
+	if result.Err != nil {
+		fmt.Fprintf(w, "    error: %v\n", result.Err)
+		return
+	}
+	for _, mismatch := range result.Mismatches {
+		if mismatch.Actual == nil {
+			fmt.Fprintf(w, "    %s: expected in output but not returned by %s\n", mismatch.Name, testCase.Operation)
+			continue
+		}
+		diff, err := diffResult(mismatch.Expected, mismatch.Actual)
+		if err != nil {
+			fmt.Fprintf(w, "    %s: failed to diff result: %v\n", mismatch.Name, err)
+			continue
+		}
+		fmt.Fprintf(w, "    %s (-expected +actual):\n", mismatch.Name)
+		for _, line := range strings.Split(strings.TrimRight(diff, "\n"), "\n") {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+}
+
+// diffResult compares the JSON representation of results, so that the diff is
+// presented in the same way as the output written in test cases.
+func diffResult(expected, actual any) (string, error) {
+	toGeneric := func(obj any) (any, error) {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		var generic any
+		err = json.Unmarshal(data, &generic)
+		return generic, err
+	}
+
+	expectedGeneric, err := toGeneric(expected)
+	if err != nil {
+		return "", err
+	}
+	actualGeneric, err := toGeneric(actual)
+	if err != nil {
+		return "", err
+	}
+	return cmp.Diff(expectedGeneric, actualGeneric), nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpret
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	cmdtesting "github.com/karmada-io/karmada/pkg/karmadactl/util/testing"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+)
+
+func TestTestOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *TestOptions
+		wantErr bool
+	}{
+		{
+			name:    "no test paths",
+			options: &TestOptions{ThirdParty: true},
+			wantErr: true,
+		},
+		{
+			name:    "neither filename nor thirdparty",
+			options: &TestOptions{TestPaths: []string{"testdata"}},
+			wantErr: true,
+		},
+		{
+			name: "both filename and thirdparty",
+			options: &TestOptions{
+				FilenameOptions: resource.FilenameOptions{Filenames: []string{"customization.yml"}},
+				ThirdParty:      true,
+				TestPaths:       []string{"testdata"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "thirdparty",
+			options: &TestOptions{ThirdParty: true, TestPaths: []string{"testdata"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTestOptions_Run(t *testing.T) {
+	tests := []struct {
+		name      string
+		testPaths []string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "all test cases passed",
+			testPaths: []string{"./testdata/testcases/passed.yml"},
+			want: `PASS [InterpretHealth] healthy deployment (./testdata/testcases/passed.yml)
+PASS [InterpretDependency] dependencies of deployment (./testdata/testcases/passed.yml)
+
+2 passed, 0 failed
+`,
+		},
+		{
+			name:      "some test cases failed",
+			testPaths: []string{"./testdata/testcases"},
+			wantErr:   true,
+			want: `FAIL [InterpretHealth] unhealthy deployment (testdata/testcases/failed.yml)
+    healthy (-expected +actual):
+PASS [InterpretHealth] healthy deployment (testdata/testcases/passed.yml)
+PASS [InterpretDependency] dependencies of deployment (testdata/testcases/passed.yml)
+
+2 passed, 1 failed
+`,
+		},
+		{
+			name:      "no test cases",
+			testPaths: []string{"./testdata/customization.yml"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := cmdtesting.NewTestFactory()
+			defer tf.Cleanup()
+
+			streams, _, buf, _ := genericiooptions.NewTestIOStreams()
+			o := &TestOptions{
+				FilenameOptions: resource.FilenameOptions{Filenames: []string{"./testdata/customization.yml"}},
+				Rules:           interpreter.AllResourceInterpreterCustomizationRules,
+				IOStreams:       streams,
+			}
+			if err := o.Complete(tf, tt.testPaths); err != nil {
+				t.Fatal(err)
+			}
+			if err := o.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			err := o.Run()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := withoutDiffBody(buf.String()); got != tt.want {
+				t.Errorf("Run() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// withoutDiffBody drops the diff lines, whose format is not guaranteed to be stable by go-cmp.
+func withoutDiffBody(output string) string {
+	var lines []string
+	for _, line := range strings.SplitAfter(output, "\n") {
+		if strings.HasPrefix(line, "    ") && !strings.HasSuffix(line, ":\n") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "")
+}
@@ -33,14 +33,20 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/apiresources"
 	"github.com/karmada-io/karmada/pkg/karmadactl/apply"
 	"github.com/karmada-io/karmada/pkg/karmadactl/attach"
+	"github.com/karmada-io/karmada/pkg/karmadactl/backup"
 	"github.com/karmada-io/karmada/pkg/karmadactl/cmdinit"
 	"github.com/karmada-io/karmada/pkg/karmadactl/completion"
 	"github.com/karmada-io/karmada/pkg/karmadactl/cordon"
+	"github.com/karmada-io/karmada/pkg/karmadactl/cp"
 	"github.com/karmada-io/karmada/pkg/karmadactl/create"
 	"github.com/karmada-io/karmada/pkg/karmadactl/deinit"
 	karmadactldelete "github.com/karmada-io/karmada/pkg/karmadactl/delete"
 	"github.com/karmada-io/karmada/pkg/karmadactl/describe"
+	"github.com/karmada-io/karmada/pkg/karmadactl/diff"
+	"github.com/karmada-io/karmada/pkg/karmadactl/doctor"
+	"github.com/karmada-io/karmada/pkg/karmadactl/drain"
 	"github.com/karmada-io/karmada/pkg/karmadactl/edit"
+	"github.com/karmada-io/karmada/pkg/karmadactl/events"
 	"github.com/karmada-io/karmada/pkg/karmadactl/exec"
 	"github.com/karmada-io/karmada/pkg/karmadactl/explain"
 	"github.com/karmada-io/karmada/pkg/karmadactl/get"
@@ -50,11 +56,14 @@ import (
 	"github.com/karmada-io/karmada/pkg/karmadactl/logs"
 	"github.com/karmada-io/karmada/pkg/karmadactl/options"
 	"github.com/karmada-io/karmada/pkg/karmadactl/patch"
+	"github.com/karmada-io/karmada/pkg/karmadactl/portforward"
 	"github.com/karmada-io/karmada/pkg/karmadactl/promote"
 	"github.com/karmada-io/karmada/pkg/karmadactl/register"
+	"github.com/karmada-io/karmada/pkg/karmadactl/rollout"
 	"github.com/karmada-io/karmada/pkg/karmadactl/taint"
 	"github.com/karmada-io/karmada/pkg/karmadactl/token"
 	"github.com/karmada-io/karmada/pkg/karmadactl/top"
+	"github.com/karmada-io/karmada/pkg/karmadactl/tree"
 	"github.com/karmada-io/karmada/pkg/karmadactl/unjoin"
 	"github.com/karmada-io/karmada/pkg/karmadactl/unregister"
 	"github.com/karmada-io/karmada/pkg/karmadactl/util"
@@ -119,6 +128,8 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 			Commands: []*cobra.Command{
 				cmdinit.NewCmdInit(parentCommand),
 				deinit.NewCmdDeInit(parentCommand),
+				backup.NewCmdBackup(f, parentCommand, ioStreams),
+				backup.NewCmdRestore(f, parentCommand, ioStreams),
 				addons.NewCmdAddons(parentCommand),
 				join.NewCmdJoin(f, parentCommand),
 				unjoin.NewCmdUnjoin(f, parentCommand),
@@ -132,6 +143,7 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 			Commands: []*cobra.Command{
 				cordon.NewCmdCordon(f, parentCommand),
 				cordon.NewCmdUncordon(f, parentCommand),
+				drain.NewCmdDrain(f, parentCommand, ioStreams),
 				taint.NewCmdTaint(f, parentCommand),
 			},
 		},
@@ -141,15 +153,22 @@ func NewKarmadaCtlCommand(cmdUse, parentCommand string) *cobra.Command {
 				attach.NewCmdAttach(f, parentCommand, ioStreams),
 				logs.NewCmdLogs(f, parentCommand, ioStreams),
 				exec.NewCmdExec(f, parentCommand, ioStreams),
+				portforward.NewCmdPortForward(f, parentCommand, ioStreams),
+				cp.NewCmdCp(f, parentCommand, ioStreams),
 				describe.NewCmdDescribe(f, parentCommand, ioStreams),
 				interpret.NewCmdInterpret(f, parentCommand, ioStreams),
+				tree.NewCmdTree(f, parentCommand, ioStreams),
+				events.NewCmdEvents(f, parentCommand, ioStreams),
+				doctor.NewCmdDoctor(f, parentCommand, ioStreams),
 			},
 		},
 		{
 			Message: "Advanced Commands:",
 			Commands: []*cobra.Command{
 				apply.NewCmdApply(f, parentCommand, ioStreams),
+				diff.NewCmdDiff(f, parentCommand, ioStreams),
 				promote.NewCmdPromote(f, parentCommand),
+				rollout.NewCmdRollout(f, parentCommand, ioStreams),
 				top.NewCmdTop(f, parentCommand, ioStreams),
 				patch.NewCmdPatch(f, parentCommand, ioStreams),
 			},
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubectlportforward "k8s.io/kubectl/pkg/cmd/portforward"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+const (
+	// Amount of time to wait until at least one pod is running
+	defaultPodPortForwardWaitTimeout = 60 * time.Second
+)
+
+var (
+	portForwardLong = templates.LongDesc(`
+		Forward one or more local ports to a pod in a member cluster.
+
+		The connection is tunneled through the cluster proxy of Karmada, so the kubeconfig of the member
+		cluster is not required.
+
+		Use resource type/name such as deployment/mydeployment to select a pod. Resource type defaults to 'pod' if omitted.
+
+		If there are multiple pods matching the criteria, a pod will be selected automatically. The
+		forwarding session ends when the selected pod terminates, and a rerun of the command is needed
+		to resume forwarding.`)
+
+	portForwardExample = templates.Examples(`
+		# Listen on ports 5000 and 6000 locally, forwarding data to/from ports 5000 and 6000 in the pod in cluster(member1)
+		%[1]s port-forward pod/mypod 5000 6000 -C=member1
+
+		# Listen on ports 5000 and 6000 locally, forwarding data to/from ports 5000 and 6000 in a pod selected by the deployment in cluster(member1)
+		%[1]s port-forward deployment/mydeployment 5000 6000 -C=member1
+
+		# Listen on port 8443 locally, forwarding to the targetPort of the service's port named "https" in a pod selected by the service in cluster(member1)
+		%[1]s port-forward service/myservice 8443:https -C=member1
+
+		# Listen on port 8888 locally, forwarding to 5000 in the pod in cluster(member1)
+		%[1]s port-forward pod/mypod 8888:5000 -C=member1
+
+		# Listen on port 8888 on all addresses, forwarding to 5000 in the pod in cluster(member1)
+		%[1]s port-forward --address 0.0.0.0 pod/mypod 8888:5000 -C=member1
+
+		# Listen on a random port locally, forwarding to 5000 in the pod in cluster(member1)
+		%[1]s port-forward pod/mypod :5000 -C=member1`)
+)
+
+// NewCmdPortForward new port-forward command.
+func NewCmdPortForward(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandPortForwardOptions{
+		KubectlPortForwardOptions: kubectlportforward.NewDefaultPortForwardOptions(streams),
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "port-forward TYPE/NAME [options] [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N] (-C CLUSTER)",
+		Short:                 "Forward one or more local ports to a pod in a cluster",
+		Long:                  portForwardLong,
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		Example:               fmt.Sprintf(portForwardExample, parentCommand),
+		ValidArgsFunction:     utilcomp.PodResourceNameCompletionFunc(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(f, cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+	cmdutil.AddPodRunningTimeoutFlag(cmd, defaultPodPortForwardWaitTimeout)
+	flags.StringSliceVar(&o.KubectlPortForwardOptions.Address, "address", []string{"localhost"}, "Addresses to listen on (comma separated). Only accepts IP addresses or localhost as a value. When localhost is supplied, karmadactl will try to bind on both 127.0.0.1 and ::1 and will fail if neither of these addresses are available to bind.")
+	flags.StringVarP(&o.Cluster, "cluster", "C", "", "Specify a member cluster")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	utilcomp.RegisterCompletionFuncForClusterFlag(cmd)
+	return cmd
+}
+
+// CommandPortForwardOptions contains the input to the port-forward command.
+type CommandPortForwardOptions struct {
+	// flags specific to port-forward
+	KubectlPortForwardOptions *kubectlportforward.PortForwardOptions
+	Cluster                   string
+}
+
+// Complete completes all the required options for port-forward cmd.
+func (o *CommandPortForwardOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	if o.Cluster == "" {
+		return errors.New("must specify a cluster")
+	}
+
+	memberFactory, err := f.FactoryForMemberCluster(o.Cluster)
+	if err != nil {
+		return err
+	}
+	return o.KubectlPortForwardOptions.Complete(memberFactory, cmd, args)
+}
+
+// Validate validates all the required options for port-forward cmd.
+func (o *CommandPortForwardOptions) Validate() error {
+	return o.KubectlPortForwardOptions.Validate()
+}
+
+// Run forwards the local ports to the pod through the cluster proxy.
+func (o *CommandPortForwardOptions) Run(ctx context.Context) error {
+	return o.KubectlPortForwardOptions.RunPortForwardContext(ctx)
+}
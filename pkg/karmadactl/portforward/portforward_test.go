@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubectlportforward "k8s.io/kubectl/pkg/cmd/portforward"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+)
+
+type testFactory struct {
+	util.Factory
+	memberFactory cmdutil.Factory
+	cluster       string
+}
+
+func (t *testFactory) FactoryForMemberCluster(cluster string) (cmdutil.Factory, error) {
+	if t.memberFactory == nil {
+		return nil, errors.New("failed to create factory for member cluster")
+	}
+	t.cluster = cluster
+	return t.memberFactory, nil
+}
+
+func TestCompletePortForwardOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster string
+		args    []string
+		f       *testFactory
+		errMsg  string
+	}{
+		{
+			name:   "CompletePortForwardOptions_WithoutCluster_ClusterMustBeSpecified",
+			f:      &testFactory{},
+			errMsg: "must specify a cluster",
+		},
+		{
+			name:    "CompletePortForwardOptions_ReturnMemberClusterFactory_FailedToReturnMemberClusterFactory",
+			cluster: "member1",
+			f:       &testFactory{},
+			args:    []string{"pod/nginx", "8080"},
+			errMsg:  "failed to create factory for member cluster",
+		},
+		{
+			name:    "CompletePortForwardOptions_WithoutPorts_GotPortForwardUsage",
+			cluster: "member1",
+			f:       &testFactory{memberFactory: cmdtesting.NewTestFactory()},
+			args:    []string{"pod/nginx"},
+			errMsg:  "TYPE/NAME and list of ports are required for port-forward",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := &CommandPortForwardOptions{
+				KubectlPortForwardOptions: kubectlportforward.NewDefaultPortForwardOptions(genericiooptions.NewTestIOStreamsDiscard()),
+				Cluster:                   test.cluster,
+			}
+			err := o.Complete(test.f, &cobra.Command{}, test.args)
+			if err == nil {
+				t.Fatal("expected an error, but got none")
+			}
+			if !strings.Contains(err.Error(), test.errMsg) {
+				t.Errorf("expected error message %s to be in %s", test.errMsg, err.Error())
+			}
+			if test.f.memberFactory != nil && test.f.cluster != test.cluster {
+				t.Errorf("expected factory for cluster %s, but got %s", test.cluster, test.f.cluster)
+			}
+		})
+	}
+}
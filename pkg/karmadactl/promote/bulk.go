@@ -0,0 +1,522 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	u "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"github.com/karmada-io/karmada/pkg/util/restmapper"
+)
+
+// defaultBulkResources are the resource types promoted in bulk mode if no resource type is specified.
+const defaultBulkResources = "serviceaccounts,secrets,configmaps,persistentvolumeclaims,roles,rolebindings,services," +
+	"deployments,statefulsets,daemonsets,jobs,cronjobs,ingresses,horizontalpodautoscalers,poddisruptionbudgets"
+
+// promoteOrder is the order of kinds to promote in bulk mode, the kinds depended on by others come first.
+// The kinds not listed are promoted at last.
+var promoteOrder = []string{
+	"CustomResourceDefinition", "PriorityClass", "StorageClass", "ClusterRole", "ClusterRoleBinding", "PersistentVolume",
+	"ServiceAccount", "Secret", "ConfigMap", "LimitRange", "ResourceQuota", "NetworkPolicy", "PersistentVolumeClaim",
+	"Role", "RoleBinding", "Service", "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Ingress",
+	"HorizontalPodAutoscaler", "PodDisruptionBudget",
+}
+
+// systemNamespaces are not promoted when promoting resources in all namespaces.
+var systemNamespaces = []string{metav1.NamespaceSystem, metav1.NamespacePublic, corev1.NamespaceNodeLease,
+	names.NamespaceKarmadaSystem, names.NamespaceKarmadaCluster}
+
+// bulkAction is the action taken for a resource in bulk mode.
+type bulkAction string
+
+const (
+	// bulkActionPromote means the resource is going to be promoted.
+	bulkActionPromote bulkAction = "Promote"
+	// bulkActionPromoted means the resource has been promoted by this run.
+	bulkActionPromoted bulkAction = "Promoted"
+	// bulkActionAlreadyPromoted means the resource has been promoted by a previous run.
+	bulkActionAlreadyPromoted bulkAction = "AlreadyPromoted"
+	// bulkActionSkip means the resource is not promoted.
+	bulkActionSkip bulkAction = "Skip"
+	// bulkActionFailed means the resource failed to be promoted.
+	bulkActionFailed bulkAction = "Failed"
+)
+
+// bulkItem is a resource to promote in bulk mode.
+type bulkItem struct {
+	obj    *unstructured.Unstructured
+	gvr    schema.GroupVersionResource
+	action bulkAction
+	reason string
+}
+
+// runBulk promotes the resources selected by namespace, label selector and resource types from the legacy cluster.
+func (o *CommandPromoteOption) runBulk(memberClusterFactory cmdutil.Factory, config *rest.Config, mapper meta.RESTMapper, out io.Writer) error {
+	items, err := o.collectBulkItems(memberClusterFactory, mapper)
+	if err != nil {
+		return fmt.Errorf("failed to list resources in cluster(%s). err: %w", o.Cluster, err)
+	}
+	if len(items) == 0 {
+		fmt.Fprintf(out, "No resources found in cluster(%s)\n", o.Cluster)
+		return nil
+	}
+
+	if o.OutputFormat != "" {
+		return o.printBulkObjectsAndPolicies(items, out)
+	}
+	return o.promoteBulk(context.TODO(), items, dynamicClientBuilder(config), karmadaClientBuilder(config), out)
+}
+
+// collectBulkItems lists the resources to promote from the legacy cluster.
+func (o *CommandPromoteOption) collectBulkItems(memberClusterFactory cmdutil.Factory, mapper meta.RESTMapper) ([]*bulkItem, error) {
+	resources := o.resourceTypes
+	if resources == "" {
+		resources = defaultBulkResources
+	}
+	r := memberClusterFactory.NewBuilder().
+		Unstructured().
+		NamespaceParam(o.Namespace).DefaultNamespace().AllNamespaces(o.AllNamespaces).
+		LabelSelectorParam(o.LabelSelector).
+		RequestChunksOf(500).
+		ResourceTypeOrNameArgs(true, resources).
+		ContinueOnError().
+		Latest().
+		Flatten().
+		Do()
+	infos, err := r.Infos()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*bulkItem, 0, len(infos))
+	for _, info := range infos {
+		obj, ok := info.Object.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if o.AllNamespaces && isSystemNamespace(obj.GetNamespace()) {
+			continue
+		}
+		item := &bulkItem{obj: obj.DeepCopy(), action: bulkActionPromote}
+		if item.gvr, err = restmapper.GetGroupVersionResource(mapper, obj.GroupVersionKind()); err != nil {
+			item.action, item.reason = bulkActionSkip, "resource type not installed in Karmada control plane"
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func isSystemNamespace(namespace string) bool {
+	return slices.Contains(systemNamespaces, namespace)
+}
+
+// promoteBulk promotes the resources in dependency order, and creates one PropagationPolicy for each namespace,
+// and one ClusterPropagationPolicy for the cluster-scoped resources. The resources which have been promoted by a
+// previous run are skipped, so that the promotion can be resumed after a failure.
+func (o *CommandPromoteOption) promoteBulk(ctx context.Context, items []*bulkItem, controlPlaneDynamicClient dynamic.Interface,
+	karmadaClient karmadaclientset.Interface, out io.Writer) error {
+	sortBulkItems(items)
+
+	groups, err := o.planBulk(ctx, items, controlPlaneDynamicClient, karmadaClient)
+	if err != nil {
+		return err
+	}
+	if o.DryRun {
+		printBulkReport(out, items)
+		return nil
+	}
+
+	for _, namespace := range sortedKeys(groups) {
+		group := groups[namespace]
+		if err := o.promoteBulkGroup(ctx, namespace, group, controlPlaneDynamicClient, karmadaClient, out); err != nil {
+			for _, item := range group {
+				if item.action == bulkActionPromote {
+					item.action, item.reason = bulkActionFailed, err.Error()
+				}
+			}
+		}
+	}
+	printBulkReport(out, items)
+
+	failed := 0
+	for _, item := range items {
+		if item.action == bulkActionFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d resource(s) failed to be promoted, rerun the command to resume the promotion after fixing the errors", failed)
+	}
+	return nil
+}
+
+// planBulk decides the action for each resource, and groups the resources to promote by namespace.
+func (o *CommandPromoteOption) planBulk(ctx context.Context, items []*bulkItem, controlPlaneDynamicClient dynamic.Interface,
+	karmadaClient karmadaclientset.Interface) (map[string][]*bulkItem, error) {
+	policies := make(map[string]*policyv1alpha1.PropagationSpec)
+	groups := make(map[string][]*bulkItem)
+	for _, item := range items {
+		if item.action != bulkActionPromote {
+			continue
+		}
+		if reason := skipReason(item.obj); reason != "" {
+			item.action, item.reason = bulkActionSkip, reason
+			continue
+		}
+
+		namespace := item.obj.GetNamespace()
+		spec, ok := policies[namespace]
+		if !ok {
+			var err error
+			if spec, err = o.getBulkPolicySpec(ctx, karmadaClient, namespace); err != nil {
+				return nil, err
+			}
+			policies[namespace] = spec
+		}
+
+		_, err := controlPlaneDynamicClient.Resource(item.gvr).Namespace(namespace).Get(ctx, item.obj.GetName(), metav1.GetOptions{})
+		switch {
+		case err == nil && spec != nil && selectorIndex(spec.ResourceSelectors, bulkResourceSelector(item)) >= 0:
+			item.action = bulkActionAlreadyPromoted
+		case err == nil:
+			item.action, item.reason = bulkActionSkip, "already exists in Karmada control plane"
+			continue
+		case !apierrors.IsNotFound(err):
+			return nil, fmt.Errorf("failed to get resource %q(%s) in control plane: %v", item.gvr, objectKey(item.obj), err)
+		}
+
+		if err = preprocessResource(item.obj); err != nil {
+			item.action, item.reason = bulkActionSkip, fmt.Sprintf("failed to preprocess resource: %v", err)
+			continue
+		}
+		groups[namespace] = append(groups[namespace], item)
+	}
+	return groups, nil
+}
+
+// promoteBulkGroup promotes the resources in the namespace, or the cluster-scoped resources if namespace is empty.
+func (o *CommandPromoteOption) promoteBulkGroup(ctx context.Context, namespace string, group []*bulkItem, controlPlaneDynamicClient dynamic.Interface,
+	karmadaClient karmadaclientset.Interface, out io.Writer) error {
+	if namespace != "" {
+		if err := ensureNamespace(ctx, controlPlaneDynamicClient, namespace); err != nil {
+			return err
+		}
+	}
+	// The policy is updated before creating the resource templates, so that the resources found in the control plane
+	// while resuming are known to be promoted by a previous run.
+	if o.AutoCreatePolicy {
+		if err := o.applyBulkPolicy(ctx, karmadaClient, namespace, group); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range group {
+		if item.action != bulkActionPromote {
+			continue
+		}
+		_, err := controlPlaneDynamicClient.Resource(item.gvr).Namespace(namespace).Create(ctx, item.obj, metav1.CreateOptions{})
+		switch {
+		case err == nil:
+			item.action = bulkActionPromoted
+		case apierrors.IsAlreadyExists(err):
+			item.action = bulkActionAlreadyPromoted
+		default:
+			item.action, item.reason = bulkActionFailed, err.Error()
+		}
+	}
+
+	if o.AutoCreatePolicy {
+		if namespace == "" {
+			fmt.Fprintf(out, "ClusterPropagationPolicy %s is applied successfully\n", o.bulkPolicyName())
+		} else {
+			fmt.Fprintf(out, "PropagationPolicy (%s/%s) is applied successfully\n", namespace, o.bulkPolicyName())
+		}
+	}
+	return nil
+}
+
+func ensureNamespace(ctx context.Context, controlPlaneDynamicClient dynamic.Interface, namespace string) error {
+	gvr := corev1.SchemeGroupVersion.WithResource("namespaces")
+	_, err := controlPlaneDynamicClient.Resource(gvr).Get(ctx, namespace, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get namespace %s in control plane: %v", namespace, err)
+	}
+
+	ns := &unstructured.Unstructured{}
+	ns.SetAPIVersion("v1")
+	ns.SetKind("Namespace")
+	ns.SetName(namespace)
+	if _, err = controlPlaneDynamicClient.Resource(gvr).Create(ctx, ns, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s in control plane: %v", namespace, err)
+	}
+	return nil
+}
+
+// bulkPolicyName returns the name of the PropagationPolicy(or ClusterPropagationPolicy) created in bulk mode.
+func (o *CommandPromoteOption) bulkPolicyName() string {
+	if o.PolicyName != "" {
+		return o.PolicyName
+	}
+	return "promoted-from-" + o.Cluster
+}
+
+// getBulkPolicySpec gets the spec of the policy created in bulk mode, nil is returned if it doesn't exist.
+func (o *CommandPromoteOption) getBulkPolicySpec(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace string) (*policyv1alpha1.PropagationSpec, error) {
+	if !o.AutoCreatePolicy {
+		return nil, nil
+	}
+	if namespace == "" {
+		cpp, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, o.bulkPolicyName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ClusterPropagationPolicy(%s) in control plane: %v", o.bulkPolicyName(), err)
+		}
+		return &cpp.Spec, nil
+	}
+	pp, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).Get(ctx, o.bulkPolicyName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PropagationPolicy(%s/%s) in control plane: %v", namespace, o.bulkPolicyName(), err)
+	}
+	return &pp.Spec, nil
+}
+
+// applyBulkPolicy creates the policy selecting the resources of the group, or adds the resources to it if it exists.
+func (o *CommandPromoteOption) applyBulkPolicy(ctx context.Context, karmadaClient karmadaclientset.Interface, namespace string, group []*bulkItem) error {
+	selectors := make([]policyv1alpha1.ResourceSelector, 0, len(group))
+	for _, item := range group {
+		selectors = append(selectors, bulkResourceSelector(item))
+	}
+	policyName := o.bulkPolicyName()
+
+	if namespace == "" {
+		cpp, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, policyName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			cpp = &policyv1alpha1.ClusterPropagationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyName},
+				Spec:       buildPropagationSpec(selectors, o.Cluster, o.Deps),
+			}
+			_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, cpp, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get ClusterPropagationPolicy(%s) in control plane: %v", policyName, err)
+		}
+		if !mergeResourceSelectors(&cpp.Spec, selectors) {
+			return nil
+		}
+		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, cpp, metav1.UpdateOptions{})
+		return err
+	}
+
+	pp, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).Get(ctx, policyName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		pp = &policyv1alpha1.PropagationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: policyName, Namespace: namespace},
+			Spec:       buildPropagationSpec(selectors, o.Cluster, o.Deps),
+		}
+		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).Create(ctx, pp, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get PropagationPolicy(%s/%s) in control plane: %v", namespace, policyName, err)
+	}
+	if !mergeResourceSelectors(&pp.Spec, selectors) {
+		return nil
+	}
+	_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).Update(ctx, pp, metav1.UpdateOptions{})
+	return err
+}
+
+// mergeResourceSelectors adds the missing selectors to the spec, and tells whether the spec is changed.
+func mergeResourceSelectors(spec *policyv1alpha1.PropagationSpec, selectors []policyv1alpha1.ResourceSelector) bool {
+	changed := false
+	for _, selector := range selectors {
+		if selectorIndex(spec.ResourceSelectors, selector) < 0 {
+			spec.ResourceSelectors = append(spec.ResourceSelectors, selector)
+			changed = true
+		}
+	}
+	return changed
+}
+
+func selectorIndex(selectors []policyv1alpha1.ResourceSelector, selector policyv1alpha1.ResourceSelector) int {
+	for i, s := range selectors {
+		if s.APIVersion == selector.APIVersion && s.Kind == selector.Kind && s.Name == selector.Name && s.LabelSelector == nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func bulkResourceSelector(item *bulkItem) policyv1alpha1.ResourceSelector {
+	return policyv1alpha1.ResourceSelector{
+		APIVersion: item.gvr.GroupVersion().String(),
+		Kind:       item.obj.GetKind(),
+		Name:       item.obj.GetName(),
+	}
+}
+
+// skipReason tells why the resource should not be promoted, empty string is returned if it should be promoted.
+func skipReason(obj *unstructured.Unstructured) string {
+	if owner := metav1.GetControllerOf(obj); owner != nil {
+		return fmt.Sprintf("controlled by %s/%s", owner.Kind, owner.Name)
+	}
+	labels := obj.GetLabels()
+	if _, ok := labels[workv1alpha2.WorkPermanentIDLabel]; ok {
+		return "managed by Karmada"
+	}
+	if labels[u.ManagedByKarmadaLabel] == u.ManagedByKarmadaLabelValue {
+		return "managed by Karmada"
+	}
+
+	switch {
+	case obj.GetKind() == "ServiceAccount" && obj.GetName() == "default",
+		obj.GetKind() == "ConfigMap" && obj.GetName() == "kube-root-ca.crt",
+		obj.GetKind() == "Service" && obj.GetNamespace() == metav1.NamespaceDefault && obj.GetName() == "kubernetes":
+		return "created by Kubernetes"
+	case obj.GetKind() == "Secret":
+		if secretType, _, _ := unstructured.NestedString(obj.Object, "type"); secretType == string(corev1.SecretTypeServiceAccountToken) {
+			return "service account token"
+		}
+	}
+	return ""
+}
+
+// sortBulkItems sorts the resources in dependency order.
+func sortBulkItems(items []*bulkItem) {
+	order := func(kind string) int {
+		if i := slices.Index(promoteOrder, kind); i >= 0 {
+			return i
+		}
+		return len(promoteOrder)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].obj, items[j].obj
+		if order(a.GetKind()) != order(b.GetKind()) {
+			return order(a.GetKind()) < order(b.GetKind())
+		}
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+}
+
+func sortedKeys(groups map[string][]*bulkItem) []string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// printBulkReport prints the action taken for each resource and a summary.
+func printBulkReport(out io.Writer, items []*bulkItem) {
+	w := printers.GetNewTabWriter(out)
+	fmt.Fprintln(w, "NAMESPACE\tKIND\tNAME\tACTION\tREASON")
+	counts := make(map[bulkAction]int)
+	for _, item := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.obj.GetNamespace(), item.obj.GetKind(), item.obj.GetName(), item.action, item.reason)
+		counts[item.action]++
+	}
+	_ = w.Flush()
+
+	fmt.Fprintf(out, "\n%d to promote, %d promoted, %d already promoted, %d skipped, %d failed\n",
+		counts[bulkActionPromote], counts[bulkActionPromoted], counts[bulkActionAlreadyPromoted], counts[bulkActionSkip], counts[bulkActionFailed])
+}
+
+// printBulkObjectsAndPolicies prints the resource templates and the policies to be created instead of promoting them.
+func (o *CommandPromoteOption) printBulkObjectsAndPolicies(items []*bulkItem, out io.Writer) error {
+	printer, err := o.Printer(nil, nil, false, false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize k8s printer. err: %v", err)
+	}
+
+	sortBulkItems(items)
+	selectors := make(map[string][]policyv1alpha1.ResourceSelector)
+	for _, item := range items {
+		if item.action != bulkActionPromote || skipReason(item.obj) != "" {
+			continue
+		}
+		if err = preprocessResource(item.obj); err != nil {
+			return fmt.Errorf("failed to preprocess resource %q(%s): %v", item.gvr, objectKey(item.obj), err)
+		}
+		if err = printer.PrintObj(item.obj, out); err != nil {
+			return fmt.Errorf("failed to print the resource template. err: %v", err)
+		}
+		selectors[item.obj.GetNamespace()] = append(selectors[item.obj.GetNamespace()], bulkResourceSelector(item))
+	}
+	if !o.AutoCreatePolicy {
+		return nil
+	}
+
+	namespaces := make([]string, 0, len(selectors))
+	for namespace := range selectors {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		spec := buildPropagationSpec(selectors[namespace], o.Cluster, o.Deps)
+		if namespace == "" {
+			err = printer.PrintObj(&policyv1alpha1.ClusterPropagationPolicy{ObjectMeta: metav1.ObjectMeta{Name: o.bulkPolicyName()}, Spec: spec}, out)
+		} else {
+			err = printer.PrintObj(&policyv1alpha1.PropagationPolicy{ObjectMeta: metav1.ObjectMeta{Name: o.bulkPolicyName(), Namespace: namespace}, Spec: spec}, out)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to print the policy. err: %v", err)
+		}
+	}
+	return nil
+}
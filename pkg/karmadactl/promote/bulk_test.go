@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	coretesting "k8s.io/client-go/testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+var (
+	deploymentGVR  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	replicaSetGVR  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+	configMapGVR   = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	accountGVR     = schema.GroupVersionResource{Version: "v1", Resource: "serviceaccounts"}
+	clusterRoleGVR = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}
+	namespaceGVR   = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+)
+
+func newBulkObject(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+// newBulkItems returns the resources listed from the legacy cluster.
+func newBulkItems() []*bulkItem {
+	replicaSet := newBulkObject("apps/v1", "ReplicaSet", "default", "nginx-6799fc88d8")
+	replicaSet.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Controller: new(true)}})
+	return []*bulkItem{
+		{obj: newBulkObject("apps/v1", "Deployment", "default", "nginx"), gvr: deploymentGVR, action: bulkActionPromote},
+		{obj: replicaSet, gvr: replicaSetGVR, action: bulkActionPromote},
+		{obj: newBulkObject("v1", "ConfigMap", "default", "nginx-config"), gvr: configMapGVR, action: bulkActionPromote},
+		{obj: newBulkObject("v1", "ConfigMap", "default", "existing"), gvr: configMapGVR, action: bulkActionPromote},
+		{obj: newBulkObject("v1", "ServiceAccount", "default", "default"), gvr: accountGVR, action: bulkActionPromote},
+		{obj: newBulkObject("v1", "ConfigMap", "foo", "foo-config"), gvr: configMapGVR, action: bulkActionPromote},
+		{obj: newBulkObject("rbac.authorization.k8s.io/v1", "ClusterRole", "", "nginx-reader"), gvr: clusterRoleGVR, action: bulkActionPromote},
+		{obj: newBulkObject("example.io/v1", "Foo", "default", "foo"), action: bulkActionSkip, reason: "resource type not installed in Karmada control plane"},
+	}
+}
+
+func bulkResult(items []*bulkItem) map[string]bulkAction {
+	result := make(map[string]bulkAction, len(items))
+	for _, item := range items {
+		result[item.obj.GetKind()+"/"+objectKey(item.obj)] = item.action
+	}
+	return result
+}
+
+func TestPromoteBulk(t *testing.T) {
+	existing := newBulkObject("v1", "ConfigMap", "default", "existing")
+	defaultNamespace := newBulkObject("v1", "Namespace", "", "default")
+	controlPlaneDynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), existing, defaultNamespace)
+	karmadaClient := fakekarmadaclient.NewClientset()
+	o := &CommandPromoteOption{Cluster: "member1", AutoCreatePolicy: true, bulk: true}
+
+	// dry run makes no changes
+	o.DryRun = true
+	out := &bytes.Buffer{}
+	items := newBulkItems()
+	require.NoError(t, o.promoteBulk(context.TODO(), items, controlPlaneDynamicClient, karmadaClient, out))
+	assert.Equal(t, map[string]bulkAction{
+		"Deployment/default/nginx":            bulkActionPromote,
+		"ReplicaSet/default/nginx-6799fc88d8": bulkActionSkip,
+		"ConfigMap/default/nginx-config":      bulkActionPromote,
+		"ConfigMap/default/existing":          bulkActionSkip,
+		"ServiceAccount/default/default":      bulkActionSkip,
+		"ConfigMap/foo/foo-config":            bulkActionPromote,
+		"ClusterRole/nginx-reader":            bulkActionPromote,
+		"Foo/default/foo":                     bulkActionSkip,
+	}, bulkResult(items))
+	assert.Contains(t, out.String(), "4 to promote, 0 promoted, 0 already promoted, 4 skipped, 0 failed")
+	_, err := controlPlaneDynamicClient.Resource(configMapGVR).Namespace("default").Get(context.TODO(), "nginx-config", metav1.GetOptions{})
+	assert.True(t, err != nil, "resource should not be created in dry-run mode")
+
+	// the promotion of the deployment fails
+	o.DryRun = false
+	controlPlaneDynamicClient.PrependReactor("create", "deployments", func(coretesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("unexpected error")
+	})
+	items = newBulkItems()
+	err = o.promoteBulk(context.TODO(), items, controlPlaneDynamicClient, karmadaClient, &bytes.Buffer{})
+	assert.EqualError(t, err, "1 resource(s) failed to be promoted, rerun the command to resume the promotion after fixing the errors")
+	result := bulkResult(items)
+	assert.Equal(t, bulkActionFailed, result["Deployment/default/nginx"])
+	assert.Equal(t, bulkActionPromoted, result["ConfigMap/default/nginx-config"])
+	assert.Equal(t, bulkActionPromoted, result["ConfigMap/foo/foo-config"])
+	assert.Equal(t, bulkActionPromoted, result["ClusterRole/nginx-reader"])
+	_, err = controlPlaneDynamicClient.Resource(namespaceGVR).Get(context.TODO(), "foo", metav1.GetOptions{})
+	assert.NoError(t, err, "namespace foo should be created")
+
+	pp, err := karmadaClient.PolicyV1alpha1().PropagationPolicies("default").Get(context.TODO(), "promoted-from-member1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []policyv1alpha1.ResourceSelector{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "nginx-config"},
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"},
+	}, pp.Spec.ResourceSelectors)
+	assert.Equal(t, []string{"member1"}, pp.Spec.Placement.ClusterAffinity.ClusterNames)
+	cpp, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(context.TODO(), "promoted-from-member1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []policyv1alpha1.ResourceSelector{{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "nginx-reader"}},
+		cpp.Spec.ResourceSelectors)
+
+	// resume the promotion
+	controlPlaneDynamicClient.ReactionChain = controlPlaneDynamicClient.ReactionChain[1:]
+	items = newBulkItems()
+	out = &bytes.Buffer{}
+	require.NoError(t, o.promoteBulk(context.TODO(), items, controlPlaneDynamicClient, karmadaClient, out))
+	result = bulkResult(items)
+	assert.Equal(t, bulkActionPromoted, result["Deployment/default/nginx"])
+	assert.Equal(t, bulkActionAlreadyPromoted, result["ConfigMap/default/nginx-config"])
+	assert.Equal(t, bulkActionAlreadyPromoted, result["ConfigMap/foo/foo-config"])
+	assert.Equal(t, bulkActionAlreadyPromoted, result["ClusterRole/nginx-reader"])
+	assert.Equal(t, bulkActionSkip, result["ConfigMap/default/existing"])
+	assert.Contains(t, out.String(), "0 to promote, 1 promoted, 3 already promoted, 4 skipped, 0 failed")
+
+	pp, err = karmadaClient.PolicyV1alpha1().PropagationPolicies("default").Get(context.TODO(), "promoted-from-member1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, pp.Spec.ResourceSelectors, 2)
+}
+
+func TestSortBulkItems(t *testing.T) {
+	items := []*bulkItem{
+		{obj: newBulkObject("example.io/v1", "Foo", "default", "foo")},
+		{obj: newBulkObject("apps/v1", "Deployment", "default", "nginx")},
+		{obj: newBulkObject("v1", "ConfigMap", "foo", "config")},
+		{obj: newBulkObject("v1", "ConfigMap", "default", "config")},
+		{obj: newBulkObject("v1", "ServiceAccount", "default", "nginx")},
+		{obj: newBulkObject("rbac.authorization.k8s.io/v1", "ClusterRole", "", "nginx-reader")},
+	}
+	sortBulkItems(items)
+
+	var got []string
+	for _, item := range items {
+		got = append(got, item.obj.GetKind()+"/"+objectKey(item.obj))
+	}
+	assert.Equal(t, []string{
+		"ClusterRole/nginx-reader",
+		"ServiceAccount/default/nginx",
+		"ConfigMap/default/config",
+		"ConfigMap/foo/config",
+		"Deployment/default/nginx",
+		"Foo/default/foo",
+	}, got)
+}
+
+func TestValidateBulkPromoteOptions(t *testing.T) {
+	o := &CommandPromoteOption{Cluster: "member1", AllNamespaces: true, name: "nginx"}
+	assert.EqualError(t, o.Validate(), "--all-namespaces can only be used when promoting resources in bulk with --all or --selector")
+
+	o.bulk = true
+	assert.NoError(t, o.Validate())
+}
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name              string
+		opts              CommandPromoteOption
+		args              []string
+		wantErr           string
+		wantBulk          bool
+		wantName          string
+		wantResourceTypes string
+	}{
+		{
+			name:     "single resource",
+			args:     []string{"deployment", "nginx"},
+			wantName: "nginx",
+		},
+		{
+			name:    "no resource name",
+			args:    []string{"deployment"},
+			wantErr: "incorrect command format, please use correct command format",
+		},
+		{
+			name:    "no args",
+			wantErr: "incorrect command format, please use correct command format",
+		},
+		{
+			name:    "no resource name in all namespaces",
+			opts:    CommandPromoteOption{AllNamespaces: true},
+			args:    []string{"deployment"},
+			wantErr: "incorrect command format, please use correct command format",
+		},
+		{
+			name:     "all resources",
+			opts:     CommandPromoteOption{All: true},
+			wantBulk: true,
+		},
+		{
+			name:              "all resources of the types",
+			opts:              CommandPromoteOption{All: true},
+			args:              []string{"deployments,configmaps"},
+			wantBulk:          true,
+			wantResourceTypes: "deployments,configmaps",
+		},
+		{
+			name:     "resources selected by labels",
+			opts:     CommandPromoteOption{LabelSelector: "app=nginx"},
+			wantBulk: true,
+		},
+		{
+			name:    "resource name in bulk mode",
+			opts:    CommandPromoteOption{All: true},
+			args:    []string{"deployment", "nginx"},
+			wantErr: "a resource name cannot be given with --all or --selector",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.opts
+			err := o.parseArgs(tt.args)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBulk, o.bulk)
+			assert.Equal(t, tt.wantName, o.name)
+			assert.Equal(t, tt.wantResourceTypes, o.resourceTypes)
+		})
+	}
+}
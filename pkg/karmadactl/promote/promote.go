@@ -68,6 +68,12 @@ var (
 
 	If the resource already exists in the Karmada control plane, 
 	please edit PropagationPolicy and OverridePolicy to propagate it.
+
+	With '--all' or '--selector' instead of a resource name, the resources in the namespace, or in all namespaces
+	with '--all-namespaces', are promoted in bulk. The resources can be filtered by resource types and '--selector',
+	and are promoted in dependency order. One PropagationPolicy is created for each namespace, and one ClusterPropagationPolicy for
+	the cluster-scoped resources. The resources already promoted by a previous run are skipped, so the command
+	can be rerun to resume the promotion after a failure.
 	`)
 
 	promoteExample = templates.Examples(`
@@ -90,7 +96,13 @@ var (
 		%[1]s promote deployment nginx -n default -C cluster1 --cluster-kubeconfig=<CLUSTER_KUBECONFIG_PATH>
 
 		# Support to use '--cluster-kubeconfig' and '--cluster-context' to specify the configuration of member cluster
-		%[1]s promote deployment nginx -n default -C cluster1 --cluster-kubeconfig=<CLUSTER_KUBECONFIG_PATH> --cluster-context=<CLUSTER_CONTEXT>`)
+		%[1]s promote deployment nginx -n default -C cluster1 --cluster-kubeconfig=<CLUSTER_KUBECONFIG_PATH> --cluster-context=<CLUSTER_CONTEXT>
+
+		# Print a report of the resources in namespace default to be promoted from cluster1, without promoting them
+		%[1]s promote --all -n default -C cluster1 --dry-run
+
+		# Promote the deployments and configmaps labeled app=nginx in all namespaces from cluster1 to Karmada
+		%[1]s promote deployments,configmaps -A -l app=nginx -C cluster1`)
 )
 
 var (
@@ -111,7 +123,7 @@ func NewCmdPromote(f util.Factory, parentCommand string) *cobra.Command {
 	opts.JSONYamlPrintFlags = genericclioptions.NewJSONYamlPrintFlags()
 
 	cmd := &cobra.Command{
-		Use:                   "promote <RESOURCE_TYPE> <RESOURCE_NAME> -n <NAME_SPACE> -C <CLUSTER_NAME>",
+		Use:                   "promote (<RESOURCE_TYPE> <RESOURCE_NAME> | [<RESOURCE_TYPE>[,<RESOURCE_TYPE>...]] (--all | -l <SELECTOR>)) (-n <NAME_SPACE> | -A) -C <CLUSTER_NAME>",
 		Short:                 "Promote resources from legacy clusters to Karmada control plane",
 		Long:                  promoteLong,
 		Example:               fmt.Sprintf(promoteExample, parentCommand),
@@ -172,10 +184,20 @@ type CommandPromoteOption struct {
 	AutoCreatePolicy bool
 
 	// PolicyName is the name of the PropagationPolicy(or ClusterPropagationPolicy),
-	// It defaults to the promoting resource name with a random hash suffix.
+	// It defaults to the promoting resource name with a random hash suffix,
+	// or 'promoted-from-<cluster>' in bulk mode.
 	// It will be ignored if AutoCreatePolicy is false.
 	PolicyName string
 
+	// All tells if promote all the resources of the given types, or of all types if none is given, in bulk mode.
+	All bool
+
+	// AllNamespaces tells if promote resources in all namespaces in bulk mode.
+	AllNamespaces bool
+
+	// LabelSelector selects the resources to promote in bulk mode.
+	LabelSelector string
+
 	resource.FilenameOptions
 
 	JSONYamlPrintFlags *genericclioptions.JSONYamlPrintFlags
@@ -185,6 +207,11 @@ type CommandPromoteOption struct {
 
 	name string
 	gvk  schema.GroupVersionKind
+
+	// bulk tells if promote resources in bulk mode, which is enabled by '--all' or '--selector'.
+	bulk bool
+	// resourceTypes are the comma separated resource types to promote in bulk mode.
+	resourceTypes string
 }
 
 // AddFlags adds flags to the specified FlagSet.
@@ -192,7 +219,7 @@ func (o *CommandPromoteOption) AddFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.AutoCreatePolicy, "auto-create-policy", true,
 		"Automatically create a PropagationPolicy for namespace-scoped resources or create a ClusterPropagationPolicy for cluster-scoped resources.")
 	flags.StringVar(&o.PolicyName, "policy-name", "",
-		"The name of the PropagationPolicy(or ClusterPropagationPolicy) that is automatically created after promotion. If not specified, the name will be the resource name with a hash suffix that is generated by resource metadata.")
+		"The name of the PropagationPolicy(or ClusterPropagationPolicy) that is automatically created after promotion. If not specified, the name will be the resource name with a hash suffix that is generated by resource metadata, or 'promoted-from-<cluster>' in bulk mode.")
 	flags.StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: json|yaml")
 
 	flags.StringVarP(&o.Cluster, "cluster", "C", "", "Name of the legacy cluster (e.g. -C=member1)")
@@ -201,19 +228,20 @@ func (o *CommandPromoteOption) AddFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.ClusterKubeConfig, "cluster-kubeconfig", "",
 		"Path of the legacy cluster's kubeconfig.")
 	flags.BoolVarP(&o.Deps, "dependencies", "d", false, "Promote resource with its dependencies automatically, default to false")
-	flags.BoolVar(&o.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any server requests.")
+	flags.BoolVar(&o.DryRun, "dry-run", false, "Run the command in dry-run mode, without making any changes. In bulk mode, a report of the resources to promote is printed.")
+	flags.BoolVar(&o.All, "all", false, "Promote all the resources of the given types, or of all types if none is given, in bulk mode.")
+	flags.BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, "Promote the resources in all namespaces in bulk mode, except the system namespaces.")
+	flags.StringVarP(&o.LabelSelector, "selector", "l", "", "Selector (label query) to filter the resources to promote in bulk mode, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 }
 
 // Complete ensures that options are valid and marshals them if necessary
 func (o *CommandPromoteOption) Complete(f util.Factory, args []string) error {
 	var err error
 
-	if len(args) != 2 {
-		return errors.New("incorrect command format, please use correct command format")
+	if err = o.parseArgs(args); err != nil {
+		return err
 	}
 
-	o.name = args[1]
-
 	if o.OutputFormat == "yaml" || o.OutputFormat == "json" {
 		o.Printer = func(_ *meta.RESTMapping, _ *bool, _ bool, _ bool) (printers.ResourcePrinterFunc, error) {
 			printer, err := o.JSONYamlPrintFlags.ToPrinter(o.OutputFormat)
@@ -244,6 +272,27 @@ func (o *CommandPromoteOption) Complete(f util.Factory, args []string) error {
 	return nil
 }
 
+// parseArgs parses the resource type and name from the args. Resources are promoted in bulk only if '--all' or
+// '--selector' is given, in which case the resource name must not be given.
+func (o *CommandPromoteOption) parseArgs(args []string) error {
+	if o.All || o.LabelSelector != "" {
+		if len(args) > 1 {
+			return errors.New("a resource name cannot be given with --all or --selector")
+		}
+		o.bulk = true
+		if len(args) == 1 {
+			o.resourceTypes = args[0]
+		}
+		return nil
+	}
+
+	if len(args) != 2 {
+		return errors.New("incorrect command format, please use correct command format")
+	}
+	o.name = args[1]
+	return nil
+}
+
 // Validate checks to the PromoteOptions to see if there is sufficient information run the command
 func (o *CommandPromoteOption) Validate() error {
 	if o.Cluster == "" {
@@ -254,6 +303,10 @@ func (o *CommandPromoteOption) Validate() error {
 		return errors.New("invalid output format: supported formats are json and yaml")
 	}
 
+	if !o.bulk && o.AllNamespaces {
+		return errors.New("--all-namespaces can only be used when promoting resources in bulk with --all or --selector")
+	}
+
 	return nil
 }
 
@@ -275,6 +328,18 @@ func (o *CommandPromoteOption) Run(f util.Factory, args []string) error {
 		}
 	}
 
+	if o.bulk {
+		controlPlaneRestConfig, err := f.ToRESTConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get control plane rest config. err: %w", err)
+		}
+		mapper, err := restmapper.NewCachedRESTMapper(controlPlaneRestConfig, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create restmapper: %v", err)
+		}
+		return o.runBulk(memberClusterFactory, controlPlaneRestConfig, mapper, os.Stdout)
+	}
+
 	objInfo, err := o.getObjInfo(memberClusterFactory, o.Cluster, args)
 	if err != nil {
 		return fmt.Errorf("failed to get resource in cluster(%s). err: %w", o.Cluster, err)
@@ -754,23 +819,13 @@ func buildPropagationPolicy(resourceName, policyName, namespace, cluster string,
 			Name:      policyName,
 			Namespace: namespace,
 		},
-		Spec: policyv1alpha1.PropagationSpec{
-			PropagateDeps: deps,
-			ResourceSelectors: []policyv1alpha1.ResourceSelector{
-				{
-					APIVersion: gvr.GroupVersion().String(),
-					Kind:       gvk.Kind,
-					Name:       resourceName,
-				},
-			},
-			Placement: policyv1alpha1.Placement{
-				ClusterAffinity: &policyv1alpha1.ClusterAffinity{
-					ClusterNames: []string{cluster},
-				},
+		Spec: buildPropagationSpec([]policyv1alpha1.ResourceSelector{
+			{
+				APIVersion: gvr.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Name:       resourceName,
 			},
-			ConflictResolution:          policyv1alpha1.ConflictOverwrite,
-			PreserveResourcesOnDeletion: new(true),
-		},
+		}, cluster, deps),
 	}
 	return pp
 }
@@ -781,23 +836,28 @@ func buildClusterPropagationPolicy(resourceName, policyName, cluster string, gvr
 		ObjectMeta: metav1.ObjectMeta{
 			Name: policyName,
 		},
-		Spec: policyv1alpha1.PropagationSpec{
-			PropagateDeps: deps,
-			ResourceSelectors: []policyv1alpha1.ResourceSelector{
-				{
-					APIVersion: gvr.GroupVersion().String(),
-					Kind:       gvk.Kind,
-					Name:       resourceName,
-				},
+		Spec: buildPropagationSpec([]policyv1alpha1.ResourceSelector{
+			{
+				APIVersion: gvr.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Name:       resourceName,
 			},
-			Placement: policyv1alpha1.Placement{
-				ClusterAffinity: &policyv1alpha1.ClusterAffinity{
-					ClusterNames: []string{cluster},
-				},
+		}, cluster, deps),
+	}
+	return cpp
+}
+
+// buildPropagationSpec build the spec of policy which propagates the selected resources to the legacy cluster
+func buildPropagationSpec(selectors []policyv1alpha1.ResourceSelector, cluster string, deps bool) policyv1alpha1.PropagationSpec {
+	return policyv1alpha1.PropagationSpec{
+		PropagateDeps:     deps,
+		ResourceSelectors: selectors,
+		Placement: policyv1alpha1.Placement{
+			ClusterAffinity: &policyv1alpha1.ClusterAffinity{
+				ClusterNames: []string{cluster},
 			},
-			ConflictResolution:          policyv1alpha1.ConflictOverwrite,
-			PreserveResourcesOnDeletion: new(true),
 		},
+		ConflictResolution:          policyv1alpha1.ConflictOverwrite,
+		PreserveResourcesOnDeletion: new(true),
 	}
-	return cpp
 }
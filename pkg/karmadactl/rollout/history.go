@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+var (
+	historyLong = templates.LongDesc(`
+		View the revisions of a resource propagated by Karmada, including the template hash and the target clusters.`)
+
+	historyExample = templates.Examples(`
+		# View the rollout history of a deployment
+		%[1]s rollout history deployment/nginx
+
+		# View the details of revision 3, including the template and the applied overrides
+		%[1]s rollout history deployment/nginx --revision=3`)
+)
+
+// NewCmdRolloutHistory returns the `rollout history` command.
+func NewCmdRolloutHistory(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &HistoryOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "history (TYPE NAME | TYPE/NAME) [flags]",
+		Short:                 "View the rollout history of a resource",
+		Long:                  historyLong,
+		Example:               fmt.Sprintf(historyExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&o.Revision, "revision", 0, "See the details, including the template and the applied overrides of the revision specified.")
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// HistoryOptions holds the options of the `rollout history` command.
+type HistoryOptions struct {
+	// Namespace is the namespace of the resource.
+	Namespace string
+	// Revision is the revision to show the details of, all revisions are listed if it is 0.
+	Revision int64
+
+	args []string
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *HistoryOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("failed to get namespace from Factory. error: %w", err)
+	}
+	o.args = args
+	return nil
+}
+
+// Validate checks the options.
+func (o *HistoryOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	if o.Revision < 0 {
+		return fmt.Errorf("revision must be a positive integer: %v", o.Revision)
+	}
+	return nil
+}
+
+// Run prints the revisions of the resource.
+func (o *HistoryOptions) Run(f util.Factory) error {
+	info, err := getResourceTemplate(f, o.Namespace, o.args)
+	if err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := f.KubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	return o.printHistory(context.TODO(), karmadaClient, kubeClient, info.Object.(*unstructured.Unstructured))
+}
+
+func (o *HistoryOptions) printHistory(ctx context.Context, karmadaClient karmadaclientset.Interface, kubeClient kubeclientset.Interface,
+	template *unstructured.Unstructured) error {
+	_, revisions, err := getBindingRevisions(ctx, karmadaClient, kubeClient, template)
+	if err != nil {
+		return err
+	}
+
+	if o.Revision > 0 {
+		revision, err := findRevision(revisions, o.Revision)
+		if err != nil {
+			return err
+		}
+		return printRevisionDetail(o.Out, revision)
+	}
+
+	if len(revisions) == 0 {
+		fmt.Fprintf(o.Out, "No rollout history found for %s %s/%s.\n", template.GetKind(), template.GetNamespace(), template.GetName())
+		return nil
+	}
+
+	w := printers.GetNewTabWriter(o.Out)
+	defer w.Flush()
+	fmt.Fprintln(w, "REVISION\tTEMPLATE-HASH\tCLUSTERS")
+	for _, controllerRevision := range revisions {
+		revision, err := helper.ParseBindingRevision(controllerRevision)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", controllerRevision.Revision, revision.TemplateHash, formatClusters(revision.Clusters))
+	}
+	return nil
+}
+
+func printRevisionDetail(out io.Writer, controllerRevision *appsv1.ControllerRevision) error {
+	revision, err := helper.ParseBindingRevision(controllerRevision)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Revision:\t%d\n", controllerRevision.Revision)
+	fmt.Fprintf(out, "Template Hash:\t%s\n", revision.TemplateHash)
+	fmt.Fprintf(out, "Clusters:\t%s\n", formatClusters(revision.Clusters))
+
+	var overrides []helper.ClusterAppliedOverrides
+	if data := karmadautil.GetAnnotationValue(controllerRevision.Annotations, karmadautil.BindingRevisionAppliedOverridesAnnotation); data != "" {
+		if err = json.Unmarshal([]byte(data), &overrides); err != nil {
+			return fmt.Errorf("failed to parse the applied overrides of revision %d: %w", controllerRevision.Revision, err)
+		}
+	}
+	fmt.Fprintln(out, "Applied Overrides:")
+	if len(overrides) == 0 {
+		fmt.Fprintln(out, "  <none>")
+	}
+	for _, override := range overrides {
+		fmt.Fprintf(out, "  %s:\n", override.ClusterName)
+		if override.AppliedOverrides != "" {
+			fmt.Fprintf(out, "    OverridePolicies:\t%s\n", override.AppliedOverrides)
+		}
+		if override.AppliedClusterOverrides != "" {
+			fmt.Fprintf(out, "    ClusterOverridePolicies:\t%s\n", override.AppliedClusterOverrides)
+		}
+	}
+
+	template, err := yaml.Marshal(revision.Template.Object)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Template:\n%s", template)
+	return nil
+}
+
+func formatClusters(clusters []workv1alpha2.TargetCluster) string {
+	if len(clusters) == 0 {
+		return "<none>"
+	}
+	formatted := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		formatted = append(formatted, fmt.Sprintf("%s(%d)", cluster.Name, cluster.Replicas))
+	}
+	return strings.Join(formatted, ",")
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+var (
+	pauseLong = templates.LongDesc(`
+		Pause the rollout of a workload propagated by Karmada.
+
+		The dispatching of the ResourceBinding of the workload is suspended, so the changes made to the
+		resource template are not synced to the target clusters until the rollout is resumed. The pause
+		takes precedence over the suspension declared by the propagation policy.`)
+
+	pauseExample = templates.Examples(`
+		# Pause the rollout of a deployment, then update its image without syncing to the member clusters
+		%[1]s rollout pause deployment/nginx
+		kubectl --kubeconfig=<karmada-kubeconfig> set image deployment/nginx nginx=nginx:1.27`)
+)
+
+// NewCmdRolloutPause returns the `rollout pause` command.
+func NewCmdRolloutPause(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &PauseOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "pause (TYPE NAME | TYPE/NAME) [flags]",
+		Short:                 "Pause the rollout of a workload",
+		Long:                  pauseLong,
+		Example:               fmt.Sprintf(pauseExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// PauseOptions holds the options of the `rollout pause` command.
+type PauseOptions struct {
+	// Namespace is the namespace of the resource.
+	Namespace string
+
+	args []string
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *PauseOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("failed to get namespace from Factory. error: %w", err)
+	}
+	o.args = args
+	return nil
+}
+
+// Validate checks the options.
+func (o *PauseOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	return nil
+}
+
+// Run pauses the rollout of the workload.
+func (o *PauseOptions) Run(f util.Factory) error {
+	info, err := getResourceTemplate(f, o.Namespace, o.args)
+	if err != nil {
+		return err
+	}
+	if err = checkWorkload(info); err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	return o.pause(context.TODO(), karmadaClient, info.Mapping.Resource, info.Object.(*unstructured.Unstructured))
+}
+
+func (o *PauseOptions) pause(ctx context.Context, karmadaClient karmadaclientset.Interface, gvr schema.GroupVersionResource,
+	template *unstructured.Unstructured) error {
+	resourceName := fmt.Sprintf("%s/%s", gvr.GroupResource().String(), template.GetName())
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		binding, err := getBinding(ctx, karmadaClient, template)
+		if err != nil {
+			return err
+		}
+		if binding.Annotations[workv1alpha2.RolloutPausedAnnotation] == "true" {
+			return fmt.Errorf("%s is already paused", resourceName)
+		}
+
+		metav1.SetMetaDataAnnotation(&binding.ObjectMeta, workv1alpha2.RolloutPausedAnnotation, "true")
+		binding.Spec.Suspension = karmadautil.RetainRolloutPause(binding.Annotations, binding.Spec.Suspension)
+		_, err = karmadaClient.WorkV1alpha2().ResourceBindings(binding.Namespace).Update(ctx, binding, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "%s paused\n", resourceName)
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+// restartedAtAnnotation is the annotation added to the pod template to trigger a restart,
+// the same as the one used by 'kubectl rollout restart'.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+var (
+	restartLong = templates.LongDesc(`
+		Restart a workload propagated by Karmada in all its target clusters.
+
+		The pod template of the resource template is annotated with the restart time, the annotation is
+		propagated to the target clusters and triggers a rolling restart there.`)
+
+	restartExample = templates.Examples(`
+		# Restart a deployment
+		%[1]s rollout restart deployment/nginx
+
+		# Restart a daemonset in the namespace foo
+		%[1]s rollout restart daemonset/fluentd -n foo`)
+)
+
+// NewCmdRolloutRestart returns the `rollout restart` command.
+func NewCmdRolloutRestart(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &RestartOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "restart (TYPE NAME | TYPE/NAME) [flags]",
+		Short:                 "Restart a workload in all target clusters",
+		Long:                  restartLong,
+		Example:               fmt.Sprintf(restartExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// RestartOptions holds the options of the `rollout restart` command.
+type RestartOptions struct {
+	// Namespace is the namespace of the resource.
+	Namespace string
+
+	args []string
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *RestartOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("failed to get namespace from Factory. error: %w", err)
+	}
+	o.args = args
+	return nil
+}
+
+// Validate checks the options.
+func (o *RestartOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	return nil
+}
+
+// Run restarts the workload.
+func (o *RestartOptions) Run(f util.Factory) error {
+	info, err := getResourceTemplate(f, o.Namespace, o.args)
+	if err != nil {
+		return err
+	}
+	if err = checkWorkload(info); err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := f.DynamicClient()
+	if err != nil {
+		return err
+	}
+	return o.restart(context.TODO(), karmadaClient, dynamicClient, info.Mapping.Resource, info.Object.(*unstructured.Unstructured), time.Now())
+}
+
+func (o *RestartOptions) restart(ctx context.Context, karmadaClient karmadaclientset.Interface, dynamicClient dynamic.Interface,
+	gvr schema.GroupVersionResource, template *unstructured.Unstructured, now time.Time) error {
+	resourceName := fmt.Sprintf("%s/%s", gvr.GroupResource().String(), template.GetName())
+
+	// The restart would be held until the rollout is resumed, which is unlikely what the user expects.
+	binding, err := getBinding(ctx, karmadaClient, template)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && binding.Annotations[workv1alpha2.RolloutPausedAnnotation] == "true" {
+		return fmt.Errorf("can't restart paused %s (run rollout resume first)", resourceName)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{restartedAtAnnotation: now.Format(time.RFC3339)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = dynamicClient.Resource(gvr).Namespace(template.GetNamespace()).Patch(ctx, template.GetName(), types.MergePatchType,
+		patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to restart %s: %w", resourceName, err)
+	}
+
+	fmt.Fprintf(o.Out, "%s restarted\n", resourceName)
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+)
+
+var (
+	resumeLong = templates.LongDesc(`
+		Resume the rollout of a workload paused by 'rollout pause'.
+
+		The suspension of the ResourceBinding of the workload is restored to the one declared by the
+		propagation policy, and the pending changes of the resource template are synced to the target clusters.`)
+
+	resumeExample = templates.Examples(`
+		# Resume the rollout of a deployment
+		%[1]s rollout resume deployment/nginx`)
+)
+
+// NewCmdRolloutResume returns the `rollout resume` command.
+func NewCmdRolloutResume(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &ResumeOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "resume (TYPE NAME | TYPE/NAME) [flags]",
+		Short:                 "Resume the rollout of a paused workload",
+		Long:                  resumeLong,
+		Example:               fmt.Sprintf(resumeExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// ResumeOptions holds the options of the `rollout resume` command.
+type ResumeOptions struct {
+	// Namespace is the namespace of the resource.
+	Namespace string
+
+	args []string
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *ResumeOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("failed to get namespace from Factory. error: %w", err)
+	}
+	o.args = args
+	return nil
+}
+
+// Validate checks the options.
+func (o *ResumeOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	return nil
+}
+
+// Run resumes the rollout of the workload.
+func (o *ResumeOptions) Run(f util.Factory) error {
+	info, err := getResourceTemplate(f, o.Namespace, o.args)
+	if err != nil {
+		return err
+	}
+	if err = checkWorkload(info); err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	return o.resume(context.TODO(), karmadaClient, info.Mapping.Resource, info.Object.(*unstructured.Unstructured))
+}
+
+func (o *ResumeOptions) resume(ctx context.Context, karmadaClient karmadaclientset.Interface, gvr schema.GroupVersionResource,
+	template *unstructured.Unstructured) error {
+	resourceName := fmt.Sprintf("%s/%s", gvr.GroupResource().String(), template.GetName())
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		binding, err := getBinding(ctx, karmadaClient, template)
+		if err != nil {
+			return err
+		}
+		if binding.Annotations[workv1alpha2.RolloutPausedAnnotation] != "true" {
+			return fmt.Errorf("%s is not paused", resourceName)
+		}
+		policySuspension, err := getPolicySuspension(ctx, karmadaClient, binding)
+		if err != nil {
+			return err
+		}
+
+		delete(binding.Annotations, workv1alpha2.RolloutPausedAnnotation)
+		binding.Spec.Suspension = karmadautil.MergePolicySuspension(binding.Spec.Suspension, policySuspension)
+		_, err = karmadaClient.WorkV1alpha2().ResourceBindings(binding.Namespace).Update(ctx, binding, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "%s resumed\n", resourceName)
+	return nil
+}
+
+// getPolicySuspension gets the suspension declared by the policy claiming the binding, nil is returned
+// if the policy no longer exists.
+func getPolicySuspension(ctx context.Context, karmadaClient karmadaclientset.Interface, binding *workv1alpha2.ResourceBinding) (*policyv1alpha1.Suspension, error) {
+	var spec *policyv1alpha1.PropagationSpec
+	if name := binding.Annotations[policyv1alpha1.PropagationPolicyNameAnnotation]; name != "" {
+		namespace := binding.Annotations[policyv1alpha1.PropagationPolicyNamespaceAnnotation]
+		policy, err := karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to get PropagationPolicy(%s/%s): %w", namespace, name, err)
+		}
+		spec = &policy.Spec
+	} else if name := binding.Annotations[policyv1alpha1.ClusterPropagationPolicyAnnotation]; name != "" {
+		policy, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to get ClusterPropagationPolicy(%s): %w", name, err)
+		}
+		spec = &policy.Spec
+	}
+	if spec == nil {
+		return nil, nil
+	}
+	return spec.Suspension, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	karmadautil "github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var (
+	rolloutLong = templates.LongDesc(`
+		Manage the rollout of resources propagated by Karmada.
+
+		The revisions of a resource are recorded by the binding-history-controller of karmada-controller-manager,
+		each revision holds the resource template, the overrides applied to it and the clusters it is scheduled to.
+		The revisions of Secrets are not recorded.`)
+
+	rolloutExample = templates.Examples(`
+		# View the rollout history of a deployment
+		%[1]s rollout history deployment/nginx
+
+		# Roll back to the previous revision of a deployment, including both the template and the placement
+		%[1]s rollout undo deployment/nginx
+
+		# Watch the rollout status of a deployment until it finishes in all target clusters
+		%[1]s rollout status deployment/nginx
+
+		# Restart a deployment in all target clusters
+		%[1]s rollout restart deployment/nginx
+
+		# Pause the propagation of a deployment, and resume it after the template is updated
+		%[1]s rollout pause deployment/nginx
+		%[1]s rollout resume deployment/nginx`)
+)
+
+// NewCmdRollout returns the `rollout` command with its sub-commands.
+func NewCmdRollout(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "rollout SUBCOMMAND",
+		Short:                 "Manage the rollout of resources propagated by Karmada",
+		Long:                  rolloutLong,
+		Example:               fmt.Sprintf(rolloutExample, parentCommand),
+		DisableFlagsInUseLine: true,
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupAdvancedCommands,
+		},
+	}
+
+	cmd.AddCommand(NewCmdRolloutHistory(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdRolloutUndo(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdRolloutStatus(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdRolloutRestart(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdRolloutPause(f, parentCommand, streams))
+	cmd.AddCommand(NewCmdRolloutResume(f, parentCommand, streams))
+	return cmd
+}
+
+// getResourceTemplate gets the single resource template specified by the arguments from the Karmada control plane.
+func getResourceTemplate(f util.Factory, namespace string, args []string) (*resource.Info, error) {
+	infos, err := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(true, args...).
+		SingleResourceType().
+		Latest().
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) != 1 {
+		return nil, fmt.Errorf("expected exactly one resource, but got %d", len(infos))
+	}
+	return infos[0], nil
+}
+
+// getBinding gets the ResourceBinding of the resource template.
+func getBinding(ctx context.Context, karmadaClient karmadaclientset.Interface, template *unstructured.Unstructured) (*workv1alpha2.ResourceBinding, error) {
+	bindingName := names.GenerateBindingName(template.GetKind(), template.GetName())
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(template.GetNamespace()).Get(ctx, bindingName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ResourceBinding(%s/%s): %w", template.GetNamespace(), bindingName, err)
+	}
+	return binding, nil
+}
+
+// bindingObject is the ResourceBinding or ClusterResourceBinding of a resource template.
+type bindingObject struct {
+	metav1.Object
+	spec *workv1alpha2.ResourceBindingSpec
+}
+
+// getTemplateBinding gets the ResourceBinding of the namespace-scoped resource template, or the ClusterResourceBinding
+// of the cluster-scoped one.
+func getTemplateBinding(ctx context.Context, karmadaClient karmadaclientset.Interface, template *unstructured.Unstructured) (*bindingObject, error) {
+	if template.GetNamespace() != "" {
+		binding, err := getBinding(ctx, karmadaClient, template)
+		if err != nil {
+			return nil, err
+		}
+		return &bindingObject{Object: binding, spec: &binding.Spec}, nil
+	}
+
+	bindingName := names.GenerateBindingName(template.GetKind(), template.GetName())
+	binding, err := karmadaClient.WorkV1alpha2().ClusterResourceBindings().Get(ctx, bindingName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ClusterResourceBinding(%s): %w", bindingName, err)
+	}
+	return &bindingObject{Object: binding, spec: &binding.Spec}, nil
+}
+
+// getBindingRevisions gets the ResourceBinding or ClusterResourceBinding of the resource template and its revisions
+// in ascending order of revision number.
+func getBindingRevisions(ctx context.Context, karmadaClient karmadaclientset.Interface, kubeClient kubeclientset.Interface,
+	template *unstructured.Unstructured) (*bindingObject, []*appsv1.ControllerRevision, error) {
+	binding, err := getTemplateBinding(ctx, karmadaClient, template)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !helper.IsBindingRevisionRecorded(binding.spec.Resource) {
+		return nil, nil, fmt.Errorf("the revisions of %s are not recorded", template.GetKind())
+	}
+
+	selector := helper.GetBindingRevisionSelector(binding)
+	for _, permanentID := range selector {
+		if permanentID == "" {
+			return binding, nil, nil
+		}
+	}
+	revisionList, err := kubeClient.AppsV1().ControllerRevisions(helper.GetBindingRevisionNamespace(binding.GetNamespace())).List(ctx,
+		metav1.ListOptions{LabelSelector: labels.SelectorFromSet(selector).String()})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list revisions of binding %s: %w", binding.GetName(), err)
+	}
+
+	revisions := make([]*appsv1.ControllerRevision, 0, len(revisionList.Items))
+	for i := range revisionList.Items {
+		revisions = append(revisions, &revisionList.Items[i])
+	}
+	helper.SortBindingControllerRevisions(revisions)
+	return binding, revisions, nil
+}
+
+// findRevision finds the revision with the given number, a non-positive number means the previous revision.
+func findRevision(revisions []*appsv1.ControllerRevision, revision int64) (*appsv1.ControllerRevision, error) {
+	if revision <= 0 {
+		if len(revisions) < 2 {
+			return nil, fmt.Errorf("no previous revision found")
+		}
+		return revisions[len(revisions)-2], nil
+	}
+	for _, r := range revisions {
+		if r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("revision %d not found", revision)
+}
+
+// checkWorkload checks if the resource template is a workload supported by the status, restart, pause and resume commands.
+func checkWorkload(info *resource.Info) error {
+	gvk := info.Object.GetObjectKind().GroupVersionKind()
+	if gvk.Group == appsv1.GroupName {
+		switch gvk.Kind {
+		case karmadautil.DeploymentKind, karmadautil.StatefulSetKind, karmadautil.DaemonSetKind:
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not supported, only Deployments, StatefulSets and DaemonSets are supported", info.ObjectName())
+}
@@ -0,0 +1,436 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+var deploymentGVR = appsv1.SchemeGroupVersion.WithResource("deployments")
+
+func newTemplate(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "nginx"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "nginx", "image": image}},
+				},
+			},
+		},
+	}}
+}
+
+func newBinding(clusters ...workv1alpha2.TargetCluster) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      names.GenerateBindingName("Deployment", "nginx"),
+			Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "permanent-id"},
+		},
+		Spec: workv1alpha2.ResourceBindingSpec{Clusters: clusters},
+	}
+}
+
+func newControllerRevision(t *testing.T, binding metav1.Object, template *unstructured.Unstructured,
+	number int64, clusters ...workv1alpha2.TargetCluster) *appsv1.ControllerRevision {
+	revision, err := helper.NewBindingControllerRevision(binding, helper.NewBindingRevision(template, clusters), number)
+	require.NoError(t, err)
+	return revision
+}
+
+func TestFindRevision(t *testing.T) {
+	revisions := []*appsv1.ControllerRevision{{Revision: 1}, {Revision: 3}, {Revision: 4}}
+
+	revision, err := findRevision(revisions, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), revision.Revision)
+
+	revision, err = findRevision(revisions, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), revision.Revision)
+
+	_, err = findRevision(revisions, 2)
+	assert.EqualError(t, err, "revision 2 not found")
+
+	_, err = findRevision(revisions[:1], 0)
+	assert.EqualError(t, err, "no previous revision found")
+}
+
+func TestPrintHistory(t *testing.T) {
+	binding := newBinding(workv1alpha2.TargetCluster{Name: "member2", Replicas: 2})
+	first := newControllerRevision(t, binding, newTemplate(2, "nginx:1.0"), 1, workv1alpha2.TargetCluster{Name: "member1", Replicas: 2})
+	first.Annotations = map[string]string{util.BindingRevisionAppliedOverridesAnnotation: `[{"clusterName":"member1","appliedOverrides":"op"}]`}
+	second := newControllerRevision(t, binding, newTemplate(2, "nginx:2.0"), 2, workv1alpha2.TargetCluster{Name: "member2", Replicas: 2})
+	karmadaClient := karmadafake.NewSimpleClientset(binding)
+	kubeClient := kubefake.NewSimpleClientset(second, first)
+
+	out := &bytes.Buffer{}
+	o := &HistoryOptions{IOStreams: genericiooptions.IOStreams{Out: out}}
+	require.NoError(t, o.printHistory(context.TODO(), karmadaClient, kubeClient, newTemplate(2, "nginx:2.0")))
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	assert.Regexp(t, `^REVISION\s+TEMPLATE-HASH\s+CLUSTERS$`, string(lines[0]))
+	assert.Regexp(t, `^1\s+\S+\s+member1\(2\)$`, string(lines[1]))
+	assert.Regexp(t, `^2\s+\S+\s+member2\(2\)$`, string(lines[2]))
+
+	out.Reset()
+	o.Revision = 1
+	require.NoError(t, o.printHistory(context.TODO(), karmadaClient, kubeClient, newTemplate(2, "nginx:2.0")))
+	assert.Contains(t, out.String(), "Clusters:\tmember1(2)\n")
+	assert.Contains(t, out.String(), "  member1:\n    OverridePolicies:\top\n")
+	assert.Contains(t, out.String(), "image: nginx:1.0")
+
+	o.Revision = 5
+	assert.EqualError(t, o.printHistory(context.TODO(), karmadaClient, kubeClient, newTemplate(2, "nginx:2.0")), "revision 5 not found")
+}
+
+func TestPrintHistoryOfClusterScopedResource(t *testing.T) {
+	template := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "rbac.authorization.k8s.io/v1",
+		"kind":       "ClusterRole",
+		"metadata":   map[string]interface{}{"name": "reader"},
+	}}
+	binding := &workv1alpha2.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   names.GenerateBindingName("ClusterRole", "reader"),
+			Labels: map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "permanent-id"},
+		},
+	}
+	karmadaClient := karmadafake.NewSimpleClientset(binding)
+	kubeClient := kubefake.NewSimpleClientset(newControllerRevision(t, binding, template, 1, workv1alpha2.TargetCluster{Name: "member1"}))
+
+	out := &bytes.Buffer{}
+	o := &HistoryOptions{IOStreams: genericiooptions.IOStreams{Out: out}}
+	require.NoError(t, o.printHistory(context.TODO(), karmadaClient, kubeClient, template))
+	assert.Regexp(t, `\n1\s+\S+\s+member1\(0\)\n$`, out.String())
+}
+
+func TestPrintHistoryOfSecret(t *testing.T) {
+	template := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "token", "namespace": "default"},
+	}}
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: names.GenerateBindingName("Secret", "token")},
+		Spec:       workv1alpha2.ResourceBindingSpec{Resource: workv1alpha2.ObjectReference{APIVersion: "v1", Kind: "Secret"}},
+	}
+
+	o := &HistoryOptions{IOStreams: genericiooptions.IOStreams{Out: &bytes.Buffer{}}}
+	err := o.printHistory(context.TODO(), karmadafake.NewSimpleClientset(binding), kubefake.NewSimpleClientset(), template)
+	assert.EqualError(t, err, "the revisions of Secret are not recorded")
+}
+
+func TestUndo(t *testing.T) {
+	tests := []struct {
+		name              string
+		toRevision        int64
+		dryRun            bool
+		expectedOutput    string
+		expectedImage     string
+		expectedClusters  []string
+		expectedErrSubstr string
+	}{
+		{
+			name:             "roll back to the previous revision",
+			expectedOutput:   "deployments.apps/nginx rolled back to revision 2\n",
+			expectedImage:    "nginx:2.0",
+			expectedClusters: []string{"member2"},
+		},
+		{
+			name:             "roll back to the specified revision",
+			toRevision:       1,
+			expectedOutput:   "deployments.apps/nginx rolled back to revision 1\n",
+			expectedImage:    "nginx:1.0",
+			expectedClusters: []string{"member1", "member2"},
+		},
+		{
+			name:           "skip rolling back to the current revision",
+			toRevision:     3,
+			expectedOutput: "deployments.apps/nginx skipped rollback (current template and placement already match revision 3)\n",
+			expectedImage:  "nginx:3.0",
+		},
+		{
+			name:           "dry run",
+			dryRun:         true,
+			expectedOutput: "deployments.apps/nginx rolled back to revision 2 (dry run)\n",
+			expectedImage:  "nginx:3.0",
+		},
+		{
+			name:              "revision not found",
+			toRevision:        4,
+			expectedImage:     "nginx:3.0",
+			expectedErrSubstr: "revision 4 not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := newTemplate(2, "nginx:3.0")
+			current.SetResourceVersion("3")
+			current.Object["status"] = map[string]interface{}{"replicas": int64(2)}
+			binding := newBinding(workv1alpha2.TargetCluster{Name: "member3", Replicas: 2})
+			binding.Spec.Placement = &policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{LabelSelector: &metav1.LabelSelector{}},
+				ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+					ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+					ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceAggregated,
+				},
+			}
+
+			karmadaClient := karmadafake.NewSimpleClientset(binding)
+			kubeClient := kubefake.NewSimpleClientset(
+				newControllerRevision(t, binding, newTemplate(2, "nginx:1.0"), 1,
+					workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}, workv1alpha2.TargetCluster{Name: "member2", Replicas: 1}),
+				newControllerRevision(t, binding, newTemplate(2, "nginx:2.0"), 2, workv1alpha2.TargetCluster{Name: "member2", Replicas: 2}),
+				newControllerRevision(t, binding, newTemplate(2, "nginx:3.0"), 3, workv1alpha2.TargetCluster{Name: "member3", Replicas: 2}),
+			)
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), current.DeepCopy())
+
+			out := &bytes.Buffer{}
+			o := &UndoOptions{ToRevision: tt.toRevision, DryRun: tt.dryRun, IOStreams: genericiooptions.IOStreams{Out: out}}
+			err := o.undo(context.TODO(), karmadaClient, kubeClient, dynamicClient, deploymentGVR, current)
+			if tt.expectedErrSubstr != "" {
+				assert.ErrorContains(t, err, tt.expectedErrSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, out.String())
+
+			template, err := dynamicClient.Resource(deploymentGVR).Namespace("default").Get(context.TODO(), "nginx", metav1.GetOptions{})
+			require.NoError(t, err)
+			containers, _, _ := unstructured.NestedSlice(template.Object, "spec", "template", "spec", "containers")
+			assert.Equal(t, tt.expectedImage, containers[0].(map[string]interface{})["image"])
+			assert.Equal(t, map[string]string{"app": "nginx"}, template.GetLabels())
+			assert.Equal(t, current.Object["status"], template.Object["status"])
+
+			gotBinding, err := karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), binding.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			if tt.expectedClusters == nil {
+				assert.Equal(t, binding.Spec.Placement, gotBinding.Spec.Placement)
+				assert.NotContains(t, gotBinding.Annotations, workv1alpha2.RollbackPlacementAnnotation)
+				return
+			}
+			assert.Equal(t, tt.expectedClusters, gotBinding.Spec.Placement.ClusterAffinity.ClusterNames)
+			assert.Equal(t, policyv1alpha1.ReplicaDivisionPreferenceWeighted, gotBinding.Spec.Placement.ReplicaScheduling.ReplicaDivisionPreference)
+			assert.Contains(t, gotBinding.Annotations, workv1alpha2.RollbackPlacementAnnotation)
+			// the scheduling result is left to the scheduler
+			assert.Equal(t, binding.Spec.Clusters, gotBinding.Spec.Clusters)
+		})
+	}
+}
+
+func newAggregatedStatusItem(cluster string, templateGeneration int64, state workv1alpha2.RolloutState, message string) workv1alpha2.AggregatedStatusItem {
+	return workv1alpha2.AggregatedStatusItem{
+		ClusterName:   cluster,
+		Applied:       true,
+		Status:        &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"resourceTemplateGeneration":%d}`, templateGeneration))},
+		RolloutStatus: &workv1alpha2.RolloutStatus{State: state, Message: message},
+	}
+}
+
+func TestRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		binding         *workv1alpha2.ResourceBinding
+		expectedMessage string
+		expectedDone    bool
+		expectedErr     string
+	}{
+		{
+			name:            "not scheduled",
+			binding:         newBinding(),
+			expectedMessage: "Waiting for deployment \"nginx\" to be scheduled...\n",
+		},
+		{
+			name: "done in all clusters",
+			binding: func() *workv1alpha2.ResourceBinding {
+				binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}, workv1alpha2.TargetCluster{Name: "member2", Replicas: 1})
+				binding.Status.AggregatedStatus = []workv1alpha2.AggregatedStatusItem{
+					newAggregatedStatusItem("member1", 2, workv1alpha2.RolloutDone, ""),
+					newAggregatedStatusItem("member2", 3, workv1alpha2.RolloutDone, ""),
+				}
+				return binding
+			}(),
+			expectedMessage: "deployment \"nginx\" successfully rolled out in 2 cluster(s)\n",
+			expectedDone:    true,
+		},
+		{
+			name: "progressing in some clusters",
+			binding: func() *workv1alpha2.ResourceBinding {
+				binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 1}, workv1alpha2.TargetCluster{Name: "member2", Replicas: 1},
+					workv1alpha2.TargetCluster{Name: "member3", Replicas: 1}, workv1alpha2.TargetCluster{Name: "member4", Replicas: 1})
+				binding.Status.AggregatedStatus = []workv1alpha2.AggregatedStatusItem{
+					newAggregatedStatusItem("member1", 2, workv1alpha2.RolloutDone, ""),
+					newAggregatedStatusItem("member2", 1, workv1alpha2.RolloutDone, ""),
+					newAggregatedStatusItem("member3", 2, workv1alpha2.RolloutProgressing, "0 of 1 updated replicas are available"),
+				}
+				return binding
+			}(),
+			expectedMessage: "Waiting for the rollout of deployment \"nginx\" to finish in 3 of 4 cluster(s)...\n" +
+				"  member2: waiting for the latest resource template to be synced\n" +
+				"  member3: 0 of 1 updated replicas are available\n" +
+				"  member4: waiting for the resource to be applied\n",
+		},
+		{
+			name: "failed in a cluster",
+			binding: func() *workv1alpha2.ResourceBinding {
+				binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 1})
+				binding.Status.AggregatedStatus = []workv1alpha2.AggregatedStatusItem{
+					newAggregatedStatusItem("member1", 2, workv1alpha2.RolloutFailed, "deployment \"nginx\" exceeded its progress deadline"),
+				}
+				return binding
+			}(),
+			expectedErr: "rollout of deployment \"nginx\" failed in cluster(member1): deployment \"nginx\" exceeded its progress deadline",
+		},
+		{
+			name: "paused",
+			binding: func() *workv1alpha2.ResourceBinding {
+				binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 1})
+				binding.Spec.Suspension = &workv1alpha2.Suspension{Suspension: policyv1alpha1.Suspension{Dispatching: new(true)}}
+				return binding
+			}(),
+			expectedErr: "the rollout of deployment \"nginx\" is paused, run 'rollout resume' to continue",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, done, err := rolloutStatus(tt.binding, 2, `deployment "nginx"`)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMessage, message)
+			assert.Equal(t, tt.expectedDone, done)
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	template := newTemplate(1, "nginx:1.0")
+	template.SetGeneration(2)
+	binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 1})
+	binding.Status.AggregatedStatus = []workv1alpha2.AggregatedStatusItem{
+		newAggregatedStatusItem("member1", 2, workv1alpha2.RolloutProgressing, "0 of 1 updated replicas are available"),
+	}
+	karmadaClient := karmadafake.NewSimpleClientset(binding)
+
+	out := &bytes.Buffer{}
+	o := &StatusOptions{Watch: false, pollInterval: 10 * time.Millisecond, IOStreams: genericiooptions.IOStreams{Out: out}}
+	require.NoError(t, o.status(context.TODO(), karmadaClient, template))
+	assert.Contains(t, out.String(), "member1: 0 of 1 updated replicas are available\n")
+
+	out.Reset()
+	o.Watch = true
+	o.Timeout = 50 * time.Millisecond
+	assert.EqualError(t, o.status(context.TODO(), karmadaClient, template), `timed out waiting for the rollout of deployment "nginx" to finish`)
+	// the unchanged status is printed only once
+	assert.Equal(t, 1, strings.Count(out.String(), "Waiting for the rollout"))
+}
+
+func TestRestart(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	current := newTemplate(2, "nginx:1.0")
+	binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 2})
+	karmadaClient := karmadafake.NewSimpleClientset(binding)
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), current.DeepCopy())
+
+	out := &bytes.Buffer{}
+	o := &RestartOptions{IOStreams: genericiooptions.IOStreams{Out: out}}
+	require.NoError(t, o.restart(context.TODO(), karmadaClient, dynamicClient, deploymentGVR, current, now))
+	assert.Equal(t, "deployments.apps/nginx restarted\n", out.String())
+
+	template, err := dynamicClient.Resource(deploymentGVR).Namespace("default").Get(context.TODO(), "nginx", metav1.GetOptions{})
+	require.NoError(t, err)
+	restartedAt, _, _ := unstructured.NestedString(template.Object, "spec", "template", "metadata", "annotations", restartedAtAnnotation)
+	assert.Equal(t, "2026-01-02T03:04:05Z", restartedAt)
+
+	binding.Annotations = map[string]string{workv1alpha2.RolloutPausedAnnotation: "true"}
+	karmadaClient = karmadafake.NewSimpleClientset(binding)
+	assert.EqualError(t, o.restart(context.TODO(), karmadaClient, dynamicClient, deploymentGVR, current, now),
+		"can't restart paused deployments.apps/nginx (run rollout resume first)")
+}
+
+func TestPauseAndResume(t *testing.T) {
+	binding := newBinding(workv1alpha2.TargetCluster{Name: "member1", Replicas: 2})
+	binding.Annotations = map[string]string{
+		policyv1alpha1.PropagationPolicyNamespaceAnnotation: "default",
+		policyv1alpha1.PropagationPolicyNameAnnotation:      "nginx",
+	}
+	binding.Spec.Suspension = &workv1alpha2.Suspension{
+		Suspension: policyv1alpha1.Suspension{DispatchingOnClusters: &policyv1alpha1.SuspendClusters{ClusterNames: []string{"member2"}}},
+	}
+	policy := &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Spec: policyv1alpha1.PropagationSpec{
+			Suspension: &policyv1alpha1.Suspension{DispatchingOnClusters: &policyv1alpha1.SuspendClusters{ClusterNames: []string{"member3"}}},
+		},
+	}
+	karmadaClient := karmadafake.NewSimpleClientset(binding, policy)
+	template := newTemplate(2, "nginx:1.0")
+
+	out := &bytes.Buffer{}
+	pause := &PauseOptions{IOStreams: genericiooptions.IOStreams{Out: out}}
+	require.NoError(t, pause.pause(context.TODO(), karmadaClient, deploymentGVR, template))
+	assert.Equal(t, "deployments.apps/nginx paused\n", out.String())
+	got, err := karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), binding.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "true", got.Annotations[workv1alpha2.RolloutPausedAnnotation])
+	assert.Equal(t, &workv1alpha2.Suspension{Suspension: policyv1alpha1.Suspension{Dispatching: new(true)}}, got.Spec.Suspension)
+	assert.EqualError(t, pause.pause(context.TODO(), karmadaClient, deploymentGVR, template), "deployments.apps/nginx is already paused")
+
+	out.Reset()
+	resume := &ResumeOptions{IOStreams: genericiooptions.IOStreams{Out: out}}
+	require.NoError(t, resume.resume(context.TODO(), karmadaClient, deploymentGVR, template))
+	assert.Equal(t, "deployments.apps/nginx resumed\n", out.String())
+	got, err = karmadaClient.WorkV1alpha2().ResourceBindings("default").Get(context.TODO(), binding.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, got.Annotations, workv1alpha2.RolloutPausedAnnotation)
+	// the suspension declared by the policy is restored
+	assert.Equal(t, &workv1alpha2.Suspension{Suspension: *policy.Spec.Suspension}, got.Spec.Suspension)
+	assert.EqualError(t, resume.resume(context.TODO(), karmadaClient, deploymentGVR, template), "deployments.apps/nginx is not paused")
+}
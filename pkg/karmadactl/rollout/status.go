@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/ptr"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+// statusPollInterval is the interval of checking the rollout status of the ResourceBinding.
+const statusPollInterval = 2 * time.Second
+
+var (
+	statusLong = templates.LongDesc(`
+		Show the status of the rollout of a workload propagated by Karmada.
+
+		The rollout progress reported by every target cluster is aggregated, and by default the command
+		watches the status until the latest resource template has been rolled out in all target clusters.
+		Use --watch=false to print the current status and exit.`)
+
+	statusExample = templates.Examples(`
+		# Watch the rollout status of a deployment
+		%[1]s rollout status deployment/nginx
+
+		# Print the current rollout status of a statefulset without watching
+		%[1]s rollout status statefulset/web --watch=false
+
+		# Wait at most 5 minutes for the rollout of a daemonset to finish
+		%[1]s rollout status daemonset/fluentd --timeout=5m`)
+)
+
+// NewCmdRolloutStatus returns the `rollout status` command.
+func NewCmdRolloutStatus(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &StatusOptions{IOStreams: streams, pollInterval: statusPollInterval}
+
+	cmd := &cobra.Command{
+		Use:                   "status (TYPE NAME | TYPE/NAME) [flags]",
+		Short:                 "Show the rollout status of a workload across target clusters",
+		Long:                  statusLong,
+		Example:               fmt.Sprintf(statusExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&o.Watch, "watch", "w", true, "Watch the status of the rollout until it's done.")
+	flags.DurationVar(&o.Timeout, "timeout", 0, "The length of time to wait before ending watch, zero means never. Any other values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// StatusOptions holds the options of the `rollout status` command.
+type StatusOptions struct {
+	// Namespace is the namespace of the resource.
+	Namespace string
+	// Watch tells if watch the status until the rollout is done.
+	Watch bool
+	// Timeout is the length of time to wait before ending watch, zero means never.
+	Timeout time.Duration
+
+	pollInterval time.Duration
+	args         []string
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *StatusOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("failed to get namespace from Factory. error: %w", err)
+	}
+	o.args = args
+	return nil
+}
+
+// Validate checks the options.
+func (o *StatusOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative: %v", o.Timeout)
+	}
+	return nil
+}
+
+// Run shows the rollout status of the workload.
+func (o *StatusOptions) Run(f util.Factory) error {
+	info, err := getResourceTemplate(f, o.Namespace, o.args)
+	if err != nil {
+		return err
+	}
+	if err = checkWorkload(info); err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	return o.status(context.TODO(), karmadaClient, info.Object.(*unstructured.Unstructured))
+}
+
+func (o *StatusOptions) status(ctx context.Context, karmadaClient karmadaclientset.Interface, template *unstructured.Unstructured) error {
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	resourceName := fmt.Sprintf("%s %q", strings.ToLower(template.GetKind()), template.GetName())
+	lastMessage := ""
+	err := wait.PollUntilContextCancel(ctx, o.pollInterval, true, func(ctx context.Context) (bool, error) {
+		binding, err := getBinding(ctx, karmadaClient, template)
+		if err != nil {
+			return false, err
+		}
+		message, done, err := rolloutStatus(binding, template.GetGeneration(), resourceName)
+		if err != nil {
+			return false, err
+		}
+		// Only print the status when it changes, to avoid flooding the output while watching.
+		if message != lastMessage {
+			fmt.Fprint(o.Out, message)
+			lastMessage = message
+		}
+		return done || !o.Watch, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out waiting for the rollout of %s to finish", resourceName)
+	}
+	return err
+}
+
+// memberStatus holds the fields of the status collected from member clusters used by the rollout status.
+type memberStatus struct {
+	ResourceTemplateGeneration int64 `json:"resourceTemplateGeneration,omitempty"`
+}
+
+// rolloutStatus summarizes the rollout progress reported by the target clusters of the binding, and tells
+// whether the resource template of the given generation has been rolled out in all of them.
+func rolloutStatus(binding *workv1alpha2.ResourceBinding, generation int64, resourceName string) (string, bool, error) {
+	suspension := binding.Spec.Suspension
+	if suspension != nil && ptr.Deref(suspension.Dispatching, false) {
+		return "", false, fmt.Errorf("the rollout of %s is paused, run 'rollout resume' to continue", resourceName)
+	}
+	if len(binding.Spec.Clusters) == 0 {
+		return fmt.Sprintf("Waiting for %s to be scheduled...\n", resourceName), false, nil
+	}
+
+	items := make(map[string]workv1alpha2.AggregatedStatusItem, len(binding.Status.AggregatedStatus))
+	for _, item := range binding.Status.AggregatedStatus {
+		items[item.ClusterName] = item
+	}
+
+	var waiting []string
+	for _, target := range binding.Spec.Clusters {
+		if suspension != nil && suspension.DispatchingOnClusters != nil {
+			for _, cluster := range suspension.DispatchingOnClusters.ClusterNames {
+				if cluster == target.Name {
+					return "", false, fmt.Errorf("the dispatching of %s to cluster(%s) is suspended", resourceName, target.Name)
+				}
+			}
+		}
+
+		item, ok := items[target.Name]
+		switch {
+		case !ok || !item.Applied:
+			reason := "waiting for the resource to be applied"
+			if ok && item.AppliedMessage != "" {
+				reason = fmt.Sprintf("%s: %s", reason, item.AppliedMessage)
+			}
+			waiting = append(waiting, fmt.Sprintf("%s: %s", target.Name, reason))
+			continue
+		case !templateObserved(item, generation):
+			waiting = append(waiting, fmt.Sprintf("%s: waiting for the latest resource template to be synced", target.Name))
+			continue
+		case item.RolloutStatus == nil:
+			waiting = append(waiting, fmt.Sprintf("%s: waiting for the rollout status to be reported", target.Name))
+			continue
+		}
+
+		switch item.RolloutStatus.State {
+		case workv1alpha2.RolloutFailed:
+			return "", false, fmt.Errorf("rollout of %s failed in cluster(%s): %s", resourceName, target.Name, item.RolloutStatus.Message)
+		case workv1alpha2.RolloutDone:
+		default:
+			waiting = append(waiting, fmt.Sprintf("%s: %s", target.Name, item.RolloutStatus.Message))
+		}
+	}
+
+	if len(waiting) == 0 {
+		return fmt.Sprintf("%s successfully rolled out in %d cluster(s)\n", resourceName, len(binding.Spec.Clusters)), true, nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Waiting for the rollout of %s to finish in %d of %d cluster(s)...\n", resourceName, len(waiting), len(binding.Spec.Clusters))
+	for _, line := range waiting {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+	return b.String(), false, nil
+}
+
+// templateObserved tells whether the resource in the member cluster has been synced with the given generation
+// of the resource template. The generation is taken as observed if it's not reported.
+func templateObserved(item workv1alpha2.AggregatedStatusItem, generation int64) bool {
+	if item.Status == nil {
+		return true
+	}
+	status := &memberStatus{}
+	if err := json.Unmarshal(item.Status.Raw, status); err != nil || status.ResourceTemplateGeneration == 0 {
+		return true
+	}
+	return status.ResourceTemplateGeneration >= generation
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+var (
+	undoLong = templates.LongDesc(`
+		Roll back a resource propagated by Karmada to a previous revision.
+
+		The resource template recorded in the revision is restored, including its labels and annotations
+		other than the ones managed by Karmada. The rest of the metadata of the resource template is left
+		untouched.
+
+		The target clusters recorded in the revision are restored by pinning the placement of the binding
+		to them, the replicas are divided among them as recorded if the propagation policy divides replicas.
+		The placement stays pinned until the resource template is changed again, after which the resource
+		is scheduled according to the propagation policy.`)
+
+	undoExample = templates.Examples(`
+		# Roll back to the previous revision of a deployment
+		%[1]s rollout undo deployment/nginx
+
+		# Roll back to revision 3 of a deployment
+		%[1]s rollout undo deployment/nginx --to-revision=3
+
+		# Check the rollback without applying it
+		%[1]s rollout undo deployment/nginx --dry-run`)
+)
+
+// NewCmdRolloutUndo returns the `rollout undo` command.
+func NewCmdRolloutUndo(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &UndoOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "undo (TYPE NAME | TYPE/NAME) [flags]",
+		Short:                 "Roll back a resource to a previous revision",
+		Long:                  undoLong,
+		Example:               fmt.Sprintf(undoExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(f)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Int64Var(&o.ToRevision, "to-revision", 0, "The revision to roll back to. Default to 0 (previous revision).")
+	flags.BoolVar(&o.DryRun, "dry-run", false, "Run the command in dry-run mode, printing the revision to roll back to without changing anything on the server.")
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// UndoOptions holds the options of the `rollout undo` command.
+type UndoOptions struct {
+	// Namespace is the namespace of the resource.
+	Namespace string
+	// ToRevision is the revision to roll back to, the previous revision is used if it is 0.
+	ToRevision int64
+	// DryRun tells if run the command in dry-run mode, without changing anything on the server.
+	DryRun bool
+
+	args []string
+	genericiooptions.IOStreams
+}
+
+// Complete completes all the required options.
+func (o *UndoOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.Namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return fmt.Errorf("failed to get namespace from Factory. error: %w", err)
+	}
+	o.args = args
+	return nil
+}
+
+// Validate checks the options.
+func (o *UndoOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	if o.ToRevision < 0 {
+		return fmt.Errorf("revision must be a positive integer: %v", o.ToRevision)
+	}
+	return nil
+}
+
+// Run rolls back the resource.
+func (o *UndoOptions) Run(f util.Factory) error {
+	info, err := getResourceTemplate(f, o.Namespace, o.args)
+	if err != nil {
+		return err
+	}
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := f.KubernetesClientSet()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := f.DynamicClient()
+	if err != nil {
+		return err
+	}
+	return o.undo(context.TODO(), karmadaClient, kubeClient, dynamicClient, info.Mapping.Resource, info.Object.(*unstructured.Unstructured))
+}
+
+func (o *UndoOptions) undo(ctx context.Context, karmadaClient karmadaclientset.Interface, kubeClient kubeclientset.Interface,
+	dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, template *unstructured.Unstructured) error {
+	binding, revisions, err := getBindingRevisions(ctx, karmadaClient, kubeClient, template)
+	if err != nil {
+		return err
+	}
+	controllerRevision, err := findRevision(revisions, o.ToRevision)
+	if err != nil {
+		return err
+	}
+	revision, err := helper.ParseBindingRevision(controllerRevision)
+	if err != nil {
+		return err
+	}
+
+	resourceName := fmt.Sprintf("%s/%s", gvr.GroupResource().String(), template.GetName())
+	current := helper.NewBindingRevision(template, binding.spec.Clusters)
+	if current.Hash() == revision.Hash() {
+		fmt.Fprintf(o.Out, "%s skipped rollback (current template and placement already match revision %d)\n", resourceName, controllerRevision.Revision)
+		return nil
+	}
+	if o.DryRun {
+		fmt.Fprintf(o.Out, "%s rolled back to revision %d (dry run)\n", resourceName, controllerRevision.Revision)
+		return nil
+	}
+
+	if current.TemplateHash != revision.TemplateHash {
+		restored := revision.RestoreTemplate(template)
+		if _, err = dynamicClient.Resource(gvr).Namespace(template.GetNamespace()).Update(ctx, restored, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to restore the template of %s: %w", resourceName, err)
+		}
+	}
+
+	// The placement is pinned after the template is restored, since the pinning is released once the template
+	// differs from the restored one.
+	if err = pinPlacement(ctx, karmadaClient, template, &helper.RollbackPlacement{
+		TemplateHash: revision.TemplateHash,
+		Clusters:     revision.Clusters,
+	}); err != nil {
+		return fmt.Errorf("failed to restore the placement of %s: %w", resourceName, err)
+	}
+
+	fmt.Fprintf(o.Out, "%s rolled back to revision %d\n", resourceName, controllerRevision.Revision)
+	return nil
+}
+
+// pinPlacement pins the placement of the binding of the resource template to the target clusters of the rollback.
+func pinPlacement(ctx context.Context, karmadaClient karmadaclientset.Interface, template *unstructured.Unstructured,
+	rollback *helper.RollbackPlacement) error {
+	data, err := json.Marshal(rollback)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		binding, err := getTemplateBinding(ctx, karmadaClient, template)
+		if err != nil {
+			return err
+		}
+
+		annotations := binding.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[workv1alpha2.RollbackPlacementAnnotation] = string(data)
+		binding.SetAnnotations(annotations)
+		binding.spec.Placement = helper.PinPlacement(binding.spec.Placement, rollback.Clusters)
+		switch obj := binding.Object.(type) {
+		case *workv1alpha2.ClusterResourceBinding:
+			_, err = karmadaClient.WorkV1alpha2().ClusterResourceBindings().Update(ctx, obj, metav1.UpdateOptions{})
+		case *workv1alpha2.ResourceBinding:
+			_, err = karmadaClient.WorkV1alpha2().ResourceBindings(obj.Namespace).Update(ctx, obj, metav1.UpdateOptions{})
+		}
+		return err
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -44,6 +45,11 @@ var (
 	PodColumn = "POD"
 	// NodeColumns is the list of columns used in the top node command.
 	NodeColumns = []string{"NAME", "CLUSTER", "CPU(cores)", "CPU%", "MEMORY(bytes)", "MEMORY%"}
+	// ClusterColumns is the list of columns following the name column used in the top cluster command.
+	ClusterColumns = []string{"NODES(ready/total)", "CPU-ALLOCATABLE", "CPU-ALLOCATED", "CPU%", "CPU-ALLOCATING",
+		"MEMORY-ALLOCATABLE", "MEMORY-ALLOCATED", "MEMORY%", "MEMORY-ALLOCATING", "MODELINGS(grade:nodes)"}
+	// ClustersColumn is the column name for the number of clusters in a group.
+	ClustersColumn = "CLUSTERS"
 )
 
 // ResourceMetricsInfo contains the information of a resource metric.
@@ -142,6 +148,80 @@ func (printer *CmdPrinter) PrintNodeMetrics(metrics []metricsapi.NodeMetrics, av
 	return nil
 }
 
+// PrintClusterResources prints the given resource summaries of clusters to the given writer.
+func (printer *CmdPrinter) PrintClusterResources(infos []ClusterResourceInfo, nameColumn string, grouped, noHeaders bool, sortBy string) error {
+	if len(infos) == 0 {
+		return nil
+	}
+	w := printers.GetNewTabWriter(printer.out)
+	defer w.Flush()
+
+	sort.Sort(NewClusterResourceSorter(infos, sortBy))
+
+	if !noHeaders {
+		printValue(w, nameColumn)
+		if grouped {
+			printValue(w, ClustersColumn)
+		}
+		printColumnNames(w, ClusterColumns)
+	}
+	for i := range infos {
+		printClusterResourceLine(w, &infos[i], grouped)
+	}
+	return nil
+}
+
+func printClusterResourceLine(out io.Writer, info *ClusterResourceInfo, grouped bool) {
+	printValue(out, info.Name)
+	if grouped {
+		printValue(out, info.Clusters)
+	}
+	printValue(out, fmt.Sprintf("%d/%d", info.ReadyNodes, info.TotalNodes))
+	for _, res := range MeasuredResources {
+		// none of the clusters has reported the resource summary.
+		if info.Reported == 0 {
+			for i := 0; i < 4; i++ {
+				printValue(out, "<unknown>")
+			}
+			continue
+		}
+		allocatable, allocated := info.Allocatable[res], info.Allocated[res]
+		printSingleResourceUsage(out, res, allocatable)
+		fmt.Fprint(out, "\t")
+		printSingleResourceUsage(out, res, allocated)
+		fmt.Fprint(out, "\t")
+		if allocatable.MilliValue() > 0 {
+			fraction := float64(allocated.MilliValue()) / float64(allocatable.MilliValue()) * 100
+			fmt.Fprintf(out, "%d%%\t", int64(fraction))
+		} else {
+			printValue(out, "<unknown>")
+		}
+		printSingleResourceUsage(out, res, info.Allocating[res])
+		fmt.Fprint(out, "\t")
+	}
+	printValue(out, formatModelings(info.Modelings))
+	fmt.Fprint(out, "\n")
+}
+
+func formatModelings(modelings map[uint]int) string {
+	grades := make([]uint, 0, len(modelings))
+	for grade, count := range modelings {
+		if count > 0 {
+			grades = append(grades, grade)
+		}
+	}
+	if len(grades) == 0 {
+		return "<none>"
+	}
+	sort.Slice(grades, func(i, j int) bool { return grades[i] < grades[j] })
+
+	parts := make([]string, 0, len(grades))
+	for _, grade := range grades {
+		parts = append(parts, fmt.Sprintf("%d:%d", grade, modelings[grade]))
+	}
+	return strings.Join(parts, ",")
+}
+
 func printMissingMetricsNodeLine(out io.Writer, cluster, nodeName string) {
 	printValue(out, nodeName)
 	printValue(out, cluster)
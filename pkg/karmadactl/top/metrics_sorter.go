@@ -112,3 +112,39 @@ func NewNodeMetricsSorter(metrics []metricsapi.NodeMetrics, sortBy string) *Node
 		sortBy:  sortBy,
 	}
 }
+
+// ClusterResourceSorter sorts a list of ClusterResourceInfo.
+type ClusterResourceSorter struct {
+	infos  []ClusterResourceInfo
+	sortBy string
+}
+
+// Len returns the length of the ClusterResourceSorter.
+func (c *ClusterResourceSorter) Len() int {
+	return len(c.infos)
+}
+
+// Swap swaps the place of two ClusterResourceInfo.
+func (c *ClusterResourceSorter) Swap(i, j int) {
+	c.infos[i], c.infos[j] = c.infos[j], c.infos[i]
+}
+
+// Less compares two ClusterResourceInfo and returns true if the first ClusterResourceInfo should sort before the second.
+func (c *ClusterResourceSorter) Less(i, j int) bool {
+	switch c.sortBy {
+	case "cpu":
+		return c.infos[i].Allocated.Cpu().MilliValue() > c.infos[j].Allocated.Cpu().MilliValue()
+	case "memory":
+		return c.infos[i].Allocated.Memory().Value() > c.infos[j].Allocated.Memory().Value()
+	default:
+		return c.infos[i].Name < c.infos[j].Name
+	}
+}
+
+// NewClusterResourceSorter returns a new ClusterResourceSorter, which can be used to sort a list of ClusterResourceInfo.
+func NewClusterResourceSorter(infos []ClusterResourceInfo, sortBy string) *ClusterResourceSorter {
+	return &ClusterResourceSorter{
+		infos:  infos,
+		sortBy: sortBy,
+	}
+}
@@ -48,7 +48,7 @@ var (
 
 		The top command allows you to see the resource consumption for pods of member clusters.
 
-		This command requires karmada-metrics-adapter to be correctly configured and working on the Karmada control plane and
+		The pod and node subcommands require karmada-metrics-adapter to be correctly configured and working on the Karmada control plane and
 		Metrics Server to be correctly configured and working on the member clusters.`)
 )
 
@@ -67,6 +67,7 @@ func NewCmdTop(f util.Factory, parentCommand string, streams genericiooptions.IO
 	// create subcommands
 	cmd.AddCommand(NewCmdTopPod(f, parentCommand, nil, streams))
 	cmd.AddCommand(NewCmdTopNode(f, parentCommand, nil, streams))
+	cmd.AddCommand(NewCmdTopCluster(f, parentCommand, nil, streams))
 
 	return cmd
 }
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+)
+
+const (
+	groupByRegion   = "region"
+	groupByZone     = "zone"
+	groupByProvider = "provider"
+
+	// noneGroup is the group of clusters which don't declare the field used for roll-up.
+	noneGroup = "<none>"
+)
+
+// ClusterOptions contains all the options for running the top-cluster cli command.
+type ClusterOptions struct {
+	ResourceName string
+	Selector     string
+	SortBy       string
+	GroupBy      string
+	NoHeaders    bool
+
+	Printer       *CmdPrinter
+	karmadaClient karmadaclientset.Interface
+
+	genericiooptions.IOStreams
+}
+
+var (
+	topClusterLong = templates.LongDesc(i18n.T(`
+		Display resource (CPU/memory) allocation of member clusters.
+
+		The top-cluster command allows you to see the allocatable, allocated and allocating resources,
+		the ready nodes and the resource modeling grades of member clusters. The data comes from the
+		resource summary collected in the status of clusters, so neither karmada-metrics-adapter nor
+		Metrics Server is required.`))
+
+	topClusterExample = templates.Examples(i18n.T(`
+		# Show resource allocation of all member clusters
+		%[1]s top cluster
+
+		# Show resource allocation of the member1 cluster
+		%[1]s top cluster member1
+
+		# Show resource allocation of the clusters defined by label env=prod, sorted by the allocated cpu
+		%[1]s top cluster -l env=prod --sort-by=cpu
+
+		# Show resource allocation rolled up by region
+		%[1]s top cluster --by=region`))
+)
+
+// NewCmdTopCluster implements the top cluster command.
+func NewCmdTopCluster(f util.Factory, parentCommand string, o *ClusterOptions, streams genericiooptions.IOStreams) *cobra.Command {
+	if o == nil {
+		o = &ClusterOptions{
+			IOStreams: streams,
+		}
+	}
+
+	cmd := &cobra.Command{
+		Use:                   "cluster [NAME | -l label]",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Display resource (CPU/memory) allocation of member clusters"),
+		Long:                  topClusterLong,
+		Example:               fmt.Sprintf(topClusterExample, parentCommand),
+		ValidArgsFunction:     utilcomp.SpecifiedResourceTypeAndNameCompletionFunc(f, []string{"cluster"}),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate())
+			cmdutil.CheckErr(o.RunTopCluster())
+		},
+		Aliases: []string{"clusters"},
+	}
+	cmdutil.AddLabelSelectorFlagVar(cmd, &o.Selector)
+	options.AddKubeConfigFlags(cmd.Flags())
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", o.SortBy, "If non-empty, sort clusters list using specified field. The field can be either 'cpu' or 'memory', which sorts by the allocated resources.")
+	cmd.Flags().StringVar(&o.GroupBy, "by", o.GroupBy, "If non-empty, roll up the clusters by the specified field. The field can be one of 'region', 'zone' or 'provider'.")
+	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", o.NoHeaders, "If present, print output without headers")
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ClusterOptions) Complete(f util.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 1 {
+		o.ResourceName = args[0]
+	} else if len(args) > 1 {
+		return cmdutil.UsageErrorf(cmd, "%s", cmd.Use)
+	}
+
+	o.Printer = NewTopCmdPrinter(o.Out)
+
+	karmadaClient, err := f.KarmadaClientSet()
+	if err != nil {
+		return err
+	}
+	o.karmadaClient = karmadaClient
+	return nil
+}
+
+// Validate checks the validity of the options.
+func (o *ClusterOptions) Validate() error {
+	if len(o.SortBy) > 0 {
+		if o.SortBy != sortByCPU && o.SortBy != sortByMemory {
+			return errors.New("--sort-by accepts only cpu or memory")
+		}
+	}
+	if len(o.GroupBy) > 0 {
+		if o.GroupBy != groupByRegion && o.GroupBy != groupByZone && o.GroupBy != groupByProvider {
+			return errors.New("--by accepts only region, zone or provider")
+		}
+	}
+	if len(o.ResourceName) > 0 && len(o.Selector) > 0 {
+		return errors.New("only one of NAME or --selector can be provided")
+	}
+	return nil
+}
+
+// RunTopCluster runs the top cluster command.
+func (o *ClusterOptions) RunTopCluster() error {
+	selector, err := labels.Parse(o.Selector)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := getClusters(o.karmadaClient, selector, o.ResourceName)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		fmt.Fprintln(o.ErrOut, "No resources found")
+		return nil
+	}
+
+	infos := make([]ClusterResourceInfo, 0, len(clusters))
+	for i := range clusters {
+		infos = append(infos, newClusterResourceInfo(&clusters[i]))
+	}
+	nameColumn := "NAME"
+	if len(o.GroupBy) > 0 {
+		infos = rollUpClusterResources(clusters, infos, o.GroupBy)
+		nameColumn = strings.ToUpper(o.GroupBy)
+	}
+
+	return o.Printer.PrintClusterResources(infos, nameColumn, len(o.GroupBy) > 0, o.NoHeaders, o.SortBy)
+}
+
+func getClusters(karmadaClient karmadaclientset.Interface, selector labels.Selector, resourceName string) ([]clusterv1alpha1.Cluster, error) {
+	if len(resourceName) > 0 {
+		cluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), resourceName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return []clusterv1alpha1.Cluster{*cluster}, nil
+	}
+
+	clusterList, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clusterList.Items, nil
+}
+
+// ClusterResourceInfo contains the resource summary of a cluster, or of a group of clusters when rolled up.
+type ClusterResourceInfo struct {
+	Name string
+	// Clusters is the number of clusters in the group.
+	Clusters int
+	// Reported is the number of clusters in the group which have reported the resource summary.
+	Reported   int
+	ReadyNodes int32
+	TotalNodes int32
+
+	Allocatable corev1.ResourceList
+	Allocated   corev1.ResourceList
+	Allocating  corev1.ResourceList
+	// Modelings maps the resource model grade to the number of nodes in the grade.
+	Modelings map[uint]int
+}
+
+func newClusterResourceInfo(cluster *clusterv1alpha1.Cluster) ClusterResourceInfo {
+	info := ClusterResourceInfo{
+		Name:        cluster.Name,
+		Clusters:    1,
+		Allocatable: corev1.ResourceList{},
+		Allocated:   corev1.ResourceList{},
+		Allocating:  corev1.ResourceList{},
+		Modelings:   map[uint]int{},
+	}
+	if summary := cluster.Status.NodeSummary; summary != nil {
+		info.ReadyNodes = summary.ReadyNum
+		info.TotalNodes = summary.TotalNum
+	}
+	if summary := cluster.Status.ResourceSummary; summary != nil {
+		info.Reported = 1
+		addResources(info.Allocatable, summary.Allocatable)
+		addResources(info.Allocated, summary.Allocated)
+		addResources(info.Allocating, summary.Allocating)
+		for _, modeling := range summary.AllocatableModelings {
+			info.Modelings[modeling.Grade] += modeling.Count
+		}
+	}
+	return info
+}
+
+// rollUpClusterResources sums up the resource summaries of the clusters sharing the same value of the given field.
+// The infos are expected to be in the same order as the clusters.
+func rollUpClusterResources(clusters []clusterv1alpha1.Cluster, infos []ClusterResourceInfo, groupBy string) []ClusterResourceInfo {
+	groups := make(map[string]*ClusterResourceInfo)
+	var names []string
+	for i := range clusters {
+		name := clusterGroup(&clusters[i], groupBy)
+		group, ok := groups[name]
+		if !ok {
+			group = &ClusterResourceInfo{
+				Name:        name,
+				Allocatable: corev1.ResourceList{},
+				Allocated:   corev1.ResourceList{},
+				Allocating:  corev1.ResourceList{},
+				Modelings:   map[uint]int{},
+			}
+			groups[name] = group
+			names = append(names, name)
+		}
+
+		info := infos[i]
+		group.Clusters += info.Clusters
+		group.Reported += info.Reported
+		group.ReadyNodes += info.ReadyNodes
+		group.TotalNodes += info.TotalNodes
+		addResources(group.Allocatable, info.Allocatable)
+		addResources(group.Allocated, info.Allocated)
+		addResources(group.Allocating, info.Allocating)
+		for grade, count := range info.Modelings {
+			group.Modelings[grade] += count
+		}
+	}
+
+	result := make([]ClusterResourceInfo, 0, len(names))
+	for _, name := range names {
+		result = append(result, *groups[name])
+	}
+	return result
+}
+
+// clusterGroup returns the value of the given field of the cluster used for roll-up.
+// A cluster spanning multiple zones is grouped by the combination of its zones.
+func clusterGroup(cluster *clusterv1alpha1.Cluster, groupBy string) string {
+	var group string
+	switch groupBy {
+	case groupByRegion:
+		group = cluster.Spec.Region
+	case groupByProvider:
+		group = cluster.Spec.Provider
+	case groupByZone:
+		zones := slices.Clone(cluster.Spec.Zones)
+		if len(zones) == 0 && len(cluster.Spec.Zone) > 0 {
+			zones = []string{cluster.Spec.Zone}
+		}
+		sort.Strings(zones)
+		group = strings.Join(zones, ",")
+	}
+	if len(group) == 0 {
+		return noneGroup
+	}
+	return group
+}
+
+func addResources(total, resources corev1.ResourceList) {
+	for name, quantity := range resources {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	fakekarmadaclient "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+)
+
+func newTestCluster(name, region string, zones []string, cpu, memory, allocatedCPU, allocatedMemory string, modelings ...clusterv1alpha1.AllocatableModeling) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"region": region}},
+		Spec:       clusterv1alpha1.ClusterSpec{Region: region, Zones: zones},
+		Status: clusterv1alpha1.ClusterStatus{
+			NodeSummary: &clusterv1alpha1.NodeSummary{TotalNum: 3, ReadyNum: 2},
+			ResourceSummary: &clusterv1alpha1.ResourceSummary{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+				Allocated: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(allocatedCPU),
+					corev1.ResourceMemory: resource.MustParse(allocatedMemory),
+				},
+				Allocating: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("500m"),
+				},
+				AllocatableModelings: modelings,
+			},
+		},
+	}
+}
+
+func TestClusterOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *ClusterOptions
+		wantErr string
+	}{
+		{
+			name:    "valid options",
+			options: &ClusterOptions{SortBy: sortByCPU, GroupBy: groupByRegion},
+		},
+		{
+			name:    "invalid sort-by",
+			options: &ClusterOptions{SortBy: "nodes"},
+			wantErr: "--sort-by accepts only cpu or memory",
+		},
+		{
+			name:    "invalid by",
+			options: &ClusterOptions{GroupBy: "cluster"},
+			wantErr: "--by accepts only region, zone or provider",
+		},
+		{
+			name:    "both name and selector",
+			options: &ClusterOptions{ResourceName: "member1", Selector: "env=prod"},
+			wantErr: "only one of NAME or --selector can be provided",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClusterOptions_RunTopCluster(t *testing.T) {
+	objects := []runtime.Object{
+		newTestCluster("member1", "east", []string{"east-a"}, "8", "16Gi", "2", "4Gi",
+			clusterv1alpha1.AllocatableModeling{Grade: 0, Count: 1}, clusterv1alpha1.AllocatableModeling{Grade: 2, Count: 2}),
+		newTestCluster("member2", "east", []string{"east-b", "east-a"}, "4", "8Gi", "3", "2Gi",
+			clusterv1alpha1.AllocatableModeling{Grade: 2, Count: 1}),
+		newTestCluster("member3", "west", nil, "16", "32Gi", "1", "8Gi"),
+		&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member4"}},
+	}
+
+	tests := []struct {
+		name    string
+		options *ClusterOptions
+		want    [][]string
+	}{
+		{
+			name:    "show all clusters",
+			options: &ClusterOptions{},
+			want: [][]string{
+				{"NAME", "NODES(ready/total)", "CPU-ALLOCATABLE", "CPU-ALLOCATED", "CPU%", "CPU-ALLOCATING",
+					"MEMORY-ALLOCATABLE", "MEMORY-ALLOCATED", "MEMORY%", "MEMORY-ALLOCATING", "MODELINGS(grade:nodes)"},
+				{"member1", "2/3", "8000m", "2000m", "25%", "500m", "16384Mi", "4096Mi", "25%", "0Mi", "0:1,2:2"},
+				{"member2", "2/3", "4000m", "3000m", "75%", "500m", "8192Mi", "2048Mi", "25%", "0Mi", "2:1"},
+				{"member3", "2/3", "16000m", "1000m", "6%", "500m", "32768Mi", "8192Mi", "25%", "0Mi", "<none>"},
+				{"member4", "0/0", "<unknown>", "<unknown>", "<unknown>", "<unknown>",
+					"<unknown>", "<unknown>", "<unknown>", "<unknown>", "<none>"},
+			},
+		},
+		{
+			name:    "sort by cpu with selector",
+			options: &ClusterOptions{Selector: "region", SortBy: sortByCPU, NoHeaders: true},
+			want: [][]string{
+				{"member2", "2/3", "4000m", "3000m", "75%", "500m", "8192Mi", "2048Mi", "25%", "0Mi", "2:1"},
+				{"member1", "2/3", "8000m", "2000m", "25%", "500m", "16384Mi", "4096Mi", "25%", "0Mi", "0:1,2:2"},
+				{"member3", "2/3", "16000m", "1000m", "6%", "500m", "32768Mi", "8192Mi", "25%", "0Mi", "<none>"},
+			},
+		},
+		{
+			name:    "roll up by region",
+			options: &ClusterOptions{GroupBy: groupByRegion, SortBy: sortByMemory},
+			want: [][]string{
+				{"REGION", "CLUSTERS", "NODES(ready/total)", "CPU-ALLOCATABLE", "CPU-ALLOCATED", "CPU%", "CPU-ALLOCATING",
+					"MEMORY-ALLOCATABLE", "MEMORY-ALLOCATED", "MEMORY%", "MEMORY-ALLOCATING", "MODELINGS(grade:nodes)"},
+				{"west", "1", "2/3", "16000m", "1000m", "6%", "500m", "32768Mi", "8192Mi", "25%", "0Mi", "<none>"},
+				{"east", "2", "4/6", "12000m", "5000m", "41%", "1000m", "24576Mi", "6144Mi", "25%", "0Mi", "0:1,2:3"},
+				{"<none>", "1", "0/0", "<unknown>", "<unknown>", "<unknown>", "<unknown>",
+					"<unknown>", "<unknown>", "<unknown>", "<unknown>", "<none>"},
+			},
+		},
+		{
+			name:    "roll up by zone",
+			options: &ClusterOptions{GroupBy: groupByZone, NoHeaders: true},
+			want: [][]string{
+				{"<none>", "2", "2/3", "16000m", "1000m", "6%", "500m", "32768Mi", "8192Mi", "25%", "0Mi", "<none>"},
+				{"east-a", "1", "2/3", "8000m", "2000m", "25%", "500m", "16384Mi", "4096Mi", "25%", "0Mi", "0:1,2:2"},
+				{"east-a,east-b", "1", "2/3", "4000m", "3000m", "75%", "500m", "8192Mi", "2048Mi", "25%", "0Mi", "2:1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			tt.options.IOStreams = genericiooptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}}
+			tt.options.Printer = NewTopCmdPrinter(out)
+			tt.options.karmadaClient = fakekarmadaclient.NewClientset(objects...)
+			require.NoError(t, tt.options.RunTopCluster())
+
+			var got [][]string
+			for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+				got = append(got, strings.Fields(line))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// node is a line of the tree view along with its children.
+type node struct {
+	text     string
+	children []*node
+}
+
+func (n *node) add(text string) *node {
+	child := &node{text: text}
+	n.children = append(n.children, child)
+	return child
+}
+
+// printTree prints the propagation chain in a tree view. The reasons and messages are printed if wide is true.
+func printTree(out io.Writer, tree *Tree, wide bool) {
+	root := buildNode(tree, wide)
+	fmt.Fprintln(out, root.text)
+	printChildren(out, root, "")
+}
+
+func printChildren(out io.Writer, n *node, prefix string) {
+	for i, child := range n.children {
+		branch, indent := "├── ", "│   "
+		if i == len(n.children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(out, "%s%s%s\n", prefix, branch, child.text)
+		printChildren(out, child, prefix+indent)
+	}
+}
+
+func buildNode(tree *Tree, wide bool) *node {
+	root := &node{text: formatReference(tree.Template)}
+	if tree.Policy != nil {
+		root.add(formatReference(*tree.Policy))
+	}
+	if tree.Binding == nil {
+		root.add("<not propagated>")
+		return root
+	}
+
+	binding := root.add(joinFields(formatReference(tree.Binding.ObjectReference),
+		formatReplicas(tree.Binding.Replicas), formatConditions(tree.Binding.Conditions, wide)))
+	for _, cluster := range tree.Binding.Clusters {
+		clusterNode := binding.add(formatCluster(cluster, wide))
+		for _, work := range cluster.Works {
+			clusterNode.add(joinFields(formatReference(work.ObjectReference), formatConditions(work.Conditions, wide)))
+		}
+	}
+
+	if len(tree.Dependencies) > 0 {
+		dependencies := root.add("Dependencies")
+		for i := range tree.Dependencies {
+			dependencies.children = append(dependencies.children, buildNode(&tree.Dependencies[i], wide))
+		}
+	}
+	return root
+}
+
+func formatReference(ref ObjectReference) string {
+	if ref.Namespace == "" {
+		return fmt.Sprintf("%s %s", ref.Kind, ref.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
+
+func formatReplicas(replicas int32) string {
+	if replicas == 0 {
+		return ""
+	}
+	return fmt.Sprintf("replicas=%d", replicas)
+}
+
+func formatCluster(cluster Cluster, wide bool) string {
+	applied := fmt.Sprintf("applied=%t", cluster.Applied)
+	if wide && cluster.AppliedMessage != "" {
+		applied = fmt.Sprintf("%s(%s)", applied, cluster.AppliedMessage)
+	}
+	health := ""
+	if cluster.Health != "" {
+		health = fmt.Sprintf("health=%s", cluster.Health)
+	}
+	scheduled := ""
+	if !cluster.Scheduled {
+		scheduled = "<not scheduled>"
+	}
+	return joinFields("Cluster "+cluster.Name, formatReplicas(cluster.Replicas), applied, health, scheduled)
+}
+
+func formatConditions(conditions []metav1.Condition, wide bool) string {
+	fields := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		field := fmt.Sprintf("%s=%s", c.Type, c.Status)
+		if wide {
+			switch {
+			case c.Reason != "" && c.Message != "":
+				field = fmt.Sprintf("%s(%s: %s)", field, c.Reason, c.Message)
+			case c.Reason != "":
+				field = fmt.Sprintf("%s(%s)", field, c.Reason)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return strings.Join(fields, " ")
+}
+
+// joinFields joins the non-empty fields of a line.
+func joinFields(fields ...string) string {
+	nonEmpty := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field != "" {
+			nonEmpty = append(nonEmpty, field)
+		}
+	}
+	return strings.Join(nonEmpty, "  ")
+}
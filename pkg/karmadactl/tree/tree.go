@@ -0,0 +1,450 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/karmadactl/options"
+	"github.com/karmada-io/karmada/pkg/karmadactl/util"
+	utilcomp "github.com/karmada-io/karmada/pkg/karmadactl/util/completion"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// outputWide prints the reasons and messages of conditions in addition.
+	outputWide = "wide"
+	// outputJSON prints the propagation chain in JSON.
+	outputJSON = "json"
+)
+
+var (
+	treeLong = templates.LongDesc(`
+		Show the propagation chain of a resource template in a tree view.
+
+		The tree starts from the resource template, followed by the propagation policy it matches, the
+		ResourceBinding or ClusterResourceBinding, the target clusters with the scheduled replicas, the
+		applied and health status collected from them, and the Works in the execution namespaces of
+		the target clusters. The resources propagated along with the resource template because of
+		propagateDeps are shown as dependencies.`)
+
+	treeExample = templates.Examples(`
+		# Show the propagation chain of the deployment(default/nginx)
+		%[1]s tree deployment/nginx -n default
+
+		# Show the propagation chain with the reasons and messages of conditions
+		%[1]s tree deployment nginx -n default -o wide
+
+		# Show the propagation chain of the clusterrole(admin) in JSON
+		%[1]s tree clusterrole/admin -o json`)
+)
+
+// NewCmdTree creates the `tree` command.
+func NewCmdTree(f util.Factory, parentCommand string, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &CommandTreeOptions{IOStreams: streams}
+
+	cmd := &cobra.Command{
+		Use:                   "tree (TYPE NAME | TYPE/NAME) [-o wide|json]",
+		Short:                 "Show the propagation chain of a resource template in a tree view",
+		Long:                  treeLong,
+		Example:               fmt.Sprintf(treeExample, parentCommand),
+		SilenceUsage:          true,
+		DisableFlagsInUseLine: true,
+		ValidArgsFunction:     utilcomp.ResourceTypeAndNameCompletionFunc(f),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := o.Complete(f, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run(context.TODO())
+		},
+		Annotations: map[string]string{
+			util.TagCommandGroup: util.GroupClusterTroubleshootingAndDebugging,
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: wide|json")
+	options.AddKubeConfigFlags(flags)
+	options.AddNamespaceFlag(flags)
+
+	utilcomp.RegisterCompletionFuncForKarmadaContextFlag(cmd)
+	utilcomp.RegisterCompletionFuncForNamespaceFlag(cmd, f)
+	return cmd
+}
+
+// CommandTreeOptions contains the input to the tree command.
+type CommandTreeOptions struct {
+	genericiooptions.IOStreams
+
+	// OutputFormat is the format of the output, one of: wide|json.
+	OutputFormat string
+
+	args               []string
+	namespace          string
+	builder            *resource.Builder
+	controlPlaneClient client.Client
+}
+
+// Complete completes all the required options.
+func (o *CommandTreeOptions) Complete(f util.Factory, args []string) error {
+	var err error
+	o.args = args
+	o.namespace, _, err = f.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	o.builder = f.NewBuilder()
+
+	restConfig, err := f.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	o.controlPlaneClient, err = gclient.NewForConfig(restConfig)
+	return err
+}
+
+// Validate checks the options.
+func (o *CommandTreeOptions) Validate() error {
+	if len(o.args) == 0 {
+		return fmt.Errorf("required resource not specified")
+	}
+	if o.OutputFormat != "" && o.OutputFormat != outputWide && o.OutputFormat != outputJSON {
+		return fmt.Errorf("invalid output format %q, only wide and json are supported", o.OutputFormat)
+	}
+	return nil
+}
+
+// Run prints the propagation chain of the resource template.
+func (o *CommandTreeOptions) Run(ctx context.Context) error {
+	infos, err := o.builder.
+		Unstructured().
+		NamespaceParam(o.namespace).DefaultNamespace().
+		ResourceTypeOrNameArgs(true, o.args...).
+		SingleResourceType().
+		Latest().
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("expected exactly one resource, but got %d", len(infos))
+	}
+
+	tree, err := o.buildTree(ctx, infos[0].Object.(*unstructured.Unstructured))
+	if err != nil {
+		return err
+	}
+
+	if o.OutputFormat == outputJSON {
+		data, err := json.MarshalIndent(tree, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.Out, string(data))
+		return nil
+	}
+	printTree(o.Out, tree, o.OutputFormat == outputWide)
+	return nil
+}
+
+// Tree is the propagation chain of a resource template.
+type Tree struct {
+	// Template is the resource template.
+	Template ObjectReference `json:"template"`
+	// Policy is the PropagationPolicy or ClusterPropagationPolicy the resource template matches.
+	// It's empty for the resources propagated as dependencies.
+	Policy *ObjectReference `json:"policy,omitempty"`
+	// Binding is the ResourceBinding or ClusterResourceBinding of the resource template.
+	// It's empty if the resource template is not propagated.
+	Binding *Binding `json:"binding,omitempty"`
+	// Dependencies are the propagation chains of the resources propagated along with the resource template.
+	Dependencies []Tree `json:"dependencies,omitempty"`
+}
+
+// ObjectReference refers to an object in Karmada control plane.
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// Binding is the ResourceBinding or ClusterResourceBinding in the propagation chain.
+type Binding struct {
+	ObjectReference
+	// Replicas is the desired replicas of the resource template.
+	Replicas int32 `json:"replicas,omitempty"`
+	// Conditions are the conditions of the binding.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Clusters are the target clusters of the binding, and the clusters that still have Works left.
+	Clusters []Cluster `json:"clusters,omitempty"`
+}
+
+// Cluster is a member cluster the resource template is propagated to.
+type Cluster struct {
+	Name string `json:"name"`
+	// Scheduled tells whether the cluster is in the scheduling result of the binding.
+	Scheduled bool `json:"scheduled"`
+	// Replicas is the replicas scheduled to the cluster.
+	Replicas int32 `json:"replicas,omitempty"`
+	// Applied tells whether the resource has been applied to the cluster.
+	Applied bool `json:"applied"`
+	// AppliedMessage is the message of the applied status.
+	AppliedMessage string `json:"appliedMessage,omitempty"`
+	// Health is the health of the resource in the cluster.
+	Health workv1alpha2.ResourceHealth `json:"health,omitempty"`
+	// Works are the Works in the execution namespace of the cluster.
+	Works []Work `json:"works,omitempty"`
+}
+
+// Work is a Work in the propagation chain.
+type Work struct {
+	ObjectReference
+	// Conditions are the conditions of the Work.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// bindingObject is the common part of ResourceBinding and ClusterResourceBinding.
+type bindingObject struct {
+	client.Object
+	kind    string
+	idLabel string
+	spec    *workv1alpha2.ResourceBindingSpec
+	status  *workv1alpha2.ResourceBindingStatus
+}
+
+func (o *CommandTreeOptions) buildTree(ctx context.Context, template *unstructured.Unstructured) (*Tree, error) {
+	tree := &Tree{
+		Template: ObjectReference{
+			APIVersion: template.GetAPIVersion(),
+			Kind:       template.GetKind(),
+			Namespace:  template.GetNamespace(),
+			Name:       template.GetName(),
+		},
+	}
+
+	binding, err := o.getBinding(ctx, template.GetNamespace(), names.GenerateBindingName(template.GetKind(), template.GetName()))
+	if err != nil {
+		return nil, err
+	}
+	tree.Policy = policyReference(template.GetAnnotations())
+	if binding == nil {
+		return tree, nil
+	}
+	if tree.Policy == nil {
+		tree.Policy = policyReference(binding.GetAnnotations())
+	}
+
+	if tree.Binding, err = o.buildBinding(ctx, binding); err != nil {
+		return nil, err
+	}
+	if tree.Dependencies, err = o.buildDependencies(ctx, binding); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+func (o *CommandTreeOptions) getBinding(ctx context.Context, namespace, name string) (*bindingObject, error) {
+	var binding *bindingObject
+	var err error
+	if namespace != "" {
+		rb := &workv1alpha2.ResourceBinding{}
+		err = o.controlPlaneClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, rb)
+		binding = &bindingObject{Object: rb, kind: workv1alpha2.ResourceKindResourceBinding, idLabel: workv1alpha2.ResourceBindingPermanentIDLabel,
+			spec: &rb.Spec, status: &rb.Status}
+	} else {
+		crb := &workv1alpha2.ClusterResourceBinding{}
+		err = o.controlPlaneClient.Get(ctx, client.ObjectKey{Name: name}, crb)
+		binding = &bindingObject{Object: crb, kind: workv1alpha2.ResourceKindClusterResourceBinding, idLabel: workv1alpha2.ClusterResourceBindingPermanentIDLabel,
+			spec: &crb.Spec, status: &crb.Status}
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get binding %s: %w", name, err)
+	}
+	return binding, nil
+}
+
+// policyReference returns the propagation policy recorded in the annotations of the resource template or binding.
+func policyReference(annotations map[string]string) *ObjectReference {
+	if name := annotations[policyv1alpha1.PropagationPolicyNameAnnotation]; name != "" {
+		return &ObjectReference{
+			APIVersion: policyv1alpha1.SchemeGroupVersion.String(),
+			Kind:       policyv1alpha1.ResourceKindPropagationPolicy,
+			Namespace:  annotations[policyv1alpha1.PropagationPolicyNamespaceAnnotation],
+			Name:       name,
+		}
+	}
+	if name := annotations[policyv1alpha1.ClusterPropagationPolicyAnnotation]; name != "" {
+		return &ObjectReference{
+			APIVersion: policyv1alpha1.SchemeGroupVersion.String(),
+			Kind:       policyv1alpha1.ResourceKindClusterPropagationPolicy,
+			Name:       name,
+		}
+	}
+	return nil
+}
+
+func (o *CommandTreeOptions) buildBinding(ctx context.Context, binding *bindingObject) (*Binding, error) {
+	result := &Binding{
+		ObjectReference: ObjectReference{
+			APIVersion: workv1alpha2.SchemeGroupVersion.String(),
+			Kind:       binding.kind,
+			Namespace:  binding.GetNamespace(),
+			Name:       binding.GetName(),
+		},
+		Replicas:   binding.spec.Replicas,
+		Conditions: binding.status.Conditions,
+	}
+
+	works, err := o.listWorks(ctx, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make(map[string]*Cluster)
+	for _, target := range binding.spec.Clusters {
+		clusters[target.Name] = &Cluster{Name: target.Name, Scheduled: true, Replicas: target.Replicas}
+	}
+	for _, item := range binding.status.AggregatedStatus {
+		cluster, ok := clusters[item.ClusterName]
+		if !ok {
+			cluster = &Cluster{Name: item.ClusterName}
+			clusters[item.ClusterName] = cluster
+		}
+		cluster.Applied = item.Applied
+		cluster.AppliedMessage = item.AppliedMessage
+		cluster.Health = item.Health
+	}
+	for _, work := range works {
+		clusterName, err := names.GetClusterName(work.Namespace)
+		if err != nil {
+			continue
+		}
+		cluster, ok := clusters[clusterName]
+		if !ok {
+			cluster = &Cluster{Name: clusterName}
+			clusters[clusterName] = cluster
+		}
+		cluster.Works = append(cluster.Works, Work{
+			ObjectReference: ObjectReference{
+				APIVersion: workv1alpha1.SchemeGroupVersion.String(),
+				Kind:       workv1alpha1.ResourceKindWork,
+				Namespace:  work.Namespace,
+				Name:       work.Name,
+			},
+			Conditions: work.Status.Conditions,
+		})
+	}
+
+	for _, cluster := range clusters {
+		result.Clusters = append(result.Clusters, *cluster)
+	}
+	sort.Slice(result.Clusters, func(i, j int) bool {
+		return result.Clusters[i].Name < result.Clusters[j].Name
+	})
+	return result, nil
+}
+
+func (o *CommandTreeOptions) listWorks(ctx context.Context, binding *bindingObject) ([]workv1alpha1.Work, error) {
+	bindingID := binding.GetLabels()[binding.idLabel]
+	if bindingID == "" {
+		return nil, nil
+	}
+	workList := &workv1alpha1.WorkList{}
+	if err := o.controlPlaneClient.List(ctx, workList, &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{binding.idLabel: bindingID}),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list Works of %s(%s): %w", binding.kind, binding.GetName(), err)
+	}
+	sort.Slice(workList.Items, func(i, j int) bool {
+		if workList.Items[i].Namespace != workList.Items[j].Namespace {
+			return workList.Items[i].Namespace < workList.Items[j].Namespace
+		}
+		return workList.Items[i].Name < workList.Items[j].Name
+	})
+	return workList.Items, nil
+}
+
+// buildDependencies builds the propagation chains of the resources propagated along with the resource template,
+// which are bound by the attached ResourceBindings requiring the binding of the resource template.
+func (o *CommandTreeOptions) buildDependencies(ctx context.Context, binding *bindingObject) ([]Tree, error) {
+	if !binding.spec.PropagateDeps || binding.GetNamespace() == "" {
+		return nil, nil
+	}
+
+	bindingList := &workv1alpha2.ResourceBindingList{}
+	if err := o.controlPlaneClient.List(ctx, bindingList, client.InNamespace(binding.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("failed to list ResourceBindings in namespace %s: %w", binding.GetNamespace(), err)
+	}
+
+	var dependencies []Tree
+	for i := range bindingList.Items {
+		attached := &bindingList.Items[i]
+		if !requiredBy(attached, binding.GetNamespace(), binding.GetName()) {
+			continue
+		}
+		result, err := o.buildBinding(ctx, &bindingObject{Object: attached, kind: workv1alpha2.ResourceKindResourceBinding,
+			idLabel: workv1alpha2.ResourceBindingPermanentIDLabel, spec: &attached.Spec, status: &attached.Status})
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, Tree{
+			Template: ObjectReference{
+				APIVersion: attached.Spec.Resource.APIVersion,
+				Kind:       attached.Spec.Resource.Kind,
+				Namespace:  attached.Spec.Resource.Namespace,
+				Name:       attached.Spec.Resource.Name,
+			},
+			Binding: result,
+		})
+	}
+	return dependencies, nil
+}
+
+func requiredBy(binding *workv1alpha2.ResourceBinding, namespace, name string) bool {
+	for _, snapshot := range binding.Spec.RequiredBy {
+		if snapshot.Namespace == namespace && snapshot.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+)
+
+func newTemplate(apiVersion, kind, namespace, name string, annotations map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func newWork(cluster, name, bindingID string, applied metav1.ConditionStatus) *workv1alpha1.Work {
+	return &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "karmada-es-" + cluster,
+			Name:      name,
+			Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: bindingID},
+		},
+		Status: workv1alpha1.WorkStatus{
+			Conditions: []metav1.Condition{{Type: workv1alpha1.WorkApplied, Status: applied, Reason: "AppliedFailed", Message: "conflict"}},
+		},
+	}
+}
+
+func TestCommandTreeOptions_buildTree(t *testing.T) {
+	deployment := newTemplate("apps/v1", "Deployment", "default", "nginx", map[string]string{
+		policyv1alpha1.PropagationPolicyNamespaceAnnotation: "default",
+		policyv1alpha1.PropagationPolicyNameAnnotation:      "nginx-pp",
+	})
+	objects := []runtime.Object{
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "nginx-deployment",
+				Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "rb-1"},
+			},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource:      workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+				Replicas:      3,
+				PropagateDeps: true,
+				Clusters:      []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 1}, {Name: "member1", Replicas: 2}},
+			},
+			Status: workv1alpha2.ResourceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: workv1alpha2.Scheduled, Status: metav1.ConditionTrue, Reason: "Success"},
+					{Type: workv1alpha2.FullyApplied, Status: metav1.ConditionFalse, Reason: "FullyAppliedFailed", Message: "failed to apply"},
+				},
+				AggregatedStatus: []workv1alpha2.AggregatedStatusItem{
+					{ClusterName: "member1", Applied: true, Health: workv1alpha2.ResourceHealthy},
+					{ClusterName: "member2", Applied: false, AppliedMessage: "conflict", Health: workv1alpha2.ResourceUnknown},
+				},
+			},
+		},
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "nginx-config-configmap",
+				Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "rb-2"},
+			},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource:   workv1alpha2.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "nginx-config"},
+				RequiredBy: []workv1alpha2.BindingSnapshot{{Namespace: "default", Name: "nginx-deployment"}},
+				Clusters:   []workv1alpha2.TargetCluster{{Name: "member1"}},
+			},
+		},
+		&workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other-configmap"},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource:   workv1alpha2.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "other"},
+				RequiredBy: []workv1alpha2.BindingSnapshot{{Namespace: "default", Name: "other-deployment"}},
+			},
+		},
+		newWork("member1", "nginx-work", "rb-1", metav1.ConditionTrue),
+		newWork("member2", "nginx-work", "rb-1", metav1.ConditionFalse),
+		newWork("member3", "nginx-work", "rb-1", metav1.ConditionTrue),
+		newWork("member1", "nginx-config-work", "rb-2", metav1.ConditionTrue),
+	}
+
+	tests := []struct {
+		name     string
+		template *unstructured.Unstructured
+		wide     bool
+		want     string
+	}{
+		{
+			name:     "resource template not propagated",
+			template: newTemplate("v1", "Secret", "default", "foo", nil),
+			want: `Secret default/foo
+└── <not propagated>
+`,
+		},
+		{
+			name:     "resource template with dependencies",
+			template: deployment,
+			want: `Deployment default/nginx
+├── PropagationPolicy default/nginx-pp
+├── ResourceBinding default/nginx-deployment  replicas=3  Scheduled=True FullyApplied=False
+│   ├── Cluster member1  replicas=2  applied=true  health=Healthy
+│   │   └── Work karmada-es-member1/nginx-work  Applied=True
+│   ├── Cluster member2  replicas=1  applied=false  health=Unknown
+│   │   └── Work karmada-es-member2/nginx-work  Applied=False
+│   └── Cluster member3  applied=false  <not scheduled>
+│       └── Work karmada-es-member3/nginx-work  Applied=True
+└── Dependencies
+    └── ConfigMap default/nginx-config
+        └── ResourceBinding default/nginx-config-configmap
+            └── Cluster member1  applied=false
+                └── Work karmada-es-member1/nginx-config-work  Applied=True
+`,
+		},
+		{
+			name:     "wide output",
+			template: deployment,
+			wide:     true,
+			want: `Deployment default/nginx
+├── PropagationPolicy default/nginx-pp
+├── ResourceBinding default/nginx-deployment  replicas=3  Scheduled=True(Success) FullyApplied=False(FullyAppliedFailed: failed to apply)
+│   ├── Cluster member1  replicas=2  applied=true  health=Healthy
+│   │   └── Work karmada-es-member1/nginx-work  Applied=True(AppliedFailed: conflict)
+│   ├── Cluster member2  replicas=1  applied=false(conflict)  health=Unknown
+│   │   └── Work karmada-es-member2/nginx-work  Applied=False(AppliedFailed: conflict)
+│   └── Cluster member3  applied=false  <not scheduled>
+│       └── Work karmada-es-member3/nginx-work  Applied=True(AppliedFailed: conflict)
+└── Dependencies
+    └── ConfigMap default/nginx-config
+        └── ResourceBinding default/nginx-config-configmap
+            └── Cluster member1  applied=false
+                └── Work karmada-es-member1/nginx-config-work  Applied=True(AppliedFailed: conflict)
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &CommandTreeOptions{
+				controlPlaneClient: fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithRuntimeObjects(objects...).Build(),
+			}
+			tree, err := o.buildTree(context.TODO(), tt.template)
+			require.NoError(t, err)
+
+			out := &bytes.Buffer{}
+			printTree(out, tree, tt.wide)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestCommandTreeOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *CommandTreeOptions
+		wantErr bool
+	}{
+		{name: "no resource", options: &CommandTreeOptions{}, wantErr: true},
+		{name: "json output", options: &CommandTreeOptions{args: []string{"deployment/nginx"}, OutputFormat: outputJSON}},
+		{name: "invalid output", options: &CommandTreeOptions{args: []string{"deployment/nginx"}, OutputFormat: "yaml"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.options.Validate() != nil)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	utilmetrics "github.com/karmada-io/karmada/pkg/util/metrics"
+)
+
+const (
+	interpreterWebhookCacheMetricsName     = "resource_interpreter_webhook_cache_total"
+	interpreterWebhookBatchSizeMetricsName = "resource_interpreter_webhook_batch_size"
+	luaScriptDurationMetricsName           = "resource_interpreter_lua_script_duration_seconds"
+	luaScriptFailuresMetricsName           = "resource_interpreter_lua_script_failures_total"
+)
+
+var (
+	interpreterWebhookCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: interpreterWebhookCacheMetricsName,
+		Help: "Number of lookups in the result cache of resource interpreter webhooks. By the result, 'hit' means the result is served from the cache. Otherwise 'miss'.",
+	}, []string{"webhook", "operation", "result"})
+
+	interpreterWebhookBatchSizeHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    interpreterWebhookBatchSizeMetricsName,
+		Help:    "Number of objects sent in one batch request to resource interpreter webhooks.",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	}, []string{"webhook", "operation"})
+
+	luaScriptDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    luaScriptDurationMetricsName,
+		Help:    "Duration in seconds to run the Lua scripts of resource interpreter customizations. By the result, 'error' means the script failed. Otherwise 'success'.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 2, 15),
+	}, []string{"function", "result"})
+
+	luaScriptFailuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: luaScriptFailuresMetricsName,
+		Help: "Number of failures of running the Lua scripts of resource interpreter customizations. The reason is one of 'Timeout', 'InstructionLimitExceeded', 'StackOverflow' and 'Error'.",
+	}, []string{"function", "reason"})
+)
+
+// CountInterpreterWebhookCacheLookup records a lookup in the result cache of a resource interpreter webhook.
+func CountInterpreterWebhookCacheLookup(webhook, operation string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	interpreterWebhookCacheCounter.WithLabelValues(webhook, operation, result).Inc()
+}
+
+// ObserveInterpreterWebhookBatchSize records the number of objects sent in one batch request to a resource interpreter webhook.
+func ObserveInterpreterWebhookBatchSize(webhook, operation string, size int) {
+	interpreterWebhookBatchSizeHistogram.WithLabelValues(webhook, operation).Observe(float64(size))
+}
+
+// ObserveLuaScriptDuration records the duration of running the Lua script function.
+func ObserveLuaScriptDuration(function string, err error, start time.Time) {
+	luaScriptDurationHistogram.WithLabelValues(function, utilmetrics.GetResultByError(err)).Observe(utilmetrics.DurationInSeconds(start))
+}
+
+// CountLuaScriptFailure records a failure of running the Lua script function.
+func CountLuaScriptFailure(function, reason string) {
+	luaScriptFailuresCounter.WithLabelValues(function, reason).Inc()
+}
+
+// ResourceInterpreterCollectors returns the collectors about resource interpreter.
+func ResourceInterpreterCollectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		interpreterWebhookCacheCounter,
+		interpreterWebhookBatchSizeHistogram,
+		luaScriptDurationHistogram,
+		luaScriptFailuresCounter,
+	}
+}
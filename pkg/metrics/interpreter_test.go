@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCountInterpreterWebhookCacheLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		hit  bool
+		want string
+	}{
+		{
+			name: "cache hit",
+			hit:  true,
+			want: `
+# HELP resource_interpreter_webhook_cache_total Number of lookups in the result cache of resource interpreter webhooks. By the result, 'hit' means the result is served from the cache. Otherwise 'miss'.
+# TYPE resource_interpreter_webhook_cache_total counter
+resource_interpreter_webhook_cache_total{operation="InterpretHealth",result="hit",webhook="foo/bar"} 1
+`,
+		},
+		{
+			name: "cache miss",
+			hit:  false,
+			want: `
+# HELP resource_interpreter_webhook_cache_total Number of lookups in the result cache of resource interpreter webhooks. By the result, 'hit' means the result is served from the cache. Otherwise 'miss'.
+# TYPE resource_interpreter_webhook_cache_total counter
+resource_interpreter_webhook_cache_total{operation="InterpretHealth",result="miss",webhook="foo/bar"} 1
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interpreterWebhookCacheCounter.Reset()
+			CountInterpreterWebhookCacheLookup("foo/bar", "InterpretHealth", tt.hit)
+			if err := testutil.CollectAndCompare(interpreterWebhookCacheCounter, strings.NewReader(tt.want), interpreterWebhookCacheMetricsName); err != nil {
+				t.Errorf("unexpected collecting result:\n%s", err)
+			}
+		})
+	}
+}
+
+func TestObserveInterpreterWebhookBatchSize(t *testing.T) {
+	interpreterWebhookBatchSizeHistogram.Reset()
+	ObserveInterpreterWebhookBatchSize("foo/bar", "InterpretStatus", 3)
+	ObserveInterpreterWebhookBatchSize("foo/bar", "InterpretStatus", 5)
+
+	if count := testutil.CollectAndCount(interpreterWebhookBatchSizeHistogram, interpreterWebhookBatchSizeMetricsName); count != 1 {
+		t.Errorf("expected 1 series, got %d", count)
+	}
+}
+
+func TestObserveLuaScriptDuration(t *testing.T) {
+	luaScriptDurationHistogram.Reset()
+	ObserveLuaScriptDuration("InterpretHealth", nil, time.Now())
+	ObserveLuaScriptDuration("InterpretHealth", errors.New("timeout"), time.Now())
+
+	if count := testutil.CollectAndCount(luaScriptDurationHistogram, luaScriptDurationMetricsName); count != 2 {
+		t.Errorf("expected 2 series, got %d", count)
+	}
+}
+
+func TestCountLuaScriptFailure(t *testing.T) {
+	luaScriptFailuresCounter.Reset()
+	CountLuaScriptFailure("InterpretHealth", "Timeout")
+	CountLuaScriptFailure("InterpretHealth", "Timeout")
+
+	want := `
+# HELP resource_interpreter_lua_script_failures_total Number of failures of running the Lua scripts of resource interpreter customizations. The reason is one of 'Timeout', 'InstructionLimitExceeded', 'StackOverflow' and 'Error'.
+# TYPE resource_interpreter_lua_script_failures_total counter
+resource_interpreter_lua_script_failures_total{function="InterpretHealth",reason="Timeout"} 2
+`
+	if err := testutil.CollectAndCompare(luaScriptFailuresCounter, strings.NewReader(want), luaScriptFailuresMetricsName); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
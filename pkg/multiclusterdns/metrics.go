@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterdns
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// dnsRequestsTotal records the number of DNS queries answered, by query type and response code.
+var dnsRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "multicluster_dns_requests_total",
+	Help: "Number of DNS queries answered by the multi-cluster DNS server, by query type and response code.",
+}, []string{"type", "rcode"})
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(dnsRequestsTotal)
+}
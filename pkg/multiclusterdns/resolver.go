@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterdns
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	clusterinformers "github.com/karmada-io/karmada/pkg/generated/informers/externalversions/cluster/v1alpha1"
+	workinformers "github.com/karmada-io/karmada/pkg/generated/informers/externalversions/work/v1alpha1"
+	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+const (
+	// DefaultZone is the default DNS zone served for multi-cluster services.
+	DefaultZone = "clusterset.local."
+
+	multiClusterServiceIndex = "multiClusterService"
+)
+
+// Resolver resolves the multi-cluster service names to the addresses of the endpoints collected
+// from member clusters. Both the EndpointSlices collected for ServiceImports and the EndpointSlice
+// works collected for MultiClusterServices are taken into account.
+type Resolver struct {
+	endpointSliceLister discoverylisters.EndpointSliceLister
+	workIndexer         cache.Indexer
+	clusterLister       clusterlister.ClusterLister
+	informersSynced     []cache.InformerSynced
+}
+
+// ServiceAddresses holds the healthy addresses of a multi-cluster service.
+type ServiceAddresses struct {
+	IPv4 []netip.Addr
+	IPv6 []netip.Addr
+}
+
+// TweakEndpointSliceListOptions limits the EndpointSlices watched by the resolver to the ones
+// collected by Karmada.
+func TweakEndpointSliceListOptions(options *metav1.ListOptions) {
+	options.LabelSelector = labels.Set{discoveryv1.LabelManagedBy: util.EndpointSliceControllerLabelValue}.String()
+}
+
+// TweakWorkListOptions limits the Works watched by the resolver to the ones managed by MultiClusterServices.
+func TweakWorkListOptions(options *metav1.ListOptions) {
+	options.LabelSelector = util.MultiClusterServiceNameLabel
+}
+
+// NewResolver creates a Resolver, the EndpointSlice and Work informers are expected to be created
+// with TweakEndpointSliceListOptions and TweakWorkListOptions respectively.
+func NewResolver(endpointSliceInformer discoveryinformers.EndpointSliceInformer, workInformer workinformers.WorkInformer,
+	clusterInformer clusterinformers.ClusterInformer) (*Resolver, error) {
+	if err := workInformer.Informer().AddIndexers(cache.Indexers{multiClusterServiceIndex: indexByMultiClusterService}); err != nil {
+		return nil, err
+	}
+
+	return &Resolver{
+		endpointSliceLister: endpointSliceInformer.Lister(),
+		workIndexer:         workInformer.Informer().GetIndexer(),
+		clusterLister:       clusterInformer.Lister(),
+		informersSynced: []cache.InformerSynced{
+			endpointSliceInformer.Informer().HasSynced,
+			workInformer.Informer().HasSynced,
+			clusterInformer.Informer().HasSynced,
+		},
+	}, nil
+}
+
+// WaitForCacheSync waits for the caches used by the resolver to be synced.
+func (r *Resolver) WaitForCacheSync(ctx context.Context) bool {
+	return cache.WaitForCacheSync(ctx.Done(), r.informersSynced...)
+}
+
+// HasSynced tells whether the caches used by the resolver have been synced.
+func (r *Resolver) HasSynced() bool {
+	for _, synced := range r.informersSynced {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve returns the healthy addresses of the service, the returned bool is false if the service is unknown.
+// Endpoints which are not ready, or located in a cluster which is not ready, are excluded.
+func (r *Resolver) Resolve(namespace, name string) (*ServiceAddresses, bool, error) {
+	var endpointSlices []*discoveryv1.EndpointSlice
+
+	collected, err := r.endpointSliceLister.EndpointSlices(namespace).List(labels.SelectorFromSet(labels.Set{
+		discoveryv1.LabelServiceName: names.GenerateDerivedServiceName(name),
+	}))
+	if err != nil {
+		return nil, false, err
+	}
+	endpointSlices = append(endpointSlices, collected...)
+
+	works, err := r.workIndexer.ByIndex(multiClusterServiceIndex, namespace+"/"+name)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, obj := range works {
+		work := obj.(*workv1alpha1.Work)
+		// The works with this annotation are dispatched to the consumer clusters, the same EndpointSlices
+		// are held by the works in the provider clusters' execution namespace.
+		if util.GetAnnotationValue(work.Annotations, util.EndpointSliceProvisionClusterAnnotation) != "" {
+			continue
+		}
+		if !work.DeletionTimestamp.IsZero() || !util.IsWorkContains(work.Spec.Workload.Manifests, util.EndpointSliceGVK) {
+			continue
+		}
+		workEndpointSlices, err := getEndpointSlicesFromWork(work)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, eps := range workEndpointSlices {
+			eps.Annotations = util.DedupeAndMergeAnnotations(eps.Annotations, map[string]string{
+				workv1alpha2.WorkNamespaceAnnotation: work.Namespace,
+			})
+			endpointSlices = append(endpointSlices, eps)
+		}
+	}
+
+	if len(endpointSlices) == 0 {
+		return nil, false, nil
+	}
+
+	addresses := &ServiceAddresses{}
+	for _, eps := range endpointSlices {
+		if eps.AddressType != discoveryv1.AddressTypeIPv4 && eps.AddressType != discoveryv1.AddressTypeIPv6 {
+			continue
+		}
+		if !r.isSourceClusterReady(eps) {
+			continue
+		}
+		for _, endpoint := range eps.Endpoints {
+			if !isEndpointHealthy(endpoint) {
+				continue
+			}
+			for _, address := range endpoint.Addresses {
+				ip, err := netip.ParseAddr(address)
+				if err != nil {
+					klog.V(4).InfoS("Skip invalid endpoint address", "namespace", eps.Namespace, "endpointSlice", eps.Name, "address", address)
+					continue
+				}
+				if ip.Unmap().Is4() {
+					addresses.IPv4 = append(addresses.IPv4, ip.Unmap())
+				} else {
+					addresses.IPv6 = append(addresses.IPv6, ip)
+				}
+			}
+		}
+	}
+	addresses.IPv4 = compactAddresses(addresses.IPv4)
+	addresses.IPv6 = compactAddresses(addresses.IPv6)
+	return addresses, true, nil
+}
+
+// isSourceClusterReady tells whether the cluster which the EndpointSlice is collected from is ready.
+// The EndpointSlice is considered healthy if the source cluster could not be figured out.
+func (r *Resolver) isSourceClusterReady(eps *discoveryv1.EndpointSlice) bool {
+	workNamespace := util.GetAnnotationValue(eps.Annotations, workv1alpha2.WorkNamespaceAnnotation)
+	if workNamespace == "" {
+		return true
+	}
+	clusterName, err := names.GetClusterName(workNamespace)
+	if err != nil {
+		return true
+	}
+	cluster, err := r.clusterLister.Get(clusterName)
+	if err != nil {
+		// The cluster may have been removed, its endpoints would be cleaned up soon.
+		return false
+	}
+	return util.IsClusterReady(&cluster.Status)
+}
+
+func isEndpointHealthy(endpoint discoveryv1.Endpoint) bool {
+	if endpoint.Conditions.Ready != nil {
+		return *endpoint.Conditions.Ready
+	}
+	return endpoint.Conditions.Terminating == nil || !*endpoint.Conditions.Terminating
+}
+
+func compactAddresses(addresses []netip.Addr) []netip.Addr {
+	slices.SortFunc(addresses, func(a, b netip.Addr) int { return a.Compare(b) })
+	return slices.Compact(addresses)
+}
+
+func getEndpointSlicesFromWork(work *workv1alpha1.Work) ([]*discoveryv1.EndpointSlice, error) {
+	var endpointSlices []*discoveryv1.EndpointSlice
+	for _, manifest := range work.Spec.Workload.Manifests {
+		unstructuredObj := &unstructured.Unstructured{}
+		if err := unstructuredObj.UnmarshalJSON(manifest.Raw); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest of work(%s/%s): %v", work.Namespace, work.Name, err)
+		}
+		if unstructuredObj.GroupVersionKind() != util.EndpointSliceGVK {
+			continue
+		}
+		endpointSlice := &discoveryv1.EndpointSlice{}
+		if err := helper.ConvertToTypedObject(unstructuredObj, endpointSlice); err != nil {
+			return nil, fmt.Errorf("failed to convert manifest of work(%s/%s) to EndpointSlice: %v", work.Namespace, work.Name, err)
+		}
+		endpointSlices = append(endpointSlices, endpointSlice)
+	}
+	return endpointSlices, nil
+}
+
+func indexByMultiClusterService(obj interface{}) ([]string, error) {
+	work, ok := obj.(*workv1alpha1.Work)
+	if !ok {
+		return nil, nil
+	}
+	namespace := util.GetLabelValue(work.Labels, util.MultiClusterServiceNamespaceLabel)
+	name := util.GetLabelValue(work.Labels, util.MultiClusterServiceNameLabel)
+	if namespace == "" || name == "" {
+		return nil, nil
+	}
+	return []string{namespace + "/" + name}, nil
+}
+
+// parseServiceName parses the query name in form of "<service>.<namespace>.svc.<zone>", the zone
+// must be fully qualified and lower-cased.
+func parseServiceName(queryName, zone string) (namespace, name string, inZone, ok bool) {
+	queryName = strings.ToLower(queryName)
+	if queryName != zone && !strings.HasSuffix(queryName, "."+zone) {
+		return "", "", false, false
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimSuffix(queryName, zone), "."), ".")
+	if len(parts) != 3 || parts[2] != "svc" || parts[0] == "" || parts[1] == "" {
+		return "", "", true, false
+	}
+	return parts[1], parts[0], true, true
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterdns
+
+import (
+	"context"
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	karmadainformers "github.com/karmada-io/karmada/pkg/generated/informers/externalversions"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func TestResolve(t *testing.T) {
+	resolver := newFakeResolver(t,
+		[]runtime.Object{
+			newCollectedEndpointSlice("member1", "foo", discoveryv1.AddressTypeIPv4, "10.0.0.2", "10.0.0.1"),
+			newCollectedEndpointSlice("member2", "foo", discoveryv1.AddressTypeIPv6, "fd00::1"),
+			newCollectedEndpointSlice("member3", "foo", discoveryv1.AddressTypeIPv4, "10.0.0.3"),
+			newCollectedEndpointSlice("member1", "unhealthy", discoveryv1.AddressTypeIPv4),
+		},
+		[]runtime.Object{
+			newCluster("member1", true),
+			newCluster("member2", true),
+			newCluster("member3", false),
+			newEndpointSliceWork(t, "member1", "bar", "", "10.1.0.1"),
+			newEndpointSliceWork(t, "member2", "bar", "member1", "10.2.0.1"),
+		},
+	)
+
+	tests := []struct {
+		name              string
+		service           string
+		expectedExist     bool
+		expectedAddresses *ServiceAddresses
+	}{
+		{
+			name:          "ServiceImport endpoints in ready clusters",
+			service:       "foo",
+			expectedExist: true,
+			expectedAddresses: &ServiceAddresses{
+				IPv4: []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.2")},
+				IPv6: []netip.Addr{netip.MustParseAddr("fd00::1")},
+			},
+		},
+		{
+			name:          "MultiClusterService endpoints from provider clusters only",
+			service:       "bar",
+			expectedExist: true,
+			expectedAddresses: &ServiceAddresses{
+				IPv4: []netip.Addr{netip.MustParseAddr("10.1.0.1")},
+			},
+		},
+		{
+			name:              "service without healthy endpoints",
+			service:           "unhealthy",
+			expectedExist:     true,
+			expectedAddresses: &ServiceAddresses{},
+		},
+		{
+			name:          "unknown service",
+			service:       "unknown",
+			expectedExist: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addresses, exist, err := resolver.Resolve("default", tt.service)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedExist, exist)
+			assert.Equal(t, tt.expectedAddresses, addresses)
+		})
+	}
+}
+
+func TestParseServiceName(t *testing.T) {
+	tests := []struct {
+		queryName         string
+		expectedNamespace string
+		expectedName      string
+		expectedInZone    bool
+		expectedOK        bool
+	}{
+		{queryName: "foo.default.svc.clusterset.local.", expectedNamespace: "default", expectedName: "foo", expectedInZone: true, expectedOK: true},
+		{queryName: "Foo.Default.SVC.ClusterSet.Local.", expectedNamespace: "default", expectedName: "foo", expectedInZone: true, expectedOK: true},
+		{queryName: "clusterset.local.", expectedInZone: true},
+		{queryName: "default.svc.clusterset.local.", expectedInZone: true},
+		{queryName: "foo.default.pod.clusterset.local.", expectedInZone: true},
+		{queryName: "a.foo.default.svc.clusterset.local.", expectedInZone: true},
+		{queryName: "foo.default.svc.cluster.local."},
+		{queryName: "fooclusterset.local."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.queryName, func(t *testing.T) {
+			namespace, name, inZone, ok := parseServiceName(tt.queryName, DefaultZone)
+			assert.Equal(t, tt.expectedNamespace, namespace)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedInZone, inZone)
+			assert.Equal(t, tt.expectedOK, ok)
+		})
+	}
+}
+
+func newFakeResolver(t *testing.T, kubeObjects, karmadaObjects []runtime.Object) *Resolver {
+	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubefake.NewSimpleClientset(kubeObjects...), 0,
+		informers.WithTweakListOptions(TweakEndpointSliceListOptions))
+	karmadaClient := karmadafake.NewSimpleClientset(karmadaObjects...)
+	karmadaInformerFactory := karmadainformers.NewSharedInformerFactory(karmadaClient, 0)
+	workInformerFactory := karmadainformers.NewSharedInformerFactoryWithOptions(karmadaClient, 0,
+		karmadainformers.WithTweakListOptions(TweakWorkListOptions))
+
+	resolver, err := NewResolver(kubeInformerFactory.Discovery().V1().EndpointSlices(),
+		workInformerFactory.Work().V1alpha1().Works(), karmadaInformerFactory.Cluster().V1alpha1().Clusters())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	kubeInformerFactory.Start(ctx.Done())
+	karmadaInformerFactory.Start(ctx.Done())
+	workInformerFactory.Start(ctx.Done())
+	require.True(t, resolver.WaitForCacheSync(ctx))
+	return resolver
+}
+
+func newCluster(name string, ready bool) *clusterv1alpha1.Cluster {
+	status := metav1.ConditionFalse
+	if ready {
+		status = metav1.ConditionTrue
+	}
+	return &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{
+			{Type: clusterv1alpha1.ClusterConditionReady, Status: status},
+		}},
+	}
+}
+
+func newEndpointSlice(addressType discoveryv1.AddressType, addresses ...string) *discoveryv1.EndpointSlice {
+	eps := &discoveryv1.EndpointSlice{
+		TypeMeta:    metav1.TypeMeta{APIVersion: "discovery.k8s.io/v1", Kind: "EndpointSlice"},
+		AddressType: addressType,
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"10.255.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+		},
+	}
+	for _, address := range addresses {
+		eps.Endpoints = append(eps.Endpoints, discoveryv1.Endpoint{Addresses: []string{address}})
+	}
+	return eps
+}
+
+func newCollectedEndpointSlice(cluster, service string, addressType discoveryv1.AddressType, addresses ...string) *discoveryv1.EndpointSlice {
+	eps := newEndpointSlice(addressType, addresses...)
+	eps.ObjectMeta = metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      names.GenerateEndpointSliceName(service, cluster),
+		Labels: map[string]string{
+			discoveryv1.LabelServiceName: names.GenerateDerivedServiceName(service),
+			discoveryv1.LabelManagedBy:   util.EndpointSliceControllerLabelValue,
+		},
+		Annotations: map[string]string{workv1alpha2.WorkNamespaceAnnotation: names.GenerateExecutionSpaceName(cluster)},
+	}
+	return eps
+}
+
+func newEndpointSliceWork(t *testing.T, cluster, service, provisionCluster string, addresses ...string) *workv1alpha1.Work {
+	eps := newEndpointSlice(discoveryv1.AddressTypeIPv4, addresses...)
+	eps.ObjectMeta = metav1.ObjectMeta{Namespace: "default", Name: service + "-abcde"}
+	raw, err := json.Marshal(eps)
+	require.NoError(t, err)
+
+	work := &workv1alpha1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: names.GenerateExecutionSpaceName(cluster),
+			Name:      names.GenerateWorkName(util.EndpointSliceKind, eps.Name, eps.Namespace),
+			Labels: map[string]string{
+				util.MultiClusterServiceNamespaceLabel: "default",
+				util.MultiClusterServiceNameLabel:      service,
+			},
+		},
+		Spec: workv1alpha1.WorkSpec{Workload: workv1alpha1.WorkloadTemplate{
+			Manifests: []workv1alpha1.Manifest{{RawExtension: runtime.RawExtension{Raw: raw}}},
+		}},
+	}
+	if provisionCluster != "" {
+		work.Annotations = map[string]string{util.EndpointSliceProvisionClusterAnnotation: provisionCluster}
+	}
+	return work
+}
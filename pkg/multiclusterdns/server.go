@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterdns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// maxUDPMessageSize is the maximum size of the DNS message transported over UDP without EDNS0.
+	maxUDPMessageSize = 512
+	// tcpIdleTimeout is the amount of time a TCP connection is kept open waiting for the next query.
+	tcpIdleTimeout = 10 * time.Second
+)
+
+// Server answers the DNS queries of multi-cluster service names over UDP and TCP.
+type Server struct {
+	resolver *Resolver
+	zone     string
+	ttl      uint32
+}
+
+// NewServer creates a Server which serves the zone with records carrying the given TTL.
+func NewServer(resolver *Resolver, zone string, ttl time.Duration) *Server {
+	zone = strings.ToLower(zone)
+	if !strings.HasSuffix(zone, ".") {
+		zone += "."
+	}
+	return &Server{
+		resolver: resolver,
+		zone:     zone,
+		ttl:      uint32(ttl.Seconds()),
+	}
+}
+
+// ListenAndServe serves DNS queries on the address over both UDP and TCP until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context, address string) error {
+	packetConn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		_ = packetConn.Close()
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = packetConn.Close()
+		_ = listener.Close()
+	}()
+
+	klog.Infof("Starting multi-cluster DNS server on %s for zone %s", address, s.zone)
+	errCh := make(chan error, 2)
+	go func() { errCh <- s.serveUDP(packetConn) }()
+	go func() { errCh <- s.serveTCP(listener) }()
+
+	err = <-errCh
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) serveUDP(conn net.PacketConn) error {
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		response, err := s.handle(buf[:n], maxUDPMessageSize)
+		if err != nil {
+			klog.V(4).InfoS("Failed to handle DNS query", "client", addr.String(), "err", err)
+			continue
+		}
+		if _, err = conn.WriteTo(response, addr); err != nil {
+			klog.V(4).InfoS("Failed to write DNS response", "client", addr.String(), "err", err)
+		}
+	}
+}
+
+func (s *Server) serveTCP(listener net.Listener) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveTCPConn(conn)
+		}()
+	}
+}
+
+func (s *Server) serveTCPConn(conn net.Conn) {
+	defer conn.Close()
+	for {
+		_ = conn.SetDeadline(time.Now().Add(tcpIdleTimeout))
+		var length uint16
+		if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+			if !errors.Is(err, io.EOF) {
+				klog.V(4).InfoS("Failed to read DNS query", "client", conn.RemoteAddr().String(), "err", err)
+			}
+			return
+		}
+		query := make([]byte, length)
+		if _, err := io.ReadFull(conn, query); err != nil {
+			klog.V(4).InfoS("Failed to read DNS query", "client", conn.RemoteAddr().String(), "err", err)
+			return
+		}
+		response, err := s.handle(query, 0)
+		if err != nil {
+			klog.V(4).InfoS("Failed to handle DNS query", "client", conn.RemoteAddr().String(), "err", err)
+			return
+		}
+		if err = binary.Write(conn, binary.BigEndian, uint16(len(response))); err != nil {
+			return
+		}
+		if _, err = conn.Write(response); err != nil {
+			return
+		}
+	}
+}
+
+// handle builds the response of the query, the response is truncated if it exceeds the
+// maxSize, zero means no limit.
+func (s *Server) handle(query []byte, maxSize int) ([]byte, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil, err
+	}
+	if header.Response {
+		return nil, errors.New("unexpected response message")
+	}
+
+	responseHeader := dnsmessage.Header{
+		ID:               header.ID,
+		Response:         true,
+		OpCode:           header.OpCode,
+		RecursionDesired: header.RecursionDesired,
+	}
+	if header.OpCode != 0 {
+		responseHeader.RCode = dnsmessage.RCodeNotImplemented
+		return s.buildResponse(responseHeader, nil, nil, maxSize)
+	}
+
+	question, err := parser.Question()
+	if err != nil {
+		responseHeader.RCode = dnsmessage.RCodeFormatError
+		return s.buildResponse(responseHeader, nil, nil, maxSize)
+	}
+
+	rcode, answers := s.answer(question)
+	responseHeader.RCode = rcode
+	responseHeader.Authoritative = rcode != dnsmessage.RCodeRefused && rcode != dnsmessage.RCodeServerFailure
+	dnsRequestsTotal.WithLabelValues(question.Type.String(), rcode.String()).Inc()
+	return s.buildResponse(responseHeader, &question, answers, maxSize)
+}
+
+// answer resolves the question, an empty answer with RCodeSuccess means the name exists but has
+// no healthy endpoints of the requested type.
+func (s *Server) answer(question dnsmessage.Question) (dnsmessage.RCode, []dnsmessage.Resource) {
+	if question.Class != dnsmessage.ClassINET && question.Class != dnsmessage.ClassANY {
+		return dnsmessage.RCodeRefused, nil
+	}
+
+	namespace, name, inZone, ok := parseServiceName(question.Name.String(), s.zone)
+	if !inZone {
+		return dnsmessage.RCodeRefused, nil
+	}
+	if !ok {
+		return dnsmessage.RCodeNameError, nil
+	}
+
+	addresses, exist, err := s.resolver.Resolve(namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to resolve multi-cluster service", "namespace", namespace, "name", name)
+		return dnsmessage.RCodeServerFailure, nil
+	}
+	if !exist {
+		return dnsmessage.RCodeNameError, nil
+	}
+
+	resourceHeader := dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: s.ttl}
+	var answers []dnsmessage.Resource
+	if question.Type == dnsmessage.TypeA || question.Type == dnsmessage.TypeALL {
+		for _, address := range addresses.IPv4 {
+			answers = append(answers, dnsmessage.Resource{Header: resourceHeader, Body: &dnsmessage.AResource{A: address.As4()}})
+		}
+	}
+	if question.Type == dnsmessage.TypeAAAA || question.Type == dnsmessage.TypeALL {
+		for _, address := range addresses.IPv6 {
+			answers = append(answers, dnsmessage.Resource{Header: resourceHeader, Body: &dnsmessage.AAAAResource{AAAA: address.As16()}})
+		}
+	}
+	return dnsmessage.RCodeSuccess, answers
+}
+
+func (s *Server) buildResponse(header dnsmessage.Header, question *dnsmessage.Question, answers []dnsmessage.Resource, maxSize int) ([]byte, error) {
+	for {
+		message := dnsmessage.Message{Header: header, Answers: answers}
+		if question != nil {
+			message.Questions = []dnsmessage.Question{*question}
+		}
+		response, err := message.Pack()
+		if err != nil {
+			return nil, err
+		}
+		if maxSize <= 0 || len(response) <= maxSize || len(answers) == 0 {
+			return response, nil
+		}
+		// Drop the answers until the message fits, the client is expected to retry over TCP.
+		header.Truncated = true
+		answers = answers[:len(answers)/2]
+	}
+}
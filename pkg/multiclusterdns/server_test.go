@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterdns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestServerHandle(t *testing.T) {
+	var addresses []string
+	for i := 1; i <= 40; i++ {
+		addresses = append(addresses, fmt.Sprintf("10.0.0.%d", i))
+	}
+	resolver := newFakeResolver(t,
+		[]runtime.Object{
+			newCollectedEndpointSlice("member1", "foo", discoveryv1.AddressTypeIPv4, "10.0.0.1"),
+			newCollectedEndpointSlice("member1", "many", discoveryv1.AddressTypeIPv4, addresses...),
+		},
+		[]runtime.Object{newCluster("member1", true)},
+	)
+	server := NewServer(resolver, "ClusterSet.Local", 5*time.Second)
+
+	tests := []struct {
+		name              string
+		queryName         string
+		queryType         dnsmessage.Type
+		maxSize           int
+		expectedRCode     dnsmessage.RCode
+		expectedAnswers   int
+		expectedTruncated bool
+	}{
+		{
+			name:            "A record",
+			queryName:       "foo.default.svc.clusterset.local.",
+			queryType:       dnsmessage.TypeA,
+			expectedRCode:   dnsmessage.RCodeSuccess,
+			expectedAnswers: 1,
+		},
+		{
+			name:          "no AAAA record",
+			queryName:     "foo.default.svc.clusterset.local.",
+			queryType:     dnsmessage.TypeAAAA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+		},
+		{
+			name:          "unknown service",
+			queryName:     "bar.default.svc.clusterset.local.",
+			queryType:     dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeNameError,
+		},
+		{
+			name:          "out of zone",
+			queryName:     "foo.default.svc.cluster.local.",
+			queryType:     dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeRefused,
+		},
+		{
+			name:              "truncated over UDP",
+			queryName:         "many.default.svc.clusterset.local.",
+			queryType:         dnsmessage.TypeA,
+			maxSize:           maxUDPMessageSize,
+			expectedRCode:     dnsmessage.RCodeSuccess,
+			expectedAnswers:   20,
+			expectedTruncated: true,
+		},
+		{
+			name:            "not truncated over TCP",
+			queryName:       "many.default.svc.clusterset.local.",
+			queryType:       dnsmessage.TypeA,
+			expectedRCode:   dnsmessage.RCodeSuccess,
+			expectedAnswers: 40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := server.handle(newQuery(t, tt.queryName, tt.queryType), tt.maxSize)
+			require.NoError(t, err)
+
+			message := &dnsmessage.Message{}
+			require.NoError(t, message.Unpack(response))
+			assert.Equal(t, uint16(1), message.Header.ID)
+			assert.True(t, message.Header.Response)
+			assert.Equal(t, tt.expectedRCode, message.Header.RCode)
+			assert.Equal(t, tt.expectedTruncated, message.Header.Truncated)
+			assert.Len(t, message.Answers, tt.expectedAnswers)
+			for _, answer := range message.Answers {
+				assert.Equal(t, uint32(5), answer.Header.TTL)
+			}
+		})
+	}
+}
+
+func TestServeUDP(t *testing.T) {
+	resolver := newFakeResolver(t,
+		[]runtime.Object{newCollectedEndpointSlice("member1", "foo", discoveryv1.AddressTypeIPv4, "10.0.0.1")},
+		[]runtime.Object{newCluster("member1", true)},
+	)
+	server := NewServer(resolver, DefaultZone, 5*time.Second)
+
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer packetConn.Close()
+	go func() { _ = server.serveUDP(packetConn) }()
+
+	conn, err := net.Dial("udp", packetConn.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+	_, err = conn.Write(newQuery(t, "foo.default.svc.clusterset.local.", dnsmessage.TypeA))
+	require.NoError(t, err)
+	buf := make([]byte, maxUDPMessageSize)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+
+	message := &dnsmessage.Message{}
+	require.NoError(t, message.Unpack(buf[:n]))
+	require.Len(t, message.Answers, 1)
+	assert.Equal(t, &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}}, message.Answers[0].Body)
+}
+
+func newQuery(t *testing.T, name string, queryType dnsmessage.Type) []byte {
+	message := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: 1, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  dnsmessage.MustNewName(name),
+			Type:  queryType,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	query, err := message.Pack()
+	require.NoError(t, err)
+	return query
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celvm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/library"
+	"k8s.io/utils/lru"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// VM evaluates the CEL expressions of resource interpreter customizations.
+// The compiled programs are cached, so that an expression is only compiled once.
+type VM struct {
+	programs *lru.Cache
+}
+
+// New creates a manager for CEL VM, which caches at most cacheSize compiled programs.
+func New(cacheSize int) *VM {
+	return &VM{programs: lru.New(cacheSize)}
+}
+
+// program returns the cached program of the expression, or compiles it if not cached.
+func (vm *VM) program(operation configv1alpha1.InterpreterOperation, expression string) (cel.Program, error) {
+	key := string(operation) + "/" + expression
+	if prg, ok := vm.programs.Get(key); ok {
+		return prg.(cel.Program), nil
+	}
+
+	env, err := getEnv(operation)
+	if err != nil {
+		return nil, err
+	}
+	ast, err := compile(env, operation, expression)
+	if err != nil {
+		return nil, err
+	}
+	prg, err := env.Program(ast,
+		cel.EvalOptions(cel.OptOptimize, cel.OptTrackCost),
+		cel.CostTracking(&library.CostEstimator{}),
+		cel.CostLimit(celconfig.PerCallLimit),
+		cel.InterruptCheckFrequency(celconfig.CheckFrequency),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build program of InterpreterOperation(%s): %w", operation, err)
+	}
+	vm.programs.Add(key, prg)
+	return prg, nil
+}
+
+// eval evaluates the expression with the given variables, the evaluation is aborted once the cost limit exceeds.
+func (vm *VM) eval(operation configv1alpha1.InterpreterOperation, expression string, variables map[string]any) (ref.Val, error) {
+	prg, err := vm.program(operation, expression)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := prg.Eval(variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate CEL expression of InterpreterOperation(%s): %w", operation, err)
+	}
+	return val, nil
+}
+
+// GetReplicas returns the desired replicas of the object as well as the requirements of each replica by CEL expression.
+func (vm *VM) GetReplicas(obj *unstructured.Unstructured, expression string) (replica int32, requires *workv1alpha2.ReplicaRequirements, err error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationInterpretReplica, expression, map[string]any{
+		desiredObjVariable: obj.Object,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if val.Type() == types.IntType {
+		replica, err = toInt32(val)
+		return replica, nil, err
+	}
+	result := struct {
+		Replica  int64                             `json:"replica"`
+		Requires *workv1alpha2.ReplicaRequirements `json:"requires,omitempty"`
+	}{}
+	if err = convertResultInto(val, types.MapType, &result); err != nil {
+		return 0, nil, err
+	}
+	if result.Replica < math.MinInt32 || result.Replica > math.MaxInt32 {
+		return 0, nil, fmt.Errorf("the returned replica %d is out of range", result.Replica)
+	}
+	return int32(result.Replica), result.Requires, nil
+}
+
+// GetComponents returns the desired components of the object by CEL expression.
+func (vm *VM) GetComponents(obj *unstructured.Unstructured, expression string) ([]workv1alpha2.Component, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationInterpretComponent, expression, map[string]any{
+		desiredObjVariable: obj.Object,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if val == types.NullValue {
+		return nil, nil
+	}
+
+	var components []workv1alpha2.Component
+	if err = convertResultInto(val, types.ListType, &components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// ReviseReplica revises the replica of the given object by CEL expression.
+func (vm *VM) ReviseReplica(object *unstructured.Unstructured, replica int64, expression string) (*unstructured.Unstructured, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationReviseReplica, expression, map[string]any{
+		desiredObjVariable:     object.Object,
+		desiredReplicaVariable: replica,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergeResult(object, val)
+}
+
+// Retain returns the objects that based on the "desired" object but with values retained from the "observed" object by CEL expression.
+func (vm *VM) Retain(desired *unstructured.Unstructured, observed *unstructured.Unstructured, expression string) (*unstructured.Unstructured, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationRetain, expression, map[string]any{
+		desiredObjVariable:  desired.Object,
+		observedObjVariable: observed.Object,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergeResult(desired, val)
+}
+
+// AggregateStatus returns the objects that based on the 'object' but with status aggregated by CEL expression.
+func (vm *VM) AggregateStatus(object *unstructured.Unstructured, items []workv1alpha2.AggregatedStatusItem, expression string) (*unstructured.Unstructured, error) {
+	// The items are passed as JSON values, so that the fields can be referred in the same way as the Lua script.
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var statusItems []any
+	if err = utiljson.Unmarshal(data, &statusItems); err != nil {
+		return nil, err
+	}
+
+	val, err := vm.eval(configv1alpha1.InterpreterOperationAggregateStatus, expression, map[string]any{
+		desiredObjVariable:  object.Object,
+		statusItemsVariable: statusItems,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergeResult(object, val)
+}
+
+// InterpretHealth returns the health state of the object by CEL expression.
+func (vm *VM) InterpretHealth(object *unstructured.Unstructured, expression string) (bool, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationInterpretHealth, expression, map[string]any{
+		observedObjVariable: object.Object,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	health, ok := val.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("expect the returned type is bool but got %s", val.Type().TypeName())
+	}
+	return bool(health), nil
+}
+
+// InterpretRolloutStatus returns the rollout status of the object by CEL expression.
+func (vm *VM) InterpretRolloutStatus(object *unstructured.Unstructured, expression string) (*workv1alpha2.RolloutStatus, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationInterpretRolloutStatus, expression, map[string]any{
+		observedObjVariable: object.Object,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rolloutStatus := &workv1alpha2.RolloutStatus{}
+	if err = convertResultInto(val, types.MapType, rolloutStatus); err != nil {
+		return nil, err
+	}
+	return rolloutStatus, nil
+}
+
+// ReflectStatus returns the status of the object by CEL expression.
+func (vm *VM) ReflectStatus(object *unstructured.Unstructured, expression string) (*runtime.RawExtension, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationInterpretStatus, expression, map[string]any{
+		observedObjVariable: object.Object,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status := &runtime.RawExtension{}
+	if err = convertResultInto(val, types.MapType, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// GetDependencies returns the dependent resources of the given object by CEL expression.
+func (vm *VM) GetDependencies(object *unstructured.Unstructured, expression string) ([]configv1alpha1.DependentObjectReference, error) {
+	val, err := vm.eval(configv1alpha1.InterpreterOperationInterpretDependency, expression, map[string]any{
+		desiredObjVariable: object.Object,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []configv1alpha1.DependentObjectReference
+	if err = convertResultInto(val, types.ListType, &dependencies); err != nil {
+		return nil, err
+	}
+	return dependencies, nil
+}
+
+// mergeResult merges the returned map into the object as a JSON merge patch, the object is left untouched.
+func mergeResult(object *unstructured.Unstructured, val ref.Val) (*unstructured.Unstructured, error) {
+	if val.Type() != types.MapType {
+		return nil, fmt.Errorf("expect the returned type is map but got %s", val.Type().TypeName())
+	}
+	patch, err := toJSON(val)
+	if err != nil {
+		return nil, err
+	}
+	original, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	merged, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge the returned value into the object: %w", err)
+	}
+
+	result := &unstructured.Unstructured{}
+	if err = result.UnmarshalJSON(merged); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// convertResultInto converts the returned value into the given struct, the value is expected to be of the given type.
+func convertResultInto(val ref.Val, expected ref.Type, into any) error {
+	if val.Type() != expected {
+		return fmt.Errorf("expect the returned type is %s but got %s", expected.TypeName(), val.Type().TypeName())
+	}
+	data, err := toJSON(val)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, into); err != nil {
+		return fmt.Errorf("failed to convert the returned value: %w", err)
+	}
+	return nil
+}
+
+func toInt32(val ref.Val) (int32, error) {
+	i := int64(val.(types.Int))
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return 0, fmt.Errorf("the returned replica %d is out of range", i)
+	}
+	return int32(i), nil
+}
+
+func toJSON(val ref.Val) ([]byte, error) {
+	value, err := toJSONValue(val)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// toJSONValue converts the CEL value into the value which can be marshaled to JSON. Integers are kept as they are,
+// rather than being converted to float.
+func toJSONValue(val ref.Val) (any, error) {
+	switch v := val.(type) {
+	case types.Null:
+		return nil, nil
+	case types.Bool:
+		return bool(v), nil
+	case types.Int:
+		return int64(v), nil
+	case types.Uint:
+		return uint64(v), nil
+	case types.Double:
+		return float64(v), nil
+	case types.String:
+		return string(v), nil
+	case traits.Lister:
+		items := make([]any, 0)
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			item, err := toJSONValue(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case traits.Mapper:
+		fields := make(map[string]any)
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			key := it.Next()
+			name, ok := key.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("expect the key of map is string but got %s", key.Type().TypeName())
+			}
+			field, err := toJSONValue(v.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			fields[string(name)] = field
+		}
+		return fields, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s in the returned value", val.Type().TypeName())
+}
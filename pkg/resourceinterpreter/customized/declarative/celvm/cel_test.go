@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celvm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func newDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"paused":   false,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"serviceAccountName": "nginx",
+					"containers": []any{map[string]any{
+						"name":      "nginx",
+						"resources": map[string]any{"limits": map[string]any{"cpu": "100m"}},
+					}},
+				},
+			},
+		},
+		"status": map[string]any{
+			"readyReplicas": int64(3),
+		},
+	}}
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   configv1alpha1.InterpreterOperation
+		expression  string
+		expectedErr string
+	}{
+		{
+			name:       "health expression",
+			operation:  configv1alpha1.InterpreterOperationInterpretHealth,
+			expression: "observedObj.status.readyReplicas == observedObj.spec.replicas",
+		},
+		{
+			name:       "replica expression returns integer",
+			operation:  configv1alpha1.InterpreterOperationInterpretReplica,
+			expression: "2",
+		},
+		{
+			name:       "replica expression returns map",
+			operation:  configv1alpha1.InterpreterOperationInterpretReplica,
+			expression: `{"replica": desiredObj.spec.replicas, "requires": {"resourceRequest": {"cpu": "1"}}}`,
+		},
+		{
+			name:       "revision expression",
+			operation:  configv1alpha1.InterpreterOperationReviseReplica,
+			expression: `{"spec": {"replicas": desiredReplica}}`,
+		},
+		{
+			name:        "syntax error",
+			operation:   configv1alpha1.InterpreterOperationInterpretHealth,
+			expression:  "observedObj.status.readyReplicas ==",
+			expectedErr: "InterpreterOperation(InterpretHealth) CEL expression error",
+		},
+		{
+			name:        "variable not supplied for the operation",
+			operation:   configv1alpha1.InterpreterOperationInterpretHealth,
+			expression:  "desiredObj.spec.replicas == 1",
+			expectedErr: "undeclared reference to 'desiredObj'",
+		},
+		{
+			name:        "unexpected returned type",
+			operation:   configv1alpha1.InterpreterOperationInterpretHealth,
+			expression:  `"healthy"`,
+			expectedErr: "expect the returned type is one of [bool] but got string",
+		},
+		{
+			name:        "unexpected returned type of list",
+			operation:   configv1alpha1.InterpreterOperationInterpretDependency,
+			expression:  `{"kind": "ConfigMap"}`,
+			expectedErr: "expect the returned type is one of [list(dyn)] but got map(string, string)",
+		},
+		{
+			name:        "operation not supported",
+			operation:   configv1alpha1.InterpreterOperation("Unknown"),
+			expression:  "true",
+			expectedErr: "InterpreterOperation(Unknown) does not support CEL expression",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Compile(tt.operation, tt.expression)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestVM_GetReplicas(t *testing.T) {
+	vm := New(10)
+
+	replica, requires, err := vm.GetReplicas(newDeployment(), "desiredObj.spec.replicas")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), replica)
+	assert.Nil(t, requires)
+
+	replica, requires, err = vm.GetReplicas(newDeployment(),
+		`{"replica": desiredObj.spec.replicas, "requires": {"resourceRequest": desiredObj.spec.template.spec.containers[0].resources.limits}}`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), replica)
+	assert.Equal(t, &workv1alpha2.ReplicaRequirements{
+		ResourceRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+	}, requires)
+
+	_, _, err = vm.GetReplicas(newDeployment(), "desiredObj.spec.paused")
+	assert.ErrorContains(t, err, "expect the returned type is map but got bool")
+
+	_, _, err = vm.GetReplicas(newDeployment(), "desiredObj.spec.notExist")
+	assert.ErrorContains(t, err, "no such key: notExist")
+}
+
+func TestVM_GetComponents(t *testing.T) {
+	vm := New(10)
+
+	components, err := vm.GetComponents(newDeployment(), `[{"name": "nginx", "replicas": desiredObj.spec.replicas}]`)
+	require.NoError(t, err)
+	assert.Equal(t, []workv1alpha2.Component{{Name: "nginx", Replicas: 3}}, components)
+
+	components, err = vm.GetComponents(newDeployment(), "null")
+	require.NoError(t, err)
+	assert.Nil(t, components)
+}
+
+func TestVM_ReviseReplica(t *testing.T) {
+	vm := New(10)
+	obj := newDeployment()
+
+	revised, err := vm.ReviseReplica(obj, 5, `{"spec": {"replicas": desiredReplica}}`)
+	require.NoError(t, err)
+	replicas, _, _ := unstructured.NestedInt64(revised.Object, "spec", "replicas")
+	assert.Equal(t, int64(5), replicas)
+	assert.Equal(t, "nginx", revised.GetName())
+	// the given object is left untouched
+	replicas, _, _ = unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+}
+
+func TestVM_Retain(t *testing.T) {
+	vm := New(10)
+	observed := newDeployment()
+	observed.Object["spec"].(map[string]any)["paused"] = true
+
+	retained, err := vm.Retain(newDeployment(), observed, `{"spec": {"paused": observedObj.spec.paused}, "status": null}`)
+	require.NoError(t, err)
+	paused, _, _ := unstructured.NestedBool(retained.Object, "spec", "paused")
+	assert.True(t, paused)
+	assert.NotContains(t, retained.Object, "status")
+
+	_, err = vm.Retain(newDeployment(), observed, "desiredObj.spec.paused")
+	assert.ErrorContains(t, err, "expect the returned type is map but got bool")
+}
+
+func TestVM_AggregateStatus(t *testing.T) {
+	vm := New(10)
+	items := []workv1alpha2.AggregatedStatusItem{
+		{ClusterName: "member1", Status: &runtime.RawExtension{Raw: []byte(`{"readyReplicas":1}`)}},
+		{ClusterName: "member2", Status: &runtime.RawExtension{Raw: []byte(`{"readyReplicas":2}`)}},
+	}
+
+	aggregated, err := vm.AggregateStatus(newDeployment(), items,
+		`{"status": {"readyReplicas": statusItems.map(item, item.status.readyReplicas).sum(), "clusters": statusItems.map(item, item.clusterName)}}`)
+	require.NoError(t, err)
+	readyReplicas, _, _ := unstructured.NestedInt64(aggregated.Object, "status", "readyReplicas")
+	assert.Equal(t, int64(3), readyReplicas)
+	clusters, _, _ := unstructured.NestedStringSlice(aggregated.Object, "status", "clusters")
+	assert.Equal(t, []string{"member1", "member2"}, clusters)
+}
+
+func TestVM_InterpretHealth(t *testing.T) {
+	vm := New(10)
+
+	healthy, err := vm.InterpretHealth(newDeployment(), "observedObj.status.readyReplicas == observedObj.spec.replicas")
+	require.NoError(t, err)
+	assert.True(t, healthy)
+
+	healthy, err = vm.InterpretHealth(newDeployment(), "has(observedObj.status.unavailableReplicas)")
+	require.NoError(t, err)
+	assert.False(t, healthy)
+
+	_, err = vm.InterpretHealth(newDeployment(), "observedObj.spec.replicas")
+	assert.ErrorContains(t, err, "expect the returned type is bool but got int")
+}
+
+func TestVM_InterpretRolloutStatus(t *testing.T) {
+	vm := New(10)
+
+	rolloutStatus, err := vm.InterpretRolloutStatus(newDeployment(),
+		`{"state": observedObj.status.readyReplicas == observedObj.spec.replicas ? "Done" : "Progressing", `+
+			`"readyReplicas": observedObj.status.readyReplicas}`)
+	require.NoError(t, err)
+	assert.Equal(t, &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutDone, ReadyReplicas: ptr.To[int32](3)}, rolloutStatus)
+
+	_, err = vm.InterpretRolloutStatus(newDeployment(), `"Done"`)
+	assert.ErrorContains(t, err, "expect the returned type is one of [map(string, dyn)] but got string")
+}
+
+func TestVM_ReflectStatus(t *testing.T) {
+	vm := New(10)
+
+	status, err := vm.ReflectStatus(newDeployment(), `{"readyReplicas": observedObj.status.readyReplicas}`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"readyReplicas":3}`, string(status.Raw))
+}
+
+func TestVM_GetDependencies(t *testing.T) {
+	vm := New(10)
+
+	dependencies, err := vm.GetDependencies(newDeployment(), `[{"apiVersion": "v1", "kind": "ServiceAccount", `+
+		`"namespace": desiredObj.metadata.namespace, "name": desiredObj.spec.template.spec.serviceAccountName}]`)
+	require.NoError(t, err)
+	assert.Equal(t, []configv1alpha1.DependentObjectReference{
+		{APIVersion: "v1", Kind: "ServiceAccount", Namespace: "default", Name: "nginx"},
+	}, dependencies)
+}
+
+func TestVM_CostLimit(t *testing.T) {
+	vm := New(10)
+
+	_, err := vm.InterpretHealth(newDeployment(),
+		"[1,2,3,4,5,6,7,8,9,10].all(a, [1,2,3,4,5,6,7,8,9,10].all(b, [1,2,3,4,5,6,7,8,9,10].all(c, "+
+			"[1,2,3,4,5,6,7,8,9,10].all(d, [1,2,3,4,5,6,7,8,9,10].all(e, [1,2,3,4,5,6,7,8,9,10].all(f, a > 0))))))")
+	assert.ErrorContains(t, err, "cost limit exceeded")
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celvm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apiserver/pkg/cel/library"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+const (
+	desiredObjVariable     = "desiredObj"
+	observedObjVariable    = "observedObj"
+	desiredReplicaVariable = "desiredReplica"
+	statusItemsVariable    = "statusItems"
+)
+
+var (
+	objectType = cel.MapType(cel.StringType, cel.DynType)
+
+	// variableTypes holds the types of the variables supplied by the system.
+	variableTypes = map[string]*cel.Type{
+		desiredObjVariable:     objectType,
+		observedObjVariable:    objectType,
+		desiredReplicaVariable: cel.IntType,
+		statusItemsVariable:    cel.ListType(objectType),
+	}
+
+	// operationVariables holds the variables supplied for each operation, which are the same as the parameters
+	// of the function implemented by Lua script.
+	operationVariables = map[configv1alpha1.InterpreterOperation][]string{
+		configv1alpha1.InterpreterOperationRetain:                 {desiredObjVariable, observedObjVariable},
+		configv1alpha1.InterpreterOperationInterpretReplica:       {desiredObjVariable},
+		configv1alpha1.InterpreterOperationInterpretComponent:     {desiredObjVariable},
+		configv1alpha1.InterpreterOperationReviseReplica:          {desiredObjVariable, desiredReplicaVariable},
+		configv1alpha1.InterpreterOperationInterpretStatus:        {observedObjVariable},
+		configv1alpha1.InterpreterOperationAggregateStatus:        {desiredObjVariable, statusItemsVariable},
+		configv1alpha1.InterpreterOperationInterpretHealth:        {observedObjVariable},
+		configv1alpha1.InterpreterOperationInterpretDependency:    {desiredObjVariable},
+		configv1alpha1.InterpreterOperationInterpretRolloutStatus: {observedObjVariable},
+	}
+
+	// operationResultTypes holds the types allowed to be returned for each operation.
+	operationResultTypes = map[configv1alpha1.InterpreterOperation][]*cel.Type{
+		configv1alpha1.InterpreterOperationRetain:                 {objectType},
+		configv1alpha1.InterpreterOperationInterpretReplica:       {cel.IntType, objectType},
+		configv1alpha1.InterpreterOperationInterpretComponent:     {cel.ListType(cel.DynType), cel.NullType},
+		configv1alpha1.InterpreterOperationReviseReplica:          {objectType},
+		configv1alpha1.InterpreterOperationInterpretStatus:        {objectType},
+		configv1alpha1.InterpreterOperationAggregateStatus:        {objectType},
+		configv1alpha1.InterpreterOperationInterpretHealth:        {cel.BoolType},
+		configv1alpha1.InterpreterOperationInterpretDependency:    {cel.ListType(cel.DynType)},
+		configv1alpha1.InterpreterOperationInterpretRolloutStatus: {objectType},
+	}
+
+	envs     map[configv1alpha1.InterpreterOperation]*cel.Env
+	envsErr  error
+	envsOnce sync.Once
+)
+
+// envOptions holds the libraries available in the expressions, which are the ones available in the CEL
+// expressions of Kubernetes. Unlike Kubernetes, the literals of map and list are not required to be homogeneous,
+// since the expressions often build objects.
+func envOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.DefaultUTCTimeZone(true),
+		cel.CrossTypeNumericComparisons(true),
+		cel.OptionalTypes(),
+		ext.Strings(ext.StringsVersion(2)),
+		ext.Sets(),
+		ext.TwoVarComprehensions(),
+		library.URLs(),
+		library.Regex(),
+		library.Lists(),
+		library.Quantity(),
+		cel.ASTValidators(
+			cel.ValidateDurationLiterals(),
+			cel.ValidateTimestampLiterals(),
+			cel.ValidateRegexLiterals(),
+		),
+	}
+}
+
+// getEnv returns the environment of the operation, which declares the variables supplied for the operation.
+func getEnv(operation configv1alpha1.InterpreterOperation) (*cel.Env, error) {
+	envsOnce.Do(func() {
+		envs = make(map[configv1alpha1.InterpreterOperation]*cel.Env, len(operationVariables))
+		for op, variables := range operationVariables {
+			options := envOptions()
+			for _, variable := range variables {
+				options = append(options, cel.Variable(variable, variableTypes[variable]))
+			}
+			env, err := cel.NewEnv(options...)
+			if err != nil {
+				envsErr = fmt.Errorf("failed to build CEL environment of InterpreterOperation(%s): %w", op, err)
+				return
+			}
+			envs[op] = env
+		}
+	})
+	if envsErr != nil {
+		return nil, envsErr
+	}
+	env, ok := envs[operation]
+	if !ok {
+		return nil, fmt.Errorf("InterpreterOperation(%s) does not support CEL expression", operation)
+	}
+	return env, nil
+}
+
+// Compile parses and type-checks the expression of the operation, to make sure that it only refers to the
+// variables supplied for the operation and returns the value of the expected type.
+func Compile(operation configv1alpha1.InterpreterOperation, expression string) error {
+	env, err := getEnv(operation)
+	if err != nil {
+		return err
+	}
+	_, err = compile(env, operation, expression)
+	return err
+}
+
+func compile(env *cel.Env, operation configv1alpha1.InterpreterOperation, expression string) (*cel.Ast, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("InterpreterOperation(%s) CEL expression error: %w", operation, issues.Err())
+	}
+
+	outputType := ast.OutputType()
+	if outputType.Kind() == types.DynKind {
+		return ast, nil
+	}
+	allowed := operationResultTypes[operation]
+	for _, resultType := range allowed {
+		if resultType.IsAssignableType(outputType) {
+			return ast, nil
+		}
+	}
+	typeNames := make([]string, 0, len(allowed))
+	for _, resultType := range allowed {
+		typeNames = append(typeNames, resultType.String())
+	}
+	return nil, fmt.Errorf("InterpreterOperation(%s) CEL expression error: expect the returned type is one of %v but got %s",
+		operation, typeNames, outputType)
+}
@@ -36,23 +36,41 @@ type LuaScriptAccessor interface {
 	GetStatusReflectionLuaScript() string
 	GetStatusAggregationLuaScript() string
 	GetHealthInterpretationLuaScript() string
+	GetRolloutStatusInterpretationLuaScript() string
 	GetDependencyInterpretationLuaScripts() []string
+	GetLuaScriptLimits() *configv1alpha1.LuaScriptLimits
+}
+
+// CELExpressionAccessor provides a common interface to get custom interpreter CEL expression
+type CELExpressionAccessor interface {
+	GetRetentionCELExpression() string
+	GetReplicaResourceCELExpression() string
+	GetComponentResourceCELExpression() string
+	GetReplicaRevisionCELExpression() string
+	GetStatusReflectionCELExpression() string
+	GetStatusAggregationCELExpression() string
+	GetHealthInterpretationCELExpression() string
+	GetRolloutStatusInterpretationCELExpression() string
+	GetDependencyInterpretationCELExpressions() []string
 }
 
 // CustomAccessor provides a common interface to get custom interpreter configuration.
 type CustomAccessor interface {
 	LuaScriptAccessor
+	CELExpressionAccessor
 }
 
 type resourceCustomAccessor struct {
-	retention                 *configv1alpha1.LocalValueRetention
-	replicaResource           *configv1alpha1.ReplicaResourceRequirement
-	componentResource         *configv1alpha1.ComponentResourceRequirement
-	replicaRevision           *configv1alpha1.ReplicaRevision
-	statusReflection          *configv1alpha1.StatusReflection
-	statusAggregation         *configv1alpha1.StatusAggregation
-	healthInterpretation      *configv1alpha1.HealthInterpretation
-	dependencyInterpretations []*configv1alpha1.DependencyInterpretation
+	retention                   *configv1alpha1.LocalValueRetention
+	replicaResource             *configv1alpha1.ReplicaResourceRequirement
+	componentResource           *configv1alpha1.ComponentResourceRequirement
+	replicaRevision             *configv1alpha1.ReplicaRevision
+	statusReflection            *configv1alpha1.StatusReflection
+	statusAggregation           *configv1alpha1.StatusAggregation
+	healthInterpretation        *configv1alpha1.HealthInterpretation
+	rolloutStatusInterpretation *configv1alpha1.RolloutStatusInterpretation
+	dependencyInterpretations   []*configv1alpha1.DependencyInterpretation
+	luaScriptLimits             *configv1alpha1.LuaScriptLimits
 }
 
 // NewResourceCustomAccessor creates an accessor for resource interpreter customization.
@@ -61,6 +79,7 @@ func NewResourceCustomAccessor() CustomAccessor {
 }
 
 // Merge merges the given CustomizationRules with the current rules, ignore if duplicates occur.
+// A rule is considered to be set if either the Lua script or the CEL expression is set.
 func (a *resourceCustomAccessor) Merge(rules configv1alpha1.CustomizationRules) {
 	if rules.Retention != nil {
 		a.setRetain(rules.Retention)
@@ -83,9 +102,15 @@ func (a *resourceCustomAccessor) Merge(rules configv1alpha1.CustomizationRules)
 	if rules.HealthInterpretation != nil {
 		a.setHealthInterpretation(rules.HealthInterpretation)
 	}
+	if rules.RolloutStatusInterpretation != nil {
+		a.setRolloutStatusInterpretation(rules.RolloutStatusInterpretation)
+	}
 	if rules.DependencyInterpretation != nil {
 		a.appendDependencyInterpretation(rules.DependencyInterpretation)
 	}
+	if rules.LuaScriptLimits != nil && a.luaScriptLimits == nil {
+		a.luaScriptLimits = rules.LuaScriptLimits
+	}
 }
 
 func (a *resourceCustomAccessor) GetRetentionLuaScript() string {
@@ -137,6 +162,13 @@ func (a *resourceCustomAccessor) GetHealthInterpretationLuaScript() string {
 	return a.healthInterpretation.LuaScript
 }
 
+func (a *resourceCustomAccessor) GetRolloutStatusInterpretationLuaScript() string {
+	if a.rolloutStatusInterpretation == nil {
+		return ""
+	}
+	return a.rolloutStatusInterpretation.LuaScript
+}
+
 func (a *resourceCustomAccessor) GetDependencyInterpretationLuaScripts() []string {
 	if a.dependencyInterpretations == nil {
 		return nil
@@ -151,14 +183,88 @@ func (a *resourceCustomAccessor) GetDependencyInterpretationLuaScripts() []strin
 	return scripts
 }
 
+func (a *resourceCustomAccessor) GetLuaScriptLimits() *configv1alpha1.LuaScriptLimits {
+	return a.luaScriptLimits
+}
+
+func (a *resourceCustomAccessor) GetRetentionCELExpression() string {
+	if a.retention == nil {
+		return ""
+	}
+	return a.retention.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetReplicaResourceCELExpression() string {
+	if a.replicaResource == nil {
+		return ""
+	}
+	return a.replicaResource.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetComponentResourceCELExpression() string {
+	if a.componentResource == nil {
+		return ""
+	}
+	return a.componentResource.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetReplicaRevisionCELExpression() string {
+	if a.replicaRevision == nil {
+		return ""
+	}
+	return a.replicaRevision.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetStatusReflectionCELExpression() string {
+	if a.statusReflection == nil {
+		return ""
+	}
+	return a.statusReflection.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetStatusAggregationCELExpression() string {
+	if a.statusAggregation == nil {
+		return ""
+	}
+	return a.statusAggregation.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetHealthInterpretationCELExpression() string {
+	if a.healthInterpretation == nil {
+		return ""
+	}
+	return a.healthInterpretation.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetRolloutStatusInterpretationCELExpression() string {
+	if a.rolloutStatusInterpretation == nil {
+		return ""
+	}
+	return a.rolloutStatusInterpretation.CELExpression
+}
+
+func (a *resourceCustomAccessor) GetDependencyInterpretationCELExpressions() []string {
+	if a.dependencyInterpretations == nil {
+		return nil
+	}
+
+	var expressions []string
+	for _, interpretation := range a.dependencyInterpretations {
+		if interpretation.CELExpression != "" {
+			expressions = append(expressions, interpretation.CELExpression)
+		}
+	}
+	return expressions
+}
+
 func (a *resourceCustomAccessor) setRetain(retention *configv1alpha1.LocalValueRetention) {
 	if a.retention == nil {
 		a.retention = retention
 		return
 	}
 
-	if retention.LuaScript != "" && a.retention.LuaScript == "" {
-		a.retention.LuaScript = retention.LuaScript
+	if a.retention.LuaScript == "" && a.retention.CELExpression == "" {
+		a.retention = retention
 	}
 }
 
@@ -168,8 +274,8 @@ func (a *resourceCustomAccessor) setReplicaResource(replicaResource *configv1alp
 		return
 	}
 
-	if replicaResource.LuaScript != "" && a.replicaResource.LuaScript == "" {
-		a.replicaResource.LuaScript = replicaResource.LuaScript
+	if a.replicaResource.LuaScript == "" && a.replicaResource.CELExpression == "" {
+		a.replicaResource = replicaResource
 	}
 }
 
@@ -179,8 +285,8 @@ func (a *resourceCustomAccessor) setComponentResource(componentResource *configv
 		return
 	}
 
-	if componentResource.LuaScript != "" && a.componentResource.LuaScript == "" {
-		a.componentResource.LuaScript = componentResource.LuaScript
+	if a.componentResource.LuaScript == "" && a.componentResource.CELExpression == "" {
+		a.componentResource = componentResource
 	}
 }
 
@@ -190,8 +296,8 @@ func (a *resourceCustomAccessor) setReplicaRevision(replicaRevision *configv1alp
 		return
 	}
 
-	if replicaRevision.LuaScript != "" && a.replicaRevision.LuaScript == "" {
-		a.replicaRevision.LuaScript = replicaRevision.LuaScript
+	if a.replicaRevision.LuaScript == "" && a.replicaRevision.CELExpression == "" {
+		a.replicaRevision = replicaRevision
 	}
 }
 
@@ -201,8 +307,8 @@ func (a *resourceCustomAccessor) setStatusReflection(statusReflection *configv1a
 		return
 	}
 
-	if statusReflection.LuaScript != "" && a.statusReflection.LuaScript == "" {
-		a.statusReflection.LuaScript = statusReflection.LuaScript
+	if a.statusReflection.LuaScript == "" && a.statusReflection.CELExpression == "" {
+		a.statusReflection = statusReflection
 	}
 }
 
@@ -212,8 +318,8 @@ func (a *resourceCustomAccessor) setStatusAggregation(statusAggregation *configv
 		return
 	}
 
-	if statusAggregation.LuaScript != "" && a.statusAggregation.LuaScript == "" {
-		a.statusAggregation.LuaScript = statusAggregation.LuaScript
+	if a.statusAggregation.LuaScript == "" && a.statusAggregation.CELExpression == "" {
+		a.statusAggregation = statusAggregation
 	}
 }
 
@@ -223,8 +329,19 @@ func (a *resourceCustomAccessor) setHealthInterpretation(healthInterpretation *c
 		return
 	}
 
-	if healthInterpretation.LuaScript != "" && a.healthInterpretation.LuaScript == "" {
-		a.healthInterpretation.LuaScript = healthInterpretation.LuaScript
+	if a.healthInterpretation.LuaScript == "" && a.healthInterpretation.CELExpression == "" {
+		a.healthInterpretation = healthInterpretation
+	}
+}
+
+func (a *resourceCustomAccessor) setRolloutStatusInterpretation(rolloutStatusInterpretation *configv1alpha1.RolloutStatusInterpretation) {
+	if a.rolloutStatusInterpretation == nil {
+		a.rolloutStatusInterpretation = rolloutStatusInterpretation
+		return
+	}
+
+	if a.rolloutStatusInterpretation.LuaScript == "" && a.rolloutStatusInterpretation.CELExpression == "" {
+		a.rolloutStatusInterpretation = rolloutStatusInterpretation
 	}
 }
 
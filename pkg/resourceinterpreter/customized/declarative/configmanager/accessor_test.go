@@ -225,6 +225,49 @@ func TestGetHealthInterpretationLuaScript(t *testing.T) {
 	}
 }
 
+func TestGetRolloutStatusInterpretationLuaScript(t *testing.T) {
+	tests := []struct {
+		name     string
+		accessor *resourceCustomAccessor
+		want     string
+	}{
+		{
+			name:     "nil rollout status interpretation",
+			accessor: &resourceCustomAccessor{},
+			want:     "",
+		},
+		{
+			name: "with script",
+			accessor: &resourceCustomAccessor{
+				rolloutStatusInterpretation: &configv1alpha1.RolloutStatusInterpretation{LuaScript: "test-script"},
+			},
+			want: "test-script",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.accessor.GetRolloutStatusInterpretationLuaScript()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetLuaScriptLimits(t *testing.T) {
+	first := &configv1alpha1.LuaScriptLimits{TimeoutMilliseconds: new(int32(500))}
+	second := &configv1alpha1.LuaScriptLimits{MaxInstructions: new(int64(1000))}
+
+	accessor := &resourceCustomAccessor{}
+	assert.Nil(t, accessor.GetLuaScriptLimits())
+
+	accessor.Merge(configv1alpha1.CustomizationRules{})
+	assert.Nil(t, accessor.GetLuaScriptLimits())
+
+	accessor.Merge(configv1alpha1.CustomizationRules{LuaScriptLimits: first})
+	accessor.Merge(configv1alpha1.CustomizationRules{LuaScriptLimits: second})
+	assert.Equal(t, first, accessor.GetLuaScriptLimits(), "duplicated limits should be ignored")
+}
+
 func TestGetDependencyInterpretationLuaScripts(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -264,6 +307,38 @@ func TestGetDependencyInterpretationLuaScripts(t *testing.T) {
 	}
 }
 
+func TestGetCELExpressions(t *testing.T) {
+	accessor := &resourceCustomAccessor{
+		retention:                   &configv1alpha1.LocalValueRetention{CELExpression: "retention"},
+		replicaResource:             &configv1alpha1.ReplicaResourceRequirement{CELExpression: "replicaResource"},
+		componentResource:           &configv1alpha1.ComponentResourceRequirement{CELExpression: "componentResource"},
+		replicaRevision:             &configv1alpha1.ReplicaRevision{CELExpression: "replicaRevision"},
+		statusReflection:            &configv1alpha1.StatusReflection{CELExpression: "statusReflection"},
+		statusAggregation:           &configv1alpha1.StatusAggregation{CELExpression: "statusAggregation"},
+		healthInterpretation:        &configv1alpha1.HealthInterpretation{LuaScript: "healthInterpretation"},
+		rolloutStatusInterpretation: &configv1alpha1.RolloutStatusInterpretation{CELExpression: "rolloutStatusInterpretation"},
+		dependencyInterpretations: []*configv1alpha1.DependencyInterpretation{
+			{CELExpression: "dependency1"},
+			{LuaScript: "dependency2"},
+			{CELExpression: "dependency3"},
+		},
+	}
+	assert.Equal(t, "retention", accessor.GetRetentionCELExpression())
+	assert.Equal(t, "replicaResource", accessor.GetReplicaResourceCELExpression())
+	assert.Equal(t, "componentResource", accessor.GetComponentResourceCELExpression())
+	assert.Equal(t, "replicaRevision", accessor.GetReplicaRevisionCELExpression())
+	assert.Equal(t, "statusReflection", accessor.GetStatusReflectionCELExpression())
+	assert.Equal(t, "statusAggregation", accessor.GetStatusAggregationCELExpression())
+	assert.Equal(t, "", accessor.GetHealthInterpretationCELExpression())
+	assert.Equal(t, "rolloutStatusInterpretation", accessor.GetRolloutStatusInterpretationCELExpression())
+	assert.Equal(t, []string{"dependency1", "dependency3"}, accessor.GetDependencyInterpretationCELExpressions())
+	assert.Equal(t, []string{"dependency2"}, accessor.GetDependencyInterpretationLuaScripts())
+
+	empty := &resourceCustomAccessor{}
+	assert.Equal(t, "", empty.GetRetentionCELExpression())
+	assert.Nil(t, empty.GetDependencyInterpretationCELExpressions())
+}
+
 func TestMerge(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -281,6 +356,30 @@ func TestMerge(t *testing.T) {
 				retention: &configv1alpha1.LocalValueRetention{LuaScript: "script1"},
 			},
 		},
+		{
+			name: "merge health interpretation with existing CEL expression",
+			initial: &resourceCustomAccessor{
+				healthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: "existing"},
+			},
+			rules: configv1alpha1.CustomizationRules{
+				HealthInterpretation: &configv1alpha1.HealthInterpretation{LuaScript: "new-script"},
+			},
+			want: &resourceCustomAccessor{
+				healthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: "existing"},
+			},
+		},
+		{
+			name: "merge health interpretation CEL expression with existing empty rule",
+			initial: &resourceCustomAccessor{
+				healthInterpretation: &configv1alpha1.HealthInterpretation{},
+			},
+			rules: configv1alpha1.CustomizationRules{
+				HealthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: "new-expression"},
+			},
+			want: &resourceCustomAccessor{
+				healthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: "new-expression"},
+			},
+		},
 		{
 			name:    "merge status aggregation into empty accessor",
 			initial: &resourceCustomAccessor{},
@@ -27,6 +27,7 @@ import (
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/celvm"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/configmanager"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/luavm"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
@@ -38,6 +39,7 @@ type ConfigurableInterpreter struct {
 	// configManager caches all ResourceInterpreterCustomizations.
 	configManager configmanager.ConfigManager
 	luaVM         *luavm.VM
+	celVM         *celvm.VM
 }
 
 // NewConfigurableInterpreter builds a new interpreter by registering the
@@ -47,6 +49,7 @@ func NewConfigurableInterpreter(informer genericmanager.SingleClusterInformerMan
 		configManager: configmanager.NewInterpreterConfigManager(informer),
 		// TODO: set an appropriate pool size.
 		luaVM: luavm.New(false, 10),
+		celVM: celvm.New(100),
 	}
 }
 
@@ -58,28 +61,30 @@ func (c *ConfigurableInterpreter) HookEnabled(kind schema.GroupVersionKind, oper
 	}
 
 	if operationType == configv1alpha1.InterpreterOperationInterpretDependency {
-		scripts := accessor.GetDependencyInterpretationLuaScripts()
-		return scripts != nil
+		return accessor.GetDependencyInterpretationLuaScripts() != nil ||
+			accessor.GetDependencyInterpretationCELExpressions() != nil
 	}
 
-	var script string
+	var script, expression string
 	switch operationType {
 	case configv1alpha1.InterpreterOperationAggregateStatus:
-		script = accessor.GetStatusAggregationLuaScript()
+		script, expression = accessor.GetStatusAggregationLuaScript(), accessor.GetStatusAggregationCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretHealth:
-		script = accessor.GetHealthInterpretationLuaScript()
+		script, expression = accessor.GetHealthInterpretationLuaScript(), accessor.GetHealthInterpretationCELExpression()
+	case configv1alpha1.InterpreterOperationInterpretRolloutStatus:
+		script, expression = accessor.GetRolloutStatusInterpretationLuaScript(), accessor.GetRolloutStatusInterpretationCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretReplica:
-		script = accessor.GetReplicaResourceLuaScript()
+		script, expression = accessor.GetReplicaResourceLuaScript(), accessor.GetReplicaResourceCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretComponent:
-		script = accessor.GetComponentResourceLuaScript()
+		script, expression = accessor.GetComponentResourceLuaScript(), accessor.GetComponentResourceCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretStatus:
-		script = accessor.GetStatusReflectionLuaScript()
+		script, expression = accessor.GetStatusReflectionLuaScript(), accessor.GetStatusReflectionCELExpression()
 	case configv1alpha1.InterpreterOperationRetain:
-		script = accessor.GetRetentionLuaScript()
+		script, expression = accessor.GetRetentionLuaScript(), accessor.GetRetentionCELExpression()
 	case configv1alpha1.InterpreterOperationReviseReplica:
-		script = accessor.GetReplicaRevisionLuaScript()
+		script, expression = accessor.GetReplicaRevisionLuaScript(), accessor.GetReplicaRevisionCELExpression()
 	}
-	return len(script) > 0
+	return len(script) > 0 || len(expression) > 0
 }
 
 // GetReplicas returns the desired replicas of the object as well as the requirements of each replica.
@@ -90,14 +95,19 @@ func (c *ConfigurableInterpreter) GetReplicas(object *unstructured.Unstructured)
 	}
 
 	script := accessor.GetReplicaResourceLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetReplicaResourceCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretReplica, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	replicas, requires, err = c.luaVM.GetReplicas(object, script)
+	if len(expression) > 0 {
+		replicas, requires, err = c.celVM.GetReplicas(object, expression)
+		return
+	}
+	replicas, requires, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).GetReplicas(object, script)
 	return
 }
 
@@ -109,14 +119,19 @@ func (c *ConfigurableInterpreter) GetComponents(object *unstructured.Unstructure
 	}
 
 	script := accessor.GetComponentResourceLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetComponentResourceCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretComponent, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	components, err = c.luaVM.GetComponents(object, script)
+	if len(expression) > 0 {
+		components, err = c.celVM.GetComponents(object, expression)
+		return
+	}
+	components, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).GetComponents(object, script)
 	return
 }
 
@@ -128,14 +143,19 @@ func (c *ConfigurableInterpreter) ReviseReplica(object *unstructured.Unstructure
 	}
 
 	script := accessor.GetReplicaRevisionLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetReplicaRevisionCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationReviseReplica, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	revised, err = c.luaVM.ReviseReplica(object, replica, script)
+	if len(expression) > 0 {
+		revised, err = c.celVM.ReviseReplica(object, replica, expression)
+		return
+	}
+	revised, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).ReviseReplica(object, replica, script)
 	return
 }
 
@@ -147,14 +167,19 @@ func (c *ConfigurableInterpreter) Retain(desired *unstructured.Unstructured, obs
 	}
 
 	script := accessor.GetRetentionLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetRetentionCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationRetain, desired.GroupVersionKind(), desired.GetNamespace(), desired.GetName())
-	retained, err = c.luaVM.Retain(desired, observed, script)
+	if len(expression) > 0 {
+		retained, err = c.celVM.Retain(desired, observed, expression)
+		return
+	}
+	retained, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).Retain(desired, observed, script)
 	return
 }
 
@@ -166,14 +191,19 @@ func (c *ConfigurableInterpreter) AggregateStatus(object *unstructured.Unstructu
 	}
 
 	script := accessor.GetStatusAggregationLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetStatusAggregationCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationAggregateStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	status, err = c.luaVM.AggregateStatus(object, aggregatedStatusItems, script)
+	if len(expression) > 0 {
+		status, err = c.celVM.AggregateStatus(object, aggregatedStatusItems, expression)
+		return
+	}
+	status, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).AggregateStatus(object, aggregatedStatusItems, script)
 	return
 }
 
@@ -185,7 +215,8 @@ func (c *ConfigurableInterpreter) GetDependencies(object *unstructured.Unstructu
 	}
 
 	scripts := accessor.GetDependencyInterpretationLuaScripts()
-	if scripts == nil {
+	expressions := accessor.GetDependencyInterpretationCELExpressions()
+	if scripts == nil && expressions == nil {
 		enabled = false
 		return
 	}
@@ -195,7 +226,21 @@ func (c *ConfigurableInterpreter) GetDependencies(object *unstructured.Unstructu
 	refs := sets.New[configv1alpha1.DependentObjectReference]()
 	for _, luaScript := range scripts {
 		var references []configv1alpha1.DependentObjectReference
-		references, err = c.luaVM.GetDependencies(object, luaScript)
+		references, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).GetDependencies(object, luaScript)
+		if err != nil {
+			klog.Errorf("Failed to get DependentObjectReferences from object: %v %s/%s, error: %v",
+				object.GroupVersionKind(), object.GetNamespace(), object.GetName(), err)
+			return
+		}
+		err = validation.VerifyDependencies(references)
+		if err != nil {
+			return
+		}
+		refs.Insert(references...)
+	}
+	for _, expression := range expressions {
+		var references []configv1alpha1.DependentObjectReference
+		references, err = c.celVM.GetDependencies(object, expression)
 		if err != nil {
 			klog.Errorf("Failed to get DependentObjectReferences from object: %v %s/%s, error: %v",
 				object.GroupVersionKind(), object.GetNamespace(), object.GetName(), err)
@@ -233,14 +278,19 @@ func (c *ConfigurableInterpreter) ReflectStatus(object *unstructured.Unstructure
 	}
 
 	script := accessor.GetStatusReflectionLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetStatusReflectionCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	status, err = c.luaVM.ReflectStatus(object, script)
+	if len(expression) > 0 {
+		status, err = c.celVM.ReflectStatus(object, expression)
+		return
+	}
+	status, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).ReflectStatus(object, script)
 	return
 }
 
@@ -252,14 +302,47 @@ func (c *ConfigurableInterpreter) InterpretHealth(object *unstructured.Unstructu
 	}
 
 	script := accessor.GetHealthInterpretationLuaScript()
-	if len(script) == 0 {
+	expression := accessor.GetHealthInterpretationCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretHealth, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	health, err = c.luaVM.InterpretHealth(object, script)
+	if len(expression) > 0 {
+		health, err = c.celVM.InterpretHealth(object, expression)
+		return
+	}
+	health, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).InterpretHealth(object, script)
+	return
+}
+
+// InterpretRolloutStatus returns the rollout status of the object.
+func (c *ConfigurableInterpreter) InterpretRolloutStatus(object *unstructured.Unstructured) (rolloutStatus *workv1alpha2.RolloutStatus, enabled bool, err error) {
+	accessor, enabled := c.getCustomAccessor(object.GroupVersionKind())
+	if !enabled {
+		return
+	}
+
+	script := accessor.GetRolloutStatusInterpretationLuaScript()
+	expression := accessor.GetRolloutStatusInterpretationCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
+		enabled = false
+		return
+	}
+
+	klog.V(4).Infof("Running operation %s for object: %v %s/%s with configurable interpreter.",
+		configv1alpha1.InterpreterOperationInterpretRolloutStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
+	if len(expression) > 0 {
+		rolloutStatus, err = c.celVM.InterpretRolloutStatus(object, expression)
+	} else {
+		rolloutStatus, err = c.luaVM.WithLimits(accessor.GetLuaScriptLimits()).InterpretRolloutStatus(object, script)
+	}
+	if err != nil {
+		return
+	}
+	err = validation.VerifyRolloutStatus(rolloutStatus)
 	return
 }
 
@@ -17,11 +17,19 @@ limitations under the License.
 package luavm
 
 import (
+	"bytes"
+	"encoding/json"
 	"math"
+	"strings"
+	"time"
 
 	lua "github.com/yuin/gopher-lua"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/util/jsonpath"
+	luajson "layeh.com/gopher-json"
 
 	"github.com/karmada-io/karmada/pkg/util/helper"
 )
@@ -49,6 +57,27 @@ const (
 //   - function getPodDependencies(podTemplate, namespace) dependencies
 //     get total dependencies from podTemplate and namespace. Example:
 //     dependencies = kube.getPodDependencies(podTemplate, namespace)
+//   - function getResourceQuantity(quantity) number
+//     get the approximate number of a resource quantity. Example:
+//     memory = kube.getResourceQuantity("1Gi")
+//   - function jsonPath(obj, path) value
+//     get the first value matched by a JSONPath expression, or nil if nothing matches. Example:
+//     image = kube.jsonPath(obj, "{.spec.template.spec.containers[0].image}")
+//   - function compareVersions(v1, v2) result
+//     compare two semantic or generic versions, returns -1, 0 or 1. Example:
+//     if kube.compareVersions(obj.spec.version, "v1.2.0") >= 0 then ... end
+//   - function parseTime(timestamp) seconds
+//     parse an RFC3339 timestamp into seconds since the Unix epoch. Example:
+//     t = kube.parseTime(condition.lastTransitionTime)
+//   - function parseDuration(duration) seconds
+//     parse a duration like "1m30s" into seconds. Example:
+//     timeout = kube.parseDuration(obj.spec.timeout)
+//   - function getCondition(obj, conditionType) condition
+//     get the condition of the type from status.conditions, or nil if not found. Example:
+//     ready = kube.getCondition(obj, "Ready")
+//   - function isConditionTrue(obj, conditionType) bool
+//     tell if the condition of the type in status.conditions has status "True". Example:
+//     healthy = kube.isConditionTrue(obj, "Available")
 func KubeLoader(ls *lua.LState) int {
 	mod := ls.SetFuncs(ls.NewTable(), kubeFuncs)
 	ls.Push(mod)
@@ -60,6 +89,12 @@ var kubeFuncs = map[string]lua.LGFunction{
 	"accuratePodRequirements": accuratePodRequirements,
 	"getPodDependencies":      getPodDependencies,
 	"getResourceQuantity":     getResourceQuantity,
+	"jsonPath":                jsonPathLookup,
+	"compareVersions":         compareVersions,
+	"parseTime":               parseTime,
+	"parseDuration":           parseDuration,
+	"getCondition":            getCondition,
+	"isConditionTrue":         isConditionTrue,
 }
 
 func resourceAdd(ls *lua.LState) int {
@@ -180,3 +215,169 @@ func checkNamespace(ls *lua.LState, n int) string {
 		return ""
 	}
 }
+
+func jsonPathLookup(ls *lua.LState) int {
+	if ls.GetTop() != 2 {
+		ls.RaiseError("jsonPath only accepts two arguments")
+		return 0
+	}
+
+	obj := ls.Get(1)
+	path := ls.CheckString(2)
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+
+	j := jsonpath.New("").AllowMissingKeys(true)
+	if err := j.Parse(path); err != nil {
+		ls.RaiseError("invalid jsonPath %q: %v", path, err)
+		return 0
+	}
+
+	data, err := luajson.Encode(obj)
+	if err != nil {
+		ls.RaiseError("fail to encode lua value %#v to json: %v", obj, err)
+		return 0
+	}
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&value); err != nil {
+		ls.RaiseError("fail to decode json %s: %v", data, err)
+		return 0
+	}
+
+	results, err := j.FindResults(value)
+	if err != nil {
+		ls.RaiseError("fail to find results of jsonPath %q: %v", path, err)
+		return 0
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		ls.Push(lua.LNil)
+		return 1
+	}
+
+	result := results[0][0].Interface()
+	if number, ok := result.(json.Number); ok {
+		f, _ := number.Float64()
+		result = f
+	}
+	retValue, err := decodeValue(ls, result)
+	if err != nil {
+		ls.RaiseError("fail to convert %#v to Lua value: %v", result, err)
+		return 0
+	}
+	ls.Push(retValue)
+	return 1
+}
+
+func compareVersions(ls *lua.LState) int {
+	if ls.GetTop() != 2 {
+		ls.RaiseError("compareVersions only accepts two arguments")
+		return 0
+	}
+
+	s1, s2 := ls.CheckString(1), ls.CheckString(2)
+	v1, err1 := utilversion.ParseSemantic(s1)
+	v2, err2 := utilversion.ParseSemantic(s2)
+	if err1 != nil || err2 != nil {
+		// fall back to generic versions, e.g. "v1.2", if any of them is not a semantic version.
+		if v1, err1 = utilversion.ParseGeneric(s1); err1 != nil {
+			ls.RaiseError("invalid version %q: %v", s1, err1)
+			return 0
+		}
+		if v2, err2 = utilversion.ParseGeneric(s2); err2 != nil {
+			ls.RaiseError("invalid version %q: %v", s2, err2)
+			return 0
+		}
+	}
+
+	switch {
+	case v1.LessThan(v2):
+		ls.Push(lua.LNumber(-1))
+	case v1.EqualTo(v2):
+		ls.Push(lua.LNumber(0))
+	default:
+		ls.Push(lua.LNumber(1))
+	}
+	return 1
+}
+
+func parseTime(ls *lua.LState) int {
+	if ls.GetTop() != 1 {
+		ls.RaiseError("parseTime only accepts one argument")
+		return 0
+	}
+
+	s := ls.CheckString(1)
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		ls.RaiseError("invalid timestamp %q: %v", s, err)
+		return 0
+	}
+	ls.Push(lua.LNumber(float64(t.UnixNano()) / float64(time.Second)))
+	return 1
+}
+
+func parseDuration(ls *lua.LState) int {
+	if ls.GetTop() != 1 {
+		ls.RaiseError("parseDuration only accepts one argument")
+		return 0
+	}
+
+	s := ls.CheckString(1)
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		ls.RaiseError("invalid duration %q: %v", s, err)
+		return 0
+	}
+	ls.Push(lua.LNumber(d.Seconds()))
+	return 1
+}
+
+func getCondition(ls *lua.LState) int {
+	if ls.GetTop() != 2 {
+		ls.RaiseError("getCondition only accepts two arguments")
+		return 0
+	}
+
+	condition := findCondition(ls.CheckTable(1), ls.CheckString(2))
+	if condition == nil {
+		ls.Push(lua.LNil)
+		return 1
+	}
+	ls.Push(condition)
+	return 1
+}
+
+func isConditionTrue(ls *lua.LState) int {
+	if ls.GetTop() != 2 {
+		ls.RaiseError("isConditionTrue only accepts two arguments")
+		return 0
+	}
+
+	condition := findCondition(ls.CheckTable(1), ls.CheckString(2))
+	ls.Push(lua.LBool(condition != nil && condition.RawGetString("status") == lua.LString(metav1.ConditionTrue)))
+	return 1
+}
+
+// findCondition returns the condition of the type in status.conditions of the object.
+func findCondition(obj *lua.LTable, conditionType string) *lua.LTable {
+	status, ok := obj.RawGetString("status").(*lua.LTable)
+	if !ok {
+		return nil
+	}
+	conditions, ok := status.RawGetString("conditions").(*lua.LTable)
+	if !ok {
+		return nil
+	}
+
+	var found *lua.LTable
+	conditions.ForEach(func(_, value lua.LValue) {
+		condition, ok := value.(*lua.LTable)
+		if found == nil && ok && condition.RawGetString("type") == lua.LString(conditionType) {
+			found = condition
+		}
+	})
+	return found
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package luavm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestKubeHelpers(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"version": "v1.2.3",
+			"timeout": "1m30s",
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "app", "image": "nginx:1.25"},
+					},
+				},
+			},
+		},
+		"status": map[string]any{
+			"replicas": int64(3),
+			"conditions": []any{
+				map[string]any{"type": "Available", "status": "True", "lastTransitionTime": "2026-01-02T03:04:05Z"},
+				map[string]any{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		body    string
+		want    any
+		wantErr string
+	}{
+		{
+			name: "jsonPath",
+			body: `return kube.jsonPath(obj, "{.spec.template.spec.containers[0].image}")`,
+			want: "nginx:1.25",
+		},
+		{
+			name: "jsonPath without braces",
+			body: `return kube.jsonPath(obj, ".status.replicas")`,
+			want: float64(3),
+		},
+		{
+			name: "jsonPath with filter",
+			body: `return kube.jsonPath(obj, '{.status.conditions[?(@.type=="Progressing")].reason}')`,
+			want: "ProgressDeadlineExceeded",
+		},
+		{
+			name: "jsonPath returns tables",
+			body: `return kube.jsonPath(obj, "{.spec.template.spec.containers[0]}").name`,
+			want: "app",
+		},
+		{
+			name: "jsonPath of missing keys",
+			body: `return kube.jsonPath(obj, "{.spec.missing.field}") == nil`,
+			want: true,
+		},
+		{
+			name:    "invalid jsonPath",
+			body:    `return kube.jsonPath(obj, "{.spec[")`,
+			wantErr: "invalid jsonPath",
+		},
+		{
+			name: "compareVersions",
+			body: `return kube.compareVersions(obj.spec.version, "v1.10.0")`,
+			want: float64(-1),
+		},
+		{
+			name: "compareVersions of equal versions",
+			body: `return kube.compareVersions("1.2.3", "v1.2.3")`,
+			want: float64(0),
+		},
+		{
+			name: "compareVersions of pre-releases",
+			body: `return kube.compareVersions("v1.2.3", "v1.2.3-rc.1")`,
+			want: float64(1),
+		},
+		{
+			name: "compareVersions of generic versions",
+			body: `return kube.compareVersions("v1.3", "v1.2.3")`,
+			want: float64(1),
+		},
+		{
+			name:    "compareVersions of invalid versions",
+			body:    `return kube.compareVersions("latest", "v1.2.3")`,
+			wantErr: `invalid version "latest"`,
+		},
+		{
+			name: "parseTime",
+			body: `return kube.parseTime(kube.getCondition(obj, "Available").lastTransitionTime)`,
+			want: float64(1767323045),
+		},
+		{
+			name:    "invalid time",
+			body:    `return kube.parseTime("yesterday")`,
+			wantErr: `invalid timestamp "yesterday"`,
+		},
+		{
+			name: "parseDuration",
+			body: `return kube.parseDuration(obj.spec.timeout)`,
+			want: float64(90),
+		},
+		{
+			name:    "invalid duration",
+			body:    `return kube.parseDuration("forever")`,
+			wantErr: `invalid duration "forever"`,
+		},
+		{
+			name: "getCondition",
+			body: `return kube.getCondition(obj, "Progressing").reason`,
+			want: "ProgressDeadlineExceeded",
+		},
+		{
+			name: "getCondition of missing type",
+			body: `return kube.getCondition(obj, "Ready") == nil`,
+			want: true,
+		},
+		{
+			name: "isConditionTrue",
+			body: `return kube.isConditionTrue(obj, "Available")`,
+			want: true,
+		},
+		{
+			name: "isConditionTrue of false condition",
+			body: `return kube.isConditionTrue(obj, "Progressing")`,
+			want: false,
+		},
+		{
+			name: "isConditionTrue without conditions",
+			body: `return kube.isConditionTrue(obj.spec, "Available")`,
+			want: false,
+		},
+	}
+
+	vm := New(false, 1)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script := `local kube = require("kube")
+				function Test(obj)
+					` + tt.body + `
+				end`
+			results, err := vm.RunScript(script, "Test", 1, obj.Object)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var got any
+			switch v := results[0].(type) {
+			case lua.LString:
+				got = string(v)
+			case lua.LNumber:
+				got = float64(v)
+			case lua.LBool:
+				got = bool(v)
+			default:
+				got = v
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package luavm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+// DefaultTimeout is the timeout of running a script if not limited by LuaScriptLimits.
+const DefaultTimeout = time.Second
+
+// The sizes of the stacks of the Lua VM, which bound the memory used by local variables,
+// arguments and nested calls of a script. The stacks start small and grow on demand, so
+// that the lua states kept in the pool only hold the memory their scripts need.
+const (
+	// registrySize is the initial number of values held in the data stack.
+	registrySize = 1024
+	// registryMaxSize is the maximum number of values held in the data stack.
+	registryMaxSize = 5120
+	// callStackSize is the maximum depth of nested calls.
+	callStackSize = 256
+)
+
+// The reasons of script failures, used as the label of metrics.
+const (
+	failureReasonTimeout                  = "Timeout"
+	failureReasonInstructionLimitExceeded = "InstructionLimitExceeded"
+	failureReasonStackOverflow            = "StackOverflow"
+	failureReasonError                    = "Error"
+)
+
+// ErrInstructionLimitExceeded is raised in the script once it executes more instructions than allowed.
+var ErrInstructionLimitExceeded = errors.New("instruction limit exceeded")
+
+// closedChan is returned as the Done channel of contexts whose instruction limit is exceeded.
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// instructionLimitContext counts the instructions executed by the Lua VM.
+// The Lua VM checks the Done channel of its context before executing each instruction,
+// so that the number of Done calls is the number of executed instructions, as long as
+// the context is not used by anything else than the Lua VM.
+type instructionLimitContext struct {
+	context.Context
+	limit int64
+	count atomic.Int64
+}
+
+func (c *instructionLimitContext) Done() <-chan struct{} {
+	if c.count.Add(1) > c.limit {
+		return closedChan
+	}
+	return c.Context.Done()
+}
+
+func (c *instructionLimitContext) Err() error {
+	if c.count.Load() > c.limit {
+		return ErrInstructionLimitExceeded
+	}
+	return c.Context.Err()
+}
+
+// WithLimits returns a VM sharing the pool of vm, which runs scripts within the given limits.
+// A nil limits means running scripts only with the DefaultTimeout.
+// The limits are applied through the context of each run, so the lua states in the pool are shared.
+func (vm *VM) WithLimits(limits *configv1alpha1.LuaScriptLimits) *VM {
+	if limits == vm.limits {
+		return vm
+	}
+	return &VM{
+		UseOpenLibs: vm.UseOpenLibs,
+		Pool:        vm.Pool,
+		limits:      limits,
+	}
+}
+
+// newScriptContext returns the context to run a script within the limits of vm.
+func (vm *VM) newScriptContext() (context.Context, context.CancelFunc) {
+	timeout := DefaultTimeout
+	if vm.limits != nil && vm.limits.TimeoutMilliseconds != nil {
+		timeout = time.Duration(*vm.limits.TimeoutMilliseconds) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	if vm.limits == nil || vm.limits.MaxInstructions == nil {
+		return ctx, cancel
+	}
+	return &instructionLimitContext{Context: ctx, limit: *vm.limits.MaxInstructions}, cancel
+}
+
+// failureReason tells why the script running with ctx failed with err.
+func failureReason(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(ctx.Err(), ErrInstructionLimitExceeded):
+		return failureReasonInstructionLimitExceeded
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return failureReasonTimeout
+	case strings.Contains(err.Error(), "registry overflow"), strings.Contains(err.Error(), "stack overflow"):
+		return failureReasonStackOverflow
+	default:
+		return failureReasonError
+	}
+}
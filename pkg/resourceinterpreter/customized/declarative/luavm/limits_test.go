@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package luavm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+func TestRunScriptWithLimits(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(3)}}}
+	loop := `function InterpretHealth(observedObj)
+				local n = 0
+				while true do
+					n = n + 1
+				end
+				return true
+			end`
+	recursion := `function count(n)
+				local a, b, c, d, e, f, g, h = n, n, n, n, n, n, n, n
+				return count(n + 1) + a
+			end
+			function InterpretHealth(observedObj)
+				return count(1) > 0
+			end`
+	healthyScript := `function InterpretHealth(observedObj)
+				local sum = 0
+				for i = 1, observedObj.spec.replicas do
+					sum = sum + i
+				end
+				return sum == 6
+			end`
+
+	tests := []struct {
+		name    string
+		limits  *configv1alpha1.LuaScriptLimits
+		script  string
+		wantErr string
+	}{
+		{
+			name:    "default timeout",
+			script:  loop,
+			wantErr: "context deadline exceeded",
+		},
+		{
+			name:    "timeout",
+			limits:  &configv1alpha1.LuaScriptLimits{TimeoutMilliseconds: new(int32(10))},
+			script:  loop,
+			wantErr: "context deadline exceeded",
+		},
+		{
+			name:    "instruction limit exceeded",
+			limits:  &configv1alpha1.LuaScriptLimits{MaxInstructions: new(int64(1000))},
+			script:  loop,
+			wantErr: ErrInstructionLimitExceeded.Error(),
+		},
+		{
+			name:    "stack overflow",
+			script:  recursion,
+			wantErr: "overflow",
+		},
+		{
+			name: "within limits",
+			limits: &configv1alpha1.LuaScriptLimits{
+				TimeoutMilliseconds: new(int32(1000)),
+				MaxInstructions:     new(int64(1000)),
+			},
+			script: healthyScript,
+		},
+	}
+
+	vm := New(false, 1)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy, err := vm.WithLimits(tt.limits).InterpretHealth(obj, tt.script)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.True(t, healthy)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	// the pooled lua state is still usable after failures.
+	healthy, err := vm.InterpretHealth(obj, healthyScript)
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+}
+
+func TestRunScriptWithLimitsSharesPool(t *testing.T) {
+	vm := New(false, 1)
+	limitedVM := vm.WithLimits(&configv1alpha1.LuaScriptLimits{MaxInstructions: new(int64(1000))})
+	script := `function InterpretHealth(observedObj)
+				return true
+			end`
+
+	_, err := vm.InterpretHealth(&unstructured.Unstructured{}, script)
+	assert.NoError(t, err)
+	pooled, err := vm.Pool.Get()
+	assert.NoError(t, err)
+	vm.Pool.Put(pooled)
+
+	_, err = limitedVM.InterpretHealth(&unstructured.Unstructured{}, script)
+	assert.NoError(t, err)
+	got, err := vm.Pool.Get()
+	assert.NoError(t, err)
+	assert.Same(t, pooled, got, "the limited VM should run scripts with the pooled lua state")
+	vm.Pool.Put(got)
+}
+
+func TestFailureReason(t *testing.T) {
+	timedOut, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	exceeded := &instructionLimitContext{Context: context.Background(), limit: 0}
+	exceeded.Done()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want string
+	}{
+		{name: "timeout", ctx: timedOut, err: errors.New("context deadline exceeded"), want: failureReasonTimeout},
+		{name: "instruction limit exceeded", ctx: exceeded, err: ErrInstructionLimitExceeded, want: failureReasonInstructionLimitExceeded},
+		{name: "registry overflow", ctx: context.Background(), err: errors.New("registry overflow"), want: failureReasonStackOverflow},
+		{name: "stack overflow", ctx: context.Background(), err: errors.New("stack overflow"), want: failureReasonStackOverflow},
+		{name: "other errors", ctx: context.Background(), err: errors.New("attempt to index a nil value"), want: failureReasonError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, failureReason(tt.ctx, tt.err))
+		})
+	}
+}
+
+func TestInstructionLimitContext(t *testing.T) {
+	ctx := &instructionLimitContext{Context: context.Background(), limit: 2}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("context is done after %d instructions", i+1)
+		default:
+		}
+		assert.NoError(t, ctx.Err())
+	}
+
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatal("context is not done after exceeding the limit")
+	}
+	assert.True(t, errors.Is(ctx.Err(), ErrInstructionLimitExceeded))
+	assert.Equal(t, failureReasonInstructionLimitExceeded, failureReason(ctx, ctx.Err()))
+}
+
+// TestInstructionLimitContextCountsInstructions pins the behavior of the vendored gopher-lua that the Done
+// channel of the context is checked exactly once per executed instruction, which the instruction limit relies on.
+func TestInstructionLimitContextCountsInstructions(t *testing.T) {
+	// The chunk compiles to one LOADK instruction per statement and a trailing RETURN.
+	const statements = 100
+	script := strings.Repeat("local a = 1\n", statements)
+	instructions := int64(statements + 1)
+
+	run := func(limit int64) (*instructionLimitContext, error) {
+		l := lua.NewState(lua.Options{SkipOpenLibs: true})
+		defer l.Close()
+		ctx := &instructionLimitContext{Context: context.Background(), limit: limit}
+		l.SetContext(ctx)
+		return ctx, l.DoString(script)
+	}
+
+	ctx, err := run(instructions)
+	require.NoError(t, err)
+	assert.Equal(t, instructions, ctx.count.Load(), "each executed instruction should check the context exactly once")
+
+	ctx, err = run(instructions - 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ErrInstructionLimitExceeded.Error())
+	assert.Equal(t, instructions, ctx.count.Load(), "the script should be aborted at the instruction exceeding the limit")
+}
+
+func TestWithLimits(t *testing.T) {
+	vm := New(false, 1)
+	assert.Same(t, vm, vm.WithLimits(nil))
+
+	limits := &configv1alpha1.LuaScriptLimits{TimeoutMilliseconds: new(int32(500))}
+	limitedVM := vm.WithLimits(limits)
+	assert.Same(t, vm.Pool, limitedVM.Pool)
+	assert.Same(t, limitedVM, limitedVM.WithLimits(limits))
+
+	ctx, cancel := limitedVM.newScriptContext()
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(500*time.Millisecond), deadline, 100*time.Millisecond)
+
+	limitedVM = vm.WithLimits(&configv1alpha1.LuaScriptLimits{MaxInstructions: new(int64(1000))})
+	ctx, cancel = limitedVM.newScriptContext()
+	defer cancel()
+	_, ok = ctx.(*instructionLimitContext)
+	assert.True(t, ok, "the instructions of the script should be counted")
+}
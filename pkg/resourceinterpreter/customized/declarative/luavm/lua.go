@@ -31,6 +31,7 @@ import (
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/util/fixedpool"
 	lualifted "github.com/karmada-io/karmada/pkg/util/lifted/lua"
 )
@@ -40,6 +41,9 @@ type VM struct {
 	// UseOpenLibs flag to enable open libraries. Libraries are disabled by default while running, but enabled during testing to allow the use of print statements.
 	UseOpenLibs bool
 	Pool        *fixedpool.FixedPool
+
+	// limits bounds the resources consumed by scripts, see WithLimits.
+	limits *configv1alpha1.LuaScriptLimits
 }
 
 // New creates a manager for lua VM
@@ -58,7 +62,11 @@ func New(useOpenLibs bool, poolSize int) *VM {
 // NewLuaState creates a new lua state.
 func (vm *VM) NewLuaState() (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
-		SkipOpenLibs: !vm.UseOpenLibs,
+		SkipOpenLibs:        !vm.UseOpenLibs,
+		RegistrySize:        registrySize,
+		RegistryMaxSize:     registryMaxSize,
+		CallStackSize:       callStackSize,
+		MinimizeStackMemory: true,
 	})
 	// Opens table library to allow access to functions to manipulate tables
 	err := vm.setLib(l)
@@ -71,7 +79,17 @@ func (vm *VM) NewLuaState() (*lua.LState, error) {
 }
 
 // RunScript got a lua vm from pool, and execute script with given arguments.
-func (vm *VM) RunScript(script string, fnName string, nRets int, args ...any) ([]lua.LValue, error) {
+func (vm *VM) RunScript(script string, fnName string, nRets int, args ...any) (rets []lua.LValue, err error) {
+	start := time.Now()
+	ctx, cancel := vm.newScriptContext()
+	defer cancel()
+	defer func() {
+		metrics.ObserveLuaScriptDuration(fnName, err, start)
+		if err != nil {
+			metrics.CountLuaScriptFailure(fnName, failureReason(ctx, err))
+		}
+	}()
+
 	a, err := vm.Pool.Get()
 	if err != nil {
 		return nil, err
@@ -80,9 +98,6 @@ func (vm *VM) RunScript(script string, fnName string, nRets int, args ...any) ([
 
 	l := a.(*lua.LState)
 	l.Pop(l.GetTop())
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
 	l.SetContext(ctx)
 
 	err = l.DoString(script)
@@ -116,7 +131,7 @@ func (vm *VM) RunScript(script string, fnName string, nRets int, args ...any) ([
 	}
 
 	// get rets from stack: [ret1, ret2, ret3 ...]
-	rets := make([]lua.LValue, nRets)
+	rets = make([]lua.LValue, nRets)
 	for i := range rets {
 		rets[i] = l.Get(i + 1)
 	}
@@ -307,6 +322,25 @@ func (vm *VM) InterpretHealth(object *unstructured.Unstructured, script string)
 	return health, nil
 }
 
+// InterpretRolloutStatus returns the rollout status of the object by lua.
+func (vm *VM) InterpretRolloutStatus(object *unstructured.Unstructured, script string) (*workv1alpha2.RolloutStatus, error) {
+	results, err := vm.RunScript(script, "InterpretRolloutStatus", 1, object)
+	if err != nil {
+		return nil, err
+	}
+
+	luaResult := results[0]
+	if luaResult.Type() != lua.LTTable {
+		return nil, fmt.Errorf("expect the returned rollout status type is table but got %s", luaResult.Type())
+	}
+
+	rolloutStatus := &workv1alpha2.RolloutStatus{}
+	if err = ConvertLuaResultInto(luaResult.(*lua.LTable), rolloutStatus); err != nil {
+		return nil, err
+	}
+	return rolloutStatus, nil
+}
+
 // ReflectStatus returns the status of the object by lua.
 func (vm *VM) ReflectStatus(object *unstructured.Unstructured, script string) (status *runtime.RawExtension, err error) {
 	results, err := vm.RunScript(script, "ReflectStatus", 1, object)
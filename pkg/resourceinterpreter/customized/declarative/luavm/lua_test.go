@@ -605,6 +605,74 @@ func TestHealthDeploymentStatus(t *testing.T) {
 	}
 }
 
+func TestInterpretDeploymentRolloutStatus(t *testing.T) {
+	newDeploy := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](3),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+		},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 1, AvailableReplicas: 3}}
+	newObj, _ := helper.ToUnstructured(newDeploy)
+
+	tests := []struct {
+		name        string
+		curObj      *unstructured.Unstructured
+		expected    *workv1alpha2.RolloutStatus
+		expectedErr bool
+		luaScript   string
+	}{
+		{
+			name:   "Test InterpretRolloutStatus",
+			curObj: newObj,
+			expected: &workv1alpha2.RolloutStatus{
+				State:              workv1alpha2.RolloutProgressing,
+				Generation:         2,
+				ObservedGeneration: 2,
+				UpdatedReplicas:    ptr.To[int32](1),
+			},
+			luaScript: `function InterpretRolloutStatus(observedObj)
+							local rolloutStatus = {}
+							rolloutStatus.generation = observedObj.metadata.generation
+							rolloutStatus.observedGeneration = observedObj.status.observedGeneration
+							rolloutStatus.updatedReplicas = observedObj.status.updatedReplicas
+							if observedObj.status.updatedReplicas == observedObj.spec.replicas then
+								rolloutStatus.state = "Done"
+							else
+								rolloutStatus.state = "Progressing"
+							end
+							return rolloutStatus
+						end `,
+		},
+		{
+			name:        "Test InterpretRolloutStatus with unexpected returned type",
+			curObj:      newObj,
+			expectedErr: true,
+			luaScript: `function InterpretRolloutStatus(observedObj)
+							return "Done"
+						end `,
+		},
+	}
+	vm := New(false, 1)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rolloutStatus, err := vm.InterpretRolloutStatus(tt.curObj, tt.luaScript)
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("InterpretRolloutStatus() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+			if !reflect.DeepEqual(rolloutStatus, tt.expected) {
+				t.Errorf("InterpretRolloutStatus() got = %v, want %v", rolloutStatus, tt.expected)
+			}
+		})
+	}
+}
+
 func TestRetainDeployment(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/configmanager"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/request"
+)
+
+const (
+	// defaultBatchWindow is how long calls are collected before they are sent in one batch request,
+	// if the hook does not set BatchWindowMilliseconds.
+	defaultBatchWindow = 10 * time.Millisecond
+	// defaultBatchTimeout is the timeout of batch requests for webhooks without TimeoutSeconds.
+	defaultBatchTimeout = 10 * time.Second
+)
+
+// batchableOperations are the operations which can be sent to webhooks in batch requests.
+var batchableOperations = sets.New(
+	configv1alpha1.InterpreterOperationInterpretStatus,
+	configv1alpha1.InterpreterOperationInterpretHealth,
+)
+
+// shouldBatch tells if the operation should be sent to the hook in batch requests.
+func shouldBatch(hook configmanager.WebhookAccessor, operation configv1alpha1.InterpreterOperation) bool {
+	maxBatchSize := hook.GetMaxBatchSize()
+	return maxBatchSize != nil && *maxBatchSize > 1 && batchableOperations.Has(operation)
+}
+
+// sendBatchFunc sends all attributes to the hook in one request, and returns the response
+// and the error for each of the attributes in order.
+type sendBatchFunc func(ctx context.Context, hook configmanager.WebhookAccessor, attributes []*request.Attributes) ([]*request.ResponseAttributes, []error)
+
+type batchKey struct {
+	hook      configmanager.WebhookAccessor
+	operation configv1alpha1.InterpreterOperation
+}
+
+// batchCall is a call waiting for the batch request it belongs to.
+type batchCall struct {
+	ctx        context.Context
+	attributes *request.Attributes
+	response   *request.ResponseAttributes
+	err        error
+	done       chan struct{}
+}
+
+// pendingBatch holds the calls collected for a hook and operation.
+type pendingBatch struct {
+	calls []*batchCall
+	timer *time.Timer
+}
+
+// batcher coalesces concurrent calls to the same hook and operation into batch requests.
+// A batch request is sent once the batch is full or the batch window of the hook elapses
+// since the first call of the batch.
+type batcher struct {
+	send sendBatchFunc
+
+	lock    sync.Mutex
+	pending map[batchKey]*pendingBatch
+}
+
+func newBatcher(send sendBatchFunc) *batcher {
+	return &batcher{
+		send:    send,
+		pending: make(map[batchKey]*pendingBatch),
+	}
+}
+
+// call adds the attributes to the pending batch of the hook and waits for the response.
+func (b *batcher) call(ctx context.Context, hook configmanager.WebhookAccessor, attributes *request.Attributes) (*request.ResponseAttributes, error) {
+	c := &batchCall{ctx: ctx, attributes: attributes, done: make(chan struct{})}
+	key := batchKey{hook: hook, operation: attributes.Operation}
+
+	b.lock.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &pendingBatch{}
+		b.pending[key] = batch
+		batch.timer = time.AfterFunc(batchWindow(hook), func() { b.flush(key, batch) })
+	}
+	batch.calls = append(batch.calls, c)
+	full := len(batch.calls) >= int(*hook.GetMaxBatchSize())
+	if full {
+		// The batch is detached under the lock so that no more calls are added to it.
+		delete(b.pending, key)
+		batch.timer.Stop()
+	}
+	b.lock.Unlock()
+
+	if full {
+		go b.sendBatch(key.hook, batch)
+	}
+
+	select {
+	case <-c.done:
+		return c.response, c.err
+	case <-ctx.Done():
+		// parent context is canceled or timed out, the response of the batch is dropped.
+		return nil, apierrors.NewTimeoutError("request did not complete within requested timeout", 0)
+	}
+}
+
+// flush sends the batch if it has not been sent because of being full.
+func (b *batcher) flush(key batchKey, batch *pendingBatch) {
+	b.lock.Lock()
+	if b.pending[key] != batch {
+		b.lock.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.lock.Unlock()
+
+	b.sendBatch(key.hook, batch)
+}
+
+func (b *batcher) sendBatch(hook configmanager.WebhookAccessor, batch *pendingBatch) {
+	attributes := make([]*request.Attributes, len(batch.calls))
+	for i, c := range batch.calls {
+		attributes[i] = c.attributes
+	}
+
+	ctx, cancel := batchContext(batch.calls)
+	defer cancel()
+	responses, errs := b.send(ctx, hook, attributes)
+	for i, c := range batch.calls {
+		c.response, c.err = responses[i], errs[i]
+		close(c.done)
+	}
+}
+
+// batchWindow returns how long the calls to the hook are collected before they are sent in one batch request.
+func batchWindow(hook configmanager.WebhookAccessor) time.Duration {
+	if window := hook.GetBatchWindowMilliseconds(); window != nil && *window > 0 {
+		return time.Duration(*window) * time.Millisecond
+	}
+	return defaultBatchWindow
+}
+
+// batchContext returns the context of the batch request for the calls. The batch is shared by
+// callers with different contexts, so the deadline is the earliest one of the callers, and the
+// request is further bound by the timeout of the hook.
+func batchContext(calls []*batchCall) (context.Context, context.CancelFunc) {
+	var deadline time.Time
+	for _, c := range calls {
+		if d, ok := c.ctx.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+			deadline = d
+		}
+	}
+	if deadline.IsZero() {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithDeadline(context.Background(), deadline)
+}
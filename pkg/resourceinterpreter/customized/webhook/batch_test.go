@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/configmanager"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/request"
+)
+
+func newHealthAttributes(name string) *request.Attributes {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	obj.SetUID(types.UID(name + "-uid"))
+	obj.SetResourceVersion("1")
+	return &request.Attributes{
+		Operation: configv1alpha1.InterpreterOperationInterpretHealth,
+		Object:    obj,
+	}
+}
+
+// recordingSender records the size of each batch and reports objects named "healthy" as healthy.
+type recordingSender struct {
+	lock  sync.Mutex
+	sizes []int
+	block chan struct{}
+}
+
+func (s *recordingSender) send(_ context.Context, _ configmanager.WebhookAccessor, attributes []*request.Attributes) ([]*request.ResponseAttributes, []error) {
+	if s.block != nil {
+		<-s.block
+	}
+	s.lock.Lock()
+	s.sizes = append(s.sizes, len(attributes))
+	s.lock.Unlock()
+
+	responses := make([]*request.ResponseAttributes, len(attributes))
+	errs := make([]error, len(attributes))
+	for i, attr := range attributes {
+		responses[i] = &request.ResponseAttributes{Successful: true, Healthy: attr.Object.GetName() == "healthy"}
+	}
+	return responses, errs
+}
+
+func TestBatcher_Call(t *testing.T) {
+	tests := []struct {
+		name         string
+		window       int32
+		maxBatchSize int32
+		calls        int
+		wantSizes    []int
+	}{
+		{
+			name:         "flush when the window elapses",
+			window:       50,
+			maxBatchSize: 10,
+			calls:        3,
+			wantSizes:    []int{3},
+		},
+		{
+			name:         "flush when the batch is full",
+			window:       3600 * 1000,
+			maxBatchSize: 2,
+			calls:        4,
+			wantSizes:    []int{2, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &recordingSender{}
+			b := newBatcher(sender.send)
+			hook := &mockWebhookAccessor{uid: "test-hook", maxBatchSize: new(tt.maxBatchSize), batchWindowMilliseconds: new(tt.window)}
+
+			var wg sync.WaitGroup
+			for i := 0; i < tt.calls; i++ {
+				name := "healthy"
+				if i%2 == 1 {
+					name = "unhealthy"
+				}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					response, err := b.call(context.Background(), hook, newHealthAttributes(name))
+					assert.NoError(t, err)
+					assert.Equal(t, name == "healthy", response.Healthy)
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, tt.wantSizes, sender.sizes)
+			assert.Empty(t, b.pending)
+		})
+	}
+}
+
+func TestBatchWindow(t *testing.T) {
+	assert.Equal(t, defaultBatchWindow, batchWindow(&mockWebhookAccessor{}))
+	assert.Equal(t, 50*time.Millisecond, batchWindow(&mockWebhookAccessor{batchWindowMilliseconds: new(int32(50))}))
+}
+
+func TestBatchContext(t *testing.T) {
+	noDeadline := &batchCall{ctx: context.Background()}
+	ctx, cancel := batchContext([]*batchCall{noDeadline})
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok, "batch without caller deadline should be bound by the timeout of the hook only")
+
+	earliest := time.Now().Add(time.Second)
+	earlyCtx, earlyCancel := context.WithDeadline(context.Background(), earliest)
+	defer earlyCancel()
+	lateCtx, lateCancel := context.WithDeadline(context.Background(), earliest.Add(time.Minute))
+	defer lateCancel()
+	ctx, cancel = batchContext([]*batchCall{{ctx: lateCtx}, noDeadline, {ctx: earlyCtx}})
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, earliest, deadline, "batch should be bound by the earliest caller deadline")
+}
+
+func TestBatcher_CallWithContextCanceled(t *testing.T) {
+	sender := &recordingSender{block: make(chan struct{})}
+	defer close(sender.block)
+	b := newBatcher(sender.send)
+	hook := &mockWebhookAccessor{uid: "test-hook", maxBatchSize: new(int32(10)), batchWindowMilliseconds: new(int32(1))}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := b.call(ctx, hook, newHealthAttributes("healthy"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "request did not complete within requested timeout")
+}
+
+func TestShouldBatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxBatchSize *int32
+		operation    configv1alpha1.InterpreterOperation
+		want         bool
+	}{
+		{
+			name:      "batch is not enabled",
+			operation: configv1alpha1.InterpreterOperationInterpretHealth,
+			want:      false,
+		},
+		{
+			name:         "max batch size is 1",
+			maxBatchSize: new(int32(1)),
+			operation:    configv1alpha1.InterpreterOperationInterpretHealth,
+			want:         false,
+		},
+		{
+			name:         "operation can't be batched",
+			maxBatchSize: new(int32(10)),
+			operation:    configv1alpha1.InterpreterOperationInterpretReplica,
+			want:         false,
+		},
+		{
+			name:         "InterpretHealth",
+			maxBatchSize: new(int32(10)),
+			operation:    configv1alpha1.InterpreterOperationInterpretHealth,
+			want:         true,
+		},
+		{
+			name:         "InterpretStatus",
+			maxBatchSize: new(int32(10)),
+			operation:    configv1alpha1.InterpreterOperationInterpretStatus,
+			want:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := &mockWebhookAccessor{maxBatchSize: tt.maxBatchSize}
+			assert.Equal(t, tt.want, shouldBatch(hook, tt.operation))
+		})
+	}
+}
+
+// newTestRESTClient returns a REST client talking to the server in the same way as the webhook client manager.
+func newTestRESTClient(t *testing.T, url string) *rest.RESTClient {
+	scheme := runtime.NewScheme()
+	require.NoError(t, configv1alpha1.Install(scheme))
+	gv := schema.GroupVersion{Group: configv1alpha1.GroupVersion.Group, Version: configv1alpha1.GroupVersion.Version}
+	client, err := rest.RESTClientFor(&rest.Config{
+		Host: url,
+		ContentConfig: rest.ContentConfig{
+			GroupVersion: &gv,
+			NegotiatedSerializer: serializer.NegotiatedSerializerWrapper(runtime.SerializerInfo{
+				Serializer: serializer.NewCodecFactory(scheme).LegacyCodec(gv),
+			}),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestCustomizedInterpreter_InterpretHealthInBatch(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		interpreterContext := configv1alpha1.ResourceInterpreterContext{}
+		if err := json.NewDecoder(r.Body).Decode(&interpreterContext); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var responses []configv1alpha1.ResourceInterpreterResponse
+		for _, req := range interpreterContext.Requests {
+			if req.Name == "failed" {
+				responses = append(responses, configv1alpha1.ResourceInterpreterResponse{
+					UID:        req.UID,
+					Successful: false,
+					Status:     &configv1alpha1.RequestStatus{Code: http.StatusInternalServerError, Message: "mock failure"},
+				})
+				continue
+			}
+			responses = append(responses, configv1alpha1.ResourceInterpreterResponse{
+				UID:        req.UID,
+				Successful: true,
+				Healthy:    new(req.Name == "healthy"),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(configv1alpha1.ResourceInterpreterContext{Responses: responses})
+	}))
+	defer server.Close()
+
+	hook := &mockWebhookAccessor{
+		uid: "test-hook",
+		rules: []configv1alpha1.RuleWithOperations{
+			{
+				Operations: []configv1alpha1.InterpreterOperation{configv1alpha1.InterpreterOperationInterpretHealth},
+				Rule: configv1alpha1.Rule{
+					APIGroups:   []string{"apps"},
+					APIVersions: []string{"v1"},
+					Kinds:       []string{"Deployment"},
+				},
+			},
+		},
+		contextVersions:         []string{"v1alpha1"},
+		maxBatchSize:            new(int32(3)),
+		batchWindowMilliseconds: new(int32(3600 * 1000)),
+		cacheTTLSeconds:         new(int32(60)),
+		restClient:              newTestRESTClient(t, server.URL),
+	}
+	interpreter := &CustomizedInterpreter{
+		hookManager: &mockConfigManager{hasSynced: true, hooks: []configmanager.WebhookAccessor{hook}},
+		resultCache: newResultCache(defaultResultCacheSize),
+	}
+	interpreter.batcher = newBatcher(interpreter.callBatchHook)
+
+	names := []string{"healthy", "unhealthy", "failed"}
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			healthy, matched, err := interpreter.InterpretHealth(context.Background(), newHealthAttributes(name))
+			assert.True(t, matched)
+			if name == "failed" {
+				assert.ErrorContains(t, err, "mock failure")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, name == "healthy", healthy)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), requests.Load(), "all calls should be sent in one request")
+
+	// the results of the same objects are served from the cache
+	for _, name := range []string{"healthy", "unhealthy"} {
+		healthy, matched, err := interpreter.InterpretHealth(context.Background(), newHealthAttributes(name))
+		assert.True(t, matched)
+		assert.NoError(t, err)
+		assert.Equal(t, name == "healthy", healthy, fmt.Sprintf("unexpected result of %s", name))
+	}
+	assert.Equal(t, int32(1), requests.Load(), "cached results should not be requested again")
+}
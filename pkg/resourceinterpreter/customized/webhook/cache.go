@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/metrics"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/configmanager"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/request"
+)
+
+// defaultResultCacheSize is the maximum number of results kept in the result cache.
+const defaultResultCacheSize = 10000
+
+// cacheableOperations are the operations whose result only depends on the interpreted object,
+// so that the result can be reused for the same revision of the object.
+var cacheableOperations = sets.New(
+	configv1alpha1.InterpreterOperationInterpretStatus,
+	configv1alpha1.InterpreterOperationInterpretHealth,
+	configv1alpha1.InterpreterOperationInterpretRolloutStatus,
+)
+
+type resultCacheKey struct {
+	// hook is the accessor of the webhook. Accessors are rebuilt once webhook configurations
+	// change, so results returned by an outdated configuration are never served again and
+	// will be evicted in the end.
+	hook            configmanager.WebhookAccessor
+	uid             types.UID
+	resourceVersion string
+	operation       configv1alpha1.InterpreterOperation
+}
+
+// resultCache caches the responses of webhooks which enable caching by CacheTTLSeconds.
+type resultCache struct {
+	cache *utilcache.LRUExpireCache
+}
+
+func newResultCache(maxSize int) *resultCache {
+	return &resultCache{cache: utilcache.NewLRUExpireCache(maxSize)}
+}
+
+// get returns the cached response of the webhook for the attributes.
+func (c *resultCache) get(hook configmanager.WebhookAccessor, attributes *request.Attributes) (*request.ResponseAttributes, bool) {
+	if c == nil {
+		return nil, false
+	}
+	key, _, ok := newResultCacheKey(hook, attributes)
+	if !ok {
+		return nil, false
+	}
+
+	value, hit := c.cache.Get(key)
+	metrics.CountInterpreterWebhookCacheLookup(hook.GetUID(), string(attributes.Operation), hit)
+	if !hit {
+		return nil, false
+	}
+	return copyResponse(value.(*request.ResponseAttributes)), true
+}
+
+// add caches the response of the webhook for the attributes if it is cacheable.
+func (c *resultCache) add(hook configmanager.WebhookAccessor, attributes *request.Attributes, response *request.ResponseAttributes) {
+	if c == nil {
+		return
+	}
+	key, ttl, ok := newResultCacheKey(hook, attributes)
+	if !ok {
+		return
+	}
+	c.cache.Add(key, copyResponse(response), ttl)
+}
+
+func newResultCacheKey(hook configmanager.WebhookAccessor, attributes *request.Attributes) (resultCacheKey, time.Duration, bool) {
+	ttlSeconds := hook.GetCacheTTLSeconds()
+	if ttlSeconds == nil || *ttlSeconds <= 0 || !cacheableOperations.Has(attributes.Operation) {
+		return resultCacheKey{}, 0, false
+	}
+	// Objects which are not persisted, e.g. built in memory, can't be identified by revision.
+	uid, resourceVersion := attributes.Object.GetUID(), attributes.Object.GetResourceVersion()
+	if uid == "" || resourceVersion == "" {
+		return resultCacheKey{}, 0, false
+	}
+
+	return resultCacheKey{
+		hook:            hook,
+		uid:             uid,
+		resourceVersion: resourceVersion,
+		operation:       attributes.Operation,
+	}, time.Duration(*ttlSeconds) * time.Second, true
+}
+
+// copyResponse returns a copy of the response, so that the cached response is
+// not affected by callers. Only the fields of cacheableOperations are deep copied.
+func copyResponse(response *request.ResponseAttributes) *request.ResponseAttributes {
+	out := *response
+	out.RawStatus = *response.RawStatus.DeepCopy()
+	out.RolloutStatus = response.RolloutStatus.DeepCopy()
+	return &out
+}
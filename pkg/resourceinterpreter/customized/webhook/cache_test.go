@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/request"
+)
+
+func TestResultCache(t *testing.T) {
+	cachedHook := &mockWebhookAccessor{uid: "cached", cacheTTLSeconds: new(int32(60))}
+	response := &request.ResponseAttributes{
+		Successful: true,
+		Healthy:    true,
+		RawStatus:  runtime.RawExtension{Raw: []byte(`{"readyReplicas":1}`)},
+	}
+
+	tests := []struct {
+		name       string
+		hook       *mockWebhookAccessor
+		attributes func() *request.Attributes
+		wantHit    bool
+	}{
+		{
+			name:       "cached",
+			hook:       cachedHook,
+			attributes: func() *request.Attributes { return newHealthAttributes("foo") },
+			wantHit:    true,
+		},
+		{
+			name: "cache is not enabled",
+			hook: &mockWebhookAccessor{uid: "not-cached"},
+			attributes: func() *request.Attributes {
+				return newHealthAttributes("foo")
+			},
+			wantHit: false,
+		},
+		{
+			name: "operation is not cacheable",
+			hook: cachedHook,
+			attributes: func() *request.Attributes {
+				attributes := newHealthAttributes("foo")
+				attributes.Operation = configv1alpha1.InterpreterOperationInterpretReplica
+				return attributes
+			},
+			wantHit: false,
+		},
+		{
+			name: "object without resourceVersion",
+			hook: cachedHook,
+			attributes: func() *request.Attributes {
+				attributes := newHealthAttributes("foo")
+				attributes.Object.SetResourceVersion("")
+				return attributes
+			},
+			wantHit: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newResultCache(10)
+			c.add(tt.hook, tt.attributes(), response)
+
+			got, hit := c.get(tt.hook, tt.attributes())
+			assert.Equal(t, tt.wantHit, hit)
+			if hit {
+				assert.Equal(t, response, got)
+			}
+		})
+	}
+}
+
+func TestResultCache_KeyedByRevisionAndHook(t *testing.T) {
+	hook := &mockWebhookAccessor{uid: "cached", cacheTTLSeconds: new(int32(60))}
+	c := newResultCache(10)
+	c.add(hook, newHealthAttributes("foo"), &request.ResponseAttributes{Successful: true, Healthy: true})
+
+	updated := newHealthAttributes("foo")
+	updated.Object.SetResourceVersion("2")
+	_, hit := c.get(hook, updated)
+	assert.False(t, hit, "result of another revision should not be served")
+
+	// accessors are rebuilt when configurations change
+	rebuiltHook := &mockWebhookAccessor{uid: "cached", cacheTTLSeconds: new(int32(60))}
+	_, hit = c.get(rebuiltHook, newHealthAttributes("foo"))
+	assert.False(t, hit, "result of outdated configuration should not be served")
+
+	_, hit = c.get(hook, newHealthAttributes("foo"))
+	assert.True(t, hit)
+}
+
+func TestResultCache_ReturnsCopy(t *testing.T) {
+	hook := &mockWebhookAccessor{uid: "cached", cacheTTLSeconds: new(int32(60))}
+	attributes := newHealthAttributes("foo")
+	attributes.Operation = configv1alpha1.InterpreterOperationInterpretRolloutStatus
+
+	c := newResultCache(10)
+	c.add(hook, attributes, &request.ResponseAttributes{
+		Successful:    true,
+		RolloutStatus: &workv1alpha2.RolloutStatus{ObservedGeneration: 1},
+	})
+
+	got, hit := c.get(hook, attributes)
+	assert.True(t, hit)
+	got.RolloutStatus.ObservedGeneration = 2
+
+	got, hit = c.get(hook, attributes)
+	assert.True(t, hit)
+	assert.Equal(t, int64(1), got.RolloutStatus.ObservedGeneration)
+}
+
+func TestResultCache_Nil(t *testing.T) {
+	var c *resultCache
+	hook := &mockWebhookAccessor{uid: "cached", cacheTTLSeconds: new(int32(60))}
+	c.add(hook, newHealthAttributes("foo"), &request.ResponseAttributes{})
+	_, hit := c.get(hook, newHealthAttributes("foo"))
+	assert.False(t, hit)
+}
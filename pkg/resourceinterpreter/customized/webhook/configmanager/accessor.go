@@ -42,6 +42,12 @@ type WebhookAccessor interface {
 	GetTimeoutSeconds() *int32
 	// GetInterpreterContextVersions gets the webhook InterpreterContextVersions field.
 	GetInterpreterContextVersions() []string
+	// GetMaxBatchSize gets the webhook MaxBatchSize field.
+	GetMaxBatchSize() *int32
+	// GetBatchWindowMilliseconds gets the webhook BatchWindowMilliseconds field.
+	GetBatchWindowMilliseconds() *int32
+	// GetCacheTTLSeconds gets the webhook CacheTTLSeconds field.
+	GetCacheTTLSeconds() *int32
 
 	// GetRESTClient gets the webhook client.
 	GetRESTClient(clientManager *webhookutil.ClientManager) (*rest.RESTClient, error)
@@ -97,6 +103,21 @@ func (a *resourceExploringAccessor) GetInterpreterContextVersions() []string {
 	return a.InterpreterContextVersions
 }
 
+// GetMaxBatchSize gets the webhook MaxBatchSize field.
+func (a *resourceExploringAccessor) GetMaxBatchSize() *int32 {
+	return a.MaxBatchSize
+}
+
+// GetBatchWindowMilliseconds gets the webhook BatchWindowMilliseconds field.
+func (a *resourceExploringAccessor) GetBatchWindowMilliseconds() *int32 {
+	return a.BatchWindowMilliseconds
+}
+
+// GetCacheTTLSeconds gets the webhook CacheTTLSeconds field.
+func (a *resourceExploringAccessor) GetCacheTTLSeconds() *int32 {
+	return a.CacheTTLSeconds
+}
+
 // GetRESTClient gets the webhook client.
 func (a *resourceExploringAccessor) GetRESTClient(clientManager *webhookutil.ClientManager) (*rest.RESTClient, error) {
 	a.initClient.Do(func() {
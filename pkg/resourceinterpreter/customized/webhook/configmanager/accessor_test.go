@@ -76,6 +76,9 @@ func TestResourceExploringAccessor_Getters(t *testing.T) {
 				},
 				TimeoutSeconds:             &timeoutSeconds,
 				InterpreterContextVersions: []string{"v1", "v2"},
+				MaxBatchSize:               new(int32(50)),
+				BatchWindowMilliseconds:    new(int32(20)),
+				CacheTTLSeconds:            new(int32(30)),
 			},
 		},
 	}
@@ -94,6 +97,9 @@ func TestResourceExploringAccessor_Getters(t *testing.T) {
 			assert.Equal(t, tc.webhook.Rules, accessor.GetRules(), "rules should match")
 			assert.Equal(t, tc.webhook.TimeoutSeconds, accessor.GetTimeoutSeconds(), "timeout seconds should match")
 			assert.Equal(t, tc.webhook.InterpreterContextVersions, accessor.GetInterpreterContextVersions(), "interpreter context versions should match")
+			assert.Equal(t, tc.webhook.MaxBatchSize, accessor.GetMaxBatchSize(), "max batch size should match")
+			assert.Equal(t, tc.webhook.BatchWindowMilliseconds, accessor.GetBatchWindowMilliseconds(), "batch window milliseconds should match")
+			assert.Equal(t, tc.webhook.CacheTTLSeconds, accessor.GetCacheTTLSeconds(), "cache ttl seconds should match")
 		})
 	}
 }
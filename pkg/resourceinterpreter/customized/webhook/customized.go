@@ -35,6 +35,7 @@ import (
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/metrics"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/configmanager"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/request"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
@@ -47,6 +48,10 @@ type CustomizedInterpreter struct {
 	hookManager configmanager.ConfigManager
 	// clientManager builds REST clients to talk to webhooks.
 	clientManager *webhookutil.ClientManager
+	// resultCache caches results of webhooks which enable caching.
+	resultCache *resultCache
+	// batcher coalesces calls to webhooks which accept batch requests.
+	batcher *batcher
 }
 
 // NewCustomizedInterpreter return a new CustomizedInterpreter.
@@ -71,10 +76,13 @@ func NewCustomizedInterpreter(informer genericmanager.SingleClusterInformerManag
 	cm.SetAuthenticationInfoResolver(authInfoResolver)
 	cm.SetServiceResolver(NewServiceResolver(serviceLister))
 
-	return &CustomizedInterpreter{
+	e := &CustomizedInterpreter{
 		hookManager:   configmanager.NewExploreConfigManager(informer),
 		clientManager: &cm,
-	}, nil
+		resultCache:   newResultCache(defaultResultCacheSize),
+	}
+	e.batcher = newBatcher(e.callBatchHook)
+	return e, nil
 }
 
 // HookEnabled tells if any hook exist for specific resource gvk and operation type.
@@ -174,6 +182,10 @@ func (e *CustomizedInterpreter) interpret(ctx context.Context, attributes *reque
 		return nil, false, nil
 	}
 
+	if response, ok := e.resultCache.get(hook, attributes); ok {
+		return response, true, nil
+	}
+
 	// Check if the request has already timed out before spawning remote calls
 	select {
 	case <-ctx.Done():
@@ -189,7 +201,11 @@ func (e *CustomizedInterpreter) interpret(ctx context.Context, attributes *reque
 	var callErr error
 	go func(hook configmanager.WebhookAccessor) {
 		defer wg.Done()
-		response, callErr = e.callHook(ctx, hook, attributes)
+		if e.batcher != nil && shouldBatch(hook, attributes.Operation) {
+			response, callErr = e.batcher.call(ctx, hook, attributes)
+		} else {
+			response, callErr = e.callHook(ctx, hook, attributes)
+		}
 		if callErr != nil {
 			klog.Warningf("Failed calling webhook %v: %v", hook.GetUID(), callErr)
 			callErr = apierrors.NewInternalError(callErr)
@@ -204,6 +220,7 @@ func (e *CustomizedInterpreter) interpret(ctx context.Context, attributes *reque
 	if response == nil {
 		return nil, true, apierrors.NewInternalError(fmt.Errorf("get nil response from webhook call"))
 	}
+	e.resultCache.add(hook, attributes, response)
 	return response, true, nil
 }
 
@@ -230,22 +247,117 @@ func (e *CustomizedInterpreter) callHook(ctx context.Context, hook configmanager
 		}
 	}
 
-	client, err := hook.GetRESTClient(e.clientManager)
+	trace := utiltrace.New("Call resource interpret webhook",
+		utiltrace.Field{Key: "configuration", Value: hook.GetConfigurationName()},
+		utiltrace.Field{Key: "webhook", Value: hook.GetName()},
+		utiltrace.Field{Key: "kind", Value: attributes.Object.GroupVersionKind()},
+		utiltrace.Field{Key: "operation", Value: attributes.Operation},
+		utiltrace.Field{Key: "UID", Value: uid})
+	defer trace.LogIfLong(500 * time.Millisecond)
+
+	response, err := e.doRequest(ctx, hook, req)
+	if err != nil {
+		return nil, err
+	}
+	trace.Step("Request completed")
+
+	var res *request.ResponseAttributes
+	res, err = request.VerifyResourceInterpreterContext(uid, attributes.Operation, response)
 	if err != nil {
 		return nil, &webhookutil.ErrCallingWebhook{
 			WebhookName: hook.GetUID(),
-			Reason:      fmt.Errorf("could not get REST client: %w", err),
+			Reason:      fmt.Errorf("received invalid webhook response: %w", err),
 		}
 	}
 
-	trace := utiltrace.New("Call resource interpret webhook",
+	if !res.Successful {
+		return nil, &webhookutil.ErrCallingWebhook{
+			WebhookName: hook.GetUID(),
+			Reason:      fmt.Errorf("webhook call failed, get status code: %d, msg: %s", res.Status.Code, res.Status.Message),
+		}
+	}
+
+	return res, nil
+}
+
+// callBatchHook sends all attributes, which have the same operation, to the hook in one request.
+// It returns the response and the error for each of the attributes in order.
+func (e *CustomizedInterpreter) callBatchHook(ctx context.Context, hook configmanager.WebhookAccessor, attributes []*request.Attributes) ([]*request.ResponseAttributes, []error) {
+	responses := make([]*request.ResponseAttributes, len(attributes))
+	errs := make([]error, len(attributes))
+	failAll := func(err error) ([]*request.ResponseAttributes, []error) {
+		for i := range errs {
+			errs[i] = err
+		}
+		return responses, errs
+	}
+
+	operation := attributes[0].Operation
+	uids, req, err := request.CreateResourceInterpreterBatchContext(hook.GetInterpreterContextVersions(), attributes)
+	if err != nil {
+		return failAll(&webhookutil.ErrCallingWebhook{
+			WebhookName: hook.GetUID(),
+			Reason:      fmt.Errorf("could not create ResourceInterpreterContext objects: %w", err),
+		})
+	}
+	metrics.ObserveInterpreterWebhookBatchSize(hook.GetUID(), string(operation), len(attributes))
+
+	trace := utiltrace.New("Call resource interpret webhook in batch",
 		utiltrace.Field{Key: "configuration", Value: hook.GetConfigurationName()},
 		utiltrace.Field{Key: "webhook", Value: hook.GetName()},
-		utiltrace.Field{Key: "kind", Value: attributes.Object.GroupVersionKind()},
-		utiltrace.Field{Key: "operation", Value: attributes.Operation},
-		utiltrace.Field{Key: "UID", Value: uid})
+		utiltrace.Field{Key: "operation", Value: operation},
+		utiltrace.Field{Key: "size", Value: len(attributes)})
 	defer trace.LogIfLong(500 * time.Millisecond)
 
+	if hook.GetTimeoutSeconds() == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultBatchTimeout)
+		defer cancel()
+	}
+
+	response, err := e.doRequest(ctx, hook, req)
+	if err != nil {
+		return failAll(err)
+	}
+	trace.Step("Request completed")
+
+	results, resultErrs, err := request.VerifyResourceInterpreterBatchContext(uids, operation, response)
+	if err != nil {
+		return failAll(&webhookutil.ErrCallingWebhook{
+			WebhookName: hook.GetUID(),
+			Reason:      fmt.Errorf("received invalid webhook response: %w", err),
+		})
+	}
+
+	for i, res := range results {
+		switch {
+		case resultErrs[i] != nil:
+			errs[i] = &webhookutil.ErrCallingWebhook{
+				WebhookName: hook.GetUID(),
+				Reason:      fmt.Errorf("received invalid webhook response: %w", resultErrs[i]),
+			}
+		case !res.Successful:
+			errs[i] = &webhookutil.ErrCallingWebhook{
+				WebhookName: hook.GetUID(),
+				Reason:      fmt.Errorf("webhook call failed, get status code: %d, msg: %s", res.Status.Code, res.Status.Message),
+			}
+		default:
+			responses[i] = res
+		}
+	}
+	return responses, errs
+}
+
+// doRequest posts the ResourceInterpreterContext to the hook and returns the ResourceInterpreterContext in reply.
+func (e *CustomizedInterpreter) doRequest(ctx context.Context, hook configmanager.WebhookAccessor, req runtime.Object) (*configv1alpha1.ResourceInterpreterContext, error) {
+	client, err := hook.GetRESTClient(e.clientManager)
+	if err != nil {
+		return nil, &webhookutil.ErrCallingWebhook{
+			WebhookName: hook.GetUID(),
+			Reason:      fmt.Errorf("could not get REST client: %w", err),
+		}
+	}
+
 	// if the webhook has a specific timeout, wrap the context to apply it
 	if hook.GetTimeoutSeconds() != nil {
 		var cancel context.CancelFunc
@@ -274,25 +386,7 @@ func (e *CustomizedInterpreter) callHook(ctx context.Context, hook configmanager
 			Reason:      fmt.Errorf("failed to call webhook: %w", err),
 		}
 	}
-	trace.Step("Request completed")
-
-	var res *request.ResponseAttributes
-	res, err = request.VerifyResourceInterpreterContext(uid, attributes.Operation, response)
-	if err != nil {
-		return nil, &webhookutil.ErrCallingWebhook{
-			WebhookName: hook.GetUID(),
-			Reason:      fmt.Errorf("received invalid webhook response: %w", err),
-		}
-	}
-
-	if !res.Successful {
-		return nil, &webhookutil.ErrCallingWebhook{
-			WebhookName: hook.GetUID(),
-			Reason:      fmt.Errorf("webhook call failed, get status code: %d, msg: %s", res.Status.Code, res.Status.Message),
-		}
-	}
-
-	return res, nil
+	return response, nil
 }
 
 // applyPatch uses patchType mode to patch object.
@@ -381,6 +475,23 @@ func (e *CustomizedInterpreter) InterpretHealth(ctx context.Context, attributes
 	return response.Healthy, matched, nil
 }
 
+// InterpretRolloutStatus returns the rollout status of the object.
+// It also returns a matched value to indicate whether there is a matching hook.
+func (e *CustomizedInterpreter) InterpretRolloutStatus(ctx context.Context, attributes *request.Attributes) (rolloutStatus *workv1alpha2.RolloutStatus, matched bool, err error) {
+	var response *request.ResponseAttributes
+	response, matched, err = e.interpret(ctx, attributes)
+	if err != nil {
+		return
+	}
+	if !matched {
+		return
+	}
+
+	klog.V(4).Infof("Running operation %s for object: %v %s/%s with webhook interpreter.",
+		attributes.Operation, attributes.Object.GroupVersionKind(), attributes.Object.GetNamespace(), attributes.Object.GetName())
+	return response.RolloutStatus, matched, nil
+}
+
 // LoadConfig loads the webhook configurations.
 func (e *CustomizedInterpreter) LoadConfig(webhookConfigurations []*configv1alpha1.ResourceInterpreterWebhookConfiguration) {
 	e.hookManager.LoadConfig(webhookConfigurations)
@@ -1171,12 +1171,16 @@ func (m *mockConfigManager) LoadConfig(_ []*configv1alpha1.ResourceInterpreterWe
 
 // mockWebhookAccessor implements configmanager.WebhookAccessor interface for testing
 type mockWebhookAccessor struct {
-	uid             string
-	name            string
-	configName      string
-	rules           []configv1alpha1.RuleWithOperations
-	timeoutSeconds  *int32
-	contextVersions []string
+	uid                     string
+	name                    string
+	configName              string
+	rules                   []configv1alpha1.RuleWithOperations
+	timeoutSeconds          *int32
+	contextVersions         []string
+	maxBatchSize            *int32
+	batchWindowMilliseconds *int32
+	cacheTTLSeconds         *int32
+	restClient              *rest.RESTClient
 }
 
 func (m *mockWebhookAccessor) GetUID() string                                { return m.uid }
@@ -1185,11 +1189,14 @@ func (m *mockWebhookAccessor) GetConfigurationName() string                  { r
 func (m *mockWebhookAccessor) GetRules() []configv1alpha1.RuleWithOperations { return m.rules }
 func (m *mockWebhookAccessor) GetTimeoutSeconds() *int32                     { return m.timeoutSeconds }
 func (m *mockWebhookAccessor) GetInterpreterContextVersions() []string       { return m.contextVersions }
+func (m *mockWebhookAccessor) GetMaxBatchSize() *int32                       { return m.maxBatchSize }
+func (m *mockWebhookAccessor) GetBatchWindowMilliseconds() *int32            { return m.batchWindowMilliseconds }
+func (m *mockWebhookAccessor) GetCacheTTLSeconds() *int32                    { return m.cacheTTLSeconds }
 func (m *mockWebhookAccessor) GetClientConfig() admissionregistrationv1.WebhookClientConfig {
 	return admissionregistrationv1.WebhookClientConfig{
 		URL: new("https://test-webhook"),
 	}
 }
 func (m *mockWebhookAccessor) GetRESTClient(_ *webhookutil.ClientManager) (*rest.RESTClient, error) {
-	return nil, nil
+	return m.restClient, nil
 }
@@ -45,4 +45,5 @@ type ResponseAttributes struct {
 	RawStatus           runtime.RawExtension
 	Healthy             bool
 	Components          []workv1alpha2.Component
+	RolloutStatus       *workv1alpha2.RolloutStatus
 }
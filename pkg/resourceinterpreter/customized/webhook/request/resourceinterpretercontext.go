@@ -71,6 +71,30 @@ func CreateV1alpha1ResourceInterpreterContext(uid types.UID, attributes *Attribu
 	return r
 }
 
+// CreateResourceInterpreterBatchContext returns the unique uid of each request, the ResourceInterpreterContext object
+// containing all requests to send the webhook, or an error if the webhook does not support receiving any of the
+// versions we know to send.
+func CreateResourceInterpreterBatchContext(versions []string, attributes []*Attributes) (uids []types.UID, request runtime.Object, err error) {
+	for _, version := range versions {
+		switch version {
+		case configv1alpha1.GroupVersion.Version:
+			uids = make([]types.UID, len(attributes))
+			r := &configv1alpha1.ResourceInterpreterContext{
+				Requests: make([]configv1alpha1.ResourceInterpreterRequest, len(attributes)),
+			}
+			for i := range attributes {
+				uids[i] = uuid.NewUUID()
+				r.Requests[i] = *CreateV1alpha1ResourceInterpreterContext(uids[i], attributes[i]).Request
+			}
+			request = r
+			return
+		}
+	}
+
+	err = fmt.Errorf("webhook does not accept known ResourceInterpreterContext versions (v1alpha1)")
+	return
+}
+
 // VerifyResourceInterpreterContext checks the validity of the provided resourceInterpreterContext, and returns ResponseAttributes,
 // or an error if the provided resourceInterpreterContext was not valid.
 func VerifyResourceInterpreterContext(uid types.UID, operation configv1alpha1.InterpreterOperation, interpreterContext runtime.Object) (response *ResponseAttributes, err error) {
@@ -94,6 +118,36 @@ func VerifyResourceInterpreterContext(uid types.UID, operation configv1alpha1.In
 	}
 }
 
+// VerifyResourceInterpreterBatchContext checks the validity of the provided batch resourceInterpreterContext, and returns
+// ResponseAttributes in the same order as the uids. The returned error is not nil if the whole resourceInterpreterContext
+// was not valid, otherwise errs holds the error for each individual response which was absent or not valid.
+func VerifyResourceInterpreterBatchContext(uids []types.UID, operation configv1alpha1.InterpreterOperation, interpreterContext runtime.Object) (responses []*ResponseAttributes, errs []error, err error) {
+	r, ok := interpreterContext.(*configv1alpha1.ResourceInterpreterContext)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected response type %T", interpreterContext)
+	}
+	if len(r.Responses) == 0 {
+		return nil, nil, fmt.Errorf("webhook responses were absent")
+	}
+
+	responseByUID := make(map[types.UID]*configv1alpha1.ResourceInterpreterResponse, len(r.Responses))
+	for i := range r.Responses {
+		responseByUID[r.Responses[i].UID] = &r.Responses[i]
+	}
+
+	responses = make([]*ResponseAttributes, len(uids))
+	errs = make([]error, len(uids))
+	for i, uid := range uids {
+		response, ok := responseByUID[uid]
+		if !ok {
+			errs[i] = fmt.Errorf("webhook response for uid %q was absent", uid)
+			continue
+		}
+		responses[i], errs[i] = verifyResourceInterpreterContext(operation, response)
+	}
+	return responses, errs, nil
+}
+
 func verifyResourceInterpreterContext(operation configv1alpha1.InterpreterOperation, response *configv1alpha1.ResourceInterpreterResponse) (*ResponseAttributes, error) {
 	res := &ResponseAttributes{}
 
@@ -147,6 +201,13 @@ func verifyResourceInterpreterContext(operation configv1alpha1.InterpreterOperat
 		}
 		res.Healthy = *response.Healthy
 		return res, nil
+	case configv1alpha1.InterpreterOperationInterpretRolloutStatus:
+		err := validation.VerifyRolloutStatus(response.RolloutStatus)
+		if err != nil {
+			return nil, err
+		}
+		res.RolloutStatus = response.RolloutStatus
+		return res, nil
 	default:
 		return nil, fmt.Errorf("input wrong operation type: %s", operation)
 	}
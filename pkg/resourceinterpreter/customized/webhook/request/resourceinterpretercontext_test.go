@@ -315,6 +315,126 @@ func TestVerifyResourceInterpreterContext(t *testing.T) {
 	}
 }
 
+func TestCreateResourceInterpreterBatchContext(t *testing.T) {
+	newAttributes := func(name string) *Attributes {
+		return &Attributes{
+			Object: &unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata": map[string]any{
+						"name":      name,
+						"namespace": "default",
+					},
+				},
+			},
+			Operation: configv1alpha1.InterpreterOperationInterpretHealth,
+		}
+	}
+	attributes := []*Attributes{newAttributes("foo"), newAttributes("bar")}
+
+	t.Run("valid v1alpha1 version", func(t *testing.T) {
+		uids, request, err := CreateResourceInterpreterBatchContext([]string{"v2", "v1alpha1"}, attributes)
+		require.NoError(t, err)
+		require.Len(t, uids, 2)
+		assert.NotEqual(t, uids[0], uids[1])
+
+		ctx, ok := request.(*configv1alpha1.ResourceInterpreterContext)
+		require.True(t, ok, "request should be of type *ResourceInterpreterContext")
+		assert.Nil(t, ctx.Request)
+		require.Len(t, ctx.Requests, 2)
+		for i, name := range []string{"foo", "bar"} {
+			assert.Equal(t, uids[i], ctx.Requests[i].UID)
+			assert.Equal(t, name, ctx.Requests[i].Name)
+			assert.Equal(t, "default", ctx.Requests[i].Namespace)
+			assert.Equal(t, configv1alpha1.InterpreterOperationInterpretHealth, ctx.Requests[i].Operation)
+		}
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		uids, request, err := CreateResourceInterpreterBatchContext([]string{"v2"}, attributes)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not accept known ResourceInterpreterContext versions")
+		assert.Nil(t, uids)
+		assert.Nil(t, request)
+	})
+}
+
+func TestVerifyResourceInterpreterBatchContext(t *testing.T) {
+	uids := []types.UID{"uid-1", "uid-2", "uid-3"}
+	operation := configv1alpha1.InterpreterOperationInterpretHealth
+
+	tests := []struct {
+		name          string
+		context       runtime.Object
+		wantError     bool
+		wantHealthy   []bool
+		wantItemError []bool
+	}{
+		{
+			name: "responses in different order",
+			context: &configv1alpha1.ResourceInterpreterContext{
+				Responses: []configv1alpha1.ResourceInterpreterResponse{
+					{UID: "uid-3", Successful: true, Healthy: ptr.To(true)},
+					{UID: "uid-1", Successful: true, Healthy: ptr.To(true)},
+					{UID: "uid-2", Successful: true, Healthy: ptr.To(false)},
+				},
+			},
+			wantHealthy:   []bool{true, false, true},
+			wantItemError: []bool{false, false, false},
+		},
+		{
+			name: "absent and invalid responses",
+			context: &configv1alpha1.ResourceInterpreterContext{
+				Responses: []configv1alpha1.ResourceInterpreterResponse{
+					{UID: "uid-1", Successful: true, Healthy: ptr.To(true)},
+					{UID: "uid-2", Successful: true},
+				},
+			},
+			wantHealthy:   []bool{true, false, false},
+			wantItemError: []bool{false, true, true},
+		},
+		{
+			name: "missing responses",
+			context: &configv1alpha1.ResourceInterpreterContext{
+				Response: &configv1alpha1.ResourceInterpreterResponse{UID: "uid-1", Successful: true, Healthy: ptr.To(true)},
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid context type",
+			context: &unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "InvalidType",
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses, errs, err := VerifyResourceInterpreterBatchContext(uids, operation, tt.context)
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, responses, len(uids))
+			require.Len(t, errs, len(uids))
+			for i := range uids {
+				if tt.wantItemError[i] {
+					assert.Error(t, errs[i])
+					continue
+				}
+				assert.NoError(t, errs[i])
+				assert.Equal(t, tt.wantHealthy[i], responses[i].Healthy)
+			}
+		})
+	}
+}
+
 func TestVerifyResourceInterpreterContextByOperation(t *testing.T) {
 	const (
 		testUID        = "test-uid"
@@ -461,6 +581,30 @@ func TestVerifyResourceInterpreterContextByOperation(t *testing.T) {
 				assert.True(t, attr.Healthy)
 			},
 		},
+		{
+			name:      "interpret rollout status with valid response",
+			operation: configv1alpha1.InterpreterOperationInterpretRolloutStatus,
+			response: &configv1alpha1.ResourceInterpreterResponse{
+				UID:           types.UID(testUID),
+				Successful:    true,
+				RolloutStatus: &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutProgressing, UpdatedReplicas: ptr.To[int32](1)},
+			},
+			checkFunc: func(t *testing.T, attr *ResponseAttributes) {
+				require.NotNil(t, attr.RolloutStatus)
+				assert.Equal(t, workv1alpha2.RolloutProgressing, attr.RolloutStatus.State)
+				assert.Equal(t, int32(1), *attr.RolloutStatus.UpdatedReplicas)
+			},
+		},
+		{
+			name:      "interpret rollout status without rollout status",
+			operation: configv1alpha1.InterpreterOperationInterpretRolloutStatus,
+			response: &configv1alpha1.ResourceInterpreterResponse{
+				UID:        types.UID(testUID),
+				Successful: true,
+			},
+			wantError:     true,
+			errorContains: "missing required rolloutStatus",
+		},
 		{
 			name:      "prune operation with valid patch",
 			operation: configv1alpha1.InterpreterOperationPrune,
@@ -23,10 +23,12 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/util/interpreter/validation"
 )
 
@@ -40,6 +42,11 @@ type DefaultInterpreter struct {
 	dependenciesHandlers    map[schema.GroupVersionKind]dependenciesInterpreter
 	reflectStatusHandlers   map[schema.GroupVersionKind]reflectStatusInterpreter
 	healthHandlers          map[schema.GroupVersionKind]healthInterpreter
+	rolloutStatusHandlers   map[schema.GroupVersionKind]rolloutStatusInterpreter
+
+	// resourceClaimTemplateLister lists the ResourceClaimTemplates referenced by pod resource claims,
+	// it is nil if the Dynamic Resource Allocation API is not served by the control plane.
+	resourceClaimTemplateLister cache.GenericLister
 }
 
 // NewDefaultInterpreter return a new DefaultInterpreter.
@@ -52,9 +59,16 @@ func NewDefaultInterpreter() *DefaultInterpreter {
 		dependenciesHandlers:    getAllDefaultDependenciesInterpreter(),
 		reflectStatusHandlers:   getAllDefaultReflectStatusInterpreter(),
 		healthHandlers:          getAllDefaultHealthInterpreter(),
+		rolloutStatusHandlers:   getAllDefaultRolloutStatusInterpreter(),
 	}
 }
 
+// SetResourceClaimTemplateLister sets the lister used to interpret the device requests of the replicas
+// from the ResourceClaimTemplates referenced by pod resource claims.
+func (e *DefaultInterpreter) SetResourceClaimTemplateLister(lister cache.GenericLister) {
+	e.resourceClaimTemplateLister = lister
+}
+
 // HookEnabled tells if any hook exist for specific resource type and operation type.
 func (e *DefaultInterpreter) HookEnabled(kind schema.GroupVersionKind, operationType configv1alpha1.InterpreterOperation) bool {
 	switch operationType {
@@ -84,6 +98,10 @@ func (e *DefaultInterpreter) HookEnabled(kind schema.GroupVersionKind, operation
 		if _, exist := e.healthHandlers[kind]; exist {
 			return true
 		}
+	case configv1alpha1.InterpreterOperationInterpretRolloutStatus:
+		if _, exist := e.rolloutStatusHandlers[kind]; exist {
+			return true
+		}
 		// TODO(RainbowMango): more cases should be added here
 	}
 
@@ -98,7 +116,25 @@ func (e *DefaultInterpreter) GetReplicas(object *unstructured.Unstructured) (int
 		return 0, &workv1alpha2.ReplicaRequirements{}, fmt.Errorf("default %s interpreter for %q not found", configv1alpha1.InterpreterOperationInterpretReplica, object.GroupVersionKind())
 	}
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with build-in interpreter.", configv1alpha1.InterpreterOperationInterpretReplica, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	return handler(object)
+	replica, requirements, err := handler(object)
+	if err != nil {
+		return replica, requirements, err
+	}
+
+	if features.FeatureGate.Enabled(features.DynamicResourceEstimate) && e.resourceClaimTemplateLister != nil {
+		deviceRequests, err := generateDeviceRequests(object, e.resourceClaimTemplateLister)
+		if err != nil {
+			klog.Errorf("Failed to generate device requests for object(%s/%s), err %v", object.GetNamespace(), object.GetName(), err)
+			return 0, nil, err
+		}
+		if len(deviceRequests) > 0 {
+			if requirements == nil {
+				requirements = &workv1alpha2.ReplicaRequirements{}
+			}
+			requirements.DeviceRequests = deviceRequests
+		}
+	}
+	return replica, requirements, nil
 }
 
 // ReviseReplica revises the replica of the given object.
@@ -173,3 +209,14 @@ func (e *DefaultInterpreter) InterpretHealth(object *unstructured.Unstructured)
 
 	return false, fmt.Errorf("default %s interpreter for %q not found", configv1alpha1.InterpreterOperationInterpretHealth, object.GroupVersionKind())
 }
+
+// InterpretRolloutStatus returns the rollout status of the object.
+func (e *DefaultInterpreter) InterpretRolloutStatus(object *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error) {
+	handler, exist := e.rolloutStatusHandlers[object.GroupVersionKind()]
+	if exist {
+		klog.V(4).Infof("Running operation %s for object: %v %s/%s with build-in interpreter.", configv1alpha1.InterpreterOperationInterpretRolloutStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
+		return handler(object)
+	}
+
+	return nil, fmt.Errorf("default %s interpreter for %q not found", configv1alpha1.InterpreterOperationInterpretRolloutStatus, object.GroupVersionKind())
+}
@@ -104,6 +104,15 @@ func TestHookEnabled(t *testing.T) {
 			},
 			operationType: configv1alpha1.InterpreterOperationInterpretHealth,
 			want:          true,
+		}, {
+			name: "statefulset with interpretrolloutstatus operation enabled",
+			kind: schema.GroupVersionKind{
+				Group:   "apps",
+				Version: "v1",
+				Kind:    "StatefulSet",
+			},
+			operationType: configv1alpha1.InterpreterOperationInterpretRolloutStatus,
+			want:          true,
 		}, {
 			name: "foot5zmh with prune operation enabled",
 			kind: schema.GroupVersionKind{
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package native
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+// ResourceClaimTemplatesGVR is the GroupVersionResource of the ResourceClaimTemplates referenced by pod resource claims.
+var ResourceClaimTemplatesGVR = resourcev1.SchemeGroupVersion.WithResource("resourceclaimtemplates")
+
+// getPodResourceClaims returns the resource claims of the pod, or of the pod template for workloads.
+func getPodResourceClaims(object *unstructured.Unstructured) ([]corev1.PodResourceClaim, error) {
+	fields := []string{"spec", "template", "spec", "resourceClaims"}
+	if object.GetKind() == util.PodKind {
+		fields = []string{"spec", "resourceClaims"}
+	}
+	claims, found, err := unstructured.NestedSlice(object.Object, fields...)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	podClaims := make([]corev1.PodResourceClaim, 0, len(claims))
+	for _, claim := range claims {
+		claimMap, ok := claim.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		podClaim := corev1.PodResourceClaim{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(claimMap, &podClaim); err != nil {
+			return nil, err
+		}
+		podClaims = append(podClaims, podClaim)
+	}
+	return podClaims, nil
+}
+
+// generateDeviceRequests generates the device requests of each replica from the ResourceClaimTemplates
+// referenced by the pod resource claims. Claims referencing a shared ResourceClaim are ignored, since the
+// devices are allocated once rather than for each replica, so are the requests choosing from a list of
+// alternatives or allocating all matching devices, which can not be expressed by a device count.
+func generateDeviceRequests(object *unstructured.Unstructured, lister cache.GenericLister) ([]workv1alpha2.DeviceRequest, error) {
+	podClaims, err := getPodResourceClaims(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var deviceRequests []workv1alpha2.DeviceRequest
+	for _, podClaim := range podClaims {
+		if podClaim.ResourceClaimTemplateName == nil || *podClaim.ResourceClaimTemplateName == "" {
+			klog.V(4).Infof("Skip resource claim(%s) of object(%s/%s) not referencing a ResourceClaimTemplate.",
+				podClaim.Name, object.GetNamespace(), object.GetName())
+			continue
+		}
+
+		templateObj, err := lister.ByNamespace(object.GetNamespace()).Get(*podClaim.ResourceClaimTemplateName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				klog.V(4).Infof("ResourceClaimTemplate(%s/%s) referenced by object(%s) not found.",
+					object.GetNamespace(), *podClaim.ResourceClaimTemplateName, object.GetName())
+				continue
+			}
+			return nil, err
+		}
+		template := &resourcev1.ResourceClaimTemplate{}
+		if err = helper.ConvertToTypedObject(templateObj, template); err != nil {
+			return nil, err
+		}
+
+		for _, request := range template.Spec.Spec.Devices.Requests {
+			if request.Exactly == nil {
+				klog.V(4).Infof("Skip device request(%s) of ResourceClaimTemplate(%s/%s) without exact request.",
+					request.Name, template.Namespace, template.Name)
+				continue
+			}
+			if request.Exactly.AllocationMode == resourcev1.DeviceAllocationModeAll {
+				klog.V(4).Infof("Skip device request(%s) of ResourceClaimTemplate(%s/%s) allocating all matching devices.",
+					request.Name, template.Namespace, template.Name)
+				continue
+			}
+			deviceRequest := workv1alpha2.DeviceRequest{DeviceClassName: request.Exactly.DeviceClassName, Count: 1}
+			if request.Exactly.AllocationMode == resourcev1.DeviceAllocationModeExactCount && request.Exactly.Count > 0 {
+				deviceRequest.Count = request.Exactly.Count
+			}
+			for _, selector := range request.Exactly.Selectors {
+				if selector.CEL != nil {
+					deviceRequest.Selectors = append(deviceRequest.Selectors, selector.CEL.Expression)
+				}
+			}
+			deviceRequests = append(deviceRequests, deviceRequest)
+		}
+	}
+	return deviceRequests, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package native
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	resourcev1 "k8s.io/api/resource/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+func newResourceClaimTemplateLister(t *testing.T, templates ...*resourcev1.ResourceClaimTemplate) cache.GenericLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, template := range templates {
+		obj, err := helper.ToUnstructured(template)
+		require.NoError(t, err)
+		require.NoError(t, indexer.Add(obj))
+	}
+	return cache.NewGenericLister(indexer, ResourceClaimTemplatesGVR.GroupResource())
+}
+
+func newResourceClaimTemplate(name string, requests ...resourcev1.DeviceRequest) *resourcev1.ResourceClaimTemplate {
+	return &resourcev1.ResourceClaimTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: resourcev1.SchemeGroupVersion.String(), Kind: "ResourceClaimTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: resourcev1.ResourceClaimTemplateSpec{
+			Spec: resourcev1.ResourceClaimSpec{Devices: resourcev1.DeviceClaim{Requests: requests}},
+		},
+	}
+}
+
+func newWorkloadWithResourceClaims(kind string, claims ...any) *unstructured.Unstructured {
+	podSpec := map[string]any{
+		"containers": []any{map[string]any{
+			"name":      "app",
+			"image":     "app",
+			"resources": map[string]any{"requests": map[string]any{"cpu": "1"}},
+		}},
+		"resourceClaims": claims,
+	}
+	if kind == "Pod" {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       kind,
+			"metadata":   map[string]any{"name": "foo", "namespace": "default"},
+			"spec":       podSpec,
+		}}
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       kind,
+		"metadata":   map[string]any{"name": "foo", "namespace": "default"},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"template": map[string]any{"spec": podSpec},
+		},
+	}}
+}
+
+func TestDefaultInterpreter_GetReplicas_deviceRequests(t *testing.T) {
+	lister := newResourceClaimTemplateLister(t,
+		newResourceClaimTemplate("gpu",
+			resourcev1.DeviceRequest{Name: "gpu", Exactly: &resourcev1.ExactDeviceRequest{
+				DeviceClassName: "gpu.example.com", AllocationMode: resourcev1.DeviceAllocationModeExactCount, Count: 2,
+				Selectors: []resourcev1.DeviceSelector{{CEL: &resourcev1.CELDeviceSelector{Expression: `device.attributes["gpu.example.com"].model == "a100"`}}}}},
+			resourcev1.DeviceRequest{Name: "nic", Exactly: &resourcev1.ExactDeviceRequest{DeviceClassName: "nic.example.com"}},
+		),
+		newResourceClaimTemplate("alternatives",
+			resourcev1.DeviceRequest{Name: "gpu", FirstAvailable: []resourcev1.DeviceSubRequest{{Name: "large", DeviceClassName: "gpu.example.com"}}},
+		),
+		newResourceClaimTemplate("all",
+			resourcev1.DeviceRequest{Name: "gpu", Exactly: &resourcev1.ExactDeviceRequest{
+				DeviceClassName: "gpu.example.com", AllocationMode: resourcev1.DeviceAllocationModeAll}},
+		),
+	)
+
+	tests := []struct {
+		name               string
+		enabled            bool
+		lister             cache.GenericLister
+		object             *unstructured.Unstructured
+		wantDeviceRequests []workv1alpha2.DeviceRequest
+	}{
+		{
+			name:    "device requests from the referenced template",
+			enabled: true,
+			lister:  lister,
+			object:  newWorkloadWithResourceClaims("Deployment", map[string]any{"name": "gpu", "resourceClaimTemplateName": "gpu"}),
+			wantDeviceRequests: []workv1alpha2.DeviceRequest{
+				{DeviceClassName: "gpu.example.com", Count: 2, Selectors: []string{`device.attributes["gpu.example.com"].model == "a100"`}},
+				{DeviceClassName: "nic.example.com", Count: 1},
+			},
+		},
+		{
+			name:    "device requests of a pod",
+			enabled: true,
+			lister:  lister,
+			object:  newWorkloadWithResourceClaims("Pod", map[string]any{"name": "gpu", "resourceClaimTemplateName": "gpu"}),
+			wantDeviceRequests: []workv1alpha2.DeviceRequest{
+				{DeviceClassName: "gpu.example.com", Count: 2, Selectors: []string{`device.attributes["gpu.example.com"].model == "a100"`}},
+				{DeviceClassName: "nic.example.com", Count: 1},
+			},
+		},
+		{
+			name:    "shared claims, missing templates and requests without a count are ignored",
+			enabled: true,
+			lister:  lister,
+			object: newWorkloadWithResourceClaims("Deployment",
+				map[string]any{"name": "shared", "resourceClaimName": "shared"},
+				map[string]any{"name": "missing", "resourceClaimTemplateName": "missing"},
+				map[string]any{"name": "alternatives", "resourceClaimTemplateName": "alternatives"},
+				map[string]any{"name": "all", "resourceClaimTemplateName": "all"},
+			),
+		},
+		{
+			name:   "feature gate disabled",
+			lister: lister,
+			object: newWorkloadWithResourceClaims("Deployment", map[string]any{"name": "gpu", "resourceClaimTemplateName": "gpu"}),
+		},
+		{
+			name:    "resource claim templates not served",
+			enabled: true,
+			object:  newWorkloadWithResourceClaims("Deployment", map[string]any{"name": "gpu", "resourceClaimTemplateName": "gpu"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DynamicResourceEstimate, tt.enabled)))
+			defer func() {
+				_ = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.DynamicResourceEstimate, false))
+			}()
+
+			e := NewDefaultInterpreter()
+			e.SetResourceClaimTemplateLister(tt.lister)
+			_, requirements, err := e.GetReplicas(tt.object)
+			require.NoError(t, err)
+			require.NotNil(t, requirements)
+			assert.Equal(t, tt.wantDeviceRequests, requirements.DeviceRequests)
+		})
+	}
+}
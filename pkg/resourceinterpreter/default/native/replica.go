@@ -20,12 +20,14 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/util"
 	"github.com/karmada-io/karmada/pkg/util/helper"
 )
@@ -87,6 +89,14 @@ func statefulSetReplica(object *unstructured.Unstructured) (int32, *workv1alpha2
 		replica = *sts.Spec.Replicas
 	}
 	requirement := helper.GenerateReplicaRequirements(&sts.Spec.Template)
+	if features.FeatureGate.Enabled(features.StorageCapacityEstimate) {
+		if storageRequests := helper.GenerateStorageRequests(sts.Spec.VolumeClaimTemplates); len(storageRequests) > 0 {
+			if requirement == nil {
+				requirement = &workv1alpha2.ReplicaRequirements{}
+			}
+			requirement.StorageRequests = storageRequests
+		}
+	}
 
 	return replica, requirement, nil
 }
@@ -125,5 +135,5 @@ func podReplica(object *unstructured.Unstructured) (int32, *workv1alpha2.Replica
 	}
 
 	// For Pod, its replica is always 1.
-	return 1, helper.GenerateReplicaRequirements(&corev1.PodTemplateSpec{Spec: pod.Spec}), nil
+	return 1, helper.GenerateReplicaRequirements(&corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: pod.Labels}, Spec: pod.Spec}), nil
 }
@@ -17,17 +17,21 @@ limitations under the License.
 package native
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/util/helper"
 )
 
@@ -153,6 +157,56 @@ func Test_statefulSetReplica(t *testing.T) {
 	assert.Equalf(t, wantRequirement, gotRequirement, "statefulSetReplica(%v)", unstructuredObject)
 }
 
+func Test_statefulSetReplica_storageRequests(t *testing.T) {
+	object := &appsv1.StatefulSet{
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: ptr.To("fast"),
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}},
+		},
+	}
+	unstructuredObject, err := helper.ToUnstructured(object)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		enabled         bool
+		wantRequirement *workv1alpha2.ReplicaRequirements
+	}{
+		{
+			name:            "feature disabled",
+			enabled:         false,
+			wantRequirement: nil,
+		},
+		{
+			name:    "feature enabled",
+			enabled: true,
+			wantRequirement: &workv1alpha2.ReplicaRequirements{
+				StorageRequests: []workv1alpha2.StorageRequest{{StorageClassName: "fast", Storage: resource.MustParse("10Gi")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.StorageCapacityEstimate, tt.enabled)))
+			t.Cleanup(func() {
+				_ = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.StorageCapacityEstimate, false))
+			})
+
+			gotReplica, gotRequirement, err := statefulSetReplica(unstructuredObject)
+			require.NoError(t, err)
+			assert.Equal(t, int32(3), gotReplica)
+			assert.Equal(t, tt.wantRequirement, gotRequirement)
+		})
+	}
+}
+
 func Test_replicaSetReplica(t *testing.T) {
 	object := &appsv1.ReplicaSet{
 		Spec: appsv1.ReplicaSetSpec{
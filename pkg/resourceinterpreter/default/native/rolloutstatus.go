@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package native
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+// deploymentProgressDeadlineExceededReason is the reason of the Progressing condition of Deployment
+// when the rollout exceeds the progress deadline.
+const deploymentProgressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
+type rolloutStatusInterpreter func(object *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error)
+
+func getAllDefaultRolloutStatusInterpreter() map[schema.GroupVersionKind]rolloutStatusInterpreter {
+	s := make(map[schema.GroupVersionKind]rolloutStatusInterpreter)
+	s[appsv1.SchemeGroupVersion.WithKind(util.DeploymentKind)] = interpretDeploymentRolloutStatus
+	s[appsv1.SchemeGroupVersion.WithKind(util.StatefulSetKind)] = interpretStatefulSetRolloutStatus
+	s[appsv1.SchemeGroupVersion.WithKind(util.DaemonSetKind)] = interpretDaemonSetRolloutStatus
+	s[batchv1.SchemeGroupVersion.WithKind(util.JobKind)] = interpretJobRolloutStatus
+	return s
+}
+
+// The rules follow the ones used by 'kubectl rollout status'.
+func interpretDeploymentRolloutStatus(object *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error) {
+	deploy := &appsv1.Deployment{}
+	if err := helper.ConvertToTypedObject(object, deploy); err != nil {
+		return nil, err
+	}
+
+	status := &workv1alpha2.RolloutStatus{
+		Generation:         deploy.Generation,
+		ObservedGeneration: deploy.Status.ObservedGeneration,
+		UpdatedReplicas:    ptr.To(deploy.Status.UpdatedReplicas),
+		ReadyReplicas:      ptr.To(deploy.Status.ReadyReplicas),
+		AvailableReplicas:  ptr.To(deploy.Status.AvailableReplicas),
+	}
+	if deploy.Generation > deploy.Status.ObservedGeneration {
+		return progressing(status, "waiting for the spec update to be observed"), nil
+	}
+	for _, condition := range deploy.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == deploymentProgressDeadlineExceededReason {
+			status.State = workv1alpha2.RolloutFailed
+			status.Message = fmt.Sprintf("deployment %q exceeded its progress deadline", deploy.Name)
+			return status, nil
+		}
+	}
+	replicas := ptr.Deref(deploy.Spec.Replicas, 1)
+	switch {
+	case deploy.Status.UpdatedReplicas < replicas:
+		return progressing(status, fmt.Sprintf("%d out of %d new replicas have been updated",
+			deploy.Status.UpdatedReplicas, replicas)), nil
+	case deploy.Status.Replicas > deploy.Status.UpdatedReplicas:
+		return progressing(status, fmt.Sprintf("%d old replicas are pending termination",
+			deploy.Status.Replicas-deploy.Status.UpdatedReplicas)), nil
+	case deploy.Status.AvailableReplicas < deploy.Status.UpdatedReplicas:
+		return progressing(status, fmt.Sprintf("%d of %d updated replicas are available",
+			deploy.Status.AvailableReplicas, deploy.Status.UpdatedReplicas)), nil
+	}
+	status.State = workv1alpha2.RolloutDone
+	return status, nil
+}
+
+func interpretStatefulSetRolloutStatus(object *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	if err := helper.ConvertToTypedObject(object, statefulSet); err != nil {
+		return nil, err
+	}
+
+	status := &workv1alpha2.RolloutStatus{
+		Generation:         statefulSet.Generation,
+		ObservedGeneration: statefulSet.Status.ObservedGeneration,
+		UpdatedReplicas:    ptr.To(statefulSet.Status.UpdatedReplicas),
+		ReadyReplicas:      ptr.To(statefulSet.Status.ReadyReplicas),
+		AvailableReplicas:  ptr.To(statefulSet.Status.AvailableReplicas),
+	}
+	if statefulSet.Status.ObservedGeneration == 0 || statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return progressing(status, "waiting for the spec update to be observed"), nil
+	}
+	// The pods of StatefulSet with OnDelete strategy are only updated when they are deleted manually,
+	// so the rollout is considered to be done once the update is observed.
+	if statefulSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		status.State = workv1alpha2.RolloutDone
+		return status, nil
+	}
+	replicas := ptr.Deref(statefulSet.Spec.Replicas, 1)
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return progressing(status, fmt.Sprintf("%d of %d replicas are ready",
+			statefulSet.Status.ReadyReplicas, replicas)), nil
+	}
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil &&
+		*rollingUpdate.Partition > 0 {
+		if statefulSet.Status.UpdatedReplicas < replicas-*rollingUpdate.Partition {
+			return progressing(status, fmt.Sprintf("%d of %d replicas beyond the partition have been updated",
+				statefulSet.Status.UpdatedReplicas, replicas-*rollingUpdate.Partition)), nil
+		}
+		status.State = workv1alpha2.RolloutDone
+		return status, nil
+	}
+	if statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision {
+		return progressing(status, fmt.Sprintf("%d of %d replicas have been updated to revision %s",
+			statefulSet.Status.UpdatedReplicas, replicas, statefulSet.Status.UpdateRevision)), nil
+	}
+	status.State = workv1alpha2.RolloutDone
+	return status, nil
+}
+
+func interpretDaemonSetRolloutStatus(object *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error) {
+	daemonSet := &appsv1.DaemonSet{}
+	if err := helper.ConvertToTypedObject(object, daemonSet); err != nil {
+		return nil, err
+	}
+
+	status := &workv1alpha2.RolloutStatus{
+		Generation:         daemonSet.Generation,
+		ObservedGeneration: daemonSet.Status.ObservedGeneration,
+		UpdatedReplicas:    ptr.To(daemonSet.Status.UpdatedNumberScheduled),
+		ReadyReplicas:      ptr.To(daemonSet.Status.NumberReady),
+		AvailableReplicas:  ptr.To(daemonSet.Status.NumberAvailable),
+	}
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return progressing(status, "waiting for the spec update to be observed"), nil
+	}
+	// Same as StatefulSet, the pods of DaemonSet with OnDelete strategy are only updated when they are deleted manually.
+	if daemonSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+		status.State = workv1alpha2.RolloutDone
+		return status, nil
+	}
+	desired := daemonSet.Status.DesiredNumberScheduled
+	switch {
+	case daemonSet.Status.UpdatedNumberScheduled < desired:
+		return progressing(status, fmt.Sprintf("%d out of %d new pods have been updated",
+			daemonSet.Status.UpdatedNumberScheduled, desired)), nil
+	case daemonSet.Status.NumberAvailable < desired:
+		return progressing(status, fmt.Sprintf("%d of %d updated pods are available",
+			daemonSet.Status.NumberAvailable, desired)), nil
+	}
+	status.State = workv1alpha2.RolloutDone
+	return status, nil
+}
+
+// The rollout of a Job is considered to be done once the Job completes, and failed once the Job fails.
+func interpretJobRolloutStatus(object *unstructured.Unstructured) (*workv1alpha2.RolloutStatus, error) {
+	job := &batchv1.Job{}
+	if err := helper.ConvertToTypedObject(object, job); err != nil {
+		return nil, err
+	}
+
+	status := &workv1alpha2.RolloutStatus{
+		Generation:    job.Generation,
+		ReadyReplicas: job.Status.Ready,
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			status.State = workv1alpha2.RolloutDone
+			return status, nil
+		case batchv1.JobFailed:
+			status.State = workv1alpha2.RolloutFailed
+			status.Message = condition.Message
+			return status, nil
+		}
+	}
+	return progressing(status, fmt.Sprintf("%d of the pods have succeeded", job.Status.Succeeded)), nil
+}
+
+func progressing(status *workv1alpha2.RolloutStatus, message string) *workv1alpha2.RolloutStatus {
+	status.State = workv1alpha2.RolloutProgressing
+	status.Message = message
+	return status
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package native
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func Test_interpretDeploymentRolloutStatus(t *testing.T) {
+	newDeployment := func(generation int64, status map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]any{
+					"name":       "fake-deployment",
+					"generation": generation,
+				},
+				"spec": map[string]any{
+					"replicas": int64(3),
+				},
+				"status": status,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		object      *unstructured.Unstructured
+		wantState   workv1alpha2.RolloutState
+		wantMessage string
+	}{
+		{
+			name: "rollout done",
+			object: newDeployment(1, map[string]any{
+				"observedGeneration": int64(1),
+				"replicas":           int64(3),
+				"updatedReplicas":    int64(3),
+				"availableReplicas":  int64(3),
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "spec update not observed",
+			object: newDeployment(2, map[string]any{
+				"observedGeneration": int64(1),
+				"replicas":           int64(3),
+				"updatedReplicas":    int64(3),
+				"availableReplicas":  int64(3),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "waiting for the spec update to be observed",
+		},
+		{
+			name: "replicas partially updated",
+			object: newDeployment(2, map[string]any{
+				"observedGeneration": int64(2),
+				"replicas":           int64(4),
+				"updatedReplicas":    int64(1),
+				"availableReplicas":  int64(3),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "1 out of 3 new replicas have been updated",
+		},
+		{
+			name: "old replicas pending termination",
+			object: newDeployment(2, map[string]any{
+				"observedGeneration": int64(2),
+				"replicas":           int64(4),
+				"updatedReplicas":    int64(3),
+				"availableReplicas":  int64(3),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "1 old replicas are pending termination",
+		},
+		{
+			name: "updated replicas not available",
+			object: newDeployment(2, map[string]any{
+				"observedGeneration": int64(2),
+				"replicas":           int64(3),
+				"updatedReplicas":    int64(3),
+				"availableReplicas":  int64(2),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "2 of 3 updated replicas are available",
+		},
+		{
+			name: "progress deadline exceeded",
+			object: newDeployment(2, map[string]any{
+				"observedGeneration": int64(2),
+				"replicas":           int64(4),
+				"updatedReplicas":    int64(1),
+				"availableReplicas":  int64(3),
+				"conditions": []any{
+					map[string]any{
+						"type":   "Progressing",
+						"status": "False",
+						"reason": "ProgressDeadlineExceeded",
+					},
+				},
+			}),
+			wantState:   workv1alpha2.RolloutFailed,
+			wantMessage: `deployment "fake-deployment" exceeded its progress deadline`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := interpretDeploymentRolloutStatus(tt.object)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantState, got.State)
+			assert.Equal(t, tt.wantMessage, got.Message)
+		})
+	}
+}
+
+func Test_interpretStatefulSetRolloutStatus(t *testing.T) {
+	newStatefulSet := func(strategy map[string]any, status map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"metadata": map[string]any{
+					"name":       "fake-statefulset",
+					"generation": int64(1),
+				},
+				"spec": map[string]any{
+					"replicas":       int64(3),
+					"updateStrategy": strategy,
+				},
+				"status": status,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		object      *unstructured.Unstructured
+		wantState   workv1alpha2.RolloutState
+		wantMessage string
+	}{
+		{
+			name: "rollout done",
+			object: newStatefulSet(map[string]any{"type": "RollingUpdate"}, map[string]any{
+				"observedGeneration": int64(1),
+				"readyReplicas":      int64(3),
+				"updatedReplicas":    int64(3),
+				"currentRevision":    "rev-2",
+				"updateRevision":     "rev-2",
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "OnDelete strategy is done once observed",
+			object: newStatefulSet(map[string]any{"type": "OnDelete"}, map[string]any{
+				"observedGeneration": int64(1),
+				"readyReplicas":      int64(3),
+				"currentRevision":    "rev-1",
+				"updateRevision":     "rev-2",
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "replicas not ready",
+			object: newStatefulSet(map[string]any{"type": "RollingUpdate"}, map[string]any{
+				"observedGeneration": int64(1),
+				"readyReplicas":      int64(2),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "2 of 3 replicas are ready",
+		},
+		{
+			name: "partitioned rollout done",
+			object: newStatefulSet(map[string]any{
+				"type":          "RollingUpdate",
+				"rollingUpdate": map[string]any{"partition": int64(2)},
+			}, map[string]any{
+				"observedGeneration": int64(1),
+				"readyReplicas":      int64(3),
+				"updatedReplicas":    int64(1),
+				"currentRevision":    "rev-1",
+				"updateRevision":     "rev-2",
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "revision not updated",
+			object: newStatefulSet(map[string]any{"type": "RollingUpdate"}, map[string]any{
+				"observedGeneration": int64(1),
+				"readyReplicas":      int64(3),
+				"updatedReplicas":    int64(1),
+				"currentRevision":    "rev-1",
+				"updateRevision":     "rev-2",
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "1 of 3 replicas have been updated to revision rev-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := interpretStatefulSetRolloutStatus(tt.object)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantState, got.State)
+			assert.Equal(t, tt.wantMessage, got.Message)
+		})
+	}
+}
+
+func Test_interpretDaemonSetRolloutStatus(t *testing.T) {
+	newDaemonSet := func(strategyType string, status map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "DaemonSet",
+				"metadata": map[string]any{
+					"name":       "fake-daemonset",
+					"generation": int64(1),
+				},
+				"spec": map[string]any{
+					"updateStrategy": map[string]any{"type": strategyType},
+				},
+				"status": status,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		object      *unstructured.Unstructured
+		wantState   workv1alpha2.RolloutState
+		wantMessage string
+	}{
+		{
+			name: "rollout done",
+			object: newDaemonSet("RollingUpdate", map[string]any{
+				"observedGeneration":     int64(1),
+				"desiredNumberScheduled": int64(3),
+				"updatedNumberScheduled": int64(3),
+				"numberAvailable":        int64(3),
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "OnDelete strategy is done once observed",
+			object: newDaemonSet("OnDelete", map[string]any{
+				"observedGeneration":     int64(1),
+				"desiredNumberScheduled": int64(3),
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "pods partially updated",
+			object: newDaemonSet("RollingUpdate", map[string]any{
+				"observedGeneration":     int64(1),
+				"desiredNumberScheduled": int64(3),
+				"updatedNumberScheduled": int64(1),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "1 out of 3 new pods have been updated",
+		},
+		{
+			name: "updated pods not available",
+			object: newDaemonSet("RollingUpdate", map[string]any{
+				"observedGeneration":     int64(1),
+				"desiredNumberScheduled": int64(3),
+				"updatedNumberScheduled": int64(3),
+				"numberAvailable":        int64(2),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "2 of 3 updated pods are available",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := interpretDaemonSetRolloutStatus(tt.object)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantState, got.State)
+			assert.Equal(t, tt.wantMessage, got.Message)
+		})
+	}
+}
+
+func Test_interpretJobRolloutStatus(t *testing.T) {
+	newJob := func(status map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata": map[string]any{
+					"name": "fake-job",
+				},
+				"status": status,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		object      *unstructured.Unstructured
+		wantState   workv1alpha2.RolloutState
+		wantMessage string
+	}{
+		{
+			name: "job complete",
+			object: newJob(map[string]any{
+				"succeeded": int64(1),
+				"conditions": []any{
+					map[string]any{"type": "Complete", "status": "True"},
+				},
+			}),
+			wantState: workv1alpha2.RolloutDone,
+		},
+		{
+			name: "job failed",
+			object: newJob(map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Failed", "status": "True", "message": "Job has reached the specified backoff limit"},
+				},
+			}),
+			wantState:   workv1alpha2.RolloutFailed,
+			wantMessage: "Job has reached the specified backoff limit",
+		},
+		{
+			name: "job running",
+			object: newJob(map[string]any{
+				"active": int64(1),
+			}),
+			wantState:   workv1alpha2.RolloutProgressing,
+			wantMessage: "0 of the pods have succeeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := interpretJobRolloutStatus(tt.object)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantState, got.State)
+			assert.Equal(t, tt.wantMessage, got.Message)
+		})
+	}
+}
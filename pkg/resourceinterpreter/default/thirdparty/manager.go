@@ -79,6 +79,16 @@ func NewThirdPartyConfigManager() configmanager.ConfigManager {
 }
 
 func (t *configManager) loadThirdPartyConfig() {
+	configs, err := LoadCustomizations()
+	if err != nil {
+		klog.Warning(err, "failed to load third party resource")
+		return
+	}
+	t.LoadConfig(configs)
+}
+
+// LoadCustomizations loads the thirdparty resource interpreter customizations bundled in karmada.
+func LoadCustomizations() ([]*configv1alpha1.ResourceInterpreterCustomization, error) {
 	var configs []*configv1alpha1.ResourceInterpreterCustomization
 	if err := fs.WalkDir(resourcecustomizations.Embedded, ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -114,8 +124,7 @@ func (t *configManager) loadThirdPartyConfig() {
 		}
 		return nil
 	}); err != nil {
-		klog.Warning(err, "failed to load third party resource")
-		return
+		return nil, err
 	}
-	t.LoadConfig(configs)
+	return configs, nil
 }
@@ -27,6 +27,7 @@ import (
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/celvm"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/configmanager"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/luavm"
 	"github.com/karmada-io/karmada/pkg/util/interpreter/validation"
@@ -36,6 +37,7 @@ import (
 type ConfigurableInterpreter struct {
 	configManager configmanager.ConfigManager
 	luaVM         *luavm.VM
+	celVM         *celvm.VM
 }
 
 // HookEnabled tells if any hook exist for specific resource gvk and operation type.
@@ -45,27 +47,29 @@ func (p *ConfigurableInterpreter) HookEnabled(kind schema.GroupVersionKind, oper
 		return exist
 	}
 	if operationType == configv1alpha1.InterpreterOperationInterpretDependency {
-		scripts := customAccessor.GetDependencyInterpretationLuaScripts()
-		return scripts != nil
+		return customAccessor.GetDependencyInterpretationLuaScripts() != nil ||
+			customAccessor.GetDependencyInterpretationCELExpressions() != nil
 	}
-	var script string
+	var script, expression string
 	switch operationType {
 	case configv1alpha1.InterpreterOperationAggregateStatus:
-		script = customAccessor.GetStatusAggregationLuaScript()
+		script, expression = customAccessor.GetStatusAggregationLuaScript(), customAccessor.GetStatusAggregationCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretHealth:
-		script = customAccessor.GetHealthInterpretationLuaScript()
+		script, expression = customAccessor.GetHealthInterpretationLuaScript(), customAccessor.GetHealthInterpretationCELExpression()
+	case configv1alpha1.InterpreterOperationInterpretRolloutStatus:
+		script, expression = customAccessor.GetRolloutStatusInterpretationLuaScript(), customAccessor.GetRolloutStatusInterpretationCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretReplica:
-		script = customAccessor.GetReplicaResourceLuaScript()
+		script, expression = customAccessor.GetReplicaResourceLuaScript(), customAccessor.GetReplicaResourceCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretComponent:
-		script = customAccessor.GetComponentResourceLuaScript()
+		script, expression = customAccessor.GetComponentResourceLuaScript(), customAccessor.GetComponentResourceCELExpression()
 	case configv1alpha1.InterpreterOperationInterpretStatus:
-		script = customAccessor.GetStatusReflectionLuaScript()
+		script, expression = customAccessor.GetStatusReflectionLuaScript(), customAccessor.GetStatusReflectionCELExpression()
 	case configv1alpha1.InterpreterOperationRetain:
-		script = customAccessor.GetRetentionLuaScript()
+		script, expression = customAccessor.GetRetentionLuaScript(), customAccessor.GetRetentionCELExpression()
 	case configv1alpha1.InterpreterOperationReviseReplica:
-		script = customAccessor.GetReplicaRevisionLuaScript()
+		script, expression = customAccessor.GetReplicaRevisionLuaScript(), customAccessor.GetReplicaRevisionCELExpression()
 	}
-	return len(script) > 0
+	return len(script) > 0 || len(expression) > 0
 }
 
 // GetReplicas returns the desired replicas of the object as well as the requirements of each replica.
@@ -78,12 +82,17 @@ func (p *ConfigurableInterpreter) GetReplicas(object *unstructured.Unstructured)
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretReplica, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
 	script := customAccessor.GetReplicaResourceLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetReplicaResourceCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
-	replicas, requires, err = p.luaVM.GetReplicas(object, script)
+	if len(expression) > 0 {
+		replicas, requires, err = p.celVM.GetReplicas(object, expression)
+		return
+	}
+	replicas, requires, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).GetReplicas(object, script)
 	return
 }
 
@@ -95,14 +104,19 @@ func (p *ConfigurableInterpreter) GetComponents(object *unstructured.Unstructure
 	}
 
 	script := customAccessor.GetComponentResourceLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetComponentResourceCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretComponent, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	components, err = p.luaVM.GetComponents(object, script)
+	if len(expression) > 0 {
+		components, err = p.celVM.GetComponents(object, expression)
+		return
+	}
+	components, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).GetComponents(object, script)
 	return
 }
 
@@ -114,14 +128,19 @@ func (p *ConfigurableInterpreter) ReviseReplica(object *unstructured.Unstructure
 	}
 
 	script := customAccessor.GetReplicaRevisionLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetReplicaRevisionCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationReviseReplica, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	revised, err = p.luaVM.ReviseReplica(object, replica, script)
+	if len(expression) > 0 {
+		revised, err = p.celVM.ReviseReplica(object, replica, expression)
+		return
+	}
+	revised, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).ReviseReplica(object, replica, script)
 	return
 }
 
@@ -133,14 +152,19 @@ func (p *ConfigurableInterpreter) Retain(desired *unstructured.Unstructured, obs
 	}
 
 	script := customAccessor.GetRetentionLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetRetentionCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationRetain, desired.GroupVersionKind(), desired.GetNamespace(), desired.GetName())
-	retained, err = p.luaVM.Retain(desired, observed, script)
+	if len(expression) > 0 {
+		retained, err = p.celVM.Retain(desired, observed, expression)
+		return
+	}
+	retained, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).Retain(desired, observed, script)
 	return
 }
 
@@ -152,14 +176,19 @@ func (p *ConfigurableInterpreter) AggregateStatus(object *unstructured.Unstructu
 	}
 
 	script := customAccessor.GetStatusAggregationLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetStatusAggregationCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationAggregateStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	status, err = p.luaVM.AggregateStatus(object, aggregatedStatusItems, script)
+	if len(expression) > 0 {
+		status, err = p.celVM.AggregateStatus(object, aggregatedStatusItems, expression)
+		return
+	}
+	status, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).AggregateStatus(object, aggregatedStatusItems, script)
 	return
 }
 
@@ -171,7 +200,8 @@ func (p *ConfigurableInterpreter) GetDependencies(object *unstructured.Unstructu
 	}
 
 	scripts := customAccessor.GetDependencyInterpretationLuaScripts()
-	if scripts == nil {
+	expressions := customAccessor.GetDependencyInterpretationCELExpressions()
+	if scripts == nil && expressions == nil {
 		enabled = false
 		return
 	}
@@ -181,7 +211,21 @@ func (p *ConfigurableInterpreter) GetDependencies(object *unstructured.Unstructu
 	refs := sets.New[configv1alpha1.DependentObjectReference]()
 	for _, luaScript := range scripts {
 		var references []configv1alpha1.DependentObjectReference
-		references, err = p.luaVM.GetDependencies(object, luaScript)
+		references, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).GetDependencies(object, luaScript)
+		if err != nil {
+			klog.Errorf("Failed to get DependentObjectReferences from object: %v %s/%s, error: %v",
+				object.GroupVersionKind(), object.GetNamespace(), object.GetName(), err)
+			return
+		}
+		err = validation.VerifyDependencies(references)
+		if err != nil {
+			return
+		}
+		refs.Insert(references...)
+	}
+	for _, expression := range expressions {
+		var references []configv1alpha1.DependentObjectReference
+		references, err = p.celVM.GetDependencies(object, expression)
 		if err != nil {
 			klog.Errorf("Failed to get DependentObjectReferences from object: %v %s/%s, error: %v",
 				object.GroupVersionKind(), object.GetNamespace(), object.GetName(), err)
@@ -219,14 +263,19 @@ func (p *ConfigurableInterpreter) ReflectStatus(object *unstructured.Unstructure
 	}
 
 	script := customAccessor.GetStatusReflectionLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetStatusReflectionCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	status, err = p.luaVM.ReflectStatus(object, script)
+	if len(expression) > 0 {
+		status, err = p.celVM.ReflectStatus(object, expression)
+		return
+	}
+	status, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).ReflectStatus(object, script)
 	return
 }
 
@@ -238,14 +287,47 @@ func (p *ConfigurableInterpreter) InterpretHealth(object *unstructured.Unstructu
 	}
 
 	script := customAccessor.GetHealthInterpretationLuaScript()
-	if len(script) == 0 {
+	expression := customAccessor.GetHealthInterpretationCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
 		enabled = false
 		return
 	}
 
 	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
 		configv1alpha1.InterpreterOperationInterpretHealth, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
-	health, err = p.luaVM.InterpretHealth(object, script)
+	if len(expression) > 0 {
+		health, err = p.celVM.InterpretHealth(object, expression)
+		return
+	}
+	health, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).InterpretHealth(object, script)
+	return
+}
+
+// InterpretRolloutStatus returns the rollout status of the object.
+func (p *ConfigurableInterpreter) InterpretRolloutStatus(object *unstructured.Unstructured) (rolloutStatus *workv1alpha2.RolloutStatus, enabled bool, err error) {
+	customAccessor, enabled := p.getCustomAccessor(object.GroupVersionKind())
+	if !enabled {
+		return
+	}
+
+	script := customAccessor.GetRolloutStatusInterpretationLuaScript()
+	expression := customAccessor.GetRolloutStatusInterpretationCELExpression()
+	if len(script) == 0 && len(expression) == 0 {
+		enabled = false
+		return
+	}
+
+	klog.V(4).Infof("Running operation %s for object: %v %s/%s with thirdparty configurable interpreter.",
+		configv1alpha1.InterpreterOperationInterpretRolloutStatus, object.GroupVersionKind(), object.GetNamespace(), object.GetName())
+	if len(expression) > 0 {
+		rolloutStatus, err = p.celVM.InterpretRolloutStatus(object, expression)
+	} else {
+		rolloutStatus, err = p.luaVM.WithLimits(customAccessor.GetLuaScriptLimits()).InterpretRolloutStatus(object, script)
+	}
+	if err != nil {
+		return
+	}
+	err = validation.VerifyRolloutStatus(rolloutStatus)
 	return
 }
 
@@ -259,5 +341,6 @@ func NewConfigurableInterpreter() *ConfigurableInterpreter {
 	return &ConfigurableInterpreter{
 		configManager: NewThirdPartyConfigManager(),
 		luaVM:         luavm.New(false, 10),
+		celVM:         celvm.New(100),
 	}
 }
@@ -24,29 +24,19 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 	"testing"
 	"time"
 
-	"k8s.io/apimachinery/pkg/api/resource"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/conversion"
-	k8sjson "k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
-	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/luavm"
 	"github.com/karmada-io/karmada/pkg/util/interpreter"
 )
 
 var rules interpreter.Rules = interpreter.AllResourceInterpreterCustomizationRules
-var checker = conversion.EqualitiesOrDie(
-	func(a, b resource.Quantity) bool {
-		return a.Equal(b)
-	})
 
 func checkScript(script string) error {
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
@@ -60,23 +50,6 @@ func checkScript(script string) error {
 	return err
 }
 
-type TestStructure struct {
-	Tests []IndividualTest `yaml:"tests"`
-}
-
-type IndividualTest struct {
-	Name          string                              `yaml:"name"`                    // the name of individual test
-	Description   string                              `yaml:"description,omitempty"`   // the description of individual test
-	DesiredObj    *unstructured.Unstructured          `yaml:"desiredObj,omitempty"`    // the desired object
-	ObservedObj   *unstructured.Unstructured          `yaml:"observedObj,omitempty"`   // the observed object
-	StatusItems   []workv1alpha2.AggregatedStatusItem `yaml:"statusItems,omitempty"`   // the status items of aggregated status
-	InputReplicas int64                               `yaml:"inputReplicas,omitempty"` // the input replicas for revise operation
-	Operation     string                              `yaml:"operation"`               // the operation of resource interpreter
-	Filepath      string                              `yaml:"filepath,omitempty"`      // the file path of current test case, used for logging
-	// TODO(@zhzhuang-zju): When we have a complete set of test cases, change Output to required field.
-	Output map[string]any `yaml:"output,omitempty"` // the expected output results
-}
-
 func checkInterpretationRule(t *testing.T, path string, configs []*configv1alpha1.ResourceInterpreterCustomization) {
 	ipt := declarative.NewConfigurableInterpreter(nil)
 	ipt.LoadConfig(configs)
@@ -84,244 +57,42 @@ func checkInterpretationRule(t *testing.T, path string, configs []*configv1alpha
 	dir := filepath.Dir(path)
 	testDataDir := filepath.Join(dir, "testdata")
 
-	var err error
+	testCases, err := interpreter.LoadTestCases(testDataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
 	for _, customization := range configs {
-		for _, input := range getAllTestCases(t, testDataDir).Tests {
+		for _, input := range testCases {
 			t.Run(fmt.Sprintf("[%s/%s]:%s", customization.Name, input.Operation, input.Name), func(t *testing.T) {
 				rule := rules.GetByOperation(input.Operation)
 				if rule == nil {
 					t.Fatalf("FilePath: %s. Test case: %s. Operation %s is not supported. Use one of: %s", input.Filepath, input.Name, input.Operation, strings.Join(rules.Names(), ", "))
 				}
-				err = checkScript(rule.GetScript(customization))
+				err := checkScript(rule.GetScript(customization))
 				if err != nil {
 					t.Fatalf("FilePath: %s. Test case: %s. Checking %s of %s, expected nil, but got: %v", input.Filepath, input.Name, rule.Name(), customization.Name, err)
 				}
-				args := buildRuleArgs(input)
-				result := rule.Run(ipt, args)
+				result := rules.RunTestCase(ipt, input)
 				if result.Err != nil {
 					t.Fatalf("FilePath: %s. Test case: %s. Execute %s %s error: %v\n", input.Filepath, input.Name, customization.Name, rule.Name(), result.Err)
 				}
-
-				// Track which output keys have been validated
-				checkedKeys := make(map[string]bool)
-
-				for _, res := range result.Results {
-					expected, ok := input.Output[res.Name]
-					if !ok {
-						// TODO(@zhzhuang-zju): Once we have a complete set of test cases, change this to t.Fatal.
-						t.Logf("FilePath: %s. Test case: %s. No expected result for %s of %s\n", input.Filepath, input.Name, res.Name, customization.Name)
-						continue
-					}
-
-					// Mark this key as checked
-					checkedKeys[res.Name] = true
-
-					if equal, err := deepEqual(expected, res.Value); err != nil || !equal {
-						expectedJSON, _ := json.MarshalIndent(expected, "", "  ")
-						gotJSON, _ := json.MarshalIndent(res.Value, "", "  ")
-						t.Fatalf("FilePath: %s\nTest case: %s\nUnexpected result for %s\nExpected:\n%s\nGot:\n%s\nError: %v", input.Filepath, input.Name, res.Name, string(expectedJSON), string(gotJSON), err)
-					}
+				for _, name := range result.Unchecked {
+					// TODO(@zhzhuang-zju): Once we have a complete set of test cases, change this to t.Fatal.
+					t.Logf("FilePath: %s. Test case: %s. No expected result for %s of %s\n", input.Filepath, input.Name, name, customization.Name)
 				}
-
-				// Verify that all keys defined in output were actually present in results
-				for key := range input.Output {
-					if !checkedKeys[key] {
-						t.Fatalf("FilePath: %s. Test case: %s. Output key '%s' is defined but not present in actual results of %s", input.Filepath, input.Name, key, customization.Name)
+				for _, mismatch := range result.Mismatches {
+					if mismatch.Actual == nil {
+						t.Fatalf("FilePath: %s. Test case: %s. Output key '%s' is defined but not present in actual results of %s", input.Filepath, input.Name, mismatch.Name, customization.Name)
 					}
+					expectedJSON, _ := json.MarshalIndent(mismatch.Expected, "", "  ")
+					gotJSON, _ := json.MarshalIndent(mismatch.Actual, "", "  ")
+					t.Fatalf("FilePath: %s\nTest case: %s\nUnexpected result for %s\nExpected:\n%s\nGot:\n%s", input.Filepath, input.Name, mismatch.Name, string(expectedJSON), string(gotJSON))
 				}
 			})
 		}
 	}
 }
 
-func getAllTestCases(t *testing.T, testDataDir string) TestStructure {
-	var resourceTest TestStructure
-	err := filepath.Walk(testDataDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			t.Fatal(fmt.Errorf("failed to access path %s: %v", path, err))
-		}
-		if info.IsDir() {
-			return nil
-		}
-
-		data, err := os.ReadFile(path) // #nosec G122 -- path comes from walking a fixed testdata directory in a unit test, not external input.
-		if err != nil {
-			t.Fatal(fmt.Errorf("failed to read file %s: %v", path, err))
-		}
-
-		decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
-		for {
-			var test IndividualTest
-			err = decoder.Decode(&test)
-			if err != nil {
-				if err == io.EOF {
-					break
-				}
-				t.Fatal(fmt.Errorf("failed to decode file %s: %v", path, err))
-			}
-			test.Filepath = path
-			resourceTest.Tests = append(resourceTest.Tests, test)
-		}
-		return nil
-	})
-
-	if err != nil {
-		t.Fatal(err)
-	}
-	return resourceTest
-}
-
-func buildRuleArgs(input IndividualTest) interpreter.RuleArgs {
-	return interpreter.RuleArgs{
-		Replica:  input.InputReplicas,
-		Desired:  input.DesiredObj,
-		Observed: input.ObservedObj,
-		Status:   input.StatusItems,
-	}
-}
-
-const excludePlaceholder = "{{EXCLUDE}}"
-
-// pathKey converts a path slice to a string key for map lookup
-func pathKey(path []string) string {
-	return strings.Join(path, ".")
-}
-
-// processObjectWithExclusion recursively traverses an object to handle fields marked with excludePlaceholder.
-// It operates in two modes controlled by the `isCollecting` flag.
-//
-// In the first pass (`isCollecting = true`), it identifies fields with the excludePlaceholder value,
-// adds their paths to the `excludePaths` map, and returns a new object with these fields removed.
-// This pass is typically done on the 'expected' object from a test case.
-//
-// In the second pass (`isCollecting = false`), it uses the pre-populated `excludePaths` map to remove
-// the corresponding fields from the object it is processing. This pass is typically done on the
-// 'actual' object from a test execution.
-//
-// This two-pass mechanism ensures that both 'expected' and 'actual' objects are compared
-// after removing a consistent set of fields.
-func processObjectWithExclusion(obj any, currentPath []string, excludePaths map[string]bool, isCollecting bool) any {
-	switch val := obj.(type) {
-	case map[string]any:
-		result := make(map[string]any)
-		for k, v := range val {
-			fieldPath := append(currentPath, k)
-			pathStr := pathKey(fieldPath)
-
-			if isCollecting {
-				// Collect exclude markers during first pass
-				if str, ok := v.(string); ok && str == excludePlaceholder {
-					excludePaths[pathStr] = true
-					continue
-				}
-			} else {
-				// Skip excluded fields during second pass
-				if excludePaths[pathStr] {
-					continue
-				}
-			}
-
-			// Recursively process nested structures
-			result[k] = processObjectWithExclusion(v, fieldPath, excludePaths, isCollecting)
-		}
-		return result
-
-	case []any:
-		result := make([]any, len(val))
-		for i, v := range val {
-			indexPath := append(currentPath, fmt.Sprintf("%d", i))
-			result[i] = processObjectWithExclusion(v, indexPath, excludePaths, isCollecting)
-		}
-		return result
-
-	default:
-		return val
-	}
-}
-
-func deepEqual(expected, actualValue any) (bool, error) {
-	expectedJSONBytes, err := k8sjson.Marshal(expected)
-	if err != nil {
-		return false, fmt.Errorf("failed to marshal expected value: %w", err)
-	}
-
-	// Handle known types for semantic comparison
-	switch typedActual := actualValue.(type) {
-	case *workv1alpha2.ReplicaRequirements:
-		var unmarshaledExpected workv1alpha2.ReplicaRequirements
-		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
-			return false, fmt.Errorf("failed to unmarshal expected JSON into ReplicaRequirements: %w", err)
-		}
-		return checker.DeepEqual(&unmarshaledExpected, typedActual), nil
-
-	case []configv1alpha1.DependentObjectReference:
-		var unmarshaledExpected []configv1alpha1.DependentObjectReference
-		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
-			return false, fmt.Errorf("failed to unmarshal expected JSON into []DependentObjectReference: %w", err)
-		}
-		// Sort both slices before comparison to handle non-deterministic order from Lua pairs()
-		// This matches the sorting logic in ConfigurableInterpreter.GetDependencies
-		sortDependencies(unmarshaledExpected)
-		sortDependencies(typedActual)
-		return checker.DeepEqual(unmarshaledExpected, typedActual), nil
-
-	case []workv1alpha2.Component:
-		var unmarshaledExpected []workv1alpha2.Component
-		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
-			return false, fmt.Errorf("failed to unmarshal expected JSON into []Component: %w", err)
-		}
-		// Sort both slices before comparison to handle non-deterministic order from Lua pairs()
-		sortComponents(unmarshaledExpected)
-		sortComponents(typedActual)
-		return checker.DeepEqual(unmarshaledExpected, typedActual), nil
-
-	case *unstructured.Unstructured:
-		var unmarshaledExpected unstructured.Unstructured
-
-		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
-			return false, fmt.Errorf("failed to unmarshal expected JSON into Unstructured: %w", err)
-		}
-		// Collect field paths marked with {{EXCLUDE}} and remove them from both objects
-		excludePaths := make(map[string]bool)
-		expectedExcluded := processObjectWithExclusion(unmarshaledExpected.Object, nil, excludePaths, true).(map[string]any)
-		actualExcluded := processObjectWithExclusion(typedActual.Object, nil, excludePaths, false).(map[string]any)
-		expectedObj := &unstructured.Unstructured{Object: expectedExcluded}
-		actualObj := &unstructured.Unstructured{Object: actualExcluded}
-		return checker.DeepEqual(expectedObj, actualObj), nil
-
-	default:
-		// Fallback: marshal actualValue and do byte-wise comparison
-		actualJSON, err := k8sjson.Marshal(actualValue)
-		if err != nil {
-			return false, fmt.Errorf("failed to marshal actual value: %w", err)
-		}
-		return bytes.Equal(expectedJSONBytes, actualJSON), nil
-	}
-}
-
-// sortDependencies sorts a slice of DependentObjectReference by APIVersion, Kind, Namespace, and Name.
-func sortDependencies(deps []configv1alpha1.DependentObjectReference) {
-	sort.Slice(deps, func(i, j int) bool {
-		if deps[i].APIVersion != deps[j].APIVersion {
-			return deps[i].APIVersion < deps[j].APIVersion
-		}
-		if deps[i].Kind != deps[j].Kind {
-			return deps[i].Kind < deps[j].Kind
-		}
-		if deps[i].Namespace != deps[j].Namespace {
-			return deps[i].Namespace < deps[j].Namespace
-		}
-		return deps[i].Name < deps[j].Name
-	})
-}
-
-// sortComponents sorts a slice of Component by name.
-func sortComponents(components []workv1alpha2.Component) {
-	sort.Slice(components, func(i, j int) bool {
-		return components[i].Name < components[j].Name
-	})
-}
-
 func TestThirdPartyCustomizationsFile(t *testing.T) {
 	err := filepath.Walk("resourcecustomizations", func(path string, f os.FileInfo, err error) error {
 		if err != nil {
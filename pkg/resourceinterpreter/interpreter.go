@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,6 +31,7 @@ import (
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/webhook/request"
@@ -77,6 +80,9 @@ type ResourceInterpreter interface {
 	// InterpretHealth returns the health state of the object.
 	InterpretHealth(object *unstructured.Unstructured) (healthy bool, err error)
 
+	// InterpretRolloutStatus returns whether the object has finished rolling out its latest generation.
+	InterpretRolloutStatus(object *unstructured.Unstructured) (rolloutStatus *workv1alpha2.RolloutStatus, err error)
+
 	// other common method
 }
 
@@ -102,7 +108,7 @@ type customResourceInterpreterImpl struct {
 // ResourceInterpreterWebhookConfiguration configurations into the cache.
 // It is recommended to be called before all controllers. After called, the resource interpreter
 // will be ready to interpret custom resources.
-func (i *customResourceInterpreterImpl) Start(_ context.Context) (err error) {
+func (i *customResourceInterpreterImpl) Start(ctx context.Context) (err error) {
 	klog.Infoln("Starting resource interpreter.")
 
 	i.customizedInterpreter, err = webhook.NewCustomizedInterpreter(i.informer, i.serviceLister)
@@ -113,6 +119,17 @@ func (i *customResourceInterpreterImpl) Start(_ context.Context) (err error) {
 
 	i.thirdpartyInterpreter = thirdparty.NewConfigurableInterpreter()
 	i.defaultInterpreter = native.NewDefaultInterpreter()
+	if features.FeatureGate.Enabled(features.DynamicResourceEstimate) {
+		served, err := i.resourceClaimTemplatesServed(ctx)
+		if err != nil {
+			return err
+		}
+		if served {
+			i.defaultInterpreter.SetResourceClaimTemplateLister(i.informer.Lister(native.ResourceClaimTemplatesGVR))
+		} else {
+			klog.Warningf("Resource %s is not served, the device requests of replicas will not be interpreted.", native.ResourceClaimTemplatesGVR)
+		}
+	}
 
 	i.informer.Start()
 	i.informer.WaitForCacheSync()
@@ -125,6 +142,19 @@ func (i *customResourceInterpreterImpl) Start(_ context.Context) (err error) {
 	return nil
 }
 
+// resourceClaimTemplatesServed tells if the ResourceClaimTemplates are served by the control plane, the informer
+// of a resource not served would never be synced.
+func (i *customResourceInterpreterImpl) resourceClaimTemplatesServed(ctx context.Context) (bool, error) {
+	_, err := i.informer.GetClient().Resource(native.ResourceClaimTemplatesGVR).List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // HookEnabled tells if any hook exist for specific resource type and operation.
 func (i *customResourceInterpreterImpl) HookEnabled(objGVK schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) bool {
 	return i.defaultInterpreter.HookEnabled(objGVK, operation) ||
@@ -397,6 +427,38 @@ func (i *customResourceInterpreterImpl) InterpretHealth(object *unstructured.Uns
 	return
 }
 
+// InterpretRolloutStatus returns whether the object has finished rolling out its latest generation.
+func (i *customResourceInterpreterImpl) InterpretRolloutStatus(object *unstructured.Unstructured) (rolloutStatus *workv1alpha2.RolloutStatus, err error) {
+	rolloutStatus, hookEnabled, err := i.configurableInterpreter.InterpretRolloutStatus(object)
+	if err != nil {
+		return
+	}
+	if hookEnabled {
+		return
+	}
+
+	rolloutStatus, hookEnabled, err = i.customizedInterpreter.InterpretRolloutStatus(context.TODO(), &request.Attributes{
+		Operation: configv1alpha1.InterpreterOperationInterpretRolloutStatus,
+		Object:    object,
+	})
+	if err != nil {
+		return
+	}
+	if hookEnabled {
+		return
+	}
+	rolloutStatus, hookEnabled, err = i.thirdpartyInterpreter.InterpretRolloutStatus(object)
+	if err != nil {
+		return
+	}
+	if hookEnabled {
+		return
+	}
+
+	rolloutStatus, err = i.defaultInterpreter.InterpretRolloutStatus(object)
+	return
+}
+
 // loadConfig loads the full set of ResourceInterpreterCustomization and
 // ResourceInterpreterWebhookConfiguration configurations into the cache. It avoids resource interpreter
 // parsing errors when the resource interpreter starts and the cache is not synchronized.
@@ -118,6 +118,8 @@ type Scheduler struct {
 	schedulerEstimatorWorker            util.AsyncWorker
 	schedulerEstimatorClientConfig      *grpcconnection.ClientConfig
 	schedulerName                       string
+	// scheduleWorkers is the number of workers scheduling the bindings concurrently.
+	scheduleWorkers int
 
 	enableEmptyWorkloadPropagation bool
 }
@@ -129,6 +131,10 @@ type schedulerOptions struct {
 	disableSchedulerEstimatorInPullMode bool
 	// schedulerEstimatorTimeout specifies the timeout period of calling the accurate scheduler estimator service.
 	schedulerEstimatorTimeout metav1.Duration
+	// schedulerEstimatorBatchWindow specifies the window within which the estimation requests are coalesced into one batch request.
+	schedulerEstimatorBatchWindow metav1.Duration
+	// schedulerEstimatorMaxBatchSize specifies the maximum number of estimation requests in one batch request.
+	schedulerEstimatorMaxBatchSize int
 	// schedulerEstimatorServiceNamespace specifies the namespace to be used for discovering scheduler estimator services.
 	schedulerEstimatorServiceNamespace string
 	// SchedulerEstimatorServicePrefix presents the prefix of the accurate scheduler estimator service name.
@@ -184,6 +190,14 @@ func WithSchedulerEstimatorTimeout(schedulerEstimatorTimeout metav1.Duration) Op
 	}
 }
 
+// WithSchedulerEstimatorBatch sets the schedulerEstimatorBatchWindow and schedulerEstimatorMaxBatchSize for scheduler
+func WithSchedulerEstimatorBatch(schedulerEstimatorBatchWindow metav1.Duration, schedulerEstimatorMaxBatchSize int) Option {
+	return func(o *schedulerOptions) {
+		o.schedulerEstimatorBatchWindow = schedulerEstimatorBatchWindow
+		o.schedulerEstimatorMaxBatchSize = schedulerEstimatorMaxBatchSize
+	}
+}
+
 // WithSchedulerEstimatorServiceNamespace sets the schedulerEstimatorServiceNamespace for the scheduler
 func WithSchedulerEstimatorServiceNamespace(schedulerEstimatorServiceNamespace string) Option {
 	return func(o *schedulerOptions) {
@@ -277,6 +291,7 @@ func NewScheduler(dynamicClient dynamic.Interface, karmadaClient karmadaclientse
 		priorityQueue:        priorityQueue,
 		Algorithm:            algorithm,
 		schedulerCache:       schedulerCache,
+		scheduleWorkers:      1,
 	}
 
 	sched.clusterReconcileWorker = util.NewAsyncWorker(util.Options{
@@ -297,8 +312,11 @@ func NewScheduler(dynamicClient dynamic.Interface, karmadaClient karmadaclientse
 			ReconcileFunc: sched.reconcileEstimatorConnection,
 		}
 		sched.schedulerEstimatorWorker = util.NewAsyncWorker(schedulerEstimatorWorkerOptions)
-		schedulerEstimator := estimatorclient.NewSchedulerEstimator(sched.schedulerEstimatorCache, options.schedulerEstimatorTimeout.Duration)
+		schedulerEstimator := estimatorclient.NewSchedulerEstimator(sched.schedulerEstimatorCache, options.schedulerEstimatorTimeout.Duration,
+			estimatorclient.WithBatch(options.schedulerEstimatorBatchWindow.Duration, options.schedulerEstimatorMaxBatchSize))
 		estimatorclient.RegisterSchedulerEstimator(schedulerEstimator)
+		// The estimation requests of the bindings are coalesced only if the bindings are scheduled concurrently.
+		sched.scheduleWorkers = estimatorclient.BatchWorkers(options.schedulerEstimatorBatchWindow.Duration, options.schedulerEstimatorMaxBatchSize)
 	}
 	sched.enableEmptyWorkloadPropagation = options.enableEmptyWorkloadPropagation
 	sched.schedulerName = options.schedulerName
@@ -324,7 +342,9 @@ func (s *Scheduler) Run(ctx context.Context) {
 
 	s.clusterReconcileWorker.Run(ctx, 1)
 
-	go wait.Until(s.worker, time.Second, ctx.Done())
+	for i := 0; i < s.scheduleWorkers; i++ {
+		go wait.Until(s.worker, time.Second, ctx.Done())
+	}
 
 	// Defensive check: schedulerCache is expected to be always initialized.
 	if s.schedulerCache != nil && s.schedulerCache.AssigningResourceBindings() != nil &&
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -1787,6 +1788,49 @@ func TestCreateScheduler(t *testing.T) {
 	}
 }
 
+func TestCreateScheduler_scheduleWorkers(t *testing.T) {
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	karmadaClient := karmadafake.NewClientset()
+	kubeClient := fake.NewClientset()
+
+	tests := []struct {
+		name string
+		opts []Option
+		want int
+	}{
+		{
+			name: "scheduler estimator disabled",
+			opts: []Option{WithSchedulerEstimatorBatch(metav1.Duration{Duration: 10 * time.Millisecond}, 50)},
+			want: 1,
+		},
+		{
+			name: "batching disabled",
+			opts: []Option{
+				WithEnableSchedulerEstimator(true),
+				WithSchedulerEstimatorConnection(10025, "", "", "", false),
+				WithSchedulerEstimatorBatch(metav1.Duration{}, 50),
+			},
+			want: 1,
+		},
+		{
+			name: "batching enabled",
+			opts: []Option{
+				WithEnableSchedulerEstimator(true),
+				WithSchedulerEstimatorConnection(10025, "", "", "", false),
+				WithSchedulerEstimatorBatch(metav1.Duration{Duration: 10 * time.Millisecond}, 50),
+			},
+			want: 50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sche, err := NewScheduler(dynamicClient, karmadaClient, kubeClient, tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sche.scheduleWorkers)
+		})
+	}
+}
+
 func TestPatchBindingStatusCondition(t *testing.T) {
 	oneHourBefore := time.Now().Add(-1 * time.Hour).Round(time.Second)
 	oneHourAfter := time.Now().Add(1 * time.Hour).Round(time.Second)
@@ -19,6 +19,7 @@ package util
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
@@ -145,3 +146,18 @@ func MergePolicySuspension(bindingSuspension *workv1alpha2.Suspension, policySus
 	}
 	return bindingSuspension
 }
+
+// RetainRolloutPause keeps the dispatching of the binding suspended if its rollout is paused by the
+// RolloutPausedAnnotation, it should be applied after the suspension of the policy is merged.
+func RetainRolloutPause(bindingAnnotations map[string]string, bindingSuspension *workv1alpha2.Suspension) *workv1alpha2.Suspension {
+	if bindingAnnotations[workv1alpha2.RolloutPausedAnnotation] != "true" {
+		return bindingSuspension
+	}
+	if bindingSuspension == nil {
+		bindingSuspension = &workv1alpha2.Suspension{}
+	}
+	bindingSuspension.Dispatching = ptr.To(true)
+	// DispatchingOnClusters can not co-exist with Dispatching.
+	bindingSuspension.DispatchingOnClusters = nil
+	return bindingSuspension
+}
@@ -567,3 +567,49 @@ func TestMergePolicySuspension(t *testing.T) {
 		})
 	}
 }
+
+func TestRetainRolloutPause(t *testing.T) {
+	tests := []struct {
+		name              string
+		annotations       map[string]string
+		bindingSuspension *workv1alpha2.Suspension
+		want              *workv1alpha2.Suspension
+	}{
+		{
+			name:              "not paused keeps the suspension",
+			annotations:       map[string]string{workv1alpha2.RolloutPausedAnnotation: "false"},
+			bindingSuspension: &workv1alpha2.Suspension{Scheduling: new(true)},
+			want:              &workv1alpha2.Suspension{Scheduling: new(true)},
+		},
+		{
+			name:              "paused suspends dispatching",
+			annotations:       map[string]string{workv1alpha2.RolloutPausedAnnotation: "true"},
+			bindingSuspension: nil,
+			want: &workv1alpha2.Suspension{
+				Suspension: policyv1alpha1.Suspension{Dispatching: new(true)},
+			},
+		},
+		{
+			name:        "paused overrides the suspension on clusters",
+			annotations: map[string]string{workv1alpha2.RolloutPausedAnnotation: "true"},
+			bindingSuspension: &workv1alpha2.Suspension{
+				Suspension: policyv1alpha1.Suspension{
+					DispatchingOnClusters: &policyv1alpha1.SuspendClusters{ClusterNames: []string{"member1"}},
+				},
+				Scheduling: new(true),
+			},
+			want: &workv1alpha2.Suspension{
+				Suspension: policyv1alpha1.Suspension{Dispatching: new(true)},
+				Scheduling: new(true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RetainRolloutPause(tt.annotations, tt.bindingSuspension)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RetainRolloutPause() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
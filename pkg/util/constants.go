@@ -43,6 +43,14 @@ const (
 	// This label indicates the name.
 	MultiClusterServiceNameLabel = "multiclusterservice.karmada.io/name"
 
+	// MultiClusterIngressNamespaceLabel is added to work object, represents the work is managed by the corresponding MultiClusterIngress
+	// This label indicates the namespace.
+	MultiClusterIngressNamespaceLabel = "multiclusteringress.karmada.io/namespace"
+
+	// MultiClusterIngressNameLabel is added to work object, represents the work is managed by the corresponding MultiClusterIngress
+	// This label indicates the name.
+	MultiClusterIngressNameLabel = "multiclusteringress.karmada.io/name"
+
 	// FederatedResourceQuotaNamespaceLabel is added to Work to specify associated FederatedResourceQuota's namespace.
 	FederatedResourceQuotaNamespaceLabel = "federatedresourcequota.karmada.io/namespace"
 
@@ -104,6 +112,10 @@ const (
 	// The overrides items should be sorted alphabetically in ascending order by ClusterOverridePolicy's name.
 	AppliedClusterOverrides = "policy.karmada.io/applied-cluster-overrides"
 
+	// BindingRevisionAppliedOverridesAnnotation is added to the ControllerRevision recording a revision of the binding,
+	// it describes the overrides applied to each target cluster of the revision (json serialized).
+	BindingRevisionAppliedOverridesAnnotation = "resourcebinding.karmada.io/applied-overrides"
+
 	// EndpointSliceProvisionClusterAnnotation is added to work of the dispatch EndpointSlice in consumption clusters' namespace.
 	EndpointSliceProvisionClusterAnnotation = "endpointslice.karmada.io/provision-cluster"
 
@@ -143,6 +155,9 @@ const (
 	// MCSControllerFinalizer is added to MultiClusterService to ensure service work is deleted before itself is deleted.
 	MCSControllerFinalizer = "karmada.io/multiclusterservice-controller"
 
+	// MCIControllerFinalizer is added to MultiClusterIngress to ensure ingress works are deleted before itself is deleted.
+	MCIControllerFinalizer = "karmada.io/multiclusteringress-controller"
+
 	// PropagationPolicyControllerFinalizer is added to PropagationPolicy to ensure the related resources have been unbound before itself is deleted.
 	PropagationPolicyControllerFinalizer = "karmada.io/propagation-policy-controller"
 
@@ -438,9 +438,46 @@ func GenerateNodeClaimByPodSpec(podSpec *corev1.PodSpec) *workv1alpha2.NodeClaim
 	return nodeClaim
 }
 
+// addPodTopology adds the required inter-pod (anti-)affinity terms and the hard topology spread constraints of the
+// PodTemplateSpec to the NodeClaim, together with the pod labels the constraints are evaluated against. A NodeClaim
+// is created if the given one is nil and there is any constraint to add.
+func addPodTopology(nodeClaim *workv1alpha2.NodeClaim, podTemplate *corev1.PodTemplateSpec) *workv1alpha2.NodeClaim {
+	var hardPodAffinity, hardPodAntiAffinity []corev1.PodAffinityTerm
+	if affinity := podTemplate.Spec.Affinity; affinity != nil {
+		if affinity.PodAffinity != nil {
+			hardPodAffinity = affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+		}
+		if affinity.PodAntiAffinity != nil {
+			hardPodAntiAffinity = affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+		}
+	}
+	var topologySpreadConstraints []corev1.TopologySpreadConstraint
+	for _, constraint := range podTemplate.Spec.TopologySpreadConstraints {
+		// Only the constraints preventing the pod from being scheduled limit the replicas.
+		if constraint.WhenUnsatisfiable == corev1.DoNotSchedule {
+			topologySpreadConstraints = append(topologySpreadConstraints, constraint)
+		}
+	}
+	if len(hardPodAffinity) == 0 && len(hardPodAntiAffinity) == 0 && len(topologySpreadConstraints) == 0 {
+		return nodeClaim
+	}
+
+	if nodeClaim == nil {
+		nodeClaim = &workv1alpha2.NodeClaim{}
+	}
+	nodeClaim.PodLabels = podTemplate.Labels
+	nodeClaim.HardPodAffinity = hardPodAffinity
+	nodeClaim.HardPodAntiAffinity = hardPodAntiAffinity
+	nodeClaim.TopologySpreadConstraints = topologySpreadConstraints
+	return nodeClaim
+}
+
 // GenerateReplicaRequirements generates replica requirements for node and resources.
 func GenerateReplicaRequirements(podTemplate *corev1.PodTemplateSpec) *workv1alpha2.ReplicaRequirements {
 	nodeClaim := GenerateNodeClaimByPodSpec(&podTemplate.Spec)
+	if features.FeatureGate.Enabled(features.PodTopologyEstimate) {
+		nodeClaim = addPodTopology(nodeClaim, podTemplate)
+	}
 	resourceRequest := util.EmptyResource().AddPodTemplateRequest(&podTemplate.Spec).ResourceList()
 
 	if nodeClaim != nil || resourceRequest != nil {
@@ -459,6 +496,29 @@ func GenerateReplicaRequirements(podTemplate *corev1.PodTemplateSpec) *workv1alp
 	return nil
 }
 
+// GenerateStorageRequests generates the storage requests of each replica from the PersistentVolumeClaim templates,
+// e.g. the volumeClaimTemplates of a StatefulSet. The templates with an empty storage class, which are bound to
+// pre-provisioned volumes rather than provisioned dynamically, are ignored.
+func GenerateStorageRequests(claimTemplates []corev1.PersistentVolumeClaim) []workv1alpha2.StorageRequest {
+	var storageRequests []workv1alpha2.StorageRequest
+	for _, claimTemplate := range claimTemplates {
+		storageClassName := claimTemplate.Spec.StorageClassName
+		if storageClassName != nil && *storageClassName == "" {
+			continue
+		}
+		storage, ok := claimTemplate.Spec.Resources.Requests[corev1.ResourceStorage]
+		if !ok {
+			continue
+		}
+		storageRequest := workv1alpha2.StorageRequest{Storage: storage}
+		if storageClassName != nil {
+			storageRequest.StorageClassName = *storageClassName
+		}
+		storageRequests = append(storageRequests, storageRequest)
+	}
+	return storageRequests
+}
+
 // ConstructClusterWideKey construct resource ClusterWideKey from binding's objectReference.
 func ConstructClusterWideKey(resource workv1alpha2.ObjectReference) (keys.ClusterWideKey, error) {
 	gv, err := schema.ParseGroupVersion(resource.APIVersion)
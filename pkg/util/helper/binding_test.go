@@ -18,10 +18,12 @@ package helper
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -40,6 +42,7 @@ import (
 	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/keys"
 	"github.com/karmada-io/karmada/pkg/util/gclient"
@@ -1506,6 +1509,134 @@ func TestGenerateReplicaRequirements(t *testing.T) {
 	}
 }
 
+func TestGenerateReplicaRequirements_PodTopology(t *testing.T) {
+	antiAffinityTerm := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		TopologyKey:   corev1.LabelHostname,
+	}
+	podTemplate := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+		Spec: corev1.PodSpec{
+			Affinity: &corev1.Affinity{
+				PodAntiAffinity: &corev1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{antiAffinityTerm},
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+						{Weight: 1, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelTopologyZone}},
+					},
+				},
+			},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+				{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule},
+				{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		enabled     bool
+		podTemplate *corev1.PodTemplateSpec
+		want        *workv1alpha2.ReplicaRequirements
+	}{
+		{
+			name:        "PodTopologyEstimate disabled",
+			enabled:     false,
+			podTemplate: podTemplate,
+			want:        nil,
+		},
+		{
+			name:        "PodTopologyEstimate enabled",
+			enabled:     true,
+			podTemplate: podTemplate,
+			want: &workv1alpha2.ReplicaRequirements{
+				NodeClaim: &workv1alpha2.NodeClaim{
+					PodLabels:           map[string]string{"app": "foo"},
+					HardPodAntiAffinity: []corev1.PodAffinityTerm{antiAffinityTerm},
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.DoNotSchedule},
+					},
+				},
+			},
+		},
+		{
+			name:    "PodTopologyEstimate enabled without pod topology constraints",
+			enabled: true,
+			podTemplate: &corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.PodTopologyEstimate, tt.enabled)))
+			t.Cleanup(func() {
+				_ = features.FeatureGate.Set(fmt.Sprintf("%s=%t", features.PodTopologyEstimate, false))
+			})
+			assert.Equal(t, tt.want, GenerateReplicaRequirements(tt.podTemplate))
+		})
+	}
+}
+
+func TestGenerateStorageRequests(t *testing.T) {
+	newClaimTemplate := func(storageClassName *string, storage string) corev1.PersistentVolumeClaim {
+		claimTemplate := corev1.PersistentVolumeClaim{
+			Spec: corev1.PersistentVolumeClaimSpec{StorageClassName: storageClassName},
+		}
+		if storage != "" {
+			claimTemplate.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(storage)}
+		}
+		return claimTemplate
+	}
+
+	tests := []struct {
+		name           string
+		claimTemplates []corev1.PersistentVolumeClaim
+		expected       []workv1alpha2.StorageRequest
+	}{
+		{
+			name:     "no claim templates",
+			expected: nil,
+		},
+		{
+			name: "storage class specified",
+			claimTemplates: []corev1.PersistentVolumeClaim{
+				newClaimTemplate(new("fast"), "10Gi"),
+			},
+			expected: []workv1alpha2.StorageRequest{
+				{StorageClassName: "fast", Storage: resource.MustParse("10Gi")},
+			},
+		},
+		{
+			name: "default storage class",
+			claimTemplates: []corev1.PersistentVolumeClaim{
+				newClaimTemplate(nil, "1Gi"),
+			},
+			expected: []workv1alpha2.StorageRequest{
+				{Storage: resource.MustParse("1Gi")},
+			},
+		},
+		{
+			name: "empty storage class and no storage request are ignored",
+			claimTemplates: []corev1.PersistentVolumeClaim{
+				newClaimTemplate(new(""), "1Gi"),
+				newClaimTemplate(new("fast"), ""),
+				newClaimTemplate(new("slow"), "100Gi"),
+			},
+			expected: []workv1alpha2.StorageRequest{
+				{StorageClassName: "slow", Storage: resource.MustParse("100Gi")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GenerateStorageRequests(tt.claimTemplates))
+		})
+	}
+}
+
 func TestConstructClusterWideKey(t *testing.T) {
 	type args struct {
 		resource workv1alpha2.ObjectReference
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+// BindingRevision is the snapshot of a ResourceBinding recorded in the data of a ControllerRevision.
+type BindingRevision struct {
+	// TemplateHash is the hash of the Template.
+	TemplateHash string `json:"templateHash"`
+	// Template is the resource template without the status and the metadata other than the identifiers, labels
+	// and annotations.
+	// The labels and annotations other than the ones managed by Karmada are kept, so that they are restored along
+	// with the template.
+	Template *unstructured.Unstructured `json:"template"`
+	// Clusters is the Spec.Clusters of the binding.
+	Clusters []workv1alpha2.TargetCluster `json:"clusters,omitempty"`
+}
+
+// RollbackPlacement is the value of the RollbackPlacementAnnotation of a binding rolled back to a previous revision.
+type RollbackPlacement struct {
+	// TemplateHash is the hash of the resource template restored from the revision.
+	TemplateHash string `json:"templateHash"`
+	// Clusters is the target clusters of the revision.
+	Clusters []workv1alpha2.TargetCluster `json:"clusters"`
+}
+
+// ClusterAppliedOverrides describes the overrides applied to the resource propagated to a cluster.
+type ClusterAppliedOverrides struct {
+	// ClusterName is the name of the target cluster.
+	ClusterName string `json:"clusterName"`
+	// AppliedOverrides is the value of annotation "policy.karmada.io/applied-overrides" of the Work.
+	AppliedOverrides string `json:"appliedOverrides,omitempty"`
+	// AppliedClusterOverrides is the value of annotation "policy.karmada.io/applied-cluster-overrides" of the Work.
+	AppliedClusterOverrides string `json:"appliedClusterOverrides,omitempty"`
+}
+
+// NewBindingRevision builds the BindingRevision from the resource template and the target clusters of the binding.
+func NewBindingRevision(template *unstructured.Unstructured, clusters []workv1alpha2.TargetCluster) *BindingRevision {
+	sanitized := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(template.Object)}
+	delete(sanitized.Object, "status")
+	delete(sanitized.Object, "metadata")
+	sanitized.SetName(template.GetName())
+	sanitized.SetNamespace(template.GetNamespace())
+	if labels := userMetadata(template.GetLabels()); len(labels) > 0 {
+		sanitized.SetLabels(labels)
+	}
+	if annotations := userMetadata(template.GetAnnotations()); len(annotations) > 0 {
+		sanitized.SetAnnotations(annotations)
+	}
+
+	sortedClusters := make([]workv1alpha2.TargetCluster, len(clusters))
+	copy(sortedClusters, clusters)
+	sort.Slice(sortedClusters, func(i, j int) bool {
+		return sortedClusters[i].Name < sortedClusters[j].Name
+	})
+
+	return &BindingRevision{
+		TemplateHash: hashObject(sanitized.Object),
+		Template:     sanitized,
+		Clusters:     sortedClusters,
+	}
+}
+
+// Hash returns the hash of the revision, which identifies the ControllerRevision recording it.
+func (r *BindingRevision) Hash() string {
+	return hashObject(struct {
+		TemplateHash string
+		Clusters     []workv1alpha2.TargetCluster
+	}{r.TemplateHash, r.Clusters})
+}
+
+// RestoreTemplate returns a copy of the current resource template with the fields, labels and annotations recorded
+// in the revision restored. The rest of the metadata, the status and the labels and annotations managed by Karmada
+// are kept from the current template.
+func (r *BindingRevision) RestoreTemplate(current *unstructured.Unstructured) *unstructured.Unstructured {
+	restored := current.DeepCopy()
+	for field := range restored.Object {
+		if !isRevisionPreservedField(field) {
+			delete(restored.Object, field)
+		}
+	}
+	for field, value := range r.Template.Object {
+		if !isRevisionPreservedField(field) {
+			restored.Object[field] = runtime.DeepCopyJSONValue(value)
+		}
+	}
+	restored.SetLabels(restoreMetadata(current.GetLabels(), r.Template.GetLabels()))
+	restored.SetAnnotations(restoreMetadata(current.GetAnnotations(), r.Template.GetAnnotations()))
+	return restored
+}
+
+func isRevisionPreservedField(field string) bool {
+	switch field {
+	case "apiVersion", "kind", "metadata", "status":
+		return true
+	}
+	return false
+}
+
+// userMetadata returns the labels or annotations other than the ones managed by Karmada and kubectl, which change
+// without the user changing the resource template.
+func userMetadata(metadata map[string]string) map[string]string {
+	var filtered map[string]string
+	for key, value := range metadata {
+		if isManagedMetadataKey(key) {
+			continue
+		}
+		if filtered == nil {
+			filtered = make(map[string]string, len(metadata))
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
+
+// restoreMetadata keeps the managed labels or annotations of the current template and restores the rest from the revision.
+func restoreMetadata(current, recorded map[string]string) map[string]string {
+	restored := make(map[string]string, len(recorded))
+	for key, value := range current {
+		if isManagedMetadataKey(key) {
+			restored[key] = value
+		}
+	}
+	for key, value := range recorded {
+		restored[key] = value
+	}
+	if len(restored) == 0 {
+		return nil
+	}
+	return restored
+}
+
+func isManagedMetadataKey(key string) bool {
+	if key == corev1.LastAppliedConfigAnnotation {
+		return true
+	}
+	prefix, _, found := strings.Cut(key, "/")
+	return found && (prefix == "karmada.io" || strings.HasSuffix(prefix, ".karmada.io"))
+}
+
+// IsBindingRevisionRecorded tells whether the revisions of the binding referencing the resource are recorded.
+// Secrets are not recorded, since anyone who can read the ControllerRevisions would be able to read their data.
+func IsBindingRevisionRecorded(resource workv1alpha2.ObjectReference) bool {
+	return !(resource.APIVersion == "v1" && resource.Kind == util.SecretKind)
+}
+
+// GetBindingRevisionNamespace returns the namespace of the ControllerRevisions recording the revisions of the binding.
+// The revisions of a ClusterResourceBinding are kept in the karmada-system namespace.
+func GetBindingRevisionNamespace(bindingNamespace string) string {
+	if bindingNamespace == "" {
+		return names.NamespaceKarmadaSystem
+	}
+	return bindingNamespace
+}
+
+// GetBindingRevisionSelector returns the label selector of the ControllerRevisions recording the revisions of the binding.
+func GetBindingRevisionSelector(binding metav1.Object) map[string]string {
+	if binding.GetNamespace() == "" {
+		return map[string]string{
+			workv1alpha2.ClusterResourceBindingPermanentIDLabel: util.GetLabelValue(binding.GetLabels(), workv1alpha2.ClusterResourceBindingPermanentIDLabel),
+		}
+	}
+	return map[string]string{
+		workv1alpha2.ResourceBindingPermanentIDLabel: util.GetLabelValue(binding.GetLabels(), workv1alpha2.ResourceBindingPermanentIDLabel),
+	}
+}
+
+// NewBindingControllerRevision builds the ControllerRevision recording the revision of the ResourceBinding or
+// ClusterResourceBinding.
+func NewBindingControllerRevision(binding metav1.Object, revision *BindingRevision, revisionNumber int64) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(revision)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKind := workv1alpha2.ResourceKindResourceBinding
+	if binding.GetNamespace() == "" {
+		ownerKind = workv1alpha2.ResourceKindClusterResourceBinding
+	}
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: GetBindingRevisionNamespace(binding.GetNamespace()),
+			Name:      names.GenerateBindingRevisionName(binding.GetName(), revision.Hash()),
+			Labels:    GetBindingRevisionSelector(binding),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(binding, workv1alpha2.SchemeGroupVersion.WithKind(ownerKind)),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: revisionNumber,
+	}, nil
+}
+
+// ParseBindingRevision parses the BindingRevision from the data of the ControllerRevision.
+func ParseBindingRevision(controllerRevision *appsv1.ControllerRevision) (*BindingRevision, error) {
+	revision := &BindingRevision{}
+	if err := json.Unmarshal(controllerRevision.Data.Raw, revision); err != nil {
+		return nil, fmt.Errorf("failed to parse ControllerRevision(%s/%s): %v", controllerRevision.Namespace, controllerRevision.Name, err)
+	}
+	if revision.Template == nil {
+		return nil, fmt.Errorf("ControllerRevision(%s/%s) records no resource template", controllerRevision.Namespace, controllerRevision.Name)
+	}
+	return revision, nil
+}
+
+// SortBindingControllerRevisions sorts the ControllerRevisions of a binding in ascending order of revision number.
+func SortBindingControllerRevisions(revisions []*appsv1.ControllerRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		if revisions[i].Revision == revisions[j].Revision {
+			return revisions[i].Name < revisions[j].Name
+		}
+		return revisions[i].Revision < revisions[j].Revision
+	})
+}
+
+// PinPlacement returns a copy of the placement which schedules the resource to the given target clusters only.
+// The replicas are divided by static weights equal to the replicas of each cluster if they are divided by the placement.
+func PinPlacement(placement *policyv1alpha1.Placement, clusters []workv1alpha2.TargetCluster) *policyv1alpha1.Placement {
+	pinned := &policyv1alpha1.Placement{}
+	if placement != nil {
+		pinned = placement.DeepCopy()
+	}
+
+	clusterNames := make([]string, 0, len(clusters))
+	var weights []policyv1alpha1.StaticClusterWeight
+	for _, cluster := range clusters {
+		clusterNames = append(clusterNames, cluster.Name)
+		if cluster.Replicas > 0 {
+			weights = append(weights, policyv1alpha1.StaticClusterWeight{
+				TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{cluster.Name}},
+				Weight:        int64(cluster.Replicas),
+			})
+		}
+	}
+	pinned.ClusterAffinity = &policyv1alpha1.ClusterAffinity{ClusterNames: clusterNames}
+	pinned.ClusterAffinities = nil
+	pinned.SpreadConstraints = nil
+	if pinned.ReplicaSchedulingType() == policyv1alpha1.ReplicaSchedulingTypeDivided && len(weights) > 0 {
+		pinned.ReplicaScheduling = &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+			ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceWeighted,
+			WeightPreference:          &policyv1alpha1.ClusterPreferences{StaticWeightList: weights},
+		}
+	}
+	return pinned
+}
+
+// RetainRollbackPlacement keeps the placement of the binding pinned to the target clusters recorded in its
+// RollbackPlacementAnnotation, as long as the resource template is still the one restored by the rollback.
+// Otherwise, the annotation is removed from the binding and the placement declared by the policy is returned.
+// It should be applied after the placement of the policy is synced to the binding.
+func RetainRollbackPlacement(binding metav1.Object, placement *policyv1alpha1.Placement, template *unstructured.Unstructured) *policyv1alpha1.Placement {
+	value, exist := binding.GetAnnotations()[workv1alpha2.RollbackPlacementAnnotation]
+	if !exist {
+		return placement
+	}
+
+	rollback := &RollbackPlacement{}
+	if err := json.Unmarshal([]byte(value), rollback); err != nil || rollback.TemplateHash != NewBindingRevision(template, nil).TemplateHash {
+		annotations := binding.GetAnnotations()
+		delete(annotations, workv1alpha2.RollbackPlacementAnnotation)
+		binding.SetAnnotations(annotations)
+		return placement
+	}
+	return PinPlacement(placement, rollback.Clusters)
+}
+
+// GetClusterAppliedOverrides collects the overrides applied by the works, sorted by cluster name.
+func GetClusterAppliedOverrides(works []workv1alpha1.Work) ([]ClusterAppliedOverrides, error) {
+	var overrides []ClusterAppliedOverrides
+	for _, work := range works {
+		clusterName, err := names.GetClusterName(work.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		appliedOverrides := util.GetAnnotationValue(work.Annotations, util.AppliedOverrides)
+		appliedClusterOverrides := util.GetAnnotationValue(work.Annotations, util.AppliedClusterOverrides)
+		if appliedOverrides == "" && appliedClusterOverrides == "" {
+			continue
+		}
+		overrides = append(overrides, ClusterAppliedOverrides{
+			ClusterName:             clusterName,
+			AppliedOverrides:        appliedOverrides,
+			AppliedClusterOverrides: appliedClusterOverrides,
+		})
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].ClusterName < overrides[j].ClusterName
+	})
+	return overrides, nil
+}
+
+// hashObject hashes the json serialization of the object, which is stable as the keys of maps are sorted.
+func hashObject(obj interface{}) string {
+	data, _ := json.Marshal(obj)
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(data)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
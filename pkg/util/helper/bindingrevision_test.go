@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha1 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+)
+
+func newRevisionTemplate(resourceVersion string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "nginx",
+			"namespace":       "default",
+			"resourceVersion": resourceVersion,
+			"labels":          map[string]interface{}{"app": "nginx"},
+		},
+		"spec":   map[string]interface{}{"replicas": replicas},
+		"status": map[string]interface{}{"readyReplicas": replicas},
+	}}
+}
+
+func TestNewBindingRevision(t *testing.T) {
+	clusters := []workv1alpha2.TargetCluster{{Name: "member2", Replicas: 1}, {Name: "member1", Replicas: 2}}
+	revision := NewBindingRevision(newRevisionTemplate("1", 3), clusters)
+
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "nginx"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(3)},
+	}, revision.Template.Object)
+	assert.Equal(t, []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 1}}, revision.Clusters)
+	// the clusters of the binding are left untouched
+	assert.Equal(t, "member2", clusters[0].Name)
+
+	// metadata, status and the order of clusters make no difference
+	sameRevision := NewBindingRevision(newRevisionTemplate("2", 3),
+		[]workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 1}})
+	assert.Equal(t, revision.TemplateHash, sameRevision.TemplateHash)
+	assert.Equal(t, revision.Hash(), sameRevision.Hash())
+
+	scaledRevision := NewBindingRevision(newRevisionTemplate("1", 4), clusters)
+	assert.NotEqual(t, revision.TemplateHash, scaledRevision.TemplateHash)
+	assert.NotEqual(t, revision.Hash(), scaledRevision.Hash())
+
+	rescheduledRevision := NewBindingRevision(newRevisionTemplate("1", 3), []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}})
+	assert.Equal(t, revision.TemplateHash, rescheduledRevision.TemplateHash)
+	assert.NotEqual(t, revision.Hash(), rescheduledRevision.Hash())
+
+	// the labels and annotations managed by Karmada and kubectl make no difference
+	managed := newRevisionTemplate("1", 3)
+	managed.SetLabels(map[string]string{"app": "nginx", "propagationpolicy.karmada.io/permanent-id": "id"})
+	managed.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: "{}", "karmada.io/managed": "true"})
+	assert.Equal(t, revision.TemplateHash, NewBindingRevision(managed, clusters).TemplateHash)
+
+	relabeledTemplate := newRevisionTemplate("1", 3)
+	relabeledTemplate.SetAnnotations(map[string]string{"team": "web"})
+	relabeled := NewBindingRevision(relabeledTemplate, clusters)
+	assert.NotEqual(t, revision.TemplateHash, relabeled.TemplateHash)
+	assert.Equal(t, map[string]string{"team": "web"}, relabeled.Template.GetAnnotations())
+}
+
+func TestBindingRevisionRestoreTemplate(t *testing.T) {
+	recordedTemplate := newRevisionTemplate("1", 2)
+	recordedTemplate.SetAnnotations(map[string]string{"team": "web"})
+	revision := NewBindingRevision(recordedTemplate, nil)
+
+	current := newRevisionTemplate("3", 3)
+	current.Object["extra"] = "value"
+	current.SetLabels(map[string]string{"app": "nginx", "canary": "true", "propagationpolicy.karmada.io/permanent-id": "id"})
+
+	restored := revision.RestoreTemplate(current)
+	assert.Equal(t, map[string]interface{}{"replicas": int64(2)}, restored.Object["spec"])
+	assert.Equal(t, current.Object["status"], restored.Object["status"])
+	assert.Equal(t, "3", restored.GetResourceVersion())
+	assert.Equal(t, map[string]string{"app": "nginx", "propagationpolicy.karmada.io/permanent-id": "id"}, restored.GetLabels())
+	assert.Equal(t, map[string]string{"team": "web"}, restored.GetAnnotations())
+	assert.NotContains(t, restored.Object, "extra")
+	// the current template is left untouched
+	assert.Equal(t, "value", current.Object["extra"])
+}
+
+func TestNewAndParseBindingControllerRevision(t *testing.T) {
+	binding := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "nginx-deployment",
+			UID:       "binding-uid",
+			Labels:    map[string]string{workv1alpha2.ResourceBindingPermanentIDLabel: "permanent-id"},
+		},
+	}
+	revision := NewBindingRevision(newRevisionTemplate("1", 3), []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}})
+
+	controllerRevision, err := NewBindingControllerRevision(binding, revision, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "default", controllerRevision.Namespace)
+	assert.Equal(t, names.GenerateBindingRevisionName(binding.Name, revision.Hash()), controllerRevision.Name)
+	assert.Equal(t, "permanent-id", controllerRevision.Labels[workv1alpha2.ResourceBindingPermanentIDLabel])
+	assert.Equal(t, int64(2), controllerRevision.Revision)
+	require.Len(t, controllerRevision.OwnerReferences, 1)
+	assert.Equal(t, workv1alpha2.ResourceKindResourceBinding, controllerRevision.OwnerReferences[0].Kind)
+	assert.Equal(t, binding.UID, controllerRevision.OwnerReferences[0].UID)
+
+	parsed, err := ParseBindingRevision(controllerRevision)
+	require.NoError(t, err)
+	assert.Equal(t, revision.Hash(), parsed.Hash())
+	assert.Equal(t, revision.Clusters, parsed.Clusters)
+
+	clusterBinding := &workv1alpha2.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "reader-clusterrole",
+			UID:    "cluster-binding-uid",
+			Labels: map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "cluster-permanent-id"},
+		},
+	}
+	controllerRevision, err = NewBindingControllerRevision(clusterBinding, revision, 1)
+	require.NoError(t, err)
+	assert.Equal(t, names.NamespaceKarmadaSystem, controllerRevision.Namespace)
+	assert.Equal(t, map[string]string{workv1alpha2.ClusterResourceBindingPermanentIDLabel: "cluster-permanent-id"}, controllerRevision.Labels)
+	assert.Equal(t, workv1alpha2.ResourceKindClusterResourceBinding, controllerRevision.OwnerReferences[0].Kind)
+
+	_, err = ParseBindingRevision(&appsv1.ControllerRevision{Data: runtime.RawExtension{Raw: []byte("{}")}})
+	assert.Error(t, err)
+	_, err = ParseBindingRevision(&appsv1.ControllerRevision{Data: runtime.RawExtension{Raw: []byte("invalid")}})
+	assert.Error(t, err)
+}
+
+func TestIsBindingRevisionRecorded(t *testing.T) {
+	assert.True(t, IsBindingRevisionRecorded(workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment"}))
+	assert.True(t, IsBindingRevisionRecorded(workv1alpha2.ObjectReference{APIVersion: "v1", Kind: "ConfigMap"}))
+	assert.False(t, IsBindingRevisionRecorded(workv1alpha2.ObjectReference{APIVersion: "v1", Kind: "Secret"}))
+}
+
+func TestSortBindingControllerRevisions(t *testing.T) {
+	revisions := []*appsv1.ControllerRevision{
+		{ObjectMeta: metav1.ObjectMeta{Name: "c"}, Revision: 3},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Revision: 1},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Revision: 1},
+	}
+	SortBindingControllerRevisions(revisions)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{revisions[0].Name, revisions[1].Name, revisions[2].Name})
+}
+
+func TestPinPlacement(t *testing.T) {
+	clusters := []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member2", Replicas: 1}, {Name: "member3"}}
+
+	duplicated := PinPlacement(&policyv1alpha1.Placement{
+		ClusterAffinities:  []policyv1alpha1.ClusterAffinityTerm{{AffinityName: "primary"}},
+		SpreadConstraints:  []policyv1alpha1.SpreadConstraint{{SpreadByField: policyv1alpha1.SpreadByFieldCluster, MaxGroups: 1}},
+		ClusterTolerations: []corev1.Toleration{{Key: "maintenance", Operator: corev1.TolerationOpExists}},
+	}, clusters)
+	assert.Equal(t, &policyv1alpha1.Placement{
+		ClusterAffinity:    &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1", "member2", "member3"}},
+		ClusterTolerations: []corev1.Toleration{{Key: "maintenance", Operator: corev1.TolerationOpExists}},
+	}, duplicated)
+
+	divided := PinPlacement(&policyv1alpha1.Placement{
+		ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+			ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceAggregated,
+		},
+	}, clusters)
+	assert.Equal(t, &policyv1alpha1.ReplicaSchedulingStrategy{
+		ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+		ReplicaDivisionPreference: policyv1alpha1.ReplicaDivisionPreferenceWeighted,
+		WeightPreference: &policyv1alpha1.ClusterPreferences{StaticWeightList: []policyv1alpha1.StaticClusterWeight{
+			{TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}, Weight: 2},
+			{TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}, Weight: 1},
+		}},
+	}, divided.ReplicaScheduling)
+}
+
+func TestRetainRollbackPlacement(t *testing.T) {
+	placement := &policyv1alpha1.Placement{ClusterAffinity: &policyv1alpha1.ClusterAffinity{LabelSelector: &metav1.LabelSelector{}}}
+	template := newRevisionTemplate("1", 3)
+	newBinding := func(templateHash string) *workv1alpha2.ResourceBinding {
+		return &workv1alpha2.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"foo":                                    "bar",
+			workv1alpha2.RollbackPlacementAnnotation: `{"templateHash":"` + templateHash + `","clusters":[{"name":"member1","replicas":3}]}`,
+		}}}
+	}
+
+	// the placement stays pinned while the template is the restored one
+	binding := newBinding(NewBindingRevision(template, nil).TemplateHash)
+	retained := RetainRollbackPlacement(binding, placement, template)
+	assert.Equal(t, []string{"member1"}, retained.ClusterAffinity.ClusterNames)
+	assert.Contains(t, binding.Annotations, workv1alpha2.RollbackPlacementAnnotation)
+
+	// the pinning is released once the template is changed
+	binding = newBinding(NewBindingRevision(newRevisionTemplate("2", 4), nil).TemplateHash)
+	assert.Equal(t, placement, RetainRollbackPlacement(binding, placement, template))
+	assert.Equal(t, map[string]string{"foo": "bar"}, binding.Annotations)
+
+	assert.Equal(t, placement, RetainRollbackPlacement(&workv1alpha2.ResourceBinding{}, placement, template))
+}
+
+func TestGetClusterAppliedOverrides(t *testing.T) {
+	works := []workv1alpha1.Work{
+		{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   names.GenerateExecutionSpaceName("member2"),
+			Annotations: map[string]string{util.AppliedClusterOverrides: "cop"},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: names.GenerateExecutionSpaceName("member3")}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   names.GenerateExecutionSpaceName("member1"),
+			Annotations: map[string]string{util.AppliedOverrides: "op"},
+		}},
+	}
+
+	overrides, err := GetClusterAppliedOverrides(works)
+	require.NoError(t, err)
+	assert.Equal(t, []ClusterAppliedOverrides{
+		{ClusterName: "member1", AppliedOverrides: "op"},
+		{ClusterName: "member2", AppliedClusterOverrides: "cop"},
+	}, overrides)
+
+	_, err = GetClusterAppliedOverrides([]workv1alpha1.Work{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Annotations: map[string]string{util.AppliedOverrides: "op"}},
+	}})
+	assert.Error(t, err)
+}
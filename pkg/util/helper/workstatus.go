@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -51,6 +52,16 @@ const (
 	FullyAppliedSuccessMessage = "All works have been successfully applied"
 	// FullyAppliedFailedMessage defines the failure message for the FullyApplied condition.
 	FullyAppliedFailedMessage = "Failed to apply all works, see status.aggregatedStatus for details"
+
+	// RolloutCompletedSuccessReason defines the success reason for the RolloutCompleted condition.
+	RolloutCompletedSuccessReason = "RolloutCompleted"
+	// RolloutCompletedProgressingReason defines the reason for the RolloutCompleted condition when the rollout
+	// is still in progress in some clusters.
+	RolloutCompletedProgressingReason = "RolloutProgressing"
+	// RolloutCompletedFailedReason defines the failure reason for the RolloutCompleted condition.
+	RolloutCompletedFailedReason = "RolloutFailed"
+	// RolloutCompletedSuccessMessage defines the success message for the RolloutCompleted condition.
+	RolloutCompletedSuccessMessage = "Resource has been rolled out in all clusters"
 )
 
 // AggregateResourceBindingWorkStatus will collect all work statuses with current ResourceBinding objects,
@@ -76,6 +87,9 @@ func AggregateResourceBindingWorkStatus(
 			binding.Status.AggregatedStatus = aggregatedStatuses
 			// set binding status with the newest condition
 			meta.SetStatusCondition(&binding.Status.Conditions, generateFullyAppliedCondition(binding.Spec, aggregatedStatuses))
+			if condition := generateRolloutCompletedCondition(binding.Spec, aggregatedStatuses); condition != nil {
+				meta.SetStatusCondition(&binding.Status.Conditions, *condition)
+			}
 			return nil
 		})
 		return err
@@ -116,6 +130,9 @@ func AggregateClusterResourceBindingWorkStatus(
 			binding.Status.AggregatedStatus = aggregatedStatuses
 			// set binding status with the newest condition
 			meta.SetStatusCondition(&binding.Status.Conditions, generateFullyAppliedCondition(binding.Spec, aggregatedStatuses))
+			if condition := generateRolloutCompletedCondition(binding.Spec, aggregatedStatuses); condition != nil {
+				meta.SetStatusCondition(&binding.Status.Conditions, *condition)
+			}
 			return nil
 		})
 		return err
@@ -142,6 +159,60 @@ func generateFullyAppliedCondition(spec workv1alpha2.ResourceBindingSpec, aggreg
 	return util.NewCondition(workv1alpha2.FullyApplied, FullyAppliedFailedReason, FullyAppliedFailedMessage, metav1.ConditionFalse)
 }
 
+// generateRolloutCompletedCondition summarizes the rollout status of all target clusters into a fleet-level
+// condition. It returns nil if none of the clusters reports a rollout status, which means the resource does
+// not support the InterpretRolloutStatus operation.
+// The rollout is completed only if the resource is fully applied and the latest generation has been rolled
+// out in all target clusters.
+func generateRolloutCompletedCondition(spec workv1alpha2.ResourceBindingSpec, aggregatedStatuses []workv1alpha2.AggregatedStatusItem) *metav1.Condition {
+	reported := false
+	for _, item := range aggregatedStatuses {
+		if item.RolloutStatus != nil {
+			reported = true
+			break
+		}
+	}
+	if !reported {
+		return nil
+	}
+
+	targetClusters := ObtainBindingSpecExistingClusters(spec)
+	var failed, progressing []string
+	for _, item := range aggregatedStatuses {
+		if !targetClusters.Has(item.ClusterName) {
+			continue
+		}
+		targetClusters.Delete(item.ClusterName)
+		switch {
+		case item.RolloutStatus == nil:
+			progressing = append(progressing, item.ClusterName)
+		case item.RolloutStatus.State == workv1alpha2.RolloutFailed:
+			failed = append(failed, item.ClusterName)
+		case !item.Applied || item.RolloutStatus.State != workv1alpha2.RolloutDone:
+			progressing = append(progressing, item.ClusterName)
+		case item.RolloutStatus.ObservedGeneration != item.RolloutStatus.Generation:
+			// the controller in member cluster has not observed the latest generation yet.
+			progressing = append(progressing, item.ClusterName)
+		}
+	}
+	// clusters without collected status are still in progress.
+	progressing = append(progressing, sets.List(targetClusters)...)
+	sort.Strings(progressing)
+
+	var condition metav1.Condition
+	switch {
+	case len(failed) > 0:
+		condition = util.NewCondition(workv1alpha2.RolloutCompleted, RolloutCompletedFailedReason,
+			fmt.Sprintf("Rollout failed in clusters: %s", strings.Join(failed, ", ")), metav1.ConditionFalse)
+	case len(progressing) > 0:
+		condition = util.NewCondition(workv1alpha2.RolloutCompleted, RolloutCompletedProgressingReason,
+			fmt.Sprintf("Rollout is in progress in clusters: %s", strings.Join(progressing, ", ")), metav1.ConditionFalse)
+	default:
+		condition = util.NewCondition(workv1alpha2.RolloutCompleted, RolloutCompletedSuccessReason, RolloutCompletedSuccessMessage, metav1.ConditionTrue)
+	}
+	return &condition
+}
+
 // assemble workStatuses from workList which list by selector and match with workload.
 func assembleWorkStatus(works []workv1alpha1.Work, objRef workv1alpha2.ObjectReference) ([]workv1alpha2.AggregatedStatusItem, error) {
 	statuses := make([]workv1alpha2.AggregatedStatusItem, 0)
@@ -204,6 +275,7 @@ func assembleWorkStatus(works []workv1alpha1.Work, objRef workv1alpha2.ObjectRef
 			if equal {
 				aggregatedStatus.Status = work.Status.ManifestStatuses[i].Status
 				aggregatedStatus.Health = workv1alpha2.ResourceHealth(work.Status.ManifestStatuses[i].Health)
+				aggregatedStatus.RolloutStatus = convertRolloutStatus(work.Status.ManifestStatuses[i].RolloutStatus)
 				break
 			}
 		}
@@ -216,6 +288,22 @@ func assembleWorkStatus(works []workv1alpha1.Work, objRef workv1alpha2.ObjectRef
 	return statuses, nil
 }
 
+// convertRolloutStatus converts the rollout status collected in the Work to the one aggregated in the binding.
+func convertRolloutStatus(rolloutStatus *workv1alpha1.RolloutStatus) *workv1alpha2.RolloutStatus {
+	if rolloutStatus == nil {
+		return nil
+	}
+	return &workv1alpha2.RolloutStatus{
+		State:              workv1alpha2.RolloutState(rolloutStatus.State),
+		Generation:         rolloutStatus.Generation,
+		ObservedGeneration: rolloutStatus.ObservedGeneration,
+		UpdatedReplicas:    rolloutStatus.UpdatedReplicas,
+		ReadyReplicas:      rolloutStatus.ReadyReplicas,
+		AvailableReplicas:  rolloutStatus.AvailableReplicas,
+		Message:            rolloutStatus.Message,
+	}
+}
+
 // ManifestReference identifies an object in manifest list
 type ManifestReference struct {
 	APIVersion string
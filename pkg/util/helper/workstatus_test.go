@@ -733,6 +733,103 @@ func TestGenerateFullyAppliedCondition(t *testing.T) {
 	assert.Equal(t, expectedFalse, resultFalse.Status, "generateFullyAppliedCondition with partially applied statuses")
 }
 
+func TestGenerateRolloutCompletedCondition(t *testing.T) {
+	spec := workv1alpha2.ResourceBindingSpec{
+		Clusters: []workv1alpha2.TargetCluster{
+			{Name: "cluster1"},
+			{Name: "cluster2"},
+		},
+	}
+	done := &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutDone}
+	progressing := &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutProgressing}
+	failed := &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutFailed}
+	staleGeneration := &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutDone, Generation: 2, ObservedGeneration: 1}
+
+	tests := []struct {
+		name           string
+		statuses       []workv1alpha2.AggregatedStatusItem
+		expectNil      bool
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name: "no rollout status reported",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true},
+				{ClusterName: "cluster2", Applied: true},
+			},
+			expectNil: true,
+		},
+		{
+			name: "rollout done in all clusters",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true, RolloutStatus: done},
+				{ClusterName: "cluster2", Applied: true, RolloutStatus: done},
+			},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: RolloutCompletedSuccessReason,
+		},
+		{
+			name: "rollout in progress in one cluster",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true, RolloutStatus: done},
+				{ClusterName: "cluster2", Applied: true, RolloutStatus: progressing},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: RolloutCompletedProgressingReason,
+		},
+		{
+			name: "status of one cluster not collected yet",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true, RolloutStatus: done},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: RolloutCompletedProgressingReason,
+		},
+		{
+			name: "rollout done but not applied in one cluster",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true, RolloutStatus: done},
+				{ClusterName: "cluster2", Applied: false, RolloutStatus: done},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: RolloutCompletedProgressingReason,
+		},
+		{
+			name: "latest generation not observed in one cluster",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true, RolloutStatus: done},
+				{ClusterName: "cluster2", Applied: true, RolloutStatus: staleGeneration},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: RolloutCompletedProgressingReason,
+		},
+		{
+			name: "rollout failed in one cluster",
+			statuses: []workv1alpha2.AggregatedStatusItem{
+				{ClusterName: "cluster1", Applied: true, RolloutStatus: failed},
+				{ClusterName: "cluster2", Applied: true, RolloutStatus: progressing},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: RolloutCompletedFailedReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := generateRolloutCompletedCondition(spec, tt.statuses)
+			if tt.expectNil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.NotNil(t, got)
+			assert.Equal(t, workv1alpha2.RolloutCompleted, got.Type)
+			assert.Equal(t, tt.expectedStatus, got.Status)
+			assert.Equal(t, tt.expectedReason, got.Reason)
+		})
+	}
+}
+
 func TestWorksFullyApplied(t *testing.T) {
 	type args struct {
 		aggregatedStatuses []workv1alpha2.AggregatedStatusItem
@@ -36,6 +36,7 @@ var AllResourceInterpreterCustomizationRules = []Rule{
 	&statusReflectionRule{},
 	&statusAggregationRule{},
 	&healthInterpretationRule{},
+	&rolloutStatusInterpretationRule{},
 	&dependencyInterpretationRule{},
 }
 
@@ -61,6 +62,13 @@ func (r *retentionRule) GetScript(c *configv1alpha1.ResourceInterpreterCustomiza
 	return ""
 }
 
+func (r *retentionRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.Retention != nil {
+		return c.Spec.Customizations.Retention.CELExpression
+	}
+	return ""
+}
+
 func (r *retentionRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.Retention = nil
@@ -120,6 +128,13 @@ func (r *replicaResourceRule) GetScript(c *configv1alpha1.ResourceInterpreterCus
 	return ""
 }
 
+func (r *replicaResourceRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.ReplicaResource != nil {
+		return c.Spec.Customizations.ReplicaResource.CELExpression
+	}
+	return ""
+}
+
 func (r *replicaResourceRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.ReplicaResource = nil
@@ -180,6 +195,13 @@ func (r *componentResourceRule) GetScript(c *configv1alpha1.ResourceInterpreterC
 	return ""
 }
 
+func (r *componentResourceRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.ComponentResource != nil {
+		return c.Spec.Customizations.ComponentResource.CELExpression
+	}
+	return ""
+}
+
 func (r *componentResourceRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.ComponentResource = nil
@@ -230,6 +252,13 @@ func (r *replicaRevisionRule) GetScript(c *configv1alpha1.ResourceInterpreterCus
 	return ""
 }
 
+func (r *replicaRevisionRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.ReplicaRevision != nil {
+		return c.Spec.Customizations.ReplicaRevision.CELExpression
+	}
+	return ""
+}
+
 func (r *replicaRevisionRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.ReplicaRevision = nil
@@ -281,6 +310,13 @@ func (s *statusReflectionRule) GetScript(c *configv1alpha1.ResourceInterpreterCu
 	return ""
 }
 
+func (s *statusReflectionRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.StatusReflection != nil {
+		return c.Spec.Customizations.StatusReflection.CELExpression
+	}
+	return ""
+}
+
 func (s *statusReflectionRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.StatusReflection = nil
@@ -333,6 +369,13 @@ func (s *statusAggregationRule) GetScript(c *configv1alpha1.ResourceInterpreterC
 	return ""
 }
 
+func (s *statusAggregationRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.StatusAggregation != nil {
+		return c.Spec.Customizations.StatusAggregation.CELExpression
+	}
+	return ""
+}
+
 func (s *statusAggregationRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.StatusAggregation = nil
@@ -386,6 +429,13 @@ func (h *healthInterpretationRule) GetScript(c *configv1alpha1.ResourceInterpret
 	return ""
 }
 
+func (h *healthInterpretationRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.HealthInterpretation != nil {
+		return c.Spec.Customizations.HealthInterpretation.CELExpression
+	}
+	return ""
+}
+
 func (h *healthInterpretationRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.HealthInterpretation = nil
@@ -413,6 +463,70 @@ func (h *healthInterpretationRule) Run(interpreter *declarative.ConfigurableInte
 	return newRuleResult().add("healthy", healthy)
 }
 
+type rolloutStatusInterpretationRule struct {
+}
+
+func (r *rolloutStatusInterpretationRule) Name() string {
+	return string(configv1alpha1.InterpreterOperationInterpretRolloutStatus)
+}
+
+func (r *rolloutStatusInterpretationRule) Document() string {
+	return `This rule is used to assess whether a specific resource has finished rolling out its latest generation.
+The script should implement a function as follows:
+luaScript: >
+function InterpretRolloutStatus(observedObj)
+  local rolloutStatus = {}
+  rolloutStatus.updatedReplicas = observedObj.status.updatedReplicas
+  if observedObj.status.updatedReplicas == observedObj.spec.replicas then
+    rolloutStatus.state = "Done"
+  else
+    rolloutStatus.state = "Progressing"
+  end
+  return rolloutStatus
+end`
+}
+
+func (r *rolloutStatusInterpretationRule) GetScript(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.RolloutStatusInterpretation != nil {
+		return c.Spec.Customizations.RolloutStatusInterpretation.LuaScript
+	}
+	return ""
+}
+
+func (r *rolloutStatusInterpretationRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.RolloutStatusInterpretation != nil {
+		return c.Spec.Customizations.RolloutStatusInterpretation.CELExpression
+	}
+	return ""
+}
+
+func (r *rolloutStatusInterpretationRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
+	if script == "" {
+		c.Spec.Customizations.RolloutStatusInterpretation = nil
+		return
+	}
+
+	if c.Spec.Customizations.RolloutStatusInterpretation == nil {
+		c.Spec.Customizations.RolloutStatusInterpretation = &configv1alpha1.RolloutStatusInterpretation{}
+	}
+	c.Spec.Customizations.RolloutStatusInterpretation.LuaScript = script
+}
+
+func (r *rolloutStatusInterpretationRule) Run(interpreter *declarative.ConfigurableInterpreter, args RuleArgs) *RuleResult {
+	obj, err := args.getObjectOrError()
+	if err != nil {
+		return newRuleResultWithError(err)
+	}
+	rolloutStatus, enabled, err := interpreter.InterpretRolloutStatus(obj)
+	if err != nil {
+		return newRuleResultWithError(err)
+	}
+	if !enabled {
+		return newRuleResultWithError(fmt.Errorf("rule is not enabled"))
+	}
+	return newRuleResult().add("rolloutStatus", rolloutStatus)
+}
+
 type dependencyInterpretationRule struct {
 }
 
@@ -445,6 +559,13 @@ func (d *dependencyInterpretationRule) GetScript(c *configv1alpha1.ResourceInter
 	return ""
 }
 
+func (d *dependencyInterpretationRule) GetCELExpression(c *configv1alpha1.ResourceInterpreterCustomization) string {
+	if c.Spec.Customizations.DependencyInterpretation != nil {
+		return c.Spec.Customizations.DependencyInterpretation.CELExpression
+	}
+	return ""
+}
+
 func (d *dependencyInterpretationRule) SetScript(c *configv1alpha1.ResourceInterpreterCustomization, script string) {
 	if script == "" {
 		c.Spec.Customizations.DependencyInterpretation = nil
@@ -480,6 +601,8 @@ type Rule interface {
 	Document() string
 	// GetScript returns the script for the rule from customization. If not enabled, return empty
 	GetScript(*configv1alpha1.ResourceInterpreterCustomization) string
+	// GetCELExpression returns the CEL expression for the rule from customization. If not enabled, return empty
+	GetCELExpression(*configv1alpha1.ResourceInterpreterCustomization) string
 	// SetScript set the script for the rule. If script is empty, disable the rule.
 	SetScript(*configv1alpha1.ResourceInterpreterCustomization, string)
 	// Run execute the rule with given args, and return the result.
@@ -678,6 +678,41 @@ func TestHealthInterpretationRule_SetScript(t *testing.T) {
 	})
 }
 
+func TestRolloutStatusInterpretationRule_Name(t *testing.T) {
+	r := &rolloutStatusInterpretationRule{}
+	expected := string(configv1alpha1.InterpreterOperationInterpretRolloutStatus)
+	actual := r.Name()
+	assert.Equal(t, expected, actual, "Name should return %v", expected)
+}
+
+func TestRolloutStatusInterpretationRule_GetScript(t *testing.T) {
+	r := &rolloutStatusInterpretationRule{}
+	c := &configv1alpha1.ResourceInterpreterCustomization{
+		Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+			Customizations: configv1alpha1.CustomizationRules{
+				RolloutStatusInterpretation: &configv1alpha1.RolloutStatusInterpretation{
+					LuaScript: "return 'test script'",
+				},
+			},
+		},
+	}
+	assert.Equal(t, "return 'test script'", r.GetScript(c))
+	assert.Empty(t, r.GetScript(&configv1alpha1.ResourceInterpreterCustomization{}))
+}
+
+func TestRolloutStatusInterpretationRule_SetScript(t *testing.T) {
+	c := &configv1alpha1.ResourceInterpreterCustomization{}
+	r := &rolloutStatusInterpretationRule{}
+
+	r.SetScript(c, "test script")
+	if assert.NotNil(t, c.Spec.Customizations.RolloutStatusInterpretation) {
+		assert.Equal(t, "test script", c.Spec.Customizations.RolloutStatusInterpretation.LuaScript)
+	}
+
+	r.SetScript(c, "")
+	assert.Nil(t, c.Spec.Customizations.RolloutStatusInterpretation)
+}
+
 func TestDependencyInterpretationRule_Name(t *testing.T) {
 	r := &dependencyInterpretationRule{}
 	expected := string(configv1alpha1.InterpreterOperationInterpretDependency)
@@ -946,3 +981,26 @@ func TestRuleResultAdd(t *testing.T) {
 		t.Errorf("Unexpected result at index 1: %v", r.Results[1])
 	}
 }
+
+func TestRules_GetCELExpression(t *testing.T) {
+	c := &configv1alpha1.ResourceInterpreterCustomization{
+		Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+			Customizations: configv1alpha1.CustomizationRules{
+				Retention:                   &configv1alpha1.LocalValueRetention{CELExpression: "Retain"},
+				ReplicaResource:             &configv1alpha1.ReplicaResourceRequirement{CELExpression: "InterpretReplica"},
+				ComponentResource:           &configv1alpha1.ComponentResourceRequirement{CELExpression: "InterpretComponent"},
+				ReplicaRevision:             &configv1alpha1.ReplicaRevision{CELExpression: "ReviseReplica"},
+				StatusReflection:            &configv1alpha1.StatusReflection{CELExpression: "InterpretStatus"},
+				StatusAggregation:           &configv1alpha1.StatusAggregation{CELExpression: "AggregateStatus"},
+				HealthInterpretation:        &configv1alpha1.HealthInterpretation{CELExpression: "InterpretHealth"},
+				RolloutStatusInterpretation: &configv1alpha1.RolloutStatusInterpretation{CELExpression: "InterpretRolloutStatus"},
+				DependencyInterpretation:    &configv1alpha1.DependencyInterpretation{CELExpression: "InterpretDependency"},
+			},
+		},
+	}
+	for _, rule := range AllResourceInterpreterCustomizationRules {
+		assert.Equal(t, rule.Name(), rule.GetCELExpression(c))
+		assert.Empty(t, rule.GetScript(c))
+		assert.Empty(t, rule.GetCELExpression(&configv1alpha1.ResourceInterpreterCustomization{}))
+	}
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/conversion"
+	k8sjson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+)
+
+// ExcludePlaceholder is the value used in the expected output of a TestCase to mark
+// fields that should be ignored during comparison, e.g. timestamps.
+const ExcludePlaceholder = "{{EXCLUDE}}"
+
+var resultChecker = conversion.EqualitiesOrDie(
+	func(a, b resource.Quantity) bool {
+		return a.Equal(b)
+	})
+
+// TestCase describes an individual test case of the interpreter customization rules.
+type TestCase struct {
+	// Name is the name of the test case.
+	Name string `json:"name"`
+	// Description is the description of the test case.
+	Description string `json:"description,omitempty"`
+	// DesiredObj is the desired object.
+	DesiredObj *unstructured.Unstructured `json:"desiredObj,omitempty"`
+	// ObservedObj is the observed object.
+	ObservedObj *unstructured.Unstructured `json:"observedObj,omitempty"`
+	// StatusItems is the status items of aggregated status.
+	StatusItems []workv1alpha2.AggregatedStatusItem `json:"statusItems,omitempty"`
+	// InputReplicas is the input replicas for revise operation.
+	InputReplicas int64 `json:"inputReplicas,omitempty"`
+	// Operation is the operation of resource interpreter.
+	Operation string `json:"operation"`
+	// Output is the expected results, the key is the name of result returned by the rule,
+	// e.g. 'replica' and 'requires' for the InterpretReplica operation.
+	Output map[string]any `json:"output,omitempty"`
+
+	// Filepath is the file the test case is loaded from, used for logging.
+	Filepath string `json:"-"`
+}
+
+// ResultMismatch describes a result which is not the same as expected.
+type ResultMismatch struct {
+	// Name is the name of result.
+	Name string
+	// Expected is the expected value, nil if the result is missing from the expected output.
+	Expected any
+	// Actual is the actual value, nil if the expected result is not returned by the rule.
+	Actual any
+}
+
+// TestCaseResult is the result of running a TestCase.
+type TestCaseResult struct {
+	// Err is the error occurred when running the rule.
+	Err error
+	// Mismatches are the results which are not the same as expected.
+	Mismatches []ResultMismatch
+	// Unchecked are the names of results which are not covered by the expected output.
+	Unchecked []string
+}
+
+// Passed tells whether the test case is passed.
+func (r *TestCaseResult) Passed() bool {
+	return r.Err == nil && len(r.Mismatches) == 0
+}
+
+// LoadTestCases loads test cases from the given file or directory. Each file may contain
+// multiple test cases separated by '---', and only YAML and JSON files are loaded from directory.
+func LoadTestCases(path string) ([]TestCase, error) {
+	var testCases []TestCase
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if p != path {
+			switch filepath.Ext(p) {
+			case ".yaml", ".yml", ".json":
+			default:
+				return nil
+			}
+		}
+
+		data, err := os.ReadFile(p) // #nosec G304 -- path is given by the user on purpose.
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %v", p, err)
+		}
+		cases, err := decodeTestCases(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode file %s: %v", p, err)
+		}
+		for i := range cases {
+			cases[i].Filepath = p
+		}
+		testCases = append(testCases, cases...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return testCases, nil
+}
+
+func decodeTestCases(data []byte) ([]TestCase, error) {
+	var testCases []TestCase
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var testCase TestCase
+		if err := decoder.Decode(&testCase); err != nil {
+			if errors.Is(err, io.EOF) {
+				return testCases, nil
+			}
+			return nil, err
+		}
+		if testCase.Operation == "" {
+			// skip empty documents
+			continue
+		}
+		testCases = append(testCases, testCase)
+	}
+}
+
+// RunTestCase runs the rule of the test case's operation and compares the results with the expected output.
+func (r Rules) RunTestCase(interpreter *declarative.ConfigurableInterpreter, testCase TestCase) *TestCaseResult {
+	rule := r.GetByOperation(testCase.Operation)
+	if rule == nil {
+		return &TestCaseResult{Err: fmt.Errorf("operation %s is not supported. Use one of: %s", testCase.Operation, strings.Join(r.Names(), ", "))}
+	}
+
+	ruleResult := rule.Run(interpreter, RuleArgs{
+		Desired:  testCase.DesiredObj,
+		Observed: testCase.ObservedObj,
+		Status:   testCase.StatusItems,
+		Replica:  testCase.InputReplicas,
+	})
+	if ruleResult.Err != nil {
+		return &TestCaseResult{Err: ruleResult.Err}
+	}
+
+	result := &TestCaseResult{}
+	checked := make(map[string]bool, len(testCase.Output))
+	for _, res := range ruleResult.Results {
+		expected, ok := testCase.Output[res.Name]
+		if !ok {
+			result.Unchecked = append(result.Unchecked, res.Name)
+			continue
+		}
+		checked[res.Name] = true
+
+		normalizedExpected, normalizedActual, err := normalizeResult(expected, res.Value)
+		if err != nil {
+			return &TestCaseResult{Err: fmt.Errorf("failed to compare result %s: %v", res.Name, err)}
+		}
+		if !resultChecker.DeepEqual(normalizedExpected, normalizedActual) {
+			result.Mismatches = append(result.Mismatches, ResultMismatch{Name: res.Name, Expected: normalizedExpected, Actual: normalizedActual})
+		}
+	}
+
+	names := make([]string, 0, len(testCase.Output))
+	for name := range testCase.Output {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !checked[name] {
+			result.Mismatches = append(result.Mismatches, ResultMismatch{Name: name, Expected: testCase.Output[name]})
+		}
+	}
+	return result
+}
+
+// normalizeResult converts the expected value to the type of actual value, so that they can be compared
+// semantically. Dependencies and components are sorted to handle non-deterministic order from Lua pairs(),
+// and fields marked with ExcludePlaceholder are removed from both expected and actual objects.
+func normalizeResult(expected, actual any) (normalizedExpected, normalizedActual any, err error) {
+	expectedJSONBytes, err := k8sjson.Marshal(expected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal expected value: %w", err)
+	}
+
+	switch typedActual := actual.(type) {
+	case *workv1alpha2.ReplicaRequirements:
+		var unmarshaledExpected *workv1alpha2.ReplicaRequirements
+		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal expected JSON into ReplicaRequirements: %w", err)
+		}
+		return unmarshaledExpected, typedActual, nil
+
+	case *workv1alpha2.RolloutStatus:
+		var unmarshaledExpected *workv1alpha2.RolloutStatus
+		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal expected JSON into RolloutStatus: %w", err)
+		}
+		return unmarshaledExpected, typedActual, nil
+
+	case []configv1alpha1.DependentObjectReference:
+		var unmarshaledExpected []configv1alpha1.DependentObjectReference
+		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal expected JSON into []DependentObjectReference: %w", err)
+		}
+		// This matches the sorting logic in ConfigurableInterpreter.GetDependencies
+		sortDependencies(unmarshaledExpected)
+		sortDependencies(typedActual)
+		return unmarshaledExpected, typedActual, nil
+
+	case []workv1alpha2.Component:
+		var unmarshaledExpected []workv1alpha2.Component
+		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal expected JSON into []Component: %w", err)
+		}
+		sortComponents(unmarshaledExpected)
+		sortComponents(typedActual)
+		return unmarshaledExpected, typedActual, nil
+
+	case *unstructured.Unstructured:
+		var unmarshaledExpected unstructured.Unstructured
+		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal expected JSON into Unstructured: %w", err)
+		}
+		// Collect field paths marked with ExcludePlaceholder and remove them from both objects
+		excludePaths := make(map[string]bool)
+		expectedExcluded := processObjectWithExclusion(unmarshaledExpected.Object, nil, excludePaths, true).(map[string]any)
+		actualExcluded := processObjectWithExclusion(typedActual.Object, nil, excludePaths, false).(map[string]any)
+		return &unstructured.Unstructured{Object: expectedExcluded}, &unstructured.Unstructured{Object: actualExcluded}, nil
+
+	default:
+		// Fallback: compare the JSON representation of both values
+		var unmarshaledExpected, unmarshaledActual any
+		if err := k8sjson.Unmarshal(expectedJSONBytes, &unmarshaledExpected); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal expected value: %w", err)
+		}
+		actualJSONBytes, err := k8sjson.Marshal(actual)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal actual value: %w", err)
+		}
+		if err := k8sjson.Unmarshal(actualJSONBytes, &unmarshaledActual); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal actual value: %w", err)
+		}
+		return unmarshaledExpected, unmarshaledActual, nil
+	}
+}
+
+// processObjectWithExclusion recursively traverses an object to handle fields marked with ExcludePlaceholder.
+// It operates in two modes controlled by the `isCollecting` flag.
+//
+// In the first pass (`isCollecting = true`), it identifies fields with the ExcludePlaceholder value,
+// adds their paths to the `excludePaths` map, and returns a new object with these fields removed.
+// This pass is typically done on the 'expected' object from a test case.
+//
+// In the second pass (`isCollecting = false`), it uses the pre-populated `excludePaths` map to remove
+// the corresponding fields from the object it is processing. This pass is typically done on the
+// 'actual' object from a test execution.
+//
+// This two-pass mechanism ensures that both 'expected' and 'actual' objects are compared
+// after removing a consistent set of fields.
+func processObjectWithExclusion(obj any, currentPath []string, excludePaths map[string]bool, isCollecting bool) any {
+	switch val := obj.(type) {
+	case map[string]any:
+		result := make(map[string]any)
+		for k, v := range val {
+			fieldPath := append(currentPath, k)
+			pathStr := strings.Join(fieldPath, ".")
+
+			if isCollecting {
+				// Collect exclude markers during first pass
+				if str, ok := v.(string); ok && str == ExcludePlaceholder {
+					excludePaths[pathStr] = true
+					continue
+				}
+			} else {
+				// Skip excluded fields during second pass
+				if excludePaths[pathStr] {
+					continue
+				}
+			}
+
+			// Recursively process nested structures
+			result[k] = processObjectWithExclusion(v, fieldPath, excludePaths, isCollecting)
+		}
+		return result
+
+	case []any:
+		result := make([]any, len(val))
+		for i, v := range val {
+			indexPath := append(currentPath, fmt.Sprintf("%d", i))
+			result[i] = processObjectWithExclusion(v, indexPath, excludePaths, isCollecting)
+		}
+		return result
+
+	default:
+		return val
+	}
+}
+
+// sortDependencies sorts a slice of DependentObjectReference by APIVersion, Kind, Namespace, and Name.
+func sortDependencies(deps []configv1alpha1.DependentObjectReference) {
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].APIVersion != deps[j].APIVersion {
+			return deps[i].APIVersion < deps[j].APIVersion
+		}
+		if deps[i].Kind != deps[j].Kind {
+			return deps[i].Kind < deps[j].Kind
+		}
+		if deps[i].Namespace != deps[j].Namespace {
+			return deps[i].Namespace < deps[j].Namespace
+		}
+		return deps[i].Name < deps[j].Name
+	})
+}
+
+// sortComponents sorts a slice of Component by name.
+func sortComponents(components []workv1alpha2.Component) {
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interpreter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+)
+
+func TestLoadTestCases(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"multi.yaml": `name: first
+operation: InterpretHealth
+---
+---
+name: second
+operation: InterpretStatus
+`,
+		"single.json": `{"name": "third", "operation": "Retain"}`,
+		"README.md":   "not a test case",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	testCases, err := LoadTestCases(dir)
+	require.NoError(t, err)
+	require.Len(t, testCases, 3)
+	assert.Equal(t, "first", testCases[0].Name)
+	assert.Equal(t, filepath.Join(dir, "multi.yaml"), testCases[0].Filepath)
+	assert.Equal(t, "second", testCases[1].Name)
+	assert.Equal(t, "third", testCases[2].Name)
+	assert.Equal(t, filepath.Join(dir, "single.json"), testCases[2].Filepath)
+
+	// a file given explicitly is loaded regardless of its extension
+	testCases, err = LoadTestCases(filepath.Join(dir, "multi.yaml"))
+	require.NoError(t, err)
+	assert.Len(t, testCases, 2)
+
+	_, err = LoadTestCases(filepath.Join(dir, "not-exist"))
+	assert.Error(t, err)
+}
+
+func TestRules_RunTestCase(t *testing.T) {
+	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
+	configurableInterpreter.LoadConfig([]*configv1alpha1.ResourceInterpreterCustomization{
+		{
+			Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+				Target: configv1alpha1.CustomizationTarget{APIVersion: "apps/v1", Kind: "Deployment"},
+				Customizations: configv1alpha1.CustomizationRules{
+					HealthInterpretation: &configv1alpha1.HealthInterpretation{
+						LuaScript: `function InterpretHealth(observedObj)
+  return observedObj.status.readyReplicas == observedObj.spec.replicas
+end`,
+					},
+					ReplicaRevision: &configv1alpha1.ReplicaRevision{
+						LuaScript: `function ReviseReplica(obj, desiredReplica)
+  obj.spec.replicas = desiredReplica
+  obj.metadata.annotations = {revised = "true"}
+  return obj
+end`,
+					},
+				},
+			},
+		},
+	})
+
+	deployment := func(replicas, readyReplicas int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "nginx"},
+			"spec":       map[string]any{"replicas": replicas},
+			"status":     map[string]any{"readyReplicas": readyReplicas},
+		}}
+	}
+
+	tests := []struct {
+		name           string
+		testCase       TestCase
+		wantErr        bool
+		wantMismatches []string
+		wantUnchecked  []string
+	}{
+		{
+			name: "passed",
+			testCase: TestCase{
+				Operation:   "InterpretHealth",
+				ObservedObj: deployment(3, 3),
+				Output:      map[string]any{"healthy": true},
+			},
+		},
+		{
+			name: "result mismatch",
+			testCase: TestCase{
+				Operation:   "InterpretHealth",
+				ObservedObj: deployment(3, 1),
+				Output:      map[string]any{"healthy": true},
+			},
+			wantMismatches: []string{"healthy"},
+		},
+		{
+			name: "expected result not returned",
+			testCase: TestCase{
+				Operation:   "InterpretHealth",
+				ObservedObj: deployment(3, 3),
+				Output:      map[string]any{"healthy": true, "status": map[string]any{}},
+			},
+			wantMismatches: []string{"status"},
+		},
+		{
+			name: "result not checked",
+			testCase: TestCase{
+				Operation:   "InterpretHealth",
+				ObservedObj: deployment(3, 3),
+			},
+			wantUnchecked: []string{"healthy"},
+		},
+		{
+			name: "excluded fields are ignored",
+			testCase: TestCase{
+				Operation:     "ReviseReplica",
+				ObservedObj:   deployment(3, 3),
+				InputReplicas: 5,
+				Output: map[string]any{"revised": map[string]any{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata":   map[string]any{"name": "nginx", "annotations": ExcludePlaceholder},
+					"spec":       map[string]any{"replicas": 5},
+					"status":     map[string]any{"readyReplicas": 3},
+				}},
+			},
+		},
+		{
+			name: "unknown operation",
+			testCase: TestCase{
+				Operation: "Unknown",
+			},
+			wantErr: true,
+		},
+		{
+			name: "rule is not configured",
+			testCase: TestCase{
+				Operation:   "InterpretStatus",
+				ObservedObj: deployment(3, 3),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Rules(AllResourceInterpreterCustomizationRules).RunTestCase(configurableInterpreter, tt.testCase)
+			if tt.wantErr {
+				assert.Error(t, result.Err)
+				assert.False(t, result.Passed())
+				return
+			}
+			require.NoError(t, result.Err)
+
+			var mismatches []string
+			for _, m := range result.Mismatches {
+				mismatches = append(mismatches, m.Name)
+			}
+			assert.Equal(t, tt.wantMismatches, mismatches)
+			assert.Equal(t, tt.wantUnchecked, result.Unchecked)
+			assert.Equal(t, len(tt.wantMismatches) == 0, result.Passed())
+		})
+	}
+}
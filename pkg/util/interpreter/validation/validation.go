@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 )
 
 // VerifyDependencies verifies dependencies.
@@ -44,3 +45,18 @@ func VerifyDependencies(dependencies []configv1alpha1.DependentObjectReference)
 	}
 	return allErrs.ToAggregate()
 }
+
+// VerifyRolloutStatus verifies rollout status.
+func VerifyRolloutStatus(rolloutStatus *workv1alpha2.RolloutStatus) error {
+	fldPath := field.NewPath("rolloutStatus")
+	if rolloutStatus == nil {
+		return field.Required(fldPath, "missing required rolloutStatus")
+	}
+	switch rolloutStatus.State {
+	case workv1alpha2.RolloutDone, workv1alpha2.RolloutProgressing, workv1alpha2.RolloutFailed:
+		return nil
+	default:
+		return field.NotSupported(fldPath.Child("state"), rolloutStatus.State,
+			[]workv1alpha2.RolloutState{workv1alpha2.RolloutDone, workv1alpha2.RolloutProgressing, workv1alpha2.RolloutFailed})
+	}
+}
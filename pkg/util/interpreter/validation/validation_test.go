@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 )
 
 func TestVerifyDependencies(t *testing.T) {
@@ -244,3 +245,43 @@ func TestVerifyDependencies(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		rolloutStatus   *workv1alpha2.RolloutStatus
+		wantErrContains string
+	}{
+		{
+			name:          "done",
+			rolloutStatus: &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutDone},
+		},
+		{
+			name:          "failed",
+			rolloutStatus: &workv1alpha2.RolloutStatus{State: workv1alpha2.RolloutFailed, Message: "deadline exceeded"},
+		},
+		{
+			name:            "missing rollout status",
+			wantErrContains: "missing required rolloutStatus",
+		},
+		{
+			name:            "unsupported state",
+			rolloutStatus:   &workv1alpha2.RolloutStatus{State: "Unknown"},
+			wantErrContains: "rolloutStatus.state: Unsupported value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyRolloutStatus(tt.rolloutStatus)
+			if tt.wantErrContains == "" {
+				if err != nil {
+					t.Errorf("VerifyRolloutStatus() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("VerifyRolloutStatus() error = %v, want error containing %q", err, tt.wantErrContains)
+			}
+		})
+	}
+}
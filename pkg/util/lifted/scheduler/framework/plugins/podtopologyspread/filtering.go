@@ -0,0 +1,373 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This code is directly lifted from the Kubernetes codebase in order to avoid relying on the k8s.io/kubernetes package.
+// For reference:
+// https://github.com/kubernetes/kubernetes/blob/release-1.26/pkg/scheduler/framework/plugins/podtopologyspread/filtering.go
+
+package podtopologyspread
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework"
+)
+
+const preFilterStateKey = "PreFilter" + Name
+
+// +lifted:source=https://github.com/kubernetes/kubernetes/blob/release-1.26/pkg/scheduler/framework/plugins/podtopologyspread/filtering.go
+// +lifted:changed
+
+// preFilterState computed at PreFilter and used at Filter.
+// It combines TpKeyToDomainsMatchNums and TpPairToMatchNum to represent:
+// (1) the minimum pod match numbers of each topology key.
+// (2) how many pods match each topology pair.
+// The critical paths of kube-scheduler, which track 2 of the domains only, are replaced by the
+// match numbers of all the domains, since they don't hold the global minimum as pods are added
+// one after another onto different domains.
+type preFilterState struct {
+	Constraints []topologySpreadConstraint
+	// TpKeyToDomainsMatchNums is keyed with topologyKey, and valued with the match numbers of its domains.
+	TpKeyToDomainsMatchNums map[string]*domainsMatchNums
+	// TpKeyToDomainsNum is keyed with topologyKey, and valued with the number of domains.
+	TpKeyToDomainsNum map[string]int
+	// TpPairToMatchNum is keyed with topologyPair, and valued with the number of matching pods.
+	TpPairToMatchNum map[topologyPair]int
+}
+
+// minMatchNum returns the global minimum for the calculation of skew while taking MinDomains into account.
+func (s *preFilterState) minMatchNum(tpKey string, minDomains int32) (int, error) {
+	matchNums, ok := s.TpKeyToDomainsMatchNums[tpKey]
+	if !ok {
+		return 0, fmt.Errorf("failed to retrieve match numbers by topology key")
+	}
+
+	minMatchNum := matchNums.min
+
+	domainsNum, ok := s.TpKeyToDomainsNum[tpKey]
+	if !ok {
+		return 0, fmt.Errorf("failed to retrieve the number of domains by topology key")
+	}
+
+	if domainsNum < int(minDomains) {
+		// When the number of eligible domains with matching topology keys is less than `minDomains`,
+		// it treats "global minimum" as 0.
+		minMatchNum = 0
+	}
+
+	return minMatchNum, nil
+}
+
+// Clone makes a copy of the given state.
+func (s *preFilterState) Clone() framework.StateData {
+	if s == nil {
+		return nil
+	}
+	copy := preFilterState{
+		// Constraints are shared because they don't change.
+		Constraints:             s.Constraints,
+		TpKeyToDomainsMatchNums: make(map[string]*domainsMatchNums, len(s.TpKeyToDomainsMatchNums)),
+		// The number of domains does not change as a result of AddPod/RemovePod methods on PreFilter Extensions
+		TpKeyToDomainsNum: s.TpKeyToDomainsNum,
+		TpPairToMatchNum:  make(map[topologyPair]int, len(s.TpPairToMatchNum)),
+	}
+	for tpKey, matchNums := range s.TpKeyToDomainsMatchNums {
+		copy.TpKeyToDomainsMatchNums[tpKey] = matchNums.clone()
+	}
+	for tpPair, matchNum := range s.TpPairToMatchNum {
+		copy.TpPairToMatchNum[tpPair] = matchNum
+	}
+	return &copy
+}
+
+// domainsMatchNums counts the domains by their match numbers, so that the minimum match number is kept
+// up to date as the match number of any domain changes.
+type domainsMatchNums struct {
+	// counts is keyed with the match number, and valued with the number of domains having it.
+	counts map[int]int
+	min    int
+}
+
+func newDomainsMatchNums() *domainsMatchNums {
+	return &domainsMatchNums{counts: map[int]int{}, min: math.MaxInt32}
+}
+
+func (d *domainsMatchNums) clone() *domainsMatchNums {
+	copy := &domainsMatchNums{counts: make(map[int]int, len(d.counts)), min: d.min}
+	for num, count := range d.counts {
+		copy.counts[num] = count
+	}
+	return copy
+}
+
+// add records a new domain with the match number.
+func (d *domainsMatchNums) add(num int) {
+	d.counts[num]++
+	d.min = min(d.min, num)
+}
+
+// update moves a domain from the old match number to the new one.
+func (d *domainsMatchNums) update(oldNum, newNum int) {
+	if d.counts[oldNum]--; d.counts[oldNum] <= 0 {
+		delete(d.counts, oldNum)
+	}
+	d.counts[newNum]++
+	if newNum < d.min {
+		d.min = newNum
+		return
+	}
+	if _, ok := d.counts[d.min]; !ok {
+		d.min = math.MaxInt32
+		for num := range d.counts {
+			d.min = min(d.min, num)
+		}
+	}
+}
+
+func (pl *PodTopologySpread) updateWithPod(s *preFilterState, updatedPod, preemptorPod *corev1.Pod, node *corev1.Node, delta int) {
+	if s == nil || updatedPod.Namespace != preemptorPod.Namespace || node == nil {
+		return
+	}
+	if !nodeLabelsMatchSpreadConstraints(node.Labels, s.Constraints) {
+		return
+	}
+
+	requiredSchedulingTerm := nodeaffinity.GetRequiredNodeAffinity(preemptorPod)
+	podLabelSet := labels.Set(updatedPod.Labels)
+	for _, constraint := range s.Constraints {
+		if !constraint.Selector.Matches(podLabelSet) {
+			continue
+		}
+
+		if !constraint.matchNodeInclusionPolicies(preemptorPod, node, requiredSchedulingTerm) {
+			continue
+		}
+
+		k, v := constraint.TopologyKey, node.Labels[constraint.TopologyKey]
+		pair := topologyPair{key: k, value: v}
+		oldNum, ok := s.TpPairToMatchNum[pair]
+		s.TpPairToMatchNum[pair] += delta
+
+		if ok {
+			s.TpKeyToDomainsMatchNums[k].update(oldNum, s.TpPairToMatchNum[pair])
+		} else {
+			s.TpKeyToDomainsMatchNums[k].add(s.TpPairToMatchNum[pair])
+		}
+	}
+}
+
+// getPreFilterState fetches a pre-computed preFilterState.
+func getPreFilterState(cycleState *framework.CycleState) (*preFilterState, error) {
+	c, err := cycleState.Read(preFilterStateKey)
+	if err != nil {
+		// preFilterState doesn't exist, likely PreFilter wasn't invoked.
+		return nil, fmt.Errorf("reading %q from cycleState: %w", preFilterStateKey, err)
+	}
+
+	s, ok := c.(*preFilterState)
+	if !ok {
+		return nil, fmt.Errorf("%+v  convert to podtopologyspread.preFilterState error", c)
+	}
+	return s, nil
+}
+
+// calPreFilterState computes preFilterState describing how pods are spread on topologies.
+func (pl *PodTopologySpread) calPreFilterState(ctx context.Context, pod *corev1.Pod) (*preFilterState, error) {
+	allNodes, err := pl.sharedLister.NodeInfos().List()
+	if err != nil {
+		return nil, fmt.Errorf("listing NodeInfos: %w", err)
+	}
+	constraints, err := filterTopologySpreadConstraints(pod.Spec.TopologySpreadConstraints, pod.Labels, corev1.DoNotSchedule)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining pod's hard topology spread constraints: %w", err)
+	}
+	if len(constraints) == 0 {
+		return &preFilterState{}, nil
+	}
+
+	s := preFilterState{
+		Constraints:             constraints,
+		TpKeyToDomainsMatchNums: make(map[string]*domainsMatchNums, len(constraints)),
+		TpKeyToDomainsNum:       make(map[string]int, len(constraints)),
+		TpPairToMatchNum:        make(map[topologyPair]int, sizeHeuristic(len(allNodes), constraints)),
+	}
+
+	tpCountsByNode := make([]map[topologyPair]int, len(allNodes))
+	requiredNodeAffinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+	processNode := func(i int) {
+		nodeInfo := allNodes[i]
+		node := nodeInfo.Node()
+		if node == nil {
+			klog.ErrorS(nil, "Node not found")
+			return
+		}
+
+		// Ensure current node's labels contains all topologyKeys in 'Constraints'.
+		if !nodeLabelsMatchSpreadConstraints(node.Labels, constraints) {
+			return
+		}
+
+		tpCounts := make(map[topologyPair]int, len(constraints))
+		for _, c := range constraints {
+			if !c.matchNodeInclusionPolicies(pod, node, requiredNodeAffinity) {
+				continue
+			}
+
+			pair := topologyPair{key: c.TopologyKey, value: node.Labels[c.TopologyKey]}
+			count := countPodsMatchSelector(nodeInfo.Pods, c.Selector, pod.Namespace)
+			tpCounts[pair] = count
+		}
+		tpCountsByNode[i] = tpCounts
+	}
+	pl.parallelizer.Until(ctx, len(allNodes), processNode)
+
+	for _, tpCounts := range tpCountsByNode {
+		for tp, count := range tpCounts {
+			s.TpPairToMatchNum[tp] += count
+		}
+	}
+	for tp := range s.TpPairToMatchNum {
+		s.TpKeyToDomainsNum[tp.key]++
+	}
+
+	// calculate min match for each topology pair
+	for i := 0; i < len(constraints); i++ {
+		key := constraints[i].TopologyKey
+		s.TpKeyToDomainsMatchNums[key] = newDomainsMatchNums()
+	}
+	for pair, num := range s.TpPairToMatchNum {
+		s.TpKeyToDomainsMatchNums[pair.key].add(num)
+	}
+
+	return &s, nil
+}
+
+// PreFilter invoked at the prefilter extension point.
+func (pl *PodTopologySpread) PreFilter(ctx context.Context, cycleState *framework.CycleState, pod *corev1.Pod) *framework.Status {
+	s, err := pl.calPreFilterState(ctx, pod)
+	if err != nil {
+		return framework.AsStatus(err)
+	}
+	cycleState.Write(preFilterStateKey, s)
+	return nil
+}
+
+// PreFilterExtensions returns prefilter extensions, pod add and remove.
+func (pl *PodTopologySpread) PreFilterExtensions() framework.PreFilterExtensions {
+	return pl
+}
+
+// AddPod from pre-computed data in cycleState.
+func (pl *PodTopologySpread) AddPod(_ context.Context, cycleState *framework.CycleState, podToSchedule *corev1.Pod, podInfoToAdd *framework.PodInfo, nodeInfo *framework.NodeInfo) *framework.Status {
+	s, err := getPreFilterState(cycleState)
+	if err != nil {
+		return framework.AsStatus(err)
+	}
+
+	pl.updateWithPod(s, podInfoToAdd.Pod, podToSchedule, nodeInfo.Node(), 1)
+	return nil
+}
+
+// RemovePod from pre-computed data in cycleState.
+func (pl *PodTopologySpread) RemovePod(_ context.Context, cycleState *framework.CycleState, podToSchedule *corev1.Pod, podInfoToRemove *framework.PodInfo, nodeInfo *framework.NodeInfo) *framework.Status {
+	s, err := getPreFilterState(cycleState)
+	if err != nil {
+		return framework.AsStatus(err)
+	}
+
+	pl.updateWithPod(s, podInfoToRemove.Pod, podToSchedule, nodeInfo.Node(), -1)
+	return nil
+}
+
+// Filter invoked at the filter extension point.
+func (pl *PodTopologySpread) Filter(_ context.Context, cycleState *framework.CycleState, pod *corev1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	node := nodeInfo.Node()
+	if node == nil {
+		return framework.AsStatus(fmt.Errorf("node not found"))
+	}
+
+	s, err := getPreFilterState(cycleState)
+	if err != nil {
+		return framework.AsStatus(err)
+	}
+
+	// However, "empty" preFilterState is legit which tolerates every toSchedule Pod.
+	if len(s.Constraints) == 0 {
+		return nil
+	}
+
+	podLabelSet := labels.Set(pod.Labels)
+	for _, c := range s.Constraints {
+		tpKey := c.TopologyKey
+		tpVal, ok := node.Labels[c.TopologyKey]
+		if !ok {
+			klog.V(5).InfoS("Node doesn't have required label", "node", klog.KObj(node), "label", tpKey)
+			return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonNodeLabelNotMatch)
+		}
+
+		// judging criteria:
+		// 'existing matching num' + 'if self-match (1 or 0)' - 'global minimum' <= 'maxSkew'
+		minMatchNum, err := s.minMatchNum(tpKey, c.MinDomains)
+		if err != nil {
+			klog.ErrorS(err, "Internal error occurred while retrieving value precalculated in PreFilter", "topologyKey", tpKey)
+			continue
+		}
+
+		selfMatchNum := 0
+		if c.Selector.Matches(podLabelSet) {
+			selfMatchNum = 1
+		}
+
+		pair := topologyPair{key: tpKey, value: tpVal}
+		matchNum := 0
+		if tpCount, ok := s.TpPairToMatchNum[pair]; ok {
+			matchNum = tpCount
+		}
+		skew := matchNum + selfMatchNum - minMatchNum
+		if skew > int(c.MaxSkew) {
+			klog.V(5).InfoS("Node failed spreadConstraint: matchNum + selfMatchNum - minMatchNum > maxSkew", "node", klog.KObj(node), "topologyKey", tpKey, "matchNum", matchNum, "selfMatchNum", selfMatchNum, "minMatchNum", minMatchNum, "maxSkew", c.MaxSkew)
+			return framework.NewStatus(framework.Unschedulable, ErrReasonConstraintsNotMatch)
+		}
+	}
+
+	return nil
+}
+
+func sizeHeuristic(nodes int, constraints []topologySpreadConstraint) int {
+	for _, c := range constraints {
+		if c.TopologyKey == corev1.LabelHostname {
+			return nodes
+		}
+	}
+	return 0
+}
+
+// nodeLabelsMatchSpreadConstraints checks if ALL topology keys in spread Constraints are present in node labels.
+func nodeLabelsMatchSpreadConstraints(nodeLabels map[string]string, constraints []topologySpreadConstraint) bool {
+	for _, c := range constraints {
+		if _, ok := nodeLabels[c.TopologyKey]; !ok {
+			return false
+		}
+	}
+	return true
+}
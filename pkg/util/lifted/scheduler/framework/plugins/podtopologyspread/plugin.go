@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This code is directly lifted from the Kubernetes codebase in order to avoid relying on the k8s.io/kubernetes package.
+// For reference:
+// https://github.com/kubernetes/kubernetes/blob/release-1.26/pkg/scheduler/framework/plugins/podtopologyspread/plugin.go
+
+package podtopologyspread
+
+import (
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util/lifted/scheduler/framework/parallelize"
+)
+
+const (
+	// ErrReasonConstraintsNotMatch is used for PodTopologySpread filter error.
+	ErrReasonConstraintsNotMatch = "node(s) didn't match pod topology spread constraints"
+	// ErrReasonNodeLabelNotMatch is used when the node doesn't hold the required label.
+	ErrReasonNodeLabelNotMatch = ErrReasonConstraintsNotMatch + " (missing required label)"
+)
+
+// Name is the name of the plugin used in the plugin registry and configurations.
+const Name = "PodTopologySpread"
+
+var _ framework.PreFilterPlugin = &PodTopologySpread{}
+var _ framework.FilterPlugin = &PodTopologySpread{}
+
+// +lifted:source=https://github.com/kubernetes/kubernetes/blob/release-1.26/pkg/scheduler/framework/plugins/podtopologyspread/plugin.go
+// +lifted:changed
+
+// PodTopologySpread is a plugin that ensures pod's topologySpreadConstraints is satisfied.
+// The default constraints of kube-scheduler are not applied, only the constraints of the pod are.
+type PodTopologySpread struct {
+	parallelizer parallelize.Parallelizer
+	sharedLister framework.SharedLister
+}
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (pl *PodTopologySpread) Name() string {
+	return Name
+}
+
+// New initializes a new plugin and returns it.
+func New(sharedLister framework.SharedLister) *PodTopologySpread {
+	return &PodTopologySpread{
+		parallelizer: parallelize.NewParallelizer(parallelize.DefaultParallelism),
+		sharedLister: sharedLister,
+	}
+}
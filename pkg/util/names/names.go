@@ -45,6 +45,9 @@ const (
 	// KarmadaSchedulerEstimatorComponentName is the name of the Karmada Scheduler Estimator component.
 	KarmadaSchedulerEstimatorComponentName = "karmada-scheduler-estimator"
 
+	// KarmadaMultiClusterDNSComponentName is the name of the Karmada Multi-Cluster DNS component.
+	KarmadaMultiClusterDNSComponentName = "karmada-multicluster-dns"
+
 	// KarmadaSearchComponentName is the name of the Karmada Search addon.
 	KarmadaSearchComponentName = "karmada-search"
 
@@ -155,6 +158,16 @@ func GenerateWorkName(kind, name, namespace string) string {
 	return fmt.Sprintf("%s-%s", name, rand.SafeEncodeString(fmt.Sprint(hash.Sum32())))
 }
 
+// GenerateBindingRevisionName generates the name of the ControllerRevision which records a revision of the binding.
+func GenerateBindingRevisionName(bindingName, hash string) string {
+	// The name of a ControllerRevision must be a valid DNS subdomain name, which is no more than 253 characters,
+	// reserve the room for the hash suffix.
+	if len(bindingName) > 223 {
+		bindingName = bindingName[:223]
+	}
+	return fmt.Sprintf("%s-%s", bindingName, hash)
+}
+
 // GenerateServiceAccountName generates the name of a ServiceAccount.
 func GenerateServiceAccountName(clusterName string) string {
 	return fmt.Sprintf("%s-%s", "karmada", clusterName)
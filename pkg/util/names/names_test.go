@@ -319,6 +319,34 @@ func TestGenerateDerivedServiceName(t *testing.T) {
 	}
 }
 
+func TestGenerateBindingRevisionName(t *testing.T) {
+	tests := []struct {
+		name        string
+		bindingName string
+		hash        string
+		expected    string
+	}{
+		{
+			name:        "short binding name",
+			bindingName: "nginx-deployment",
+			hash:        "5d4b8f7c9",
+			expected:    "nginx-deployment-5d4b8f7c9",
+		},
+		{
+			name:        "long binding name is truncated",
+			bindingName: strings.Repeat("a", 250),
+			hash:        "5d4b8f7c9",
+			expected:    strings.Repeat("a", 223) + "-5d4b8f7c9",
+		},
+	}
+	for _, test := range tests {
+		got := GenerateBindingRevisionName(test.bindingName, test.hash)
+		if got != test.expected {
+			t.Errorf("Test %s failed: expected %v, but got %v", test.name, test.expected, got)
+		}
+	}
+}
+
 func TestGenerateEstimatorDeploymentName(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -82,6 +82,7 @@ var supportedInterpreterOperation = sets.NewString(
 	string(configv1alpha1.InterpreterOperationAggregateStatus),
 	string(configv1alpha1.InterpreterOperationInterpretStatus),
 	string(configv1alpha1.InterpreterOperationInterpretHealth),
+	string(configv1alpha1.InterpreterOperationInterpretRolloutStatus),
 )
 
 var acceptedInterpreterContextVersions = []string{configv1alpha1.GroupVersion.Version}
@@ -99,6 +100,18 @@ func validateWebhook(hook *configv1alpha1.ResourceInterpreterWebhook, fldPath *f
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("timeoutSeconds"), *hook.TimeoutSeconds, "the timeout value must be between 1 and 30 seconds"))
 	}
 
+	if hook.MaxBatchSize != nil && *hook.MaxBatchSize < 1 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("maxBatchSize"), *hook.MaxBatchSize, "must be greater than or equal to 1"))
+	}
+
+	if hook.BatchWindowMilliseconds != nil && *hook.BatchWindowMilliseconds < 1 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("batchWindowMilliseconds"), *hook.BatchWindowMilliseconds, "must be greater than or equal to 1"))
+	}
+
+	if hook.CacheTTLSeconds != nil && *hook.CacheTTLSeconds < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("cacheTTLSeconds"), *hook.CacheTTLSeconds, "must be greater than or equal to 0"))
+	}
+
 	cc := hook.ClientConfig
 	switch {
 	case (cc.URL == nil) == (cc.Service == nil):
@@ -231,6 +231,27 @@ func TestValidateWebhook(t *testing.T) {
 			},
 			expectedError: "the timeout value must be between 1 and 30 seconds",
 		},
+		{
+			name: "invalid max batch size",
+			hook: &configv1alpha1.ResourceInterpreterWebhook{
+				MaxBatchSize: new(int32(0)),
+			},
+			expectedError: "webhooks.maxBatchSize: Invalid value: 0: must be greater than or equal to 1",
+		},
+		{
+			name: "invalid batch window milliseconds",
+			hook: &configv1alpha1.ResourceInterpreterWebhook{
+				BatchWindowMilliseconds: new(int32(0)),
+			},
+			expectedError: "webhooks.batchWindowMilliseconds: Invalid value: 0: must be greater than or equal to 1",
+		},
+		{
+			name: "invalid cache ttl seconds",
+			hook: &configv1alpha1.ResourceInterpreterWebhook{
+				CacheTTLSeconds: new(int32(-1)),
+			},
+			expectedError: "webhooks.cacheTTLSeconds: Invalid value: -1: must be greater than or equal to 0",
+		},
 		{
 			name: "ClientConfig: exactly one of url or service is required",
 			hook: &configv1alpha1.ResourceInterpreterWebhook{
@@ -17,6 +17,7 @@ limitations under the License.
 package interpreter
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -81,12 +82,29 @@ func (wh *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		wh.writeResponse(w, res)
 		return
 	}
+
+	if len(interpreterContext.Requests) > 0 {
+		wh.handleBatch(ctx, w, interpreterContext.Requests)
+		return
+	}
 	klog.V(1).Infof("Received request UID: %q, kind: %s", request.UID, request.Kind)
 
 	res = wh.Handle(ctx, request)
 	wh.writeResponse(w, res)
 }
 
+// handleBatch handles each of the requests sent in one batch and writes all responses together.
+func (wh *Webhook) handleBatch(ctx context.Context, w io.Writer, requests []configv1alpha1.ResourceInterpreterRequest) {
+	klog.V(1).Infof("Received batch request with %d items", len(requests))
+
+	responses := make([]configv1alpha1.ResourceInterpreterResponse, len(requests))
+	for i := range requests {
+		klog.V(4).Infof("Handling batch request item UID: %q, kind: %s", requests[i].UID, requests[i].Kind)
+		responses[i] = wh.Handle(ctx, Request{ResourceInterpreterRequest: requests[i]}).ResourceInterpreterResponse
+	}
+	wh.writeResourceInterpreterResponse(w, configv1alpha1.ResourceInterpreterContext{Responses: responses})
+}
+
 // writeResponse writes response to w generically, i.e. without encoding GVK information.
 func (wh *Webhook) writeResponse(w io.Writer, response Response) {
 	wh.writeResourceInterpreterResponse(w, configv1alpha1.ResourceInterpreterContext{
@@ -109,12 +127,20 @@ func (wh *Webhook) writeResourceInterpreterResponse(w io.Writer, interpreterCont
 			klog.Errorf("still unable to encode and write the InternalServerError response: %v", err)
 		}
 	} else {
-		response := interpreterContext.Response
-		if response.Successful {
-			klog.V(4).Infof("Wrote response UID: %q, successful: %t", response.UID, response.Successful)
-		} else {
-			klog.V(4).Infof("Wrote response UID: %q, successful: %t, response.status.code: %d, response.status.message: %s",
-				response.UID, response.Successful, response.Status.Code, response.Status.Message)
+		if interpreterContext.Response != nil {
+			logResponse(interpreterContext.Response)
+		}
+		for i := range interpreterContext.Responses {
+			logResponse(&interpreterContext.Responses[i])
 		}
 	}
 }
+
+func logResponse(response *configv1alpha1.ResourceInterpreterResponse) {
+	if response.Successful {
+		klog.V(4).Infof("Wrote response UID: %q, successful: %t", response.UID, response.Successful)
+	} else {
+		klog.V(4).Infof("Wrote response UID: %q, successful: %t, response.status.code: %d, response.status.message: %s",
+			response.UID, response.Successful, response.Status.Code, response.Status.Message)
+	}
+}
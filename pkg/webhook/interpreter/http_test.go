@@ -202,6 +202,60 @@ func TestServeHTTP(t *testing.T) {
 	}
 }
 
+// healthByNameHandler reports objects named "healthy" as healthy.
+type healthByNameHandler struct{}
+
+func (h *healthByNameHandler) Handle(_ context.Context, req Request) Response {
+	healthy := req.Name == "healthy"
+	return Response{
+		ResourceInterpreterResponse: configv1alpha1.ResourceInterpreterResponse{
+			Successful: true,
+			Healthy:    &healthy,
+		},
+	}
+}
+
+func TestServeHTTP_BatchRequest(t *testing.T) {
+	requestBody := configv1alpha1.ResourceInterpreterContext{
+		Requests: []configv1alpha1.ResourceInterpreterRequest{
+			{UID: "uid-1", Name: "healthy", Operation: configv1alpha1.InterpreterOperationInterpretHealth},
+			{UID: "uid-2", Name: "unhealthy", Operation: configv1alpha1.InterpreterOperationInterpretHealth},
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	if err != nil {
+		t.Fatalf("failed to marshal request body: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	NewWebhook(&healthByNameHandler{}, &Decoder{}).ServeHTTP(recorder, req)
+
+	got := configv1alpha1.ResourceInterpreterContext{}
+	if err = json.Unmarshal(recorder.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if got.Response != nil {
+		t.Errorf("expected no single response, got %v", got.Response)
+	}
+	if len(got.Responses) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(got.Responses))
+	}
+	for i, want := range []struct {
+		uid     string
+		healthy bool
+	}{{"uid-1", true}, {"uid-2", false}} {
+		res := got.Responses[i]
+		if string(res.UID) != want.uid || !res.Successful || res.Healthy == nil || *res.Healthy != want.healthy {
+			t.Errorf("unexpected response %d: uid %s, successful %t, healthy %v", i, res.UID, res.Successful, res.Healthy)
+		}
+		if res.Status == nil || res.Status.Code != http.StatusOK {
+			t.Errorf("expected status code %d in response %d, got %v", http.StatusOK, i, res.Status)
+		}
+	}
+}
+
 func TestWriteResponse(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -138,6 +138,39 @@ func (v *ValidatingAdmission) validateMultiClusterServiceSpec(mcs *networkingv1a
 			allErrs = append(allErrs, field.Invalid(clusterNamePath, clusterName, strings.Join(errMegs, ",")))
 		}
 	}
+
+	if mcs.Spec.TrafficPolicy != nil {
+		allErrs = append(allErrs, v.validateTrafficPolicy(mcs.Spec.TrafficPolicy, specPath.Child("trafficPolicy"))...)
+	}
+	return allErrs
+}
+
+// validateTrafficPolicy validates MultiClusterService TrafficPolicy.
+func (v *ValidatingAdmission) validateTrafficPolicy(policy *networkingv1alpha1.TrafficPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch policy.LocalityPreference {
+	case "", networkingv1alpha1.LocalityPreferenceRegion, networkingv1alpha1.LocalityPreferenceZone:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("localityPreference"), policy.LocalityPreference,
+			[]string{string(networkingv1alpha1.LocalityPreferenceRegion), string(networkingv1alpha1.LocalityPreferenceZone)}))
+	}
+
+	allClusterNames := sets.New[string]()
+	weightsPath := fldPath.Child("providerWeights")
+	for i, providerWeight := range policy.ProviderWeights {
+		weightPath := weightsPath.Index(i)
+		if errMegs := clustervalidation.ValidateClusterName(providerWeight.Name); len(errMegs) > 0 {
+			allErrs = append(allErrs, field.Invalid(weightPath.Child("name"), providerWeight.Name, strings.Join(errMegs, ",")))
+		}
+		if allClusterNames.Has(providerWeight.Name) {
+			allErrs = append(allErrs, field.Duplicate(weightPath.Child("name"), providerWeight.Name))
+		} else {
+			allClusterNames.Insert(providerWeight.Name)
+		}
+		if providerWeight.Weight < 0 || providerWeight.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(weightPath.Child("weight"), providerWeight.Weight, "must be between 0 and 100, inclusive"))
+		}
+	}
 	return allErrs
 }
 
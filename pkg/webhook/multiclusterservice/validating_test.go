@@ -464,6 +464,46 @@ func TestValidateMultiClusterServiceSpec(t *testing.T) {
 			},
 			expectedErr: field.ErrorList{field.Invalid(specFld.Child("range").Child("providerClusters").Index(0), strings.Repeat("a", 49), "must be no more than 48 characters")},
 		},
+		{
+			name: "valid traffic policy",
+			mcs: &networkingv1alpha1.MultiClusterService{
+				Spec: networkingv1alpha1.MultiClusterServiceSpec{
+					Types: []networkingv1alpha1.ExposureType{
+						networkingv1alpha1.ExposureTypeCrossCluster,
+					},
+					TrafficPolicy: &networkingv1alpha1.TrafficPolicy{
+						LocalityPreference: networkingv1alpha1.LocalityPreferenceZone,
+						ProviderWeights: []networkingv1alpha1.ProviderWeight{
+							{Name: "member1", Weight: 0},
+							{Name: "member2", Weight: 100},
+						},
+					},
+				},
+			},
+			expectedErr: field.ErrorList{},
+		},
+		{
+			name: "invalid traffic policy",
+			mcs: &networkingv1alpha1.MultiClusterService{
+				Spec: networkingv1alpha1.MultiClusterServiceSpec{
+					Types: []networkingv1alpha1.ExposureType{
+						networkingv1alpha1.ExposureTypeCrossCluster,
+					},
+					TrafficPolicy: &networkingv1alpha1.TrafficPolicy{
+						LocalityPreference: "Provider",
+						ProviderWeights: []networkingv1alpha1.ProviderWeight{
+							{Name: "member1", Weight: 50},
+							{Name: "member1", Weight: 101},
+						},
+					},
+				},
+			},
+			expectedErr: field.ErrorList{
+				field.NotSupported(specFld.Child("trafficPolicy").Child("localityPreference"), networkingv1alpha1.LocalityPreference("Provider"), []string{"Region", "Zone"}),
+				field.Duplicate(specFld.Child("trafficPolicy").Child("providerWeights").Index(1).Child("name"), "member1"),
+				field.Invalid(specFld.Child("trafficPolicy").Child("providerWeights").Index(1).Child("weight"), int32(101), "must be between 0 and 100, inclusive"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/celvm"
 	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative/luavm"
 	"github.com/karmada-io/karmada/pkg/util/interpreter"
 )
@@ -36,9 +37,9 @@ func validateCustomizationRule(oldRules, newRules *configv1alpha1.ResourceInterp
 		if rule.Name() == string(configv1alpha1.InterpreterOperationInterpretDependency) {
 			continue
 		}
-		oldScript := rule.GetScript(oldRules)
-		newScript := rule.GetScript(newRules)
-		if oldScript != "" && newScript != "" {
+		oldRuleSet := rule.GetScript(oldRules) != "" || rule.GetCELExpression(oldRules) != ""
+		newRuleSet := rule.GetScript(newRules) != "" || rule.GetCELExpression(newRules) != ""
+		if oldRuleSet && newRuleSet {
 			return fmt.Errorf("conflicting with InterpreterOperation(%s) of existing ResourceInterpreterCustomization(%s)", rule.Name(), oldRules.Name)
 		}
 	}
@@ -53,16 +54,47 @@ func checkCustomizationsRule(customization *configv1alpha1.ResourceInterpreterCu
 		return err
 	}
 	defer l.Close()
+	configured := configuredRules(customization)
 	for _, rule := range interpreter.AllResourceInterpreterCustomizationRules {
-		if script := rule.GetScript(customization); script != "" {
+		script := rule.GetScript(customization)
+		expression := rule.GetCELExpression(customization)
+		if configured[rule.Name()] && script == "" && expression == "" {
+			return fmt.Errorf("InterpreterOperation(%s) must set either luaScript or celExpression", rule.Name())
+		}
+		if script != "" && expression != "" {
+			return fmt.Errorf("InterpreterOperation(%s) can not set both luaScript and celExpression", rule.Name())
+		}
+		if script != "" {
 			if _, err = l.LoadString(script); err != nil {
 				return fmt.Errorf("InterpreterOperation(%s) Lua script error: %v", rule.Name(), err)
 			}
 		}
+		if expression != "" {
+			if err = celvm.Compile(configv1alpha1.InterpreterOperation(rule.Name()), expression); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// configuredRules returns the names of the InterpreterOperations present in the customization, no matter
+// whether they set a Lua script or a CEL expression.
+func configuredRules(customization *configv1alpha1.ResourceInterpreterCustomization) map[string]bool {
+	rules := customization.Spec.Customizations
+	return map[string]bool{
+		string(configv1alpha1.InterpreterOperationRetain):                 rules.Retention != nil,
+		string(configv1alpha1.InterpreterOperationInterpretReplica):       rules.ReplicaResource != nil,
+		string(configv1alpha1.InterpreterOperationInterpretComponent):     rules.ComponentResource != nil,
+		string(configv1alpha1.InterpreterOperationReviseReplica):          rules.ReplicaRevision != nil,
+		string(configv1alpha1.InterpreterOperationInterpretStatus):        rules.StatusReflection != nil,
+		string(configv1alpha1.InterpreterOperationAggregateStatus):        rules.StatusAggregation != nil,
+		string(configv1alpha1.InterpreterOperationInterpretHealth):        rules.HealthInterpretation != nil,
+		string(configv1alpha1.InterpreterOperationInterpretRolloutStatus): rules.RolloutStatusInterpretation != nil,
+		string(configv1alpha1.InterpreterOperationInterpretDependency):    rules.DependencyInterpretation != nil,
+	}
+}
+
 func validateResourceInterpreterCustomizations(newConfig *configv1alpha1.ResourceInterpreterCustomization, customizations *configv1alpha1.ResourceInterpreterCustomizationList) error {
 	for _, config := range customizations.Items {
 		// skip self verification
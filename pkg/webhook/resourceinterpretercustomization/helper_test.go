@@ -246,6 +246,34 @@ func Test_validateCustomizationRule(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "the same InterpreterOperation(HealthInterpretation) set by CEL expression and Lua script",
+			args: args{
+				oldRules: &configv1alpha1.ResourceInterpreterCustomization{
+					Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+						Target: configv1alpha1.CustomizationTarget{
+							APIVersion: "foo/v1",
+							Kind:       "kind",
+						},
+						Customizations: configv1alpha1.CustomizationRules{
+							HealthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: "true"},
+						},
+					},
+				},
+				newRules: &configv1alpha1.ResourceInterpreterCustomization{
+					Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+						Target: configv1alpha1.CustomizationTarget{
+							APIVersion: "foo/v1",
+							Kind:       "kind",
+						},
+						Customizations: configv1alpha1.CustomizationRules{
+							HealthInterpretation: &configv1alpha1.HealthInterpretation{LuaScript: "LuaScript"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "the same InterpreterOperation(DependencyInterpretation) of ResourceInterpreterCustomization",
 			args: args{
@@ -482,6 +510,71 @@ end
 			}},
 			wantErr: true,
 		},
+		{
+			name: "correct CEL expression",
+			args: args{customization: &configv1alpha1.ResourceInterpreterCustomization{
+				Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+					Customizations: configv1alpha1.CustomizationRules{
+						ReplicaResource:      &configv1alpha1.ReplicaResourceRequirement{CELExpression: `desiredObj.spec.replicas`},
+						ReplicaRevision:      &configv1alpha1.ReplicaRevision{CELExpression: `{"spec": {"replicas": desiredReplica}}`},
+						HealthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: `observedObj.status.readyReplicas == observedObj.spec.replicas`}},
+				},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "HealthInterpretation contains the CEL expression with syntax error",
+			args: args{customization: &configv1alpha1.ResourceInterpreterCustomization{
+				Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+					Customizations: configv1alpha1.CustomizationRules{
+						HealthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: `observedObj.status.readyReplicas ==`}},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "HealthInterpretation contains the CEL expression returning unexpected type",
+			args: args{customization: &configv1alpha1.ResourceInterpreterCustomization{
+				Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+					Customizations: configv1alpha1.CustomizationRules{
+						HealthInterpretation: &configv1alpha1.HealthInterpretation{CELExpression: `"healthy"`}},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "HealthInterpretation contains both the Lua script and the CEL expression",
+			args: args{customization: &configv1alpha1.ResourceInterpreterCustomization{
+				Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+					Customizations: configv1alpha1.CustomizationRules{
+						HealthInterpretation: &configv1alpha1.HealthInterpretation{
+							LuaScript:     `function InterpretHealth(observedObj) return true end`,
+							CELExpression: `true`,
+						}},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "HealthInterpretation sets neither the Lua script nor the CEL expression",
+			args: args{customization: &configv1alpha1.ResourceInterpreterCustomization{
+				Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+					Customizations: configv1alpha1.CustomizationRules{
+						HealthInterpretation: &configv1alpha1.HealthInterpretation{}},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "DependencyInterpretation sets neither the Lua script nor the CEL expression",
+			args: args{customization: &configv1alpha1.ResourceInterpreterCustomization{
+				Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+					Customizations: configv1alpha1.CustomizationRules{
+						DependencyInterpretation: &configv1alpha1.DependencyInterpretation{}},
+				},
+			}},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {